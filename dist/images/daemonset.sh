@@ -107,6 +107,7 @@ IN_UPGRADE=
 # northd-backoff-interval, in ms
 OVN_NORTHD_BACKOFF_INTERVAL=
 OVN_OBSERV_ENABLE="false"
+OVN_ENABLE_DNS_SNOOPING="false"
 
 # Parse parameters given as arguments to this script.
 while [ "$1" != "" ]; do
@@ -380,6 +381,9 @@ while [ "$1" != "" ]; do
   --enable-observ)
     OVN_OBSERV_ENABLE=$VALUE
     ;;
+  --enable-dns-snooping)
+    OVN_ENABLE_DNS_SNOOPING=$VALUE
+    ;;
   --no-hostsubnet-label)
     OVN_NOHOSTSUBNET_LABEL=$VALUE
     ;;
@@ -590,6 +594,9 @@ echo "ovn_enable_dnsnameresolver: ${ovn_enable_dnsnameresolver}"
 ovn_observ_enable=${OVN_OBSERV_ENABLE}
 echo "ovn_observ_enable: ${ovn_observ_enable}"
 
+ovn_enable_dns_snooping=${OVN_ENABLE_DNS_SNOOPING}
+echo "ovn_enable_dns_snooping: ${ovn_enable_dns_snooping}"
+
 ovn_nohostsubnet_label=${OVN_NOHOSTSUBNET_LABEL}
 echo "ovn_nohostsubnet_label: ${ovn_nohostsubnet_label}"
 
@@ -947,6 +954,7 @@ ovn_image=${ovnkube_image} \
   ovn_enable_svc_template_support=${ovn_enable_svc_template_support} \
   ovn_enable_dnsnameresolver=${ovn_enable_dnsnameresolver} \
   ovn_observ_enable=${ovn_observ_enable} \
+  ovn_enable_dns_snooping=${ovn_enable_dns_snooping} \
   jinjanate ../templates/ovnkube-single-node-zone.yaml.j2 -o ${output_dir}/ovnkube-single-node-zone.yaml
 
 ovn_image=${ovnkube_image} \
@@ -1015,6 +1023,7 @@ ovn_image=${ovnkube_image} \
   ovn_enable_svc_template_support=${ovn_enable_svc_template_support} \
   ovn_enable_dnsnameresolver=${ovn_enable_dnsnameresolver} \
   ovn_observ_enable=${ovn_observ_enable} \
+  ovn_enable_dns_snooping=${ovn_enable_dns_snooping} \
   jinjanate ../templates/ovnkube-zone-controller.yaml.j2 -o ${output_dir}/ovnkube-zone-controller.yaml
 
 ovn_image=${image} \
@@ -1096,13 +1105,17 @@ cp ../templates/rbac-ovnkube-identity.yaml.j2 ${output_dir}/rbac-ovnkube-identit
 cp ../templates/rbac-ovnkube-db.yaml.j2 ${output_dir}/rbac-ovnkube-db.yaml
 cp ../templates/ovnkube-monitor.yaml.j2 ${output_dir}/ovnkube-monitor.yaml
 cp ../templates/k8s.ovn.org_egressfirewalls.yaml.j2 ${output_dir}/k8s.ovn.org_egressfirewalls.yaml
+cp ../templates/k8s.ovn.org_clusteregressfirewalls.yaml.j2 ${output_dir}/k8s.ovn.org_clusteregressfirewalls.yaml
 cp ../templates/k8s.ovn.org_egressips.yaml.j2 ${output_dir}/k8s.ovn.org_egressips.yaml
+cp ../templates/k8s.ovn.org_egressippools.yaml.j2 ${output_dir}/k8s.ovn.org_egressippools.yaml
 cp ../templates/k8s.ovn.org_egressqoses.yaml.j2 ${output_dir}/k8s.ovn.org_egressqoses.yaml
 cp ../templates/k8s.ovn.org_egressservices.yaml.j2 ${output_dir}/k8s.ovn.org_egressservices.yaml
 cp ../templates/k8s.ovn.org_adminpolicybasedexternalroutes.yaml.j2 ${output_dir}/k8s.ovn.org_adminpolicybasedexternalroutes.yaml
 cp ../templates/k8s.ovn.org_networkqoses.yaml.j2 ${output_dir}/k8s.ovn.org_networkqoses.yaml
 cp ../templates/k8s.ovn.org_userdefinednetworks.yaml.j2 ${output_dir}/k8s.ovn.org_userdefinednetworks.yaml
 cp ../templates/k8s.ovn.org_clusteruserdefinednetworks.yaml.j2 ${output_dir}/k8s.ovn.org_clusteruserdefinednetworks.yaml
+cp ../templates/k8s.ovn.org_networkpeerings.yaml.j2 ${output_dir}/k8s.ovn.org_networkpeerings.yaml
 cp ../templates/k8s.ovn.org_routeadvertisements.yaml.j2 ${output_dir}/k8s.ovn.org_routeadvertisements.yaml
+cp ../templates/k8s.ovn.org_routeimportpolicies.yaml.j2 ${output_dir}/k8s.ovn.org_routeimportpolicies.yaml
 
 exit 0
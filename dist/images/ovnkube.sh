@@ -76,6 +76,7 @@ fi
 # OVN_ADMIN_NETWORK_POLICY_ENABLE - enable admin network policy for ovn-kubernetes
 # OVN_EGRESSIP_ENABLE - enable egress IP for ovn-kubernetes
 # OVN_EGRESSIP_HEALTHCHECK_PORT - egress IP node check to use grpc on this port (0 ==> dial to port 9 instead)
+# OVN_EGRESSIP_REACHABILITY_MODE - egress IP node check mode, grpc or bfd (bfd falls back to grpc)
 # OVN_EGRESSFIREWALL_ENABLE - enable egressFirewall for ovn-kubernetes
 # OVN_EGRESSQOS_ENABLE - enable egress QoS for ovn-kubernetes
 # OVN_EGRESSSERVICE_ENABLE - enable egress Service for ovn-kubernetes
@@ -97,6 +98,7 @@ fi
 # OVN_ENABLE_SVC_TEMPLATE_SUPPORT - enable svc template support
 # OVN_ENABLE_DNSNAMERESOLVER - enable dns name resolver support
 # OVN_OBSERV_ENABLE - enable observability for ovnkube
+# OVN_ENABLE_DNS_SNOOPING - enable dns snooping for egress firewall dns names
 
 # The argument to the command is the operation to be performed
 # ovn-master ovn-controller ovn-node display display_env ovn_debug
@@ -257,6 +259,8 @@ ovn_admin_network_policy_enable=${OVN_ADMIN_NETWORK_POLICY_ENABLE:=false}
 ovn_egressip_enable=${OVN_EGRESSIP_ENABLE:-false}
 #OVN_EGRESSIP_HEALTHCHECK_PORT - egress IP node check to use grpc on this port
 ovn_egress_ip_healthcheck_port=${OVN_EGRESSIP_HEALTHCHECK_PORT:-9107}
+#OVN_EGRESSIP_REACHABILITY_MODE - egress IP node check mode, grpc or bfd
+ovn_egressip_reachability_mode=${OVN_EGRESSIP_REACHABILITY_MODE:-}
 #OVN_EGRESSFIREWALL_ENABLE - enable egressFirewall for ovn-kubernetes
 ovn_egressfirewall_enable=${OVN_EGRESSFIREWALL_ENABLE:-false}
 #OVN_EGRESSQOS_ENABLE - enable egress QoS for ovn-kubernetes
@@ -322,6 +326,8 @@ ovn_network_qos_enable=${OVN_NETWORK_QOS_ENABLE:-false}
 ovn_enable_dnsnameresolver=${OVN_ENABLE_DNSNAMERESOLVER:-false}
 # OVN_OBSERV_ENABLE - enable observability for ovnkube
 ovn_observ_enable=${OVN_OBSERV_ENABLE:-false}
+# OVN_ENABLE_DNS_SNOOPING - enable dns snooping for egress firewall dns names
+ovn_enable_dns_snooping=${OVN_ENABLE_DNS_SNOOPING:-false}
 # OVN_NOHOSTSUBNET_LABEL - node label indicating nodes managing their own network
 ovn_nohostsubnet_label=${OVN_NOHOSTSUBNET_LABEL:-""}
 # OVN_DISABLE_REQUESTEDCHASSIS - disable requested-chassis option during pod creation
@@ -1269,6 +1275,11 @@ ovn-master() {
       egressip_healthcheck_port_flag="--egressip-node-healthcheck-port=${ovn_egress_ip_healthcheck_port}"
   fi
 
+  egressip_reachability_mode_flag=
+  if [[ -n "${ovn_egressip_reachability_mode}" ]]; then
+      egressip_reachability_mode_flag="--egressip-reachability-mode=${ovn_egressip_reachability_mode}"
+  fi
+
   egressfirewall_enabled_flag=
   if [[ ${ovn_egressfirewall_enable} == "true" ]]; then
 	  egressfirewall_enabled_flag="--enable-egress-firewall"
@@ -1400,6 +1411,7 @@ ovn-master() {
     ${egressfirewall_enabled_flag} \
     ${egressip_enabled_flag} \
     ${egressip_healthcheck_port_flag} \
+    ${egressip_reachability_mode_flag} \
     ${egressqos_enabled_flag} \
     ${egressservice_enabled_flag} \
     ${empty_lb_events_flag} \
@@ -1575,6 +1587,11 @@ ovnkube-controller() {
   if [[ -n "${ovn_egress_ip_healthcheck_port}" ]]; then
       egressip_healthcheck_port_flag="--egressip-node-healthcheck-port=${ovn_egress_ip_healthcheck_port}"
   fi
+
+  egressip_reachability_mode_flag=
+  if [[ -n "${ovn_egressip_reachability_mode}" ]]; then
+      egressip_reachability_mode_flag="--egressip-reachability-mode=${ovn_egressip_reachability_mode}"
+  fi
   echo "egressip_healthcheck_port_flag=${egressip_healthcheck_port_flag}"
 
   egressfirewall_enabled_flag=
@@ -1711,6 +1728,12 @@ ovnkube-controller() {
   fi
   echo "ovn_observ_enable_flag=${ovn_observ_enable_flag}"
 
+  ovn_enable_dns_snooping_flag=
+  if [[ ${ovn_enable_dns_snooping} == "true" ]]; then
+    ovn_enable_dns_snooping_flag="--enable-dns-snooping"
+  fi
+  echo "ovn_enable_dns_snooping_flag=${ovn_enable_dns_snooping_flag}"
+
 
   ovn_stateless_netpol_enable_flag=
   if [[ ${ovn_stateless_netpol_enable} == "true" ]]; then
@@ -1725,6 +1748,7 @@ ovnkube-controller() {
     ${egressfirewall_enabled_flag} \
     ${egressip_enabled_flag} \
     ${egressip_healthcheck_port_flag} \
+    ${egressip_reachability_mode_flag} \
     ${egressqos_enabled_flag} \
     ${egressservice_enabled_flag} \
     ${empty_lb_events_flag} \
@@ -1740,6 +1764,7 @@ ovnkube-controller() {
     ${ovn_dbs} \
     ${ovn_enable_svc_template_support_flag} \
     ${ovn_observ_enable_flag} \
+    ${ovn_enable_dns_snooping_flag} \
     ${ovnkube_config_duration_enable_flag} \
     ${ovnkube_enable_interconnect_flag} \
     ${ovnkube_local_cert_flags} \
@@ -1913,6 +1938,11 @@ ovnkube-controller-with-node() {
   if [[ -n "${ovn_egress_ip_healthcheck_port}" ]]; then
       egressip_healthcheck_port_flag="--egressip-node-healthcheck-port=${ovn_egress_ip_healthcheck_port}"
   fi
+
+  egressip_reachability_mode_flag=
+  if [[ -n "${ovn_egressip_reachability_mode}" ]]; then
+      egressip_reachability_mode_flag="--egressip-reachability-mode=${ovn_egressip_reachability_mode}"
+  fi
   echo "egressip_healthcheck_port_flag=${egressip_healthcheck_port_flag}"
 
   egressfirewall_enabled_flag=
@@ -2181,6 +2211,12 @@ ovnkube-controller-with-node() {
   fi
   echo "ovn_observ_enable_flag=${ovn_observ_enable_flag}"
 
+  ovn_enable_dns_snooping_flag=
+  if [[ ${ovn_enable_dns_snooping} == "true" ]]; then
+    ovn_enable_dns_snooping_flag="--enable-dns-snooping"
+  fi
+  echo "ovn_enable_dns_snooping_flag=${ovn_enable_dns_snooping_flag}"
+
   ovn_stateless_netpol_enable_flag=
   if [[ ${ovn_stateless_netpol_enable} == "true" ]]; then
           ovn_stateless_netpol_enable_flag="--enable-stateless-netpol"
@@ -2203,6 +2239,7 @@ ovnkube-controller-with-node() {
     ${egress_interface} \
     ${egressip_enabled_flag} \
     ${egressip_healthcheck_port_flag} \
+    ${egressip_reachability_mode_flag} \
     ${egressqos_enabled_flag} \
     ${egressservice_enabled_flag} \
     ${empty_lb_events_flag} \
@@ -2226,6 +2263,7 @@ ovnkube-controller-with-node() {
     ${ovn_dbs} \
     ${ovn_enable_svc_template_support_flag} \
     ${ovn_observ_enable_flag} \
+    ${ovn_enable_dns_snooping_flag} \
     ${ovn_encap_ip_flag} \
     ${ovn_encap_port_flag} \
     ${ovnkube_config_duration_enable_flag} \
@@ -2297,6 +2335,11 @@ ovn-cluster-manager() {
   if [[ -n "${ovn_egress_ip_healthcheck_port}" ]]; then
       egressip_healthcheck_port_flag="--egressip-node-healthcheck-port=${ovn_egress_ip_healthcheck_port}"
   fi
+
+  egressip_reachability_mode_flag=
+  if [[ -n "${ovn_egressip_reachability_mode}" ]]; then
+      egressip_reachability_mode_flag="--egressip-reachability-mode=${ovn_egressip_reachability_mode}"
+  fi
   echo "egressip_flags: ${egressip_enabled_flag}, ${egressip_healthcheck_port_flag}"
 
   egressservice_enabled_flag=
@@ -2457,6 +2500,7 @@ ovn-cluster-manager() {
     ${egressfirewall_enabled_flag} \
     ${egressip_enabled_flag} \
     ${egressip_healthcheck_port_flag} \
+    ${egressip_reachability_mode_flag} \
     ${egressqos_enabled_flag} \
     ${egressservice_enabled_flag} \
     ${empty_lb_events_flag} \
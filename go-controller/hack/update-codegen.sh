@@ -121,8 +121,12 @@ sed -i -e':begin;$!N;s/.*metadata:\n.*type: object/&\n            properties:\n
 echo "Copying the CRDs to dist/templates as j2 files... Add them to your commit..."
 echo "Copying egressFirewall CRD"
 cp _output/crds/k8s.ovn.org_egressfirewalls.yaml ../dist/templates/k8s.ovn.org_egressfirewalls.yaml.j2
+echo "Copying clusterEgressFirewall CRD"
+cp _output/crds/k8s.ovn.org_clusteregressfirewalls.yaml ../dist/templates/k8s.ovn.org_clusteregressfirewalls.yaml.j2
 echo "Copying egressIP CRD"
 cp _output/crds/k8s.ovn.org_egressips.yaml ../dist/templates/k8s.ovn.org_egressips.yaml.j2
+echo "Copying egressIPPool CRD"
+cp _output/crds/k8s.ovn.org_egressippools.yaml ../dist/templates/k8s.ovn.org_egressippools.yaml.j2
 echo "Copying egressQoS CRD"
 cp _output/crds/k8s.ovn.org_egressqoses.yaml ../dist/templates/k8s.ovn.org_egressqoses.yaml.j2
 echo "Copying adminpolicybasedexternalroutes CRD"
@@ -135,5 +139,9 @@ echo "Copying userdefinednetworks CRD"
 cp _output/crds/k8s.ovn.org_userdefinednetworks.yaml ../dist/templates/k8s.ovn.org_userdefinednetworks.yaml.j2
 echo "Copying clusteruserdefinednetworks CRD"
 cp _output/crds/k8s.ovn.org_clusteruserdefinednetworks.yaml ../dist/templates/k8s.ovn.org_clusteruserdefinednetworks.yaml.j2
+echo "Copying networkpeerings CRD"
+cp _output/crds/k8s.ovn.org_networkpeerings.yaml ../dist/templates/k8s.ovn.org_networkpeerings.yaml.j2
 echo "Copying routeAdvertisements CRD"
 cp _output/crds/k8s.ovn.org_routeadvertisements.yaml ../dist/templates/k8s.ovn.org_routeadvertisements.yaml.j2
+echo "Copying routeImportPolicies CRD"
+cp _output/crds/k8s.ovn.org_routeimportpolicies.yaml ../dist/templates/k8s.ovn.org_routeimportpolicies.yaml.j2
@@ -43,6 +43,8 @@ type StaticAllocator interface {
 type ContinuousAllocator interface {
 	StaticAllocator
 	AllocateNext() (net.IP, error)
+	Free() int
+	Used() int
 }
 
 var (
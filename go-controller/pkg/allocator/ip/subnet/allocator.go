@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net"
 	"reflect"
+	"slices"
 	"sync"
 
 	iputils "github.com/containernetworking/plugins/pkg/ip"
@@ -29,6 +30,7 @@ type SubnetConfig struct {
 // identified by a name. Allocator should be threadsafe.
 type Allocator interface {
 	AddOrUpdateSubnet(config SubnetConfig) error
+	AddSubnets(config SubnetConfig) error
 	DeleteSubnet(name string)
 	GetSubnets(name string) ([]*net.IPNet, error)
 	AllocateUntilFull(name string) error
@@ -38,6 +40,7 @@ type Allocator interface {
 	ConditionalIPRelease(name string, ips []*net.IPNet, predicate func() (bool, error)) (bool, error)
 	ForSubnet(name string) NamedAllocator
 	GetSubnetName(subnets []*net.IPNet) (string, bool)
+	Usage() (v4, v6 IPUsage)
 }
 
 // NamedAllocator manages the allocation of IPs within a specific subnet
@@ -57,6 +60,10 @@ type subnetInfo struct {
 	subnets []*net.IPNet
 	// ipams holds continuous IP allocators for dynamic IP allocation within the managed subnets.
 	ipams []ipallocator.ContinuousAllocator
+	// appendedFrom is the index of the first subnet appended with AddSubnets.
+	// Appended subnets are only used for dynamic IP allocation once the
+	// configured subnets of the same IP family are full.
+	appendedFrom int
 	// staticIPAMs holds static IP allocators for reserved subnets that support static IP allocation (currently only supported for Layer2 primary networks)
 	staticIPAMs []ipallocator.StaticAllocator
 }
@@ -161,13 +168,66 @@ func (allocator *allocator) AddOrUpdateSubnet(config SubnetConfig) error {
 		}
 	}
 	allocator.cache[config.Name] = subnetInfo{
-		subnets:     config.Subnets,
-		ipams:       ipams,
-		staticIPAMs: staticIPAMs,
+		subnets:      config.Subnets,
+		ipams:        ipams,
+		appendedFrom: len(config.Subnets),
+		staticIPAMs:  staticIPAMs,
 	}
 	return nil
 }
 
+// AddSubnets appends subnets to an existing subnet set preserving the current
+// allocations. Subnets already managed for the set are ignored, and excluded
+// subnets must be contained in the appended subnets. Reserved subnets are not
+// supported.
+func (allocator *allocator) AddSubnets(config SubnetConfig) error {
+	allocator.Lock()
+	defer allocator.Unlock()
+	subnetInfo, ok := allocator.cache[config.Name]
+	if !ok {
+		return fmt.Errorf("failed to add subnets %v for %s: %w", util.StringSlice(config.Subnets), config.Name, ErrSubnetNotFound)
+	}
+	if len(config.ReservedSubnets) > 0 {
+		return fmt.Errorf("failed to add subnets %v for %s: reserved subnets are not supported", util.StringSlice(config.Subnets), config.Name)
+	}
+	var subnets []*net.IPNet
+	var ipams []ipallocator.ContinuousAllocator
+	for _, subnet := range config.Subnets {
+		if slices.ContainsFunc(append(subnetInfo.subnets, subnets...), func(existing *net.IPNet) bool {
+			return existing.String() == subnet.String()
+		}) {
+			continue
+		}
+		ipam, err := allocator.ipamFunc(subnet)
+		if err != nil {
+			return fmt.Errorf("failed to initialize IPAM of subnet %s for %s: %w", subnet, config.Name, err)
+		}
+		subnets = append(subnets, subnet)
+		ipams = append(ipams, ipam)
+	}
+
+	for _, excludeFromIPAM := range config.ExcludeSubnets {
+		var excluded bool
+		for i, subnet := range subnets {
+			if util.ContainsCIDR(subnet, excludeFromIPAM) {
+				err := reserveSubnets(excludeFromIPAM, ipams[i])
+				if err != nil {
+					return fmt.Errorf("failed to exclude subnet %s for %s: %w", excludeFromIPAM, config.Name, err)
+				}
+				excluded = true
+			}
+		}
+		if !excluded {
+			return fmt.Errorf("failed to exclude subnet %s for %s: not contained in any of the added subnets", excludeFromIPAM, config.Name)
+		}
+	}
+
+	subnetInfo.subnets = append(slices.Clone(subnetInfo.subnets), subnets...)
+	subnetInfo.ipams = append(slices.Clone(subnetInfo.ipams), ipams...)
+	allocator.cache[config.Name] = subnetInfo
+	return nil
+}
+
 // DeleteSubnet from the allocator
 func (allocator *allocator) DeleteSubnet(name string) {
 	allocator.Lock()
@@ -330,12 +390,13 @@ func (allocator *allocator) AllocateNextIPs(name string) ([]*net.IPNet, error) {
 			" don't match number of ipam instances %d", name, len(subnetInfo.subnets), len(subnetInfo.ipams))
 	}
 
+	var allocatedIdxs []int
 	defer func() {
 		if err != nil {
 			// iterate over range of already allocated indices and release
 			// ips allocated before the error occurred.
-			for relIdx, relIPNet := range ipnets {
-				subnetInfo.ipams[relIdx].Release(relIPNet.IP)
+			for i, relIPNet := range ipnets {
+				subnetInfo.ipams[allocatedIdxs[i]].Release(relIPNet.IP)
 				if relIPNet.IP != nil {
 					klog.Warningf("Reserved IP %s was released for %s", relIPNet.IP, name)
 				}
@@ -343,8 +404,23 @@ func (allocator *allocator) AllocateNextIPs(name string) ([]*net.IPNet, error) {
 		}
 	}()
 
-	for idx, ipam := range subnetInfo.ipams {
-		ip, err = ipam.AllocateNext()
+	for idx := 0; idx < subnetInfo.appendedFrom; idx++ {
+		allocatedIdx := idx
+		ip, err = subnetInfo.ipams[idx].AllocateNext()
+		if errors.Is(err, ipallocator.ErrFull) {
+			// fallback to the appended subnets of the same IP family
+			isIPv6 := utilnet.IsIPv6CIDR(subnetInfo.subnets[idx])
+			for appendedIdx := subnetInfo.appendedFrom; appendedIdx < len(subnetInfo.ipams); appendedIdx++ {
+				if utilnet.IsIPv6CIDR(subnetInfo.subnets[appendedIdx]) != isIPv6 {
+					continue
+				}
+				allocatedIdx = appendedIdx
+				ip, err = subnetInfo.ipams[appendedIdx].AllocateNext()
+				if !errors.Is(err, ipallocator.ErrFull) {
+					break
+				}
+			}
+		}
 		if err != nil {
 			if errors.Is(err, ipallocator.ErrFull) {
 				err = fmt.Errorf("failed to allocate new IPs for %s: %w", name, ipallocator.ErrFull)
@@ -353,9 +429,10 @@ func (allocator *allocator) AllocateNextIPs(name string) ([]*net.IPNet, error) {
 		}
 		ipnet := &net.IPNet{
 			IP:   ip,
-			Mask: subnetInfo.subnets[idx].Mask,
+			Mask: subnetInfo.subnets[allocatedIdx].Mask,
 		}
 		ipnets = append(ipnets, ipnet)
+		allocatedIdxs = append(allocatedIdxs, allocatedIdx)
 	}
 	return ipnets, nil
 }
@@ -476,6 +553,32 @@ func (allocator *allocator) GetSubnetName(subnets []*net.IPNet) (string, bool) {
 	return "", false
 }
 
+// IPUsage holds the number of allocated and free IPs of an IP family
+type IPUsage struct {
+	Allocated uint64
+	Free      uint64
+}
+
+// Usage returns the number of allocated and free IPs of each IP family
+// across all the subnet sets of the allocator. IPs reserved for the network
+// infrastructure or excluded from dynamic allocation count as allocated.
+func (allocator *allocator) Usage() (v4, v6 IPUsage) {
+	allocator.RLock()
+	defer allocator.RUnlock()
+	for _, subnetInfo := range allocator.cache {
+		for _, ipam := range subnetInfo.ipams {
+			ipamCIDR := ipam.CIDR()
+			usage := &v4
+			if utilnet.IsIPv6CIDR(&ipamCIDR) {
+				usage = &v6
+			}
+			usage.Allocated += uint64(ipam.Used())
+			usage.Free += uint64(ipam.Free())
+		}
+	}
+	return v4, v6
+}
+
 type IPAllocator struct {
 	allocator *allocator
 	name      string
@@ -129,6 +129,26 @@ var _ = ginkgo.Describe("Subnet IP allocator operations", func() {
 			}
 		})
 
+		ginkgo.It("reports the IP usage of each IP family", func() {
+			err := allocator.AddOrUpdateSubnet(SubnetConfig{
+				Name:           subnetName,
+				Subnets:        ovntest.MustParseIPNets("10.1.1.0/29", "2000::/125"),
+				ExcludeSubnets: ovntest.MustParseIPNets("10.1.1.6/32"),
+			})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			err = allocator.AddOrUpdateSubnet(SubnetConfig{
+				Name:    "subnet2",
+				Subnets: ovntest.MustParseIPNets("10.1.2.0/29"),
+			})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			_, err = allocator.AllocateNextIPs(subnetName)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+			v4, v6 := allocator.Usage()
+			gomega.Expect(v4).To(gomega.Equal(IPUsage{Allocated: 2, Free: 10}))
+			gomega.Expect(v6).To(gomega.Equal(IPUsage{Allocated: 1, Free: 6}))
+		})
+
 		ginkgo.It("IPAM allocates, releases, and reallocates IPs correctly", func() {
 			subnets := []string{
 				"10.1.1.0/24",
@@ -242,6 +262,67 @@ var _ = ginkgo.Describe("Subnet IP allocator operations", func() {
 			gomega.Expect(err).To(gomega.MatchError(ipam.ErrAllocated))
 		})
 
+		ginkgo.It("allocates from appended subnets once the subnets of the same IP family are full", func() {
+			subnets := []string{
+				"10.1.1.0/30",
+				"2000::/64",
+			}
+
+			err := allocator.AddOrUpdateSubnet(SubnetConfig{
+				Name:    subnetName,
+				Subnets: ovntest.MustParseIPNets(subnets...),
+			})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+			ips, err := allocator.AllocateNextIPs(subnetName)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(util.StringSlice(ips)).To(gomega.Equal([]string{"10.1.1.1/30", "2000::1/64"}))
+			ips, err = allocator.AllocateNextIPs(subnetName)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(util.StringSlice(ips)).To(gomega.Equal([]string{"10.1.1.2/30", "2000::2/64"}))
+			_, err = allocator.AllocateNextIPs(subnetName)
+			gomega.Expect(err).To(gomega.MatchError(ipam.ErrFull))
+
+			err = allocator.AddSubnets(SubnetConfig{
+				Name:           subnetName,
+				Subnets:        ovntest.MustParseIPNets("10.1.1.0/30", "10.1.2.0/29"),
+				ExcludeSubnets: ovntest.MustParseIPNets("10.1.2.1/32"),
+			})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(allocator.GetSubnets(subnetName)).To(gomega.Equal(ovntest.MustParseIPNets("10.1.1.0/30", "2000::/64", "10.1.2.0/29")))
+
+			// previous allocations are preserved
+			err = allocator.AllocateIPPerSubnet(subnetName, ovntest.MustParseIPNets("10.1.1.1/30"))
+			gomega.Expect(err).To(gomega.MatchError(ipam.ErrAllocated))
+
+			ips, err = allocator.AllocateNextIPs(subnetName)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(util.StringSlice(ips)).To(gomega.Equal([]string{"10.1.2.2/29", "2000::3/64"}))
+		})
+
+		ginkgo.It("fails to append subnets to an unknown subnet set", func() {
+			err := allocator.AddSubnets(SubnetConfig{
+				Name:    subnetName,
+				Subnets: ovntest.MustParseIPNets("10.1.2.0/24"),
+			})
+			gomega.Expect(err).To(gomega.MatchError(ErrSubnetNotFound))
+		})
+
+		ginkgo.It("fails to exclude subnets not contained in the appended subnets", func() {
+			err := allocator.AddOrUpdateSubnet(SubnetConfig{
+				Name:    subnetName,
+				Subnets: ovntest.MustParseIPNets("10.1.1.0/24"),
+			})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			err = allocator.AddSubnets(SubnetConfig{
+				Name:           subnetName,
+				Subnets:        ovntest.MustParseIPNets("10.1.2.0/24"),
+				ExcludeSubnets: ovntest.MustParseIPNets("10.1.1.10/32"),
+			})
+			gomega.Expect(err).To(gomega.MatchError(gomega.ContainSubstring("not contained in any of the added subnets")))
+			gomega.Expect(allocator.GetSubnets(subnetName)).To(gomega.Equal(ovntest.MustParseIPNets("10.1.1.0/24")))
+		})
+
 	})
 
 	// Reserved subnets test cases
@@ -12,6 +12,7 @@ import (
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/clustermanager/dnsnameresolver"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/clustermanager/egressservice"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/clustermanager/endpointslicemirror"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/clustermanager/networkpeering"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/clustermanager/routeadvertisements"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/clustermanager/status_manager"
 	udncontroller "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/clustermanager/userdefinednetwork"
@@ -43,6 +44,8 @@ type ClusterManager struct {
 	dnsNameResolverController *dnsnameresolver.Controller
 	// Controller for managing user-defined-network CRD
 	userDefinedNetworkController *udncontroller.Controller
+	// Controller for validating NetworkPeerings
+	networkPeeringController *networkpeering.Controller
 	// event recorder used to post events to k8s
 	recorder record.EventRecorder
 
@@ -154,7 +157,9 @@ func NewClusterManager(
 		cm.userDefinedNetworkController = udnController
 		if cm.udnClusterManager != nil {
 			cm.udnClusterManager.SetNetworkStatusReporter(udnController.UpdateSubsystemCondition)
+			cm.udnClusterManager.SetNetworkUsageReporter(udnController.UpdateNetworkUsage)
 		}
+		cm.networkPeeringController = networkpeering.NewController(cm.networkManager.Interface(), wf, ovnClient)
 	}
 
 	if util.IsRouteAdvertisementsEnabled() {
@@ -217,6 +222,9 @@ func (cm *ClusterManager) Start(ctx context.Context) error {
 		if err := cm.userDefinedNetworkController.Run(); err != nil {
 			return err
 		}
+		if err := cm.networkPeeringController.Start(); err != nil {
+			return err
+		}
 	}
 
 	if cm.raController != nil {
@@ -250,6 +258,7 @@ func (cm *ClusterManager) Stop() {
 	}
 	if util.IsNetworkSegmentationSupportEnabled() {
 		cm.userDefinedNetworkController.Shutdown()
+		cm.networkPeeringController.Stop()
 	}
 	if cm.raController != nil {
 		cm.raController.Stop()
@@ -273,5 +282,8 @@ func (cm *ClusterManager) Reconcile(name string, old, new util.NetInfo) error {
 	if cm.raController != nil {
 		cm.raController.ReconcileNetwork(name, old, new)
 	}
+	if cm.networkPeeringController != nil {
+		cm.networkPeeringController.ReconcileNetwork(name, old, new)
+	}
 	return nil
 }
@@ -67,6 +67,24 @@ var _ = ginkgo.Describe("Cluster manager DNS Name Resolver Controller operations
 		}
 	}
 
+	buildClusterEgressFirewall := func(name, dnsName string) *egressfirewallapi.ClusterEgressFirewall {
+		return &egressfirewallapi.ClusterEgressFirewall{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+			Spec: egressfirewallapi.ClusterEgressFirewallSpec{
+				Egress: []egressfirewallapi.EgressFirewallRule{
+					{
+						Type: egressfirewallapi.EgressFirewallRuleAllow,
+						To: egressfirewallapi.EgressFirewallDestination{
+							DNSName: dnsName,
+						},
+					},
+				},
+			},
+		}
+	}
+
 	buildDNSNameResolver := func(name, namespace, dnsName string) *ocpnetworkapiv1alpha1.DNSNameResolver {
 		return &ocpnetworkapiv1alpha1.DNSNameResolver{
 			ObjectMeta: metav1.ObjectMeta{
@@ -318,6 +336,46 @@ var _ = ginkgo.Describe("Cluster manager DNS Name Resolver Controller operations
 				Delete(context.Background(), egressFirewall2.Name, metav1.DeleteOptions{})
 			gomega.Expect(err).NotTo(gomega.HaveOccurred())
 
+			ginkgo.By("checking if the dns name resolver object is correctly deleted")
+			checkDNSNameResolverRemoved(dnsNameResolver.Name)
+		})
+		ginkgo.It("correctly create and delete a dns name resolver shared by an egress firewall and a cluster egress firewall", func() {
+			var err error
+			dnsName := "www.example.com"
+			namespace := "namespace1"
+			egressFirewall := buildEgressFirewall("default", namespace, dnsName)
+			clusterEgressFirewall := buildClusterEgressFirewall("cef1", dnsName)
+			ginkgo.By("starting the cluster manager")
+			start()
+
+			ginkgo.By("creating the cluster egress firewall object")
+			_, err = fakeClient.EgressFirewallClient.K8sV1().ClusterEgressFirewalls().
+				Create(context.TODO(), clusterEgressFirewall, metav1.CreateOptions{})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+			ginkgo.By("checking if the corresponding dns name resolver object got created")
+			dnsNameResolver := checkDNSNameResolverExists(dnsName)
+
+			ginkgo.By("creating the egress firewall object using the same dns name")
+			_, err = fakeClient.EgressFirewallClient.K8sV1().EgressFirewalls(namespace).
+				Create(context.TODO(), egressFirewall, metav1.CreateOptions{})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+			ginkgo.By("deleting the cluster egress firewall object")
+			err = fakeClient.EgressFirewallClient.K8sV1().ClusterEgressFirewalls().
+				Delete(context.Background(), clusterEgressFirewall.Name, metav1.DeleteOptions{})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+			ginkgo.By("checking if the dns name resolver object is not deleted while the egress firewall uses it")
+			gomega.Consistently(func() string {
+				return checkDNSNameResolverExists(dnsName).Name
+			}).Should(gomega.Equal(dnsNameResolver.Name))
+
+			ginkgo.By("deleting the egress firewall object")
+			err = fakeClient.EgressFirewallClient.K8sV1().EgressFirewalls(namespace).
+				Delete(context.Background(), egressFirewall.Name, metav1.DeleteOptions{})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
 			ginkgo.By("checking if the dns name resolver object is correctly deleted")
 			checkDNSNameResolverRemoved(dnsNameResolver.Name)
 		})
@@ -8,6 +8,7 @@ import (
 	"net"
 	"os"
 	"reflect"
+	"slices"
 	"sort"
 	"strings"
 	"sync"
@@ -107,9 +108,15 @@ type egressNode struct {
 	isReady            bool
 	isReachable        bool
 	isEgressAssignable bool
+	isDraining         bool
 	name               string
 }
 
+// isAssignable returns true if egress IPs can be assigned to the node
+func (e *egressNode) isAssignable() bool {
+	return e.isEgressAssignable && e.isReady && e.isReachable && !e.isDraining
+}
+
 func (e *egressNode) getAllocationCountForEgressIP(name string) (count int) {
 	for _, egressIPName := range e.allocations {
 		if egressIPName == name {
@@ -137,6 +144,10 @@ type nodeAllocator struct {
 	// A cache used for egress IP assignments containing data for all cluster nodes
 	// used for egress IP assignments
 	cache map[string]*egressNode
+	// preferredNodes maps the name of the EgressIPs with a Preemptive failover
+	// policy to their egress IPs and the node each egress IP was first
+	// assigned to, which the egress IP moves back to once it can host it again
+	preferredNodes map[string]map[string]string
 }
 
 type cloudPrivateIPConfigOp struct {
@@ -342,6 +353,10 @@ type egressIPClusterController struct {
 	// health-checking and tracking allocations made
 	nodeAllocator nodeAllocator
 	markAllocator id.Allocator
+	// poolAllocations maps the egress IPs allocated from EgressIPPools to the
+	// name of the EgressIP they are allocated to, it is protected by
+	// egressIPAssignmentMutex.
+	poolAllocations map[string]string
 	// watchFactory watching k8s objects
 	watchFactory *factory.WatchFactory
 	// EgressIP Node reachability total timeout configuration
@@ -350,18 +365,28 @@ type egressIPClusterController struct {
 	reachabilityCheckInterval time.Duration
 	// EgressIP Node reachability gRPC port (0 means it should use dial instead)
 	egressIPNodeHealthCheckPort int
+	// EgressIP Node reachability mode, BFD falls back to gRPC / dial
+	egressIPReachabilityMode string
+	// reachabilityCheckTrigger requests a reachability check before the next
+	// check interval, when the status of the BFD sessions between egress nodes
+	// changes
+	reachabilityCheckTrigger chan struct{}
 	// retry framework for Egress nodes
 	retryEgressNodes *objretry.RetryFramework
 	// retry framework for egress IP
 	retryEgressIPs *objretry.RetryFramework
 	// retry framework for Cloud private IP config
 	retryCloudPrivateIPConfig *objretry.RetryFramework
+	// retry framework for egress IP pools
+	retryEgressIPPools *objretry.RetryFramework
 	// egressNodes events factory handler
 	egressNodeHandler *factory.Handler
 	// egressIP events factory handler
 	egressIPHandler *factory.Handler
 	// cloudPrivateIPConfig events factory handler
 	cloudPrivateIPConfigHandler *factory.Handler
+	// egressIPPool events factory handler
+	egressIPPoolHandler *factory.Handler
 }
 
 func newEgressIPController(ovnClient *util.OVNClusterManagerClientset, wf *factory.WatchFactory, recorder record.EventRecorder) *egressIPClusterController {
@@ -379,13 +404,16 @@ func newEgressIPController(ovnClient *util.OVNClusterManagerClientset, wf *facto
 		egressIPAssignmentMutex:           &sync.Mutex{},
 		pendingCloudPrivateIPConfigsMutex: &sync.Mutex{},
 		pendingCloudPrivateIPConfigsOps:   make(map[string]map[string]*cloudPrivateIPConfigOp),
-		nodeAllocator:                     nodeAllocator{&sync.Mutex{}, make(map[string]*egressNode)},
+		nodeAllocator:                     nodeAllocator{&sync.Mutex{}, make(map[string]*egressNode), make(map[string]map[string]string)},
 		markAllocator:                     markAllocator,
+		poolAllocations:                   make(map[string]string),
 		watchFactory:                      wf,
 		recorder:                          recorder,
 		egressIPTotalTimeout:              config.OVNKubernetesFeature.EgressIPReachabiltyTotalTimeout,
 		reachabilityCheckInterval:         egressIPReachabilityCheckInterval,
 		egressIPNodeHealthCheckPort:       config.OVNKubernetesFeature.EgressIPNodeHealthCheckPort,
+		egressIPReachabilityMode:          config.OVNKubernetesFeature.EgressIPReachabilityMode,
+		reachabilityCheckTrigger:          make(chan struct{}, 1),
 		stopChan:                          make(chan struct{}),
 	}
 	eIPC.initRetryFramework()
@@ -395,6 +423,7 @@ func newEgressIPController(ovnClient *util.OVNClusterManagerClientset, wf *facto
 func (eIPC *egressIPClusterController) initRetryFramework() {
 	eIPC.retryEgressNodes = eIPC.newRetryFramework(factory.EgressNodeType)
 	eIPC.retryEgressIPs = eIPC.newRetryFramework(factory.EgressIPType)
+	eIPC.retryEgressIPPools = eIPC.newRetryFramework(factory.EgressIPPoolType)
 	if util.PlatformTypeIsEgressIPCloudProvider() {
 		eIPC.retryCloudPrivateIPConfig = eIPC.newRetryFramework(factory.CloudPrivateIPConfigType)
 	}
@@ -425,6 +454,9 @@ func (eIPC *egressIPClusterController) Start() error {
 	if eIPC.egressIPHandler, err = eIPC.WatchEgressIP(); err != nil {
 		return err
 	}
+	if eIPC.egressIPPoolHandler, err = eIPC.WatchEgressIPPool(); err != nil {
+		return err
+	}
 	if util.PlatformTypeIsEgressIPCloudProvider() {
 		if eIPC.cloudPrivateIPConfigHandler, err = eIPC.WatchCloudPrivateIPConfig(); err != nil {
 			return err
@@ -436,6 +468,10 @@ func (eIPC *egressIPClusterController) Start() error {
 		klog.Infof("EgressIP node reachability enabled and using gRPC port %d",
 			config.OVNKubernetesFeature.EgressIPNodeHealthCheckPort)
 	}
+	if config.OVNKubernetesFeature.EgressIPReachabiltyTotalTimeout != 0 &&
+		config.OVNKubernetesFeature.EgressIPReachabilityMode == config.EgressIPReachabilityModeBFD {
+		klog.Infof("EgressIP node reachability using BFD sessions between egress nodes")
+	}
 	return nil
 }
 
@@ -458,6 +494,12 @@ func (eIPC *egressIPClusterController) WatchEgressIP() (*factory.Handler, error)
 	return eIPC.retryEgressIPs.WatchResource()
 }
 
+// WatchEgressIPPool starts the watching of egressippool resource and calls
+// back the appropriate handler logic.
+func (eIPC *egressIPClusterController) WatchEgressIPPool() (*factory.Handler, error) {
+	return eIPC.retryEgressIPPools.WatchResource()
+}
+
 func (eIPC *egressIPClusterController) Stop() {
 	close(eIPC.stopChan)
 	eIPC.wg.Wait()
@@ -467,6 +509,9 @@ func (eIPC *egressIPClusterController) Stop() {
 	if eIPC.egressIPHandler != nil {
 		eIPC.watchFactory.RemoveEgressIPHandler(eIPC.egressIPHandler)
 	}
+	if eIPC.egressIPPoolHandler != nil {
+		eIPC.watchFactory.RemoveEgressIPPoolHandler(eIPC.egressIPPoolHandler)
+	}
 	if eIPC.cloudPrivateIPConfigHandler != nil {
 		eIPC.watchFactory.RemoveCloudPrivateIPConfigHandler(eIPC.cloudPrivateIPConfigHandler)
 	}
@@ -483,7 +528,7 @@ func (eIPC *egressIPClusterController) getSortedEgressData() ([]*egressNode, map
 	assignableNodes := []*egressNode{}
 	allAllocations := make(map[string]egressIPNodeStatus)
 	for _, eNode := range eIPC.nodeAllocator.cache {
-		if eNode.isEgressAssignable && eNode.isReady && eNode.isReachable {
+		if eNode.isAssignable() {
 			assignableNodes = append(assignableNodes, eNode)
 		}
 		for ip, eipName := range eNode.allocations {
@@ -515,6 +560,14 @@ func (eIPC *egressIPClusterController) setNodeEgressAssignable(nodeName string,
 	}
 }
 
+func (eIPC *egressIPClusterController) setNodeEgressDraining(nodeName string, isDraining bool) {
+	eIPC.nodeAllocator.Lock()
+	defer eIPC.nodeAllocator.Unlock()
+	if eNode, exists := eIPC.nodeAllocator.cache[nodeName]; exists {
+		eNode.isDraining = isDraining
+	}
+}
+
 func (eIPC *egressIPClusterController) isEgressNodeReady(egressNode *corev1.Node) bool {
 	for _, condition := range egressNode.Status.Conditions {
 		if condition.Type == corev1.NodeReady {
@@ -574,6 +627,8 @@ func (eIPC *egressIPClusterController) checkEgressNodesReachability() {
 		select {
 		case <-timer.C:
 			checkEgressNodesReachabilityIterate(eIPC)
+		case <-eIPC.reachabilityCheckTrigger:
+			checkEgressNodesReachabilityIterate(eIPC)
 		case <-eIPC.stopChan:
 			klog.V(5).Infof("Stop channel got triggered: will stop checkEgressNodesReachability")
 			return
@@ -584,10 +639,13 @@ func (eIPC *egressIPClusterController) checkEgressNodesReachability() {
 func checkEgressNodesReachabilityIterate(eIPC *egressIPClusterController) {
 	reAddOrDelete := map[string]bool{}
 	eIPC.nodeAllocator.Lock()
+	// the nodes are checked against the reachability of the other nodes before
+	// this iteration, so that the result doesn't depend on the checking order
+	reachableNodes := eIPC.getReachableEgressNodes()
 	for _, eNode := range eIPC.nodeAllocator.cache {
 		if eNode.isEgressAssignable && eNode.isReady {
 			wasReachable := eNode.isReachable
-			isReachable := eIPC.isReachable(eNode.name, eNode.mgmtIPs, eNode.healthClient)
+			isReachable := eIPC.isReachable(eNode.name, eNode.mgmtIPs, eNode.healthClient, reachableNodes)
 			if wasReachable && !isReachable {
 				reAddOrDelete[eNode.name] = true
 			} else if !wasReachable && isReachable {
@@ -626,23 +684,99 @@ func checkEgressNodesReachabilityIterate(eIPC *egressIPClusterController) {
 	}
 }
 
-func (eIPC *egressIPClusterController) isReachable(nodeName string, mgmtIPs []net.IP, healthClient healthcheck.EgressIPHealthClient) bool {
+// triggerReachabilityCheck requests a reachability check of the egress nodes
+// without waiting for the next check interval.
+func (eIPC *egressIPClusterController) triggerReachabilityCheck() {
+	select {
+	case eIPC.reachabilityCheckTrigger <- struct{}{}:
+	default:
+		// a check is already pending
+	}
+}
+
+// isReachable must be called with the nodeAllocator lock held.
+func (eIPC *egressIPClusterController) isReachable(nodeName string, mgmtIPs []net.IP, healthClient healthcheck.EgressIPHealthClient,
+	reachableNodes sets.Set[string]) bool {
 	// Check if we need to do node reachability check
 	if eIPC.egressIPTotalTimeout == 0 {
 		return true
 	}
 
+	if eIPC.egressIPReachabilityMode == config.EgressIPReachabilityModeBFD {
+		if isReachable, known := eIPC.isReachableViaBFD(nodeName, reachableNodes); known {
+			return isReachable
+		}
+	}
+
 	if eIPC.egressIPNodeHealthCheckPort == 0 {
 		return isReachableLegacy(nodeName, mgmtIPs, eIPC.egressIPTotalTimeout)
 	}
 	return isReachableViaGRPC(mgmtIPs, healthClient, eIPC.egressIPNodeHealthCheckPort, eIPC.egressIPTotalTimeout)
 }
 
+// getReachableEgressNodes returns the names of the egress assignable and ready
+// nodes which are reachable. Must be called with the nodeAllocator lock held.
+func (eIPC *egressIPClusterController) getReachableEgressNodes() sets.Set[string] {
+	nodes := sets.New[string]()
+	for _, eNode := range eIPC.nodeAllocator.cache {
+		if eNode.isEgressAssignable && eNode.isReady && eNode.isReachable {
+			nodes.Insert(eNode.name)
+		}
+	}
+	return nodes
+}
+
+// isReachableViaBFD returns whether the node is reachable according to the
+// status of the BFD sessions the other egress nodes run with it, and whether
+// the status is known. Only the reports of the given reachable egress nodes
+// are considered, and the reports of the nodes the node itself reports as down
+// are ignored: the session being down between two nodes doesn't tell which one
+// is unreachable. The node is reachable when all the reports say the sessions
+// are up, and unreachable when all of them say the sessions are down.
+// Otherwise, e.g. when nobody reports about the node or the reports disagree,
+// the status is unknown. Must be called with the nodeAllocator lock held.
+func (eIPC *egressIPClusterController) isReachableViaBFD(nodeName string, reachableNodes sets.Set[string]) (bool, bool) {
+	getStatus := func(name string) map[string]string {
+		node, err := eIPC.watchFactory.GetNode(name)
+		if err != nil {
+			return nil
+		}
+		status, err := util.ParseNodeEgressIPBFDStatus(node)
+		if err != nil {
+			klog.Warningf("Ignoring EgressIP BFD status reported by node %s: %v", name, err)
+			return nil
+		}
+		return status
+	}
+	nodeStatus := getStatus(nodeName)
+	up, down := 0, 0
+	for reporter := range reachableNodes {
+		if reporter == nodeName || nodeStatus[reporter] == util.EgressIPBFDStatusDown {
+			continue
+		}
+		switch getStatus(reporter)[nodeName] {
+		case util.EgressIPBFDStatusUp:
+			up++
+		case util.EgressIPBFDStatusDown:
+			down++
+		}
+	}
+	switch {
+	case up > 0 && down == 0:
+		return true, true
+	case down > 0 && up == 0:
+		klog.Warningf("Node %s is reported as unreachable by the BFD sessions of %d egress nodes", nodeName, down)
+		return false, true
+	default:
+		return false, false
+	}
+}
+
 func (eIPC *egressIPClusterController) isEgressNodeReachable(egressNode *corev1.Node) bool {
 	eIPC.nodeAllocator.Lock()
 	defer eIPC.nodeAllocator.Unlock()
 	if eNode, exists := eIPC.nodeAllocator.cache[egressNode.Name]; exists {
-		return eNode.isReachable || eIPC.isReachable(eNode.name, eNode.mgmtIPs, eNode.healthClient)
+		return eNode.isReachable || eIPC.isReachable(eNode.name, eNode.mgmtIPs, eNode.healthClient, eIPC.getReachableEgressNodes())
 	}
 	return false
 }
@@ -738,9 +872,11 @@ func (eIPC *egressIPClusterController) addEgressNode(nodeName string) error {
 	}
 	for _, egressIP := range egressIPs {
 		egressIP := *egressIP
-		if len(egressIP.Spec.EgressIPs) != len(egressIP.Status.Items) {
+		if len(getRequestedEgressIPs(&egressIP)) != len(egressIP.Status.Items) || eIPC.hasEgressIPsToMove(&egressIP, nodeName) {
 			// Send a "synthetic update" on all egress IPs which are not fully
-			// assigned, the reconciliation loop for WatchEgressIP will try to
+			// assigned, or which have egress IPs to move off drained nodes or
+			// back to this node,
+			// the reconciliation loop for WatchEgressIP will try to
 			// assign stuff to this new node. The workqueue's delta FIFO
 			// implementation will not trigger a watch event for updates on
 			// objects which have no semantic difference, hence: call the
@@ -870,6 +1006,118 @@ func (eIPC *egressIPClusterController) addAllocatorEgressIPAssignments(name stri
 	}
 }
 
+// updatePreferredEgressNodes records the node each egress IP of an EgressIP
+// with a Preemptive failover policy is first assigned to, and forgets the egress
+// IPs which are not requested anymore.
+func (eIPC *egressIPClusterController) updatePreferredEgressNodes(name string, policy egressipv1.EgressIPFailoverPolicy,
+	requestedIPs sets.Set[string], statusAssignments []egressipv1.EgressIPStatusItem) {
+	eIPC.nodeAllocator.Lock()
+	defer eIPC.nodeAllocator.Unlock()
+	if policy != egressipv1.EgressIPFailoverPolicyPreemptive {
+		delete(eIPC.nodeAllocator.preferredNodes, name)
+		return
+	}
+	preferredNodes, exists := eIPC.nodeAllocator.preferredNodes[name]
+	if !exists {
+		preferredNodes = make(map[string]string)
+		eIPC.nodeAllocator.preferredNodes[name] = preferredNodes
+	}
+	for egressIP := range preferredNodes {
+		if !requestedIPs.Has(egressIP) {
+			delete(preferredNodes, egressIP)
+		}
+	}
+	for _, status := range statusAssignments {
+		if _, exists := preferredNodes[status.EgressIP]; !exists {
+			preferredNodes[status.EgressIP] = status.Node
+		}
+	}
+}
+
+// syncPreferredEgressNodes restores the preferred nodes of the egress IPs of
+// the EgressIPs with a Preemptive failover policy from the annotation they
+// were recorded in, so that the egress IPs keep moving back to the node they
+// were first assigned to after a restart.
+func (eIPC *egressIPClusterController) syncPreferredEgressNodes(egressIPs []interface{}) error {
+	eIPC.nodeAllocator.Lock()
+	defer eIPC.nodeAllocator.Unlock()
+	for _, object := range egressIPs {
+		egressIP, ok := object.(*egressipv1.EgressIP)
+		if !ok {
+			return fmt.Errorf("failed to cast %T to *egressipv1.EgressIP", object)
+		}
+		if egressIP.Spec.FailoverPolicy != egressipv1.EgressIPFailoverPolicyPreemptive {
+			continue
+		}
+		preferredNodes, err := util.ParseEgressIPPreferredNodes(egressIP.Annotations)
+		if err != nil {
+			// the preferred nodes are recorded again from the current assignments
+			klog.Warningf("Ignoring the preferred nodes of EgressIP %s: %v", egressIP.Name, err)
+			continue
+		}
+		if len(preferredNodes) > 0 {
+			eIPC.nodeAllocator.preferredNodes[egressIP.Name] = preferredNodes
+		}
+	}
+	return nil
+}
+
+// getPreferredEgressNodesAnnotation returns the value of the annotation
+// recording the preferred nodes of the egress IPs of the EgressIP, or an empty
+// string if it has none.
+func (eIPC *egressIPClusterController) getPreferredEgressNodesAnnotation(name string) (string, error) {
+	eIPC.nodeAllocator.Lock()
+	defer eIPC.nodeAllocator.Unlock()
+	preferredNodes := eIPC.nodeAllocator.preferredNodes[name]
+	if len(preferredNodes) == 0 {
+		return "", nil
+	}
+	annotation, err := json.Marshal(preferredNodes)
+	if err != nil {
+		return "", fmt.Errorf("failed to serialize the preferred nodes of EgressIP %s: %w", name, err)
+	}
+	return string(annotation), nil
+}
+
+// preferredEgressNodesChanged returns true if the preferred nodes of the egress
+// IPs of the EgressIP differ from the ones recorded in its annotation.
+func (eIPC *egressIPClusterController) preferredEgressNodesChanged(eIP *egressipv1.EgressIP) bool {
+	annotation, err := eIPC.getPreferredEgressNodesAnnotation(eIP.Name)
+	return err == nil && annotation != eIP.Annotations[util.EgressIPPreferredNodesAnnotation]
+}
+
+// hasEgressIPsToMove returns true if an egress IP of the EgressIP is assigned to
+// a drained node, or if the node is the preferred node of an egress IP of the
+// EgressIP which is assigned to another node.
+func (eIPC *egressIPClusterController) hasEgressIPsToMove(eIP *egressipv1.EgressIP, nodeName string) bool {
+	eIPC.nodeAllocator.Lock()
+	defer eIPC.nodeAllocator.Unlock()
+	preferredNodes := eIPC.nodeAllocator.preferredNodes[eIP.Name]
+	for _, status := range eIP.Status.Items {
+		if eNode, exists := eIPC.nodeAllocator.cache[status.Node]; exists && eNode.isDraining {
+			return true
+		}
+		if preferredNodes[status.EgressIP] == nodeName && status.Node != nodeName {
+			return true
+		}
+	}
+	return false
+}
+
+// canMoveEgressIP returns true if another node than the one the egress IP is
+// assigned to can host it. Must be called with the allocator lock held.
+func (eIPC *egressIPClusterController) canMoveEgressIP(name string, eIP net.IP, nodeName string) bool {
+	for _, eNode := range eIPC.nodeAllocator.cache {
+		if eNode.name == nodeName || !eNode.isAssignable() {
+			continue
+		}
+		if eIPC.getEgressIPNetworkOnNode(eNode, name, eIP) != "" {
+			return true
+		}
+	}
+	return false
+}
+
 func (eIPC *egressIPClusterController) reconcileEgressIP(old, new *egressipv1.EgressIP) (err error) {
 	// Lock the assignment, this is needed because this function can end up
 	// being called from WatchEgressNodes and WatchEgressIP, i.e: two different
@@ -891,17 +1139,23 @@ func (eIPC *egressIPClusterController) reconcileEgressIP(old, new *egressipv1.Eg
 	// Initialize a sets.String which holds egress IPs that were not fully assigned
 	// but are allocated and they are meant to be removed.
 	staleEgressIPs := sets.NewString()
+	// Initialize the egress IPs allocated from an EgressIPPool, which are
+	// requested in addition to the egress IPs of the spec.
+	allocatedEgressIPs := []string{}
 	if old != nil {
 		name = old.Name
 		status = old.Status.Items
-		staleEgressIPs.Insert(old.Spec.EgressIPs...)
+		staleEgressIPs.Insert(getRequestedEgressIPs(old)...)
 	}
 	if new != nil {
 		newEIP = new
 		name = newEIP.Name
 		status = newEIP.Status.Items
+		if allocatedEgressIPs, err = eIPC.reconcilePoolEgressIPs(newEIP); err != nil {
+			return err
+		}
 		if staleEgressIPs.Len() > 0 {
-			for _, egressIP := range newEIP.Spec.EgressIPs {
+			for _, egressIP := range append(slices.Clone(newEIP.Spec.EgressIPs), allocatedEgressIPs...) {
 				if staleEgressIPs.Has(egressIP) {
 					staleEgressIPs.Delete(egressIP)
 				}
@@ -909,22 +1163,25 @@ func (eIPC *egressIPClusterController) reconcileEgressIP(old, new *egressipv1.Eg
 		}
 	} else {
 		eIPC.deallocMark(name)
+		eIPC.releasePoolEgressIPs(name)
 	}
+	allocationsChanged := new != nil && !slices.Equal(allocatedEgressIPs, newEIP.Status.AllocatedEgressIPs)
 
 	// Validate the spec and use only the valid egress IPs when performing any
 	// successive operations, theoretically: the user could specify invalid IP
 	// addresses, which would break us.
-	validSpecIPs, err := eIPC.validateEgressIPSpec(name, newEIP.Spec.EgressIPs)
+	validSpecIPs, err := eIPC.validateEgressIPSpec(name, append(slices.Clone(newEIP.Spec.EgressIPs), allocatedEgressIPs...))
 	if err != nil {
 		return fmt.Errorf("invalid EgressIP spec, err: %v", err)
 	}
+	requestedIPs := validSpecIPs.Clone()
 
 	// Validate the status, on restart it could be the case that what might have
 	// been assigned when ovnkube-master last ran is not a valid assignment
 	// anymore (specifically if ovnkube-master has been crashing for a while).
 	// Any invalid status at this point in time needs to be removed and assigned
 	// to a valid node.
-	validStatus, invalidStatus := eIPC.validateEgressIPStatus(name, status)
+	validStatus, invalidStatus := eIPC.validateEgressIPStatus(name, newEIP.Spec.FailoverPolicy, status)
 	for status := range validStatus {
 		// If the spec has changed and an egress IP has been removed by the
 		// user: we need to un-assign that egress IP
@@ -950,13 +1207,14 @@ func (eIPC *egressIPClusterController) reconcileEgressIP(old, new *egressipv1.Eg
 		ipsToAssign.Delete(status.EgressIP)
 	}
 	statusToRemove := make([]egressipv1.EgressIPStatusItem, 0, invalidStatusLen)
+	var unassigned []egressipv1.EgressIPUnassignedStatusItem
 	for status := range invalidStatus {
 		statusToRemove = append(statusToRemove, status)
 		ipsToRemove.Insert(status.EgressIP)
 	}
 	// Adding the mark to annotations is bundled with status update in-order to minimise updates, cover the case where there is no update to status
 	// and mark annotation has been modified / removed. This should only occur for an update and the mark was previous set.
-	if ipsToAssign.Len() == 0 && ipsToRemove.Len() == 0 {
+	if ipsToAssign.Len() == 0 && ipsToRemove.Len() == 0 && !allocationsChanged {
 		eIPC.ensureMark(old, new)
 	}
 
@@ -999,18 +1257,23 @@ func (eIPC *egressIPClusterController) reconcileEgressIP(old, new *egressipv1.Eg
 			eIPC.deleteAllocatorEgressIPAssignments(statusToRemove)
 		}
 		if len(ipsToAssign) > 0 {
-			statusToAdd = eIPC.assignEgressIPs(name, ipsToAssign.UnsortedList())
+			statusToAdd, unassigned = eIPC.assignEgressIPs(name, ipsToAssign.UnsortedList())
 			statusToKeep = append(statusToKeep, statusToAdd...)
 		}
 		// Add all assignments which are to be kept to the allocator cache,
 		// allowing us to track all assignments which have been performed and
 		// avoid incorrect future assignments due to a de-synchronized cache.
 		eIPC.addAllocatorEgressIPAssignments(name, statusToKeep)
+		eIPC.updatePreferredEgressNodes(name, newEIP.Spec.FailoverPolicy, requestedIPs, statusToKeep)
 		// Update the object only on an ADD/UPDATE. If we are processing a
 		// DELETE, new will be nil and we should not update the object.
-		if len(statusToAdd) > 0 || (len(statusToRemove) > 0 && new != nil) {
-			if err := eIPC.patchEgressIP(name, eIPC.generateEgressIPPatches(name, new.Annotations, statusToKeep)...); err != nil {
-				return err
+		if new != nil {
+			status, conditionsChanged := eIPC.buildEgressIPStatus(newEIP, statusToKeep, allocatedEgressIPs, unassigned)
+			if len(statusToAdd) > 0 || len(statusToRemove) > 0 || allocationsChanged || conditionsChanged ||
+				eIPC.preferredEgressNodesChanged(newEIP) {
+				if err := eIPC.patchEgressIPStatus(newEIP, status); err != nil {
+					return err
+				}
 			}
 		}
 	} else {
@@ -1027,6 +1290,8 @@ func (eIPC *egressIPClusterController) reconcileEgressIP(old, new *egressipv1.Eg
 			// cache. If we don't do this we will occupy assignment positions for
 			// the ipsToAdd, even though statusToRemove will be removed afterwards
 			eIPC.deleteAllocatorEgressIPAssignments(statusToRemove)
+		}
+		if len(statusToRemove) > 0 || allocationsChanged {
 			// Before updating the cloud private IP object, we need to remove the OVN configuration
 			// for these invalid statuses so that traffic is not blackholed to non-existing setup in the
 			// cloud. Thus we patch the egressIP status with the valid set of statuses which will
@@ -1037,9 +1302,12 @@ func (eIPC *egressIPClusterController) reconcileEgressIP(old, new *egressipv1.Eg
 			// Update the object only on an ADD/UPDATE. If we are processing a
 			// DELETE, new will be nil and we should not update the object.
 			if new != nil {
-				if err := eIPC.patchEgressIP(name, eIPC.generateEgressIPPatches(name, new.Annotations, statusToKeep)...); err != nil {
+				status, _ := eIPC.buildEgressIPStatus(newEIP, statusToKeep, allocatedEgressIPs, nil)
+				if err := eIPC.patchEgressIPStatus(newEIP, status); err != nil {
 					return err
 				}
+				newEIP = newEIP.DeepCopy()
+				newEIP.Status = status
 			}
 		}
 		// When egress IP is not fully assigned to a node, then statusToRemove may not
@@ -1062,8 +1330,9 @@ func (eIPC *egressIPClusterController) reconcileEgressIP(old, new *egressipv1.Eg
 		// it can assign the IPs. reconcileCloudPrivateIPConfig will take care of
 		// processing the answer from the requests we make here, and update OVN
 		// accordingly when we know what the outcome is.
+		statusAssigned := slices.Clone(statusToKeep)
 		if len(ipsToAssign) > 0 {
-			statusToAdd = eIPC.assignEgressIPs(name, ipsToAssign.UnsortedList())
+			statusToAdd, unassigned = eIPC.assignEgressIPs(name, ipsToAssign.UnsortedList())
 			statusToKeep = append(statusToKeep, statusToAdd...)
 		}
 		// Same as above: Add all assignments which are to be kept to the
@@ -1071,6 +1340,25 @@ func (eIPC *egressIPClusterController) reconcileEgressIP(old, new *egressipv1.Eg
 		// performed and avoid incorrect future assignments due to a
 		// de-synchronized cache.
 		eIPC.addAllocatorEgressIPAssignments(name, statusToKeep)
+		eIPC.updatePreferredEgressNodes(name, newEIP.Spec.FailoverPolicy, requestedIPs, statusToKeep)
+
+		// The egress IPs being added are only reported as assigned once the
+		// cloud confirmed the assignment, report them as pending meanwhile.
+		if new != nil {
+			for _, status := range statusToAdd {
+				unassigned = append(unassigned, egressipv1.EgressIPUnassignedStatusItem{
+					EgressIP: status.EgressIP,
+					Reason:   egressipv1.EgressIPReasonPending,
+					Message:  fmt.Sprintf("waiting for the cloud to assign the egress IP to node %s", status.Node),
+				})
+			}
+			if status, conditionsChanged := eIPC.buildEgressIPStatus(newEIP, statusAssigned, allocatedEgressIPs, unassigned); conditionsChanged ||
+				eIPC.preferredEgressNodesChanged(newEIP) {
+				if err := eIPC.patchEgressIPStatus(newEIP, status); err != nil {
+					return err
+				}
+			}
+		}
 
 		// Execute CloudPrivateIPConfig changes for assignments which need to be
 		// added/removed, assignments which don't change do not require any
@@ -1121,7 +1409,8 @@ func (eIPC *egressIPClusterController) syncCloudPrivateIPConfigs(objs []interfac
 		if cloudPrivateIPNotFound {
 			// There could be one or more stale entry found in egress ip object, remove it by patching egressip
 			// object with updated status.
-			err = eIPC.patchEgressIP(egressIP.Name, eIPC.generateEgressIPPatches(egressIP.Name, egressIP.Annotations, updatedStatus)...)
+			status, _ := eIPC.buildEgressIPStatus(egressIP, updatedStatus, egressIP.Status.AllocatedEgressIPs, egressIP.Status.UnassignedItems)
+			err = eIPC.patchEgressIPStatus(egressIP, status)
 			if err != nil {
 				return fmt.Errorf("syncCloudPrivateIPConfigs unable to update EgressIP status: %w", err)
 			}
@@ -1160,10 +1449,12 @@ func (eIPC *egressIPClusterController) getCloudPrivateIPConfigMap(objs []interfa
 // time, this does not guarantee complete balance, but mostly complete.
 // For Egress IPs that are hosted by secondary host networks, there must be at least
 // one node that hosts the network and exposed via the nodes host-cidrs annotation.
-func (eIPC *egressIPClusterController) assignEgressIPs(name string, egressIPs []string) []egressipv1.EgressIPStatusItem {
+// The egress IPs which could not be assigned are returned with the reason why.
+func (eIPC *egressIPClusterController) assignEgressIPs(name string, egressIPs []string) ([]egressipv1.EgressIPStatusItem, []egressipv1.EgressIPUnassignedStatusItem) {
 	eIPC.nodeAllocator.Lock()
 	defer eIPC.nodeAllocator.Unlock()
 	assignments := []egressipv1.EgressIPStatusItem{}
+	unassigned := []egressipv1.EgressIPUnassignedStatusItem{}
 	assignableNodes, existingAllocations := eIPC.getSortedEgressData()
 	if len(assignableNodes) == 0 {
 		eIPRef := corev1.ObjectReference{
@@ -1172,7 +1463,11 @@ func (eIPC *egressIPClusterController) assignEgressIPs(name string, egressIPs []
 		}
 		eIPC.recorder.Eventf(&eIPRef, corev1.EventTypeWarning, "NoMatchingNodeFound", "no assignable nodes for EgressIP: %s, please tag at least one node with label: %s", name, util.GetNodeEgressLabel())
 		klog.Errorf("No assignable nodes found for EgressIP: %s and requested IPs: %v", name, egressIPs)
-		return assignments
+		for _, egressIP := range egressIPs {
+			unassigned = append(unassigned, eIPC.newUnassignedEgressIPItem(net.ParseIP(egressIP), egressipv1.EgressIPReasonNoAssignableNodes,
+				fmt.Sprintf("no assignable nodes, please tag at least one node with label: %s", util.GetNodeEgressLabel())))
+		}
+		return assignments, unassigned
 	}
 	klog.V(5).Infof("Current assignments are: %+v", existingAllocations)
 	for _, egressIP := range egressIPs {
@@ -1183,7 +1478,7 @@ func (eIPC *egressIPClusterController) assignEgressIPs(name string, egressIPs []
 		// cluster, therefore there maybe still conflicts when we attempt to assign an egress IP with a different scope.
 		if isIPConflict, conflictedHost, err := eIPC.isEgressIPAddrConflict(eIP); err != nil {
 			klog.Errorf("Egress IP: %v failed to check if EgressIP already is assigned on any interface throughout the cluster: %v", eIP, err)
-			return assignments
+			return assignments, unassigned
 		} else if isIPConflict {
 			eIPRef := corev1.ObjectReference{
 				Kind: "EgressIP",
@@ -1192,7 +1487,12 @@ func (eIPC *egressIPClusterController) assignEgressIPs(name string, egressIPs []
 			eIPC.recorder.Eventf(&eIPRef, corev1.EventTypeWarning, "EgressIPConflict", "Egress IP %s with IP "+
 				"%v is conflicting with a host (%s) IP address and will not be assigned", name, eIP, conflictedHost)
 			klog.Errorf("Egress IP: %v address is already assigned on an interface on node %s", eIP, conflictedHost)
-			return assignments
+			unassigned = append(unassigned, egressipv1.EgressIPUnassignedStatusItem{
+				EgressIP: eIP.String(),
+				Reason:   egressipv1.EgressIPReasonIPConflict,
+				Message:  fmt.Sprintf("the egress IP is conflicting with a host (%s) IP address", conflictedHost),
+			})
+			return assignments, unassigned
 		}
 		if status, exists := existingAllocations[eIP.String()]; exists {
 			// On public clouds we will re-process assignments for the same IP
@@ -1244,7 +1544,12 @@ func (eIPC *egressIPClusterController) assignEgressIPs(name string, egressIPs []
 					"IP: %q for EgressIP: %s is already allocated for EgressIP: %s on %s", egressIP, name, status.Name, status.Node,
 				)
 				klog.Errorf("IP: %q for EgressIP: %s is already allocated for EgressIP: %s on %s", egressIP, name, status.Name, status.Node)
-				return assignments
+				unassigned = append(unassigned, egressipv1.EgressIPUnassignedStatusItem{
+					EgressIP: eIP.String(),
+					Reason:   egressipv1.EgressIPReasonAlreadyAllocated,
+					Message:  fmt.Sprintf("the egress IP is already allocated for EgressIP: %s on %s", status.Name, status.Node),
+				})
+				return assignments, unassigned
 			}
 		}
 		// Egress IP for secondary host networks is only available on baremetal environments
@@ -1281,46 +1586,20 @@ func (eIPC *egressIPClusterController) assignEgressIPs(name string, egressIPs []
 			}
 		}
 
+		candidateNodes := assignableNodes
+		// egress IPs with a Preemptive failover policy are assigned back to
+		// their preferred node first
+		if preferredNode, exists := eIPC.nodeAllocator.preferredNodes[name][eIP.String()]; exists {
+			candidateNodes = preferEgressNode(assignableNodes, preferredNode)
+		}
 		var assignmentSuccessful bool
-		for i := 0; i < len(assignableNodes) && !assignmentSuccessful; i++ {
-			eNode := assignableNodes[i]
+		for i := 0; i < len(candidateNodes) && !assignmentSuccessful; i++ {
+			eNode := candidateNodes[i]
 			klog.V(5).Infof("Attempting assignment on egress node: %+v", eNode)
-			if eNode.getAllocationCountForEgressIP(name) > 0 {
-				klog.V(5).Infof("Node: %s is already in use by another egress IP for this EgressIP: %s, trying another node", eNode.name, name)
-				continue
-			}
-			node, err := eIPC.watchFactory.GetNode(eNode.name)
-			if err != nil {
-				klog.Errorf("Failed to consider node %s because lookup of kubernetes object failed: %v", eNode.name, err)
-				continue
-			}
-			egressIPNetwork, err := util.GetEgressIPNetwork(node, eNode.egressIPConfig, eIP)
-			if err != nil {
-				klog.Errorf("Failed to consider node %s for EgressIP %s IP %s because unable to find a network to host it: %v",
-					node.Name, name, eIP.String(), err)
-				continue
-			}
+			egressIPNetwork := eIPC.getEgressIPNetworkOnNode(eNode, name, eIP)
 			if egressIPNetwork == "" {
 				continue
 			}
-			if eNode.egressIPConfig.Capacity.IP < util.UnlimitedNodeCapacity {
-				if eNode.egressIPConfig.Capacity.IP-len(eNode.allocations) <= 0 {
-					klog.V(5).Infof("Additional allocation on Node: %s exhausts it's IP capacity, trying another node", eNode.name)
-					continue
-				}
-			}
-			if eNode.egressIPConfig.Capacity.IPv4 < util.UnlimitedNodeCapacity && utilnet.IsIPv4(eIP) {
-				if eNode.egressIPConfig.Capacity.IPv4-getIPFamilyAllocationCount(eNode.allocations, false) <= 0 {
-					klog.V(5).Infof("Additional allocation on Node: %s exhausts it's IPv4 capacity, trying another node", eNode.name)
-					continue
-				}
-			}
-			if eNode.egressIPConfig.Capacity.IPv6 < util.UnlimitedNodeCapacity && utilnet.IsIPv6(eIP) {
-				if eNode.egressIPConfig.Capacity.IPv6-getIPFamilyAllocationCount(eNode.allocations, true) <= 0 {
-					klog.V(5).Infof("Additional allocation on Node: %s exhausts it's IPv6 capacity, trying another node", eNode.name)
-					continue
-				}
-			}
 			assignments = append(assignments, egressipv1.EgressIPStatusItem{
 				Node:     eNode.name,
 				EgressIP: eIP.String(),
@@ -1330,6 +1609,10 @@ func (eIPC *egressIPClusterController) assignEgressIPs(name string, egressIPs []
 			klog.Infof("Successful assignment of egress IP: %s to network %s on node: %+v", egressIP, egressIPNetwork, eNode)
 			break
 		}
+		if !assignmentSuccessful {
+			unassigned = append(unassigned, eIPC.newUnassignedEgressIPItem(eIP, egressipv1.EgressIPReasonNoMatchingNode,
+				"no assignable node can host the egress IP"))
+		}
 	}
 	if len(assignments) == 0 {
 		eIPRef := corev1.ObjectReference{
@@ -1338,7 +1621,7 @@ func (eIPC *egressIPClusterController) assignEgressIPs(name string, egressIPs []
 		}
 		eIPC.recorder.Eventf(&eIPRef, corev1.EventTypeWarning, "NoMatchingNodeFound", "No matching nodes found, which can host any of the egress IPs: %v for object EgressIP: %s", egressIPs, name)
 		klog.Errorf("No matching host found for EgressIP: %s", name)
-		return assignments
+		return assignments, unassigned
 	}
 	if len(assignments) < len(egressIPs) {
 		eIPRef := corev1.ObjectReference{
@@ -1347,7 +1630,64 @@ func (eIPC *egressIPClusterController) assignEgressIPs(name string, egressIPs []
 		}
 		eIPC.recorder.Eventf(&eIPRef, corev1.EventTypeWarning, "UnassignedRequest", "Not all egress IPs for EgressIP: %s could be assigned, please tag more nodes", name)
 	}
-	return assignments
+	return assignments, unassigned
+}
+
+// getEgressIPNetworkOnNode returns the network of the egress node able to host
+// the egress IP of the EgressIP, or an empty string if the node can't host it.
+// Must be called with the allocator lock held.
+func (eIPC *egressIPClusterController) getEgressIPNetworkOnNode(eNode *egressNode, name string, eIP net.IP) string {
+	if eNode.getAllocationCountForEgressIP(name) > 0 {
+		klog.V(5).Infof("Node: %s is already in use by another egress IP for this EgressIP: %s, trying another node", eNode.name, name)
+		return ""
+	}
+	node, err := eIPC.watchFactory.GetNode(eNode.name)
+	if err != nil {
+		klog.Errorf("Failed to consider node %s because lookup of kubernetes object failed: %v", eNode.name, err)
+		return ""
+	}
+	egressIPNetwork, err := util.GetEgressIPNetwork(node, eNode.egressIPConfig, eIP)
+	if err != nil {
+		klog.Errorf("Failed to consider node %s for EgressIP %s IP %s because unable to find a network to host it: %v",
+			node.Name, name, eIP.String(), err)
+		return ""
+	}
+	if egressIPNetwork == "" {
+		return ""
+	}
+	if eNode.egressIPConfig.Capacity.IP < util.UnlimitedNodeCapacity {
+		if eNode.egressIPConfig.Capacity.IP-len(eNode.allocations) <= 0 {
+			klog.V(5).Infof("Additional allocation on Node: %s exhausts it's IP capacity, trying another node", eNode.name)
+			return ""
+		}
+	}
+	if eNode.egressIPConfig.Capacity.IPv4 < util.UnlimitedNodeCapacity && utilnet.IsIPv4(eIP) {
+		if eNode.egressIPConfig.Capacity.IPv4-getIPFamilyAllocationCount(eNode.allocations, false) <= 0 {
+			klog.V(5).Infof("Additional allocation on Node: %s exhausts it's IPv4 capacity, trying another node", eNode.name)
+			return ""
+		}
+	}
+	if eNode.egressIPConfig.Capacity.IPv6 < util.UnlimitedNodeCapacity && utilnet.IsIPv6(eIP) {
+		if eNode.egressIPConfig.Capacity.IPv6-getIPFamilyAllocationCount(eNode.allocations, true) <= 0 {
+			klog.V(5).Infof("Additional allocation on Node: %s exhausts it's IPv6 capacity, trying another node", eNode.name)
+			return ""
+		}
+	}
+	return egressIPNetwork
+}
+
+// preferEgressNode returns the egress nodes with the given node first, if it
+// is one of them.
+func preferEgressNode(eNodes []*egressNode, nodeName string) []*egressNode {
+	for i, eNode := range eNodes {
+		if eNode.name == nodeName {
+			preferred := make([]*egressNode, 0, len(eNodes))
+			preferred = append(preferred, eNode)
+			preferred = append(preferred, eNodes[:i]...)
+			return append(preferred, eNodes[i+1:]...)
+		}
+	}
+	return eNodes
 }
 
 func getIPFamilyAllocationCount(allocations map[string]string, isIPv6 bool) (count int) {
@@ -1408,7 +1748,11 @@ func (eIPC *egressIPClusterController) isEgressIPAddrConflict(egressIP net.IP) (
 // cache knows about all egress nodes. WatchEgressNodes is initialized before
 // any other egress IP handler, so the cache should be warm and correct once we
 // start going this.
-func (eIPC *egressIPClusterController) validateEgressIPStatus(name string, items []egressipv1.EgressIPStatusItem) (map[egressipv1.EgressIPStatusItem]string, map[egressipv1.EgressIPStatusItem]string) {
+// The failover policy of the EgressIP decides whether the assignments to nodes
+// which are not ready or reachable are valid, and whether the assignments are
+// moved back to their preferred node.
+func (eIPC *egressIPClusterController) validateEgressIPStatus(name string, policy egressipv1.EgressIPFailoverPolicy,
+	items []egressipv1.EgressIPStatusItem) (map[egressipv1.EgressIPStatusItem]string, map[egressipv1.EgressIPStatusItem]string) {
 	eIPC.nodeAllocator.Lock()
 	defer eIPC.nodeAllocator.Unlock()
 	valid, invalid := make(map[egressipv1.EgressIPStatusItem]string), make(map[egressipv1.EgressIPStatusItem]string)
@@ -1427,11 +1771,11 @@ func (eIPC *egressIPClusterController) validateEgressIPStatus(name string, items
 				klog.Errorf("Allocator error: EgressIP: %s assigned to node: %s which does not have egress label, will attempt rebalancing", name, eIPStatus.Node)
 				validAssignment = false
 			}
-			if !eNode.isReachable {
+			if !eNode.isReachable && policy != egressipv1.EgressIPFailoverPolicyMaintenanceDrain {
 				klog.Errorf("Allocator error: EgressIP: %s assigned to node: %s which is not reachable, will attempt rebalancing", name, eIPStatus.Node)
 				validAssignment = false
 			}
-			if !eNode.isReady {
+			if !eNode.isReady && policy != egressipv1.EgressIPFailoverPolicyMaintenanceDrain {
 				klog.Errorf("Allocator error: EgressIP: %s assigned to node: %s which is not ready, will attempt rebalancing", name, eIPStatus.Node)
 				validAssignment = false
 			}
@@ -1439,6 +1783,21 @@ func (eIPC *egressIPClusterController) validateEgressIPStatus(name string, items
 			if ip == nil {
 				klog.Errorf("Allocator error: EgressIP allocation contains unparsable IP address: %q", eIPStatus.EgressIP)
 			}
+			// the egress IPs only move off a drained node once another node
+			// can host them
+			if validAssignment && eNode.isDraining && ip != nil && eIPC.canMoveEgressIP(name, ip, eNode.name) {
+				klog.Infof("EgressIP: %s assigned to node: %s which is drained, will attempt rebalancing", name, eIPStatus.Node)
+				validAssignment = false
+			}
+			if validAssignment && policy == egressipv1.EgressIPFailoverPolicyPreemptive && ip != nil {
+				preferredNode := eIPC.nodeAllocator.cache[eIPC.nodeAllocator.preferredNodes[name][eIPStatus.EgressIP]]
+				if preferredNode != nil && preferredNode.name != eNode.name && preferredNode.isAssignable() &&
+					eIPC.getEgressIPNetworkOnNode(preferredNode, name, ip) != "" {
+					klog.Infof("EgressIP: %s assigned to node: %s can move back to its preferred node: %s, will attempt rebalancing",
+						name, eIPStatus.Node, preferredNode.name)
+					validAssignment = false
+				}
+			}
 			node, err := eIPC.watchFactory.GetNode(eNode.name)
 			if err != nil {
 				klog.Errorf("Allocator error: failed to validate and will not consider node %s for egress IP %s: %v",
@@ -1546,7 +1905,8 @@ func (eIPC *egressIPClusterController) reconcileCloudPrivateIPConfig(old, new *o
 					updatedStatus = append(updatedStatus, status)
 				}
 			}
-			if err := eIPC.patchEgressIP(egressIP.Name, eIPC.generateEgressIPPatches(egressIP.Name, egressIP.Annotations, updatedStatus)...); err != nil {
+			status, _ := eIPC.buildEgressIPStatus(egressIP, updatedStatus, egressIP.Status.AllocatedEgressIPs, egressIP.Status.UnassignedItems)
+			if err := eIPC.patchEgressIPStatus(egressIP, status); err != nil {
 				return err
 			}
 		}
@@ -1593,7 +1953,8 @@ func (eIPC *egressIPClusterController) reconcileCloudPrivateIPConfig(old, new *o
 		}
 		if !hasStatus {
 			statusToKeep := append(egressIP.Status.Items, statusItem)
-			if err := eIPC.patchEgressIP(egressIP.Name, eIPC.generateEgressIPPatches(egressIP.Name, egressIP.Annotations, statusToKeep)...); err != nil {
+			status, _ := eIPC.buildEgressIPStatus(egressIP, statusToKeep, egressIP.Status.AllocatedEgressIPs, egressIP.Status.UnassignedItems)
+			if err := eIPC.patchEgressIPStatus(egressIP, status); err != nil {
 				return err
 			}
 		}
@@ -1695,7 +2056,7 @@ func (eIPC *egressIPClusterController) removePendingOpsAndGetResyncs(egressIPNam
 		if egressIP.Name == egressIPName {
 			continue
 		}
-		unassigned := len(egressIP.Spec.EgressIPs) - len(egressIP.Status.Items)
+		unassigned := len(getRequestedEgressIPs(&egressIP)) - len(egressIP.Status.Items)
 		ops, pending := eIPC.pendingCloudPrivateIPConfigsOps[egressIP.Name]
 		// If the EgressIP was never added to the pending cache to begin
 		// with, but has un-assigned egress IPs, try it.
@@ -1740,23 +2101,6 @@ func (eIPC *egressIPClusterController) patchEgressIP(name string, patches ...jso
 	})
 }
 
-// generateEgressIPPatches conditionally generates a mark patch if the mark doesn't exist. If it fails to allocate a mark,
-// log an error instead of failing because we do not wish to block primary default network egress IP assignments due to potential
-// mark range exhaustion. Primary default network egress IP currently does not utilize marks to config EgressIP.
-// Generating the status patch is mandatory
-func (eIPC *egressIPClusterController) generateEgressIPPatches(name string, annotations map[string]string,
-	statusItems []egressipv1.EgressIPStatusItem) []jsonPatchOperation {
-	patches := make([]jsonPatchOperation, 0, 1)
-	if !util.IsEgressIPMarkSet(annotations) {
-		if mark, _, err := eIPC.getOrAllocMark(name); err != nil {
-			klog.Errorf("Failed to get mark for EgressIP %s: %v", name, err)
-		} else {
-			patches = append(patches, generateMarkPatchOp(mark))
-		}
-	}
-	return append(patches, generateStatusPatchOp(statusItems))
-}
-
 func generateMarkPatchOp(mark int) jsonPatchOperation {
 	return jsonPatchOperation{
 		Operation: "add",
@@ -1769,16 +2113,6 @@ func createAnnotWithMark(mark int) map[string]string {
 	return map[string]string{util.EgressIPMarkAnnotation: fmt.Sprintf("%d", mark)}
 }
 
-func generateStatusPatchOp(statusItems []egressipv1.EgressIPStatusItem) jsonPatchOperation {
-	return jsonPatchOperation{
-		Operation: "replace",
-		Path:      "/status",
-		Value: egressipv1.EgressIPStatus{
-			Items: statusItems,
-		},
-	}
-}
-
 // syncEgressIPMarkAllocator iterates over all existing EgressIPs. It builds a mark cache of existing marks stored on each
 // EgressIP annotation or allocates and adds a new mark to an EgressIP if it doesn't exist
 func (eIPC *egressIPClusterController) syncEgressIPMarkAllocator(egressIPs []interface{}) error {
@@ -4,7 +4,9 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"net/netip"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -15,15 +17,19 @@ import (
 	"github.com/urfave/cli/v2"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8stypes "k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
+	clienttesting "k8s.io/client-go/testing"
 	"k8s.io/client-go/util/retry"
 	utilnet "k8s.io/utils/net"
 
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
 	egressipv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressip/v1"
+	egressipfake "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressip/v1/apis/clientset/versioned/fake"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn/healthcheck"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
 )
 
@@ -1198,6 +1204,97 @@ var _ = ginkgo.Describe("OVN cluster-manager EgressIP Operations", func() {
 			err := app.Run([]string{app.Name})
 			gomega.Expect(err).NotTo(gomega.HaveOccurred())
 		})
+
+		ginkgo.It("should check nodes reachability using the BFD status reported by the other egress nodes", func() {
+			app.Action = func(*cli.Context) error {
+				config.OVNKubernetesFeature.EgressIPReachabilityMode = config.EgressIPReachabilityModeBFD
+				newEgressNode := func(name, ip string) *corev1.Node {
+					return &corev1.Node{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: name,
+							Labels: map[string]string{
+								"k8s.ovn.org/egress-assignable": "",
+							},
+							Annotations: map[string]string{
+								"k8s.ovn.org/node-primary-ifaddr": fmt.Sprintf("{\"ipv4\": \"%s\", \"ipv6\": \"%s\"}", ip, ""),
+								"k8s.ovn.org/node-subnets":        fmt.Sprintf("{\"default\":\"%s\"}", v4NodeSubnet),
+								util.OVNNodeHostCIDRs:             fmt.Sprintf("[\"%s\"]", ip),
+							},
+						},
+						Status: corev1.NodeStatus{
+							Conditions: []corev1.NodeCondition{
+								{
+									Type:   corev1.NodeReady,
+									Status: corev1.ConditionTrue,
+								},
+							},
+						},
+					}
+				}
+				node1 := newEgressNode("node1", "192.168.126.51/24")
+				node2 := newEgressNode("node2", "192.168.126.52/24")
+				node3 := newEgressNode("node3", "192.168.126.53/24")
+				fakeClusterManagerOVN.start(&corev1.NodeList{Items: []corev1.Node{*node1, *node2, *node3}})
+				_, err := fakeClusterManagerOVN.eIPC.WatchEgressNodes()
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				gomega.Eventually(getEgressIPAllocatorSizeSafely).Should(gomega.Equal(3))
+				for _, node := range []*corev1.Node{node1, node2, node3} {
+					gomega.Eventually(isEgressAssignableNode(node.Name)).Should(gomega.BeTrue())
+				}
+
+				setBFDStatus := func(node *corev1.Node, status string) {
+					node.Annotations[util.OVNNodeEgressIPBFDStatus] = status
+					_, err := fakeClusterManagerOVN.fakeClient.KubeClient.CoreV1().Nodes().Update(context.TODO(), node, metav1.UpdateOptions{})
+					gomega.Expect(err).NotTo(gomega.HaveOccurred())
+					gomega.Eventually(func() string {
+						n, err := fakeClusterManagerOVN.eIPC.watchFactory.GetNode(node.Name)
+						if err != nil {
+							return ""
+						}
+						return n.Annotations[util.OVNNodeEgressIPBFDStatus]
+					}).Should(gomega.Equal(status))
+				}
+
+				// nobody reports about node1: the gRPC probes tell it is reachable
+				checkEgressNodesReachabilityIterate(fakeClusterManagerOVN.eIPC)
+				gomega.Expect(getEgressIPAllocatorReachableSafely(node1.Name)).To(gomega.BeTrue())
+
+				// all the reports say the sessions with node1 are down
+				setBFDStatus(node2, `{"node1":"down","node3":"up"}`)
+				setBFDStatus(node3, `{"node1":"down","node2":"up"}`)
+				checkEgressNodesReachabilityIterate(fakeClusterManagerOVN.eIPC)
+				gomega.Expect(getEgressIPAllocatorReachableSafely(node1.Name)).To(gomega.BeFalse())
+				gomega.Expect(getEgressIPAllocatorReachableSafely(node2.Name)).To(gomega.BeTrue())
+				gomega.Expect(getEgressIPAllocatorReachableSafely(node3.Name)).To(gomega.BeTrue())
+
+				// the reports disagree: the gRPC probes tell node1 is reachable
+				setBFDStatus(node2, `{"node1":"up","node3":"up"}`)
+				checkEgressNodesReachabilityIterate(fakeClusterManagerOVN.eIPC)
+				gomega.Expect(getEgressIPAllocatorReachableSafely(node1.Name)).To(gomega.BeTrue())
+
+				// the gRPC probes are not used when all the reports say the sessions are up
+				getEgressIPAllocatorHealthCheckSafely(node1.Name).setFakeProbeFailure(true)
+				setBFDStatus(node3, `{"node1":"up","node2":"up"}`)
+				checkEgressNodesReachabilityIterate(fakeClusterManagerOVN.eIPC)
+				gomega.Expect(getEgressIPAllocatorReachableSafely(node1.Name)).To(gomega.BeTrue())
+
+				// two nodes reporting each other down tell nothing about which one is
+				// unreachable, whatever the order the nodes are checked in
+				getEgressIPAllocatorHealthCheckSafely(node1.Name).setFakeProbeFailure(false)
+				setBFDStatus(node1, `{"node2":"down"}`)
+				setBFDStatus(node2, `{"node1":"down"}`)
+				setBFDStatus(node3, `{}`)
+				for i := 0; i < 10; i++ {
+					checkEgressNodesReachabilityIterate(fakeClusterManagerOVN.eIPC)
+					gomega.Expect(getEgressIPAllocatorReachableSafely(node1.Name)).To(gomega.BeTrue())
+					gomega.Expect(getEgressIPAllocatorReachableSafely(node2.Name)).To(gomega.BeTrue())
+				}
+				return nil
+			}
+
+			err := app.Run([]string{app.Name})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		})
 	})
 
 	ginkgo.Context("WatchEgressNodes running with WatchEgressIP", func() {
@@ -1307,12 +1404,20 @@ var _ = ginkgo.Describe("OVN cluster-manager EgressIP Operations", func() {
 				I0212 20:22:37.643187 1837759 egressip_controller.go:1173] Current assignments are: map[]
 				I0212 20:22:37.643205 1837759 egressip_controller.go:1175] Will attempt assignment for egress IP: 192.168.126.51
 				E0212 20:22:37.643254 1837759 egressip_controller.go:1190] Egress IP: 192.168.126.51 address is already assigned on an interface on node node2*/
-				gomega.Eventually(fakeClusterManagerOVN.fakeRecorder.Events).Should(gomega.HaveLen(4))
-				for i := 0; i < 4; i++ {
+				// event5 is triggered when the Assigned condition is first set to False
+				gomega.Eventually(fakeClusterManagerOVN.fakeRecorder.Events).Should(gomega.HaveLen(5))
+				var conflictEvents int
+				for i := 0; i < 5; i++ {
 					recordedEvent := <-fakeClusterManagerOVN.fakeRecorder.Events
-					gomega.Expect(recordedEvent).To(gomega.ContainSubstring(
-						"EgressIPConflict Egress IP egressip with IP 192.168.126.51 is conflicting with a host (node2) IP address and will not be assigned"))
+					if strings.Contains(recordedEvent, "EgressIPConflict") {
+						gomega.Expect(recordedEvent).To(gomega.ContainSubstring(
+							"EgressIPConflict Egress IP egressip with IP 192.168.126.51 is conflicting with a host (node2) IP address and will not be assigned"))
+						conflictEvents++
+					} else {
+						gomega.Expect(recordedEvent).To(gomega.ContainSubstring("Warning NotAssigned"))
+					}
 				}
+				gomega.Expect(conflictEvents).To(gomega.Equal(4))
 				return nil
 			}
 
@@ -1805,7 +1910,7 @@ var _ = ginkgo.Describe("OVN cluster-manager EgressIP Operations", func() {
 						EgressIPs: []string{egressIP},
 					},
 				}
-				assignedStatuses := fakeClusterManagerOVN.eIPC.assignEgressIPs(eIP.Name, eIP.Spec.EgressIPs)
+				assignedStatuses, _ := fakeClusterManagerOVN.eIPC.assignEgressIPs(eIP.Name, eIP.Spec.EgressIPs)
 				gomega.Expect(assignedStatuses).To(gomega.HaveLen(1))
 				gomega.Expect(assignedStatuses[0].Node).To(gomega.Equal(egressNode2.name))
 				gomega.Expect(assignedStatuses[0].EgressIP).To(gomega.Equal(net.ParseIP(egressIP).String()))
@@ -1887,7 +1992,7 @@ var _ = ginkgo.Describe("OVN cluster-manager EgressIP Operations", func() {
 				fakeClusterManagerOVN.eIPC.nodeAllocator.cache[egressNode1.name] = &egressNode1
 				fakeClusterManagerOVN.eIPC.nodeAllocator.cache[egressNode2.name] = &egressNode2
 
-				assignedStatuses := fakeClusterManagerOVN.eIPC.assignEgressIPs(eIP.Name, eIP.Spec.EgressIPs)
+				assignedStatuses, _ := fakeClusterManagerOVN.eIPC.assignEgressIPs(eIP.Name, eIP.Spec.EgressIPs)
 				gomega.Expect(assignedStatuses).To(gomega.HaveLen(2))
 				gomega.Expect(assignedStatuses[0].Node).To(gomega.Equal(egressNode2.name))
 				gomega.Expect(assignedStatuses[0].EgressIP).To(gomega.Equal(net.ParseIP(egressIP1).String()))
@@ -1974,7 +2079,7 @@ var _ = ginkgo.Describe("OVN cluster-manager EgressIP Operations", func() {
 
 				gomega.Expect(fakeClusterManagerOVN.eIPC.initEgressIPAllocator(&node1)).To(gomega.Succeed())
 				gomega.Expect(fakeClusterManagerOVN.eIPC.initEgressIPAllocator(&node2)).To(gomega.Succeed())
-				assignedStatuses := fakeClusterManagerOVN.eIPC.assignEgressIPs(eIP.Name, eIP.Spec.EgressIPs)
+				assignedStatuses, _ := fakeClusterManagerOVN.eIPC.assignEgressIPs(eIP.Name, eIP.Spec.EgressIPs)
 				gomega.Expect(assignedStatuses).To(gomega.HaveLen(2))
 				gomega.Expect(assignedStatuses[0].Node).To(gomega.Equal(egressNode2.name))
 				gomega.Expect(assignedStatuses[0].EgressIP).To(gomega.Equal(net.ParseIP(egressIP1).String()))
@@ -2068,7 +2173,7 @@ var _ = ginkgo.Describe("OVN cluster-manager EgressIP Operations", func() {
 
 				gomega.Expect(fakeClusterManagerOVN.eIPC.initEgressIPAllocator(&node1)).To(gomega.Succeed())
 				gomega.Expect(fakeClusterManagerOVN.eIPC.initEgressIPAllocator(&node2)).To(gomega.Succeed())
-				assignedStatuses := fakeClusterManagerOVN.eIPC.assignEgressIPs(eIP.Name, eIP.Spec.EgressIPs)
+				assignedStatuses, _ := fakeClusterManagerOVN.eIPC.assignEgressIPs(eIP.Name, eIP.Spec.EgressIPs)
 				gomega.Expect(assignedStatuses).To(gomega.HaveLen(2))
 				gomega.Expect(assignedStatuses[0].Node).To(gomega.Equal(egressNode2.name))
 				gomega.Expect(assignedStatuses[0].EgressIP).To(gomega.Equal(net.ParseIP(egressIP1SecondaryHost).String()))
@@ -2154,10 +2259,10 @@ var _ = ginkgo.Describe("OVN cluster-manager EgressIP Operations", func() {
 
 				gomega.Expect(fakeClusterManagerOVN.eIPC.initEgressIPAllocator(&node1)).To(gomega.Succeed())
 				gomega.Expect(fakeClusterManagerOVN.eIPC.initEgressIPAllocator(&node2)).To(gomega.Succeed())
-				assignedStatuses := fakeClusterManagerOVN.eIPC.assignEgressIPs(eIP.Name, eIP.Spec.EgressIPs)
+				assignedStatuses, _ := fakeClusterManagerOVN.eIPC.assignEgressIPs(eIP.Name, eIP.Spec.EgressIPs)
 				gomega.Expect(assignedStatuses).To(gomega.HaveLen(1))
 				gomega.Expect(assignedStatuses[0].Node).To(gomega.Equal(node2Name))
-				assignedStatuses = fakeClusterManagerOVN.eIPC.assignEgressIPs(eIP.Name, eIP.Spec.EgressIPs)
+				assignedStatuses, _ = fakeClusterManagerOVN.eIPC.assignEgressIPs(eIP.Name, eIP.Spec.EgressIPs)
 				gomega.Expect(assignedStatuses).To(gomega.HaveLen(1))
 				gomega.Expect(assignedStatuses[0].Node).To(gomega.Equal(node2Name))
 				return nil
@@ -2239,7 +2344,7 @@ var _ = ginkgo.Describe("OVN cluster-manager EgressIP Operations", func() {
 				fakeClusterManagerOVN.eIPC.nodeAllocator.cache[egressNode1.name] = &egressNode1
 				fakeClusterManagerOVN.eIPC.nodeAllocator.cache[egressNode2.name] = &egressNode2
 
-				assignedStatuses := fakeClusterManagerOVN.eIPC.assignEgressIPs(eIP.Name, eIP.Spec.EgressIPs)
+				assignedStatuses, _ := fakeClusterManagerOVN.eIPC.assignEgressIPs(eIP.Name, eIP.Spec.EgressIPs)
 				gomega.Expect(assignedStatuses).To(gomega.BeEmpty())
 
 				return nil
@@ -2322,7 +2427,7 @@ var _ = ginkgo.Describe("OVN cluster-manager EgressIP Operations", func() {
 
 				gomega.Expect(fakeClusterManagerOVN.eIPC.initEgressIPAllocator(&node1)).To(gomega.Succeed())
 				gomega.Expect(fakeClusterManagerOVN.eIPC.initEgressIPAllocator(&node2)).To(gomega.Succeed())
-				assignedStatuses := fakeClusterManagerOVN.eIPC.assignEgressIPs(eIP.Name, eIP.Spec.EgressIPs)
+				assignedStatuses, _ := fakeClusterManagerOVN.eIPC.assignEgressIPs(eIP.Name, eIP.Spec.EgressIPs)
 				gomega.Expect(assignedStatuses).To(gomega.BeEmpty())
 
 				return nil
@@ -2401,7 +2506,7 @@ var _ = ginkgo.Describe("OVN cluster-manager EgressIP Operations", func() {
 				fakeClusterManagerOVN.eIPC.nodeAllocator.cache[egressNode1.name] = &egressNode1
 				fakeClusterManagerOVN.eIPC.nodeAllocator.cache[egressNode2.name] = &egressNode2
 
-				assignedStatuses := fakeClusterManagerOVN.eIPC.assignEgressIPs(eIP.Name, eIP.Spec.EgressIPs)
+				assignedStatuses, _ := fakeClusterManagerOVN.eIPC.assignEgressIPs(eIP.Name, eIP.Spec.EgressIPs)
 				gomega.Expect(assignedStatuses).To(gomega.BeEmpty())
 				return nil
 			}
@@ -2480,7 +2585,7 @@ var _ = ginkgo.Describe("OVN cluster-manager EgressIP Operations", func() {
 				fakeClusterManagerOVN.eIPC.nodeAllocator.cache[egressNode1.name] = &egressNode1
 				fakeClusterManagerOVN.eIPC.nodeAllocator.cache[egressNode2.name] = &egressNode2
 
-				assignedStatuses := fakeClusterManagerOVN.eIPC.assignEgressIPs(eIP.Name, eIP.Spec.EgressIPs)
+				assignedStatuses, _ := fakeClusterManagerOVN.eIPC.assignEgressIPs(eIP.Name, eIP.Spec.EgressIPs)
 				gomega.Expect(assignedStatuses).To(gomega.BeEmpty())
 				return nil
 			}
@@ -2559,7 +2664,7 @@ var _ = ginkgo.Describe("OVN cluster-manager EgressIP Operations", func() {
 				fakeClusterManagerOVN.eIPC.nodeAllocator.cache[egressNode1.name] = &egressNode1
 				fakeClusterManagerOVN.eIPC.nodeAllocator.cache[egressNode2.name] = &egressNode2
 
-				assignedStatuses := fakeClusterManagerOVN.eIPC.assignEgressIPs(eIP.Name, eIP.Spec.EgressIPs)
+				assignedStatuses, _ := fakeClusterManagerOVN.eIPC.assignEgressIPs(eIP.Name, eIP.Spec.EgressIPs)
 				gomega.Expect(assignedStatuses).To(gomega.HaveLen(1))
 				gomega.Expect(assignedStatuses[0].Node).To(gomega.Equal(egressNode2.name))
 				gomega.Expect(assignedStatuses[0].EgressIP).To(gomega.Equal(net.ParseIP(egressIP).String()))
@@ -2640,7 +2745,7 @@ var _ = ginkgo.Describe("OVN cluster-manager EgressIP Operations", func() {
 				fakeClusterManagerOVN.eIPC.nodeAllocator.cache[egressNode1.name] = &egressNode1
 				fakeClusterManagerOVN.eIPC.nodeAllocator.cache[egressNode2.name] = &egressNode2
 
-				assignedStatuses := fakeClusterManagerOVN.eIPC.assignEgressIPs(eIP.Name, eIP.Spec.EgressIPs)
+				assignedStatuses, _ := fakeClusterManagerOVN.eIPC.assignEgressIPs(eIP.Name, eIP.Spec.EgressIPs)
 				gomega.Expect(assignedStatuses).To(gomega.BeEmpty())
 				return nil
 			}
@@ -2796,7 +2901,7 @@ var _ = ginkgo.Describe("OVN cluster-manager EgressIP Operations", func() {
 				fakeClusterManagerOVN.eIPC.nodeAllocator.cache[egressNode1.name] = &egressNode1
 				fakeClusterManagerOVN.eIPC.nodeAllocator.cache[egressNode2.name] = &egressNode2
 
-				assignedStatuses := fakeClusterManagerOVN.eIPC.assignEgressIPs(eIP.Name, eIP.Spec.EgressIPs)
+				assignedStatuses, _ := fakeClusterManagerOVN.eIPC.assignEgressIPs(eIP.Name, eIP.Spec.EgressIPs)
 				gomega.Expect(assignedStatuses).To(gomega.HaveLen(1))
 				gomega.Expect(assignedStatuses[0].Node).To(gomega.Equal(egressNode2.name))
 				gomega.Expect(assignedStatuses[0].EgressIP).To(gomega.Equal(net.ParseIP(egressIP).String()))
@@ -4294,6 +4399,612 @@ var _ = ginkgo.Describe("OVN cluster-manager EgressIP Operations", func() {
 		})
 	})
 
+	ginkgo.Context("EgressIPPool allocation", func() {
+
+		getAllocatedEgressIPs := func(egressIPName string) func() []string {
+			return func() []string {
+				tmp, err := fakeClusterManagerOVN.fakeClient.EgressIPClient.K8sV1().EgressIPs().Get(context.TODO(), egressIPName, metav1.GetOptions{})
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				return tmp.Status.AllocatedEgressIPs
+			}
+		}
+
+		ginkgo.It("should allocate and release egress IPs from the pool", func() {
+			app.Action = func(*cli.Context) error {
+				node1IPv4 := "192.168.126.1/24"
+				node2IPv4 := "192.168.126.51/24"
+
+				newNode := func(name, ip string) corev1.Node {
+					return corev1.Node{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: name,
+							Annotations: map[string]string{
+								"k8s.ovn.org/node-primary-ifaddr": fmt.Sprintf("{\"ipv4\": \"%s\", \"ipv6\": \"%s\"}", ip, ""),
+								"k8s.ovn.org/node-subnets":        fmt.Sprintf("{\"default\":[\"%s\", \"%s\"]}", v4NodeSubnet, v6NodeSubnet),
+								util.OVNNodeHostCIDRs:             fmt.Sprintf("[\"%s\"]", ip),
+							},
+							Labels: map[string]string{
+								"k8s.ovn.org/egress-assignable": "",
+							},
+						},
+						Status: corev1.NodeStatus{
+							Conditions: []corev1.NodeCondition{
+								{
+									Type:   corev1.NodeReady,
+									Status: corev1.ConditionTrue,
+								},
+							},
+						},
+					}
+				}
+
+				pool := egressipv1.EgressIPPool{
+					ObjectMeta: metav1.ObjectMeta{Name: "pool"},
+					Spec: egressipv1.EgressIPPoolSpec{
+						CIDRs: []string{"192.168.126.0/29"},
+					},
+				}
+				eIP := egressipv1.EgressIP{
+					ObjectMeta: newEgressIPMeta(egressIPName),
+					Spec: egressipv1.EgressIPSpec{
+						EgressIPPool: &egressipv1.EgressIPPoolRequest{Name: pool.Name, Count: 2},
+						NamespaceSelector: metav1.LabelSelector{
+							MatchLabels: map[string]string{
+								"name": "does-not-exist",
+							},
+						},
+					},
+				}
+				eIP2 := egressipv1.EgressIP{
+					ObjectMeta: newEgressIPMeta(egressIPName2),
+					Spec: egressipv1.EgressIPSpec{
+						EgressIPs: []string{"192.168.126.3"},
+						NamespaceSelector: metav1.LabelSelector{
+							MatchLabels: map[string]string{
+								"name": "does-not-exist",
+							},
+						},
+					},
+				}
+
+				fakeClusterManagerOVN.start(
+					&corev1.NodeList{Items: []corev1.Node{newNode(node1Name, node1IPv4), newNode(node2Name, node2IPv4)}},
+					&egressipv1.EgressIPList{Items: []egressipv1.EgressIP{eIP, eIP2}},
+					&egressipv1.EgressIPPoolList{Items: []egressipv1.EgressIPPool{pool}},
+				)
+
+				egressNode1 := setupNode(node1Name, []string{node1IPv4}, map[string]string{"192.168.126.4": "bogus1"})
+				egressNode2 := setupNode(node2Name, []string{node2IPv4}, map[string]string{})
+
+				fakeClusterManagerOVN.eIPC.nodeAllocator.cache[egressNode1.name] = &egressNode1
+				fakeClusterManagerOVN.eIPC.nodeAllocator.cache[egressNode2.name] = &egressNode2
+
+				_, err := fakeClusterManagerOVN.eIPC.WatchEgressIP()
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				_, err = fakeClusterManagerOVN.eIPC.WatchEgressIPPool()
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+				ginkgo.By("skipping the network address, the node addresses and the egress IPs in use")
+				gomega.Eventually(getAllocatedEgressIPs(egressIPName)).Should(gomega.Equal([]string{"192.168.126.2", "192.168.126.5"}))
+				gomega.Eventually(getEgressIPStatusLen(egressIPName)).Should(gomega.Equal(2))
+				egressIPs, _ := getEgressIPStatus(egressIPName)
+				gomega.Expect(egressIPs).To(gomega.ConsistOf("192.168.126.2", "192.168.126.5"))
+
+				ginkgo.By("releasing the egress IPs when the requested count decreases")
+				eIPUpdate, err := fakeClusterManagerOVN.fakeClient.EgressIPClient.K8sV1().EgressIPs().Get(context.TODO(), egressIPName, metav1.GetOptions{})
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				eIPUpdate.Spec.EgressIPPool.Count = 1
+				_, err = fakeClusterManagerOVN.fakeClient.EgressIPClient.K8sV1().EgressIPs().Update(context.TODO(), eIPUpdate, metav1.UpdateOptions{})
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				gomega.Eventually(getAllocatedEgressIPs(egressIPName)).Should(gomega.Equal([]string{"192.168.126.2"}))
+				gomega.Eventually(getEgressIPStatusLen(egressIPName)).Should(gomega.Equal(1))
+
+				ginkgo.By("releasing the egress IPs when the pool is deleted")
+				err = fakeClusterManagerOVN.fakeClient.EgressIPClient.K8sV1().EgressIPPools().Delete(context.TODO(), pool.Name, metav1.DeleteOptions{})
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				gomega.Eventually(getAllocatedEgressIPs(egressIPName)).Should(gomega.BeEmpty())
+				gomega.Eventually(getEgressIPStatusLen(egressIPName)).Should(gomega.Equal(0))
+				return nil
+			}
+
+			err := app.Run([]string{app.Name})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		})
+
+		ginkgo.It("should only allocate egress IPs from the networks of the egress nodes", func() {
+			app.Action = func(*cli.Context) error {
+				node1IPv4 := "192.168.126.1/24"
+				node1 := corev1.Node{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: node1Name,
+						Annotations: map[string]string{
+							"k8s.ovn.org/node-primary-ifaddr": fmt.Sprintf("{\"ipv4\": \"%s\", \"ipv6\": \"%s\"}", node1IPv4, ""),
+							"k8s.ovn.org/node-subnets":        fmt.Sprintf("{\"default\":[\"%s\", \"%s\"]}", v4NodeSubnet, v6NodeSubnet),
+							util.OVNNodeHostCIDRs:             fmt.Sprintf("[\"%s\"]", node1IPv4),
+						},
+						Labels: map[string]string{
+							"k8s.ovn.org/egress-assignable": "",
+						},
+					},
+					Status: corev1.NodeStatus{
+						Conditions: []corev1.NodeCondition{
+							{
+								Type:   corev1.NodeReady,
+								Status: corev1.ConditionTrue,
+							},
+						},
+					},
+				}
+				// the pool ranges are much larger than the node network, the
+				// search must neither start from the first address of the pool
+				// nor walk the IPv6 range
+				pool := egressipv1.EgressIPPool{
+					ObjectMeta: metav1.ObjectMeta{Name: "pool"},
+					Spec: egressipv1.EgressIPPoolSpec{
+						CIDRs: []string{"10.0.0.0/8", "192.168.0.0/16", "ae70::/64"},
+					},
+				}
+				eIP := egressipv1.EgressIP{
+					ObjectMeta: newEgressIPMeta(egressIPName),
+					Spec: egressipv1.EgressIPSpec{
+						EgressIPPool: &egressipv1.EgressIPPoolRequest{Name: pool.Name, Count: 3},
+						NamespaceSelector: metav1.LabelSelector{
+							MatchLabels: map[string]string{
+								"name": "does-not-exist",
+							},
+						},
+					},
+				}
+
+				fakeClusterManagerOVN.start(
+					&corev1.NodeList{Items: []corev1.Node{node1}},
+					&egressipv1.EgressIPList{Items: []egressipv1.EgressIP{eIP}},
+					&egressipv1.EgressIPPoolList{Items: []egressipv1.EgressIPPool{pool}},
+				)
+
+				egressNode1 := setupNode(node1Name, []string{node1IPv4}, map[string]string{})
+				fakeClusterManagerOVN.eIPC.nodeAllocator.cache[egressNode1.name] = &egressNode1
+
+				_, err := fakeClusterManagerOVN.eIPC.WatchEgressIP()
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				_, err = fakeClusterManagerOVN.eIPC.WatchEgressIPPool()
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+				gomega.Eventually(getAllocatedEgressIPs(egressIPName)).Should(gomega.Equal([]string{"192.168.126.2", "192.168.126.3", "192.168.126.4"}))
+				return nil
+			}
+
+			err := app.Run([]string{app.Name})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		})
+
+		ginkgo.DescribeTable("should allocate from the intersection of the pool and the egress node networks",
+			func(prefixes, networks, expected []string) {
+				parse := func(cidrs []string) []netip.Prefix {
+					parsed := []netip.Prefix{}
+					for _, cidr := range cidrs {
+						parsed = append(parsed, netip.MustParsePrefix(cidr))
+					}
+					return parsed
+				}
+				gomega.Expect(getEgressIPPoolCandidateRanges(parse(prefixes), parse(networks))).To(gomega.Equal(parse(expected)))
+			},
+			ginkgo.Entry("pool larger than the node network", []string{"192.168.0.0/16"}, []string{"192.168.126.0/24"},
+				[]string{"192.168.126.0/24"}),
+			ginkgo.Entry("pool smaller than the node network", []string{"192.168.126.0/29"}, []string{"192.168.0.0/16"},
+				[]string{"192.168.126.0/29"}),
+			ginkgo.Entry("pool outside of the node networks", []string{"10.0.0.0/8", "ae70::/64"}, []string{"192.168.126.0/24"},
+				[]string{}),
+			ginkgo.Entry("several node networks", []string{"192.168.0.0/16"},
+				[]string{"192.168.127.0/24", "192.168.126.0/24", "10.0.0.0/24"},
+				[]string{"192.168.126.0/24", "192.168.127.0/24"}),
+		)
+
+		ginkgo.DescribeTable("should only allocate the host addresses of the pool",
+			func(cidr, ip string, expected bool) {
+				prefixes := parseEgressIPPoolCIDRs(&egressipv1.EgressIPPool{Spec: egressipv1.EgressIPPoolSpec{CIDRs: []string{cidr}}})
+				gomega.Expect(isEgressIPPoolAddr(prefixes, netip.MustParseAddr(ip))).To(gomega.Equal(expected))
+			},
+			ginkgo.Entry("host address", "192.168.126.0/24", "192.168.126.10", true),
+			ginkgo.Entry("network address", "192.168.126.0/24", "192.168.126.0", false),
+			ginkgo.Entry("broadcast address", "192.168.126.0/22", "192.168.127.255", false),
+			ginkgo.Entry("address outside of the pool", "192.168.126.0/24", "192.168.127.10", false),
+			ginkgo.Entry("point to point range", "192.168.126.0/31", "192.168.126.0", true),
+			ginkgo.Entry("IPv6 host address", "ae70::/64", "ae70::ffff:ffff:ffff:ffff", true),
+			ginkgo.Entry("IPv6 network address", "ae70::/64", "ae70::", false),
+		)
+	})
+
+	ginkgo.Context("EgressIP status conditions", func() {
+
+		getEgressIP := func(egressIPName string) func() *egressipv1.EgressIP {
+			return func() *egressipv1.EgressIP {
+				tmp, err := fakeClusterManagerOVN.fakeClient.EgressIPClient.K8sV1().EgressIPs().Get(context.TODO(), egressIPName, metav1.GetOptions{})
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				return tmp
+			}
+		}
+
+		haveCondition := func(conditionType string, status metav1.ConditionStatus, reason string) gomega.OmegaMatcher {
+			return gomega.WithTransform(func(eIP *egressipv1.EgressIP) *metav1.Condition {
+				return meta.FindStatusCondition(eIP.Status.Conditions, conditionType)
+			}, gomega.And(
+				gomega.Not(gomega.BeNil()),
+				gomega.HaveField("Status", status),
+				gomega.HaveField("Reason", reason),
+			))
+		}
+
+		ginkgo.It("should report the unassigned egress IPs and the conditions", func() {
+			app.Action = func(*cli.Context) error {
+				node1IPv4 := "192.168.126.51/24"
+				unassignableEgressIP := "192.168.127.10"
+				assignableEgressIP := "192.168.126.10"
+
+				node1 := corev1.Node{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: node1Name,
+						Annotations: map[string]string{
+							"k8s.ovn.org/node-primary-ifaddr": fmt.Sprintf("{\"ipv4\": \"%s\", \"ipv6\": \"%s\"}", node1IPv4, ""),
+							"k8s.ovn.org/node-subnets":        fmt.Sprintf("{\"default\":[\"%s\", \"%s\"]}", v4NodeSubnet, v6NodeSubnet),
+							util.OVNNodeHostCIDRs:             fmt.Sprintf("[\"%s\"]", node1IPv4),
+						},
+						Labels: map[string]string{
+							"k8s.ovn.org/egress-assignable": "",
+						},
+					},
+					Status: corev1.NodeStatus{
+						Conditions: []corev1.NodeCondition{
+							{
+								Type:   corev1.NodeReady,
+								Status: corev1.ConditionTrue,
+							},
+						},
+					},
+				}
+				eIP := egressipv1.EgressIP{
+					ObjectMeta: newEgressIPMeta(egressIPName),
+					Spec: egressipv1.EgressIPSpec{
+						EgressIPs: []string{unassignableEgressIP},
+						NamespaceSelector: metav1.LabelSelector{
+							MatchLabels: map[string]string{
+								"name": "does-not-exist",
+							},
+						},
+					},
+				}
+
+				fakeClusterManagerOVN.start(
+					&corev1.NodeList{Items: []corev1.Node{node1}},
+					&egressipv1.EgressIPList{Items: []egressipv1.EgressIP{eIP}},
+				)
+
+				egressNode1 := setupNode(node1Name, []string{node1IPv4}, map[string]string{})
+				fakeClusterManagerOVN.eIPC.nodeAllocator.cache[egressNode1.name] = &egressNode1
+
+				_, err := fakeClusterManagerOVN.eIPC.WatchEgressIP()
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+				ginkgo.By("reporting the egress IP no node can host as unassigned")
+				gomega.Eventually(getEgressIP(egressIPName)).Should(haveCondition(egressipv1.EgressIPAssignedCondition,
+					metav1.ConditionFalse, egressIPNotAssignedReason))
+				gomega.Expect(getEgressIP(egressIPName)().Status.UnassignedItems).To(gomega.ConsistOf(
+					gomega.HaveField("Reason", egressipv1.EgressIPReasonNoMatchingNode)))
+				gomega.Expect(getEgressIP(egressIPName)()).To(haveCondition(egressipv1.EgressIPReachableCondition,
+					metav1.ConditionTrue, egressIPNodesReachableReason))
+				gomega.Expect(getEgressIP(egressIPName)()).To(haveCondition(egressipv1.EgressIPProgrammedCondition,
+					metav1.ConditionUnknown, egressIPProgrammingPending))
+
+				ginkgo.By("reporting the egress IP as assigned once a node can host it")
+				eIPUpdate := getEgressIP(egressIPName)()
+				eIPUpdate.Spec.EgressIPs = []string{assignableEgressIP}
+				_, err = fakeClusterManagerOVN.fakeClient.EgressIPClient.K8sV1().EgressIPs().Update(context.TODO(), eIPUpdate, metav1.UpdateOptions{})
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				gomega.Eventually(getEgressIP(egressIPName)).Should(haveCondition(egressipv1.EgressIPAssignedCondition,
+					metav1.ConditionTrue, egressIPAssignedReason))
+				gomega.Expect(getEgressIP(egressIPName)().Status.UnassignedItems).To(gomega.BeEmpty())
+
+				ginkgo.By("aggregating the Programmed condition of the zones")
+				eIPUpdate = getEgressIP(egressIPName)()
+				meta.SetStatusCondition(&eIPUpdate.Status.Conditions, metav1.Condition{
+					Type:    egressipv1.EgressIPProgrammedInZoneConditionPrefix + types.OvnDefaultZone,
+					Status:  metav1.ConditionFalse,
+					Reason:  "ProgrammingFailed",
+					Message: "failed",
+				})
+				_, err = fakeClusterManagerOVN.fakeClient.EgressIPClient.K8sV1().EgressIPs().Update(context.TODO(), eIPUpdate, metav1.UpdateOptions{})
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				gomega.Eventually(getEgressIP(egressIPName)).Should(haveCondition(egressipv1.EgressIPProgrammedCondition,
+					metav1.ConditionFalse, egressIPProgrammingFailedReason))
+
+				eIPUpdate = getEgressIP(egressIPName)()
+				meta.SetStatusCondition(&eIPUpdate.Status.Conditions, metav1.Condition{
+					Type:    egressipv1.EgressIPProgrammedInZoneConditionPrefix + types.OvnDefaultZone,
+					Status:  metav1.ConditionTrue,
+					Reason:  "Programmed",
+					Message: "programmed",
+				})
+				_, err = fakeClusterManagerOVN.fakeClient.EgressIPClient.K8sV1().EgressIPs().Update(context.TODO(), eIPUpdate, metav1.UpdateOptions{})
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				gomega.Eventually(getEgressIP(egressIPName)).Should(haveCondition(egressipv1.EgressIPProgrammedCondition,
+					metav1.ConditionTrue, egressIPProgrammedReason))
+				return nil
+			}
+
+			err := app.Run([]string{app.Name})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		})
+
+		ginkgo.It("should keep the conditions reported concurrently by the zones and remove the ones of stale zones", func() {
+			app.Action = func(*cli.Context) error {
+				node1IPv4 := "192.168.126.51/24"
+				staleZone := "stale-zone"
+
+				node1 := corev1.Node{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: node1Name,
+						Annotations: map[string]string{
+							"k8s.ovn.org/node-primary-ifaddr": fmt.Sprintf("{\"ipv4\": \"%s\", \"ipv6\": \"%s\"}", node1IPv4, ""),
+							"k8s.ovn.org/node-subnets":        fmt.Sprintf("{\"default\":[\"%s\", \"%s\"]}", v4NodeSubnet, v6NodeSubnet),
+							util.OVNNodeHostCIDRs:             fmt.Sprintf("[\"%s\"]", node1IPv4),
+						},
+					},
+				}
+				eIP := egressipv1.EgressIP{
+					ObjectMeta: newEgressIPMeta(egressIPName),
+					Spec: egressipv1.EgressIPSpec{
+						EgressIPs: []string{"192.168.126.10"},
+					},
+					Status: egressipv1.EgressIPStatus{
+						Conditions: []metav1.Condition{
+							{
+								Type:   egressipv1.EgressIPProgrammedInZoneConditionPrefix + staleZone,
+								Status: metav1.ConditionTrue,
+								Reason: "Programmed",
+							},
+						},
+					},
+				}
+
+				fakeClusterManagerOVN.start(
+					&corev1.NodeList{Items: []corev1.Node{node1}},
+					&egressipv1.EgressIPList{Items: []egressipv1.EgressIP{eIP}},
+				)
+
+				ginkgo.By("reporting the condition of a zone after the EgressIP was read by cluster manager")
+				staleEIP := getEgressIP(egressIPName)()
+				eIPUpdate := staleEIP.DeepCopy()
+				meta.SetStatusCondition(&eIPUpdate.Status.Conditions, metav1.Condition{
+					Type:   egressipv1.EgressIPProgrammedInZoneConditionPrefix + types.OvnDefaultZone,
+					Status: metav1.ConditionFalse,
+					Reason: "ProgrammingFailed",
+				})
+				_, err := fakeClusterManagerOVN.fakeClient.EgressIPClient.K8sV1().EgressIPs().Update(context.TODO(), eIPUpdate, metav1.UpdateOptions{})
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+				ginkgo.By("updating the status from the EgressIP read before")
+				status, _ := fakeClusterManagerOVN.eIPC.buildEgressIPStatus(staleEIP, nil, nil, nil)
+				gomega.Expect(meta.FindStatusCondition(status.Conditions,
+					egressipv1.EgressIPProgrammedInZoneConditionPrefix+staleZone)).To(gomega.BeNil())
+				err = fakeClusterManagerOVN.eIPC.patchEgressIPStatus(staleEIP, status)
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+				gomega.Expect(getEgressIP(egressIPName)()).To(haveCondition(egressipv1.EgressIPAssignedCondition,
+					metav1.ConditionFalse, egressIPNotAssignedReason))
+				gomega.Expect(getEgressIP(egressIPName)()).To(haveCondition(
+					egressipv1.EgressIPProgrammedInZoneConditionPrefix+types.OvnDefaultZone,
+					metav1.ConditionFalse, "ProgrammingFailed"))
+
+				ginkgo.By("removing the condition of the stale zone on behalf of the zone")
+				var fieldManagers []string
+				for _, action := range fakeClusterManagerOVN.fakeClient.EgressIPClient.(*egressipfake.Clientset).Actions() {
+					if patchAction, ok := action.(clienttesting.PatchAction); ok && patchAction.GetPatchType() == k8stypes.ApplyPatchType {
+						fieldManagers = append(fieldManagers, action.(clienttesting.PatchActionImpl).PatchOptions.FieldManager)
+					}
+				}
+				gomega.Expect(fieldManagers).To(gomega.ConsistOf(egressIPFieldManager, staleZone))
+				return nil
+			}
+
+			err := app.Run([]string{app.Name})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		})
+	})
+
+	ginkgo.Context("EgressIP failover policy", func() {
+
+		const (
+			egressIP  = "192.168.126.101"
+			node1IPv4 = "192.168.126.12/24"
+			node2IPv4 = "192.168.126.51/24"
+		)
+
+		newEgressNode := func(name, nodeIPv4 string) *corev1.Node {
+			return &corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: name,
+					Annotations: map[string]string{
+						"k8s.ovn.org/node-primary-ifaddr": fmt.Sprintf("{\"ipv4\": \"%s\", \"ipv6\": \"%s\"}", nodeIPv4, ""),
+						"k8s.ovn.org/node-subnets":        fmt.Sprintf("{\"default\":\"%s\"}", v4NodeSubnet),
+						util.OVNNodeHostCIDRs:             fmt.Sprintf("[\"%s\"]", nodeIPv4),
+					},
+					Labels: map[string]string{
+						"k8s.ovn.org/egress-assignable": "",
+					},
+				},
+				Status: corev1.NodeStatus{
+					Conditions: []corev1.NodeCondition{
+						{
+							Type:   corev1.NodeReady,
+							Status: corev1.ConditionTrue,
+						},
+					},
+				},
+			}
+		}
+
+		getAssignedNode := func() string {
+			_, nodes := getEgressIPStatus(egressIPName)
+			if len(nodes) > 0 {
+				return nodes[0]
+			}
+			return ""
+		}
+
+		// startWithEgressIPOnNode1 assigns the egress IP to node1 before adding
+		// node2, the assignment would otherwise be random
+		startWithEgressIPOnNode1 := func(policy egressipv1.EgressIPFailoverPolicy) (*corev1.Node, *corev1.Node) {
+			config.OVNKubernetesFeature.EnableInterconnect = true // no impact on global eIPC functions
+			node1 := newEgressNode(node1Name, node1IPv4)
+			node2 := newEgressNode(node2Name, node2IPv4)
+			eIP := egressipv1.EgressIP{
+				ObjectMeta: newEgressIPMeta(egressIPName),
+				Spec: egressipv1.EgressIPSpec{
+					EgressIPs:      []string{egressIP},
+					FailoverPolicy: policy,
+				},
+			}
+			fakeClusterManagerOVN.start(
+				&egressipv1.EgressIPList{Items: []egressipv1.EgressIP{eIP}},
+				&corev1.NodeList{Items: []corev1.Node{*node1}},
+			)
+			_, err := fakeClusterManagerOVN.eIPC.WatchEgressNodes()
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			_, err = fakeClusterManagerOVN.eIPC.WatchEgressIP()
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Eventually(getAssignedNode).Should(gomega.Equal(node1.Name))
+			_, err = fakeClusterManagerOVN.fakeClient.KubeClient.CoreV1().Nodes().Create(context.TODO(), node2, metav1.CreateOptions{})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Eventually(getEgressIPAllocatorSizeSafely).Should(gomega.Equal(2))
+			return node1, node2
+		}
+
+		setNodeReady := func(node *corev1.Node, isReady bool) {
+			node = node.DeepCopy()
+			node.Status.Conditions[0].Status = corev1.ConditionFalse
+			if isReady {
+				node.Status.Conditions[0].Status = corev1.ConditionTrue
+			}
+			_, err := fakeClusterManagerOVN.fakeClient.KubeClient.CoreV1().Nodes().Update(context.TODO(), node, metav1.UpdateOptions{})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		}
+
+		ginkgo.DescribeTable("should move the egress IPs following the failover policy when their node fails and recovers",
+			func(policy egressipv1.EgressIPFailoverPolicy, nodeAfterFailure, nodeAfterRecovery string) {
+				app.Action = func(*cli.Context) error {
+					node1, _ := startWithEgressIPOnNode1(policy)
+
+					ginkgo.By("making the node hosting the egress IP not ready")
+					setNodeReady(node1, false)
+					gomega.Eventually(getAssignedNode).Should(gomega.Equal(nodeAfterFailure))
+					gomega.Consistently(getAssignedNode).Should(gomega.Equal(nodeAfterFailure))
+
+					ginkgo.By("making the node ready again")
+					setNodeReady(node1, true)
+					gomega.Eventually(getAssignedNode).Should(gomega.Equal(nodeAfterRecovery))
+					gomega.Consistently(getAssignedNode).Should(gomega.Equal(nodeAfterRecovery))
+					return nil
+				}
+				err := app.Run([]string{app.Name})
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			},
+			ginkgo.Entry("Preemptive", egressipv1.EgressIPFailoverPolicyPreemptive, node2Name, node1Name),
+			ginkgo.Entry("NonPreemptive", egressipv1.EgressIPFailoverPolicyNonPreemptive, node2Name, node2Name),
+			ginkgo.Entry("default", egressipv1.EgressIPFailoverPolicy(""), node2Name, node2Name),
+			ginkgo.Entry("MaintenanceDrain", egressipv1.EgressIPFailoverPolicyMaintenanceDrain, node1Name, node1Name),
+		)
+
+		ginkgo.It("should record the preferred nodes of the egress IPs and restore them on startup", func() {
+			app.Action = func(*cli.Context) error {
+				config.OVNKubernetesFeature.EnableInterconnect = true // no impact on global eIPC functions
+				node1 := newEgressNode(node1Name, node1IPv4)
+				node2 := newEgressNode(node2Name, node2IPv4)
+				eIP := egressipv1.EgressIP{
+					ObjectMeta: newEgressIPMeta(egressIPName),
+					Spec: egressipv1.EgressIPSpec{
+						EgressIPs:      []string{egressIP},
+						FailoverPolicy: egressipv1.EgressIPFailoverPolicyPreemptive,
+					},
+					Status: egressipv1.EgressIPStatus{
+						Items: []egressipv1.EgressIPStatusItem{
+							{
+								EgressIP: egressIP,
+								Node:     node2.Name,
+							},
+						},
+					},
+				}
+				eIP.Annotations = map[string]string{
+					util.EgressIPPreferredNodesAnnotation: fmt.Sprintf("{%q:%q}", egressIP, node1.Name),
+				}
+				fakeClusterManagerOVN.start(
+					&egressipv1.EgressIPList{Items: []egressipv1.EgressIP{eIP}},
+					&corev1.NodeList{Items: []corev1.Node{*node1, *node2}},
+				)
+				_, err := fakeClusterManagerOVN.eIPC.WatchEgressNodes()
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				_, err = fakeClusterManagerOVN.eIPC.WatchEgressIP()
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+				ginkgo.By("moving the egress IP back to its recorded preferred node")
+				gomega.Eventually(getAssignedNode).Should(gomega.Equal(node1.Name))
+				gomega.Consistently(getAssignedNode).Should(gomega.Equal(node1.Name))
+				preferredNodes := func() (map[string]string, error) {
+					eIP, err := fakeClusterManagerOVN.fakeClient.EgressIPClient.K8sV1().EgressIPs().Get(context.TODO(), egressIPName, metav1.GetOptions{})
+					if err != nil {
+						return nil, err
+					}
+					return util.ParseEgressIPPreferredNodes(eIP.Annotations)
+				}
+				gomega.Expect(preferredNodes()).To(gomega.Equal(map[string]string{egressIP: node1.Name}))
+
+				ginkgo.By("no longer recording the preferred nodes when the failover policy is no longer Preemptive")
+				eIP.Annotations = nil
+				eIP.Spec.FailoverPolicy = egressipv1.EgressIPFailoverPolicyNonPreemptive
+				eIP.Status = egressipv1.EgressIPStatus{}
+				_, err = fakeClusterManagerOVN.fakeClient.EgressIPClient.K8sV1().EgressIPs().Update(context.TODO(), &eIP, metav1.UpdateOptions{})
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				gomega.Eventually(func() (string, error) {
+					return fakeClusterManagerOVN.eIPC.getPreferredEgressNodesAnnotation(egressIPName)
+				}).Should(gomega.BeEmpty())
+				gomega.Expect(preferredNodes()).To(gomega.BeEmpty())
+				return nil
+			}
+			err := app.Run([]string{app.Name})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		})
+
+		ginkgo.It("should move the egress IPs off a drained node only once another node can host them", func() {
+			app.Action = func(*cli.Context) error {
+				node1, node2 := startWithEgressIPOnNode1(egressipv1.EgressIPFailoverPolicyMaintenanceDrain)
+
+				ginkgo.By("keeping the egress IP on the drained node while no other node can host it")
+				node2Unlabeled := node2.DeepCopy()
+				node2Unlabeled.Labels = map[string]string{}
+				_, err := fakeClusterManagerOVN.fakeClient.KubeClient.CoreV1().Nodes().Update(context.TODO(), node2Unlabeled, metav1.UpdateOptions{})
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				node1Drained := node1.DeepCopy()
+				node1Drained.Annotations[util.OVNNodeEgressIPDrain] = "true"
+				_, err = fakeClusterManagerOVN.fakeClient.KubeClient.CoreV1().Nodes().Update(context.TODO(), node1Drained, metav1.UpdateOptions{})
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				gomega.Consistently(getAssignedNode).Should(gomega.Equal(node1.Name))
+
+				ginkgo.By("moving the egress IP once another node can host it")
+				_, err = fakeClusterManagerOVN.fakeClient.KubeClient.CoreV1().Nodes().Update(context.TODO(), node2, metav1.UpdateOptions{})
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				gomega.Eventually(getAssignedNode).Should(gomega.Equal(node2.Name))
+
+				ginkgo.By("keeping the egress IP on its new node once the drain is over")
+				_, err = fakeClusterManagerOVN.fakeClient.KubeClient.CoreV1().Nodes().Update(context.TODO(), node1, metav1.UpdateOptions{})
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				gomega.Consistently(getAssignedNode).Should(gomega.Equal(node2.Name))
+				return nil
+			}
+			err := app.Run([]string{app.Name})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		})
+	})
+
 	ginkgo.Context("EgressIP Mark cache", func() {
 		ginkgo.It("should round robin when mark range is exhausted", func() {
 			nodeAlloc := getEgressIPMarkAllocator()
@@ -10,6 +10,7 @@ import (
 	cache "k8s.io/client-go/tools/cache"
 	"k8s.io/klog/v2"
 
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
 	egressipv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressip/v1"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/factory"
 	objretry "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/retry"
@@ -60,6 +61,7 @@ func (h *egressIPClusterControllerEventHandler) AddResource(obj interface{}, _ b
 		if isReady {
 			h.eIPC.setNodeEgressReady(node.Name, true)
 		}
+		h.eIPC.setNodeEgressDraining(node.Name, util.IsNodeEgressIPDraining(node))
 		isReachable := h.eIPC.isEgressNodeReachable(node)
 		if hasEgressLabel && isReachable && isReady {
 			h.eIPC.setNodeEgressReachable(node.Name, true)
@@ -73,6 +75,9 @@ func (h *egressIPClusterControllerEventHandler) AddResource(obj interface{}, _ b
 	case factory.CloudPrivateIPConfigType:
 		cloudPrivateIPConfig := obj.(*ocpcloudnetworkapi.CloudPrivateIPConfig)
 		return h.eIPC.reconcileCloudPrivateIPConfig(nil, cloudPrivateIPConfig)
+	case factory.EgressIPPoolType:
+		eIPPool := obj.(*egressipv1.EgressIPPool)
+		return h.eIPC.reconcileEgressIPPool(eIPPool.Name)
 	default:
 		return fmt.Errorf("no add function for object type %s", h.objType)
 	}
@@ -105,6 +110,12 @@ func (h *egressIPClusterControllerEventHandler) UpdateResource(oldObj, newObj in
 		if err := h.eIPC.initEgressIPAllocator(newNode); err != nil {
 			klog.Warningf("Egress node initialization error: %v", err)
 		}
+		// check right away the reachability of the egress nodes the node runs
+		// BFD sessions with, for a fast failover
+		if h.eIPC.egressIPReachabilityMode == config.EgressIPReachabilityModeBFD &&
+			util.NodeEgressIPBFDStatusAnnotationChanged(oldNode, newNode) {
+			h.eIPC.triggerReachabilityCheck()
+		}
 		nodeEgressLabel := util.GetNodeEgressLabel()
 		oldLabels := oldNode.GetLabels()
 		newLabels := newNode.GetLabels()
@@ -125,7 +136,10 @@ func (h *egressIPClusterControllerEventHandler) UpdateResource(oldObj, newObj in
 		isNewReady := h.eIPC.isEgressNodeReady(newNode)
 		isNewReachable := h.eIPC.isEgressNodeReachable(newNode)
 		isHostCIDRsAltered := util.NodeHostCIDRsAnnotationChanged(oldNode, newNode)
+		isNewDraining := util.IsNodeEgressIPDraining(newNode)
+		isDrainingAltered := util.IsNodeEgressIPDraining(oldNode) != isNewDraining
 		h.eIPC.setNodeEgressReady(newNode.Name, isNewReady)
+		h.eIPC.setNodeEgressDraining(newNode.Name, isNewDraining)
 		if !oldHadEgressLabel && newHasEgressLabel {
 			klog.Infof("Node: %s has been labeled, adding it for egress assignment", newNode.Name)
 			if isNewReady && isNewReachable {
@@ -139,7 +153,7 @@ func (h *egressIPClusterControllerEventHandler) UpdateResource(oldObj, newObj in
 			}
 			return nil
 		}
-		if isOldReady == isNewReady && !isHostCIDRsAltered {
+		if isOldReady == isNewReady && !isHostCIDRsAltered && !isDrainingAltered {
 			return nil
 		}
 		if !isNewReady {
@@ -147,6 +161,13 @@ func (h *egressIPClusterControllerEventHandler) UpdateResource(oldObj, newObj in
 			if err := h.eIPC.deleteEgressNode(newNode.Name); err != nil {
 				return err
 			}
+		} else if isNewDraining {
+			if isDrainingAltered {
+				klog.Infof("Node: %s is drained, moving its egress IPs to other egress nodes", newNode.Name)
+				if err := h.eIPC.deleteEgressNode(newNode.Name); err != nil {
+					return err
+				}
+			}
 		} else if isNewReady && isNewReachable {
 			klog.Infof("Node: %s is ready and reachable, adding it for egress assignment", newNode.Name)
 			h.eIPC.setNodeEgressReachable(newNode.Name, isNewReachable)
@@ -165,6 +186,13 @@ func (h *egressIPClusterControllerEventHandler) UpdateResource(oldObj, newObj in
 		oldCloudPrivateIPConfig := oldObj.(*ocpcloudnetworkapi.CloudPrivateIPConfig)
 		newCloudPrivateIPConfig := newObj.(*ocpcloudnetworkapi.CloudPrivateIPConfig)
 		return h.eIPC.reconcileCloudPrivateIPConfig(oldCloudPrivateIPConfig, newCloudPrivateIPConfig)
+	case factory.EgressIPPoolType:
+		oldEIPPool := oldObj.(*egressipv1.EgressIPPool)
+		newEIPPool := newObj.(*egressipv1.EgressIPPool)
+		if reflect.DeepEqual(oldEIPPool.Spec, newEIPPool.Spec) {
+			return nil
+		}
+		return h.eIPC.reconcileEgressIPPool(newEIPPool.Name)
 	default:
 		return fmt.Errorf("no update function for object type %s", h.objType)
 	}
@@ -196,6 +224,9 @@ func (h *egressIPClusterControllerEventHandler) DeleteResource(obj, _ interface{
 	case factory.CloudPrivateIPConfigType:
 		cloudPrivateIPConfig := obj.(*ocpcloudnetworkapi.CloudPrivateIPConfig)
 		return h.eIPC.reconcileCloudPrivateIPConfig(cloudPrivateIPConfig, nil)
+	case factory.EgressIPPoolType:
+		eIPPool := obj.(*egressipv1.EgressIPPool)
+		return h.eIPC.reconcileEgressIPPool(eIPPool.Name)
 	default:
 		return fmt.Errorf("no delete function for object type %s", h.objType)
 	}
@@ -210,11 +241,13 @@ func (h *egressIPClusterControllerEventHandler) SyncFunc(objs []interface{}) err
 	} else {
 		switch h.objType {
 		case factory.EgressIPType:
-			syncFunc = h.eIPC.syncEgressIPMarkAllocator
+			syncFunc = h.eIPC.syncEgressIPs
 		case factory.EgressNodeType:
 			syncFunc = h.eIPC.initEgressNodeReachability
 		case factory.CloudPrivateIPConfigType:
 			syncFunc = h.eIPC.syncCloudPrivateIPConfigs
+		case factory.EgressIPPoolType:
+			syncFunc = nil
 
 		default:
 			return fmt.Errorf("no sync function for object type %s", h.objType)
@@ -245,6 +278,8 @@ func (h *egressIPClusterControllerEventHandler) GetResourceFromInformerCache(key
 		obj, err = h.eIPC.watchFactory.GetCloudPrivateIPConfig(name)
 	case factory.EgressIPType:
 		obj, err = h.eIPC.watchFactory.GetEgressIP(name)
+	case factory.EgressIPPoolType:
+		obj, err = h.eIPC.watchFactory.GetEgressIPPool(name)
 
 	default:
 		err = fmt.Errorf("object type %s not supported, cannot retrieve it from informers cache",
@@ -0,0 +1,340 @@
+package clustermanager
+
+import (
+	"fmt"
+	"net/netip"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+
+	egressipv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressip/v1"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
+	utilerrors "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util/errors"
+)
+
+// getRequestedEgressIPs returns the egress IPs listed in the spec of the
+// EgressIP together with the egress IPs allocated to it from an EgressIPPool.
+func getRequestedEgressIPs(eIP *egressipv1.EgressIP) []string {
+	return append(slices.Clone(eIP.Spec.EgressIPs), eIP.Status.AllocatedEgressIPs...)
+}
+
+// syncEgressIPs is the sync function of the EgressIP handler. It reserves the
+// egress IPs previously allocated from EgressIPPools and restores the preferred
+// nodes of the egress IPs before building the mark cache.
+func (eIPC *egressIPClusterController) syncEgressIPs(egressIPs []interface{}) error {
+	if err := eIPC.syncEgressIPPoolAllocations(egressIPs); err != nil {
+		return err
+	}
+	if err := eIPC.syncPreferredEgressNodes(egressIPs); err != nil {
+		return err
+	}
+	return eIPC.syncEgressIPMarkAllocator(egressIPs)
+}
+
+// syncEgressIPPoolAllocations reserves the egress IPs allocated from
+// EgressIPPools and reported in the status of the existing EgressIPs, so that
+// they are not allocated twice before all the EgressIPs are reconciled.
+func (eIPC *egressIPClusterController) syncEgressIPPoolAllocations(egressIPs []interface{}) error {
+	eIPC.egressIPAssignmentMutex.Lock()
+	defer eIPC.egressIPAssignmentMutex.Unlock()
+	for _, object := range egressIPs {
+		egressIP, ok := object.(*egressipv1.EgressIP)
+		if !ok {
+			return fmt.Errorf("failed to cast %T to *egressipv1.EgressIP", object)
+		}
+		for _, ip := range egressIP.Status.AllocatedEgressIPs {
+			if owner, exists := eIPC.poolAllocations[ip]; exists {
+				klog.Warningf("Egress IP %s is allocated to both EgressIP %s and %s, it will be released from one of them",
+					ip, owner, egressIP.Name)
+				continue
+			}
+			eIPC.poolAllocations[ip] = egressIP.Name
+		}
+	}
+	return nil
+}
+
+// reconcilePoolEgressIPs returns the egress IPs allocated to the EgressIP from
+// the EgressIPPool requested in its spec. The egress IPs previously allocated
+// are kept as long as they still belong to the pool, and egress IPs are
+// allocated or released until the requested count is met. New egress IPs are
+// only allocated from the networks of the egress nodes, so that they can be
+// assigned.
+// egressIPAssignmentMutex must be held.
+func (eIPC *egressIPClusterController) reconcilePoolEgressIPs(eIP *egressipv1.EgressIP) ([]string, error) {
+	var prefixes []netip.Prefix
+	count := 0
+	if request := eIP.Spec.EgressIPPool; request != nil {
+		count = max(int(request.Count), 1)
+		pool, err := eIPC.watchFactory.GetEgressIPPool(request.Name)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf("failed to get EgressIPPool %s: %w", request.Name, err)
+		}
+		if err != nil {
+			eIPC.recorder.Eventf(&corev1.ObjectReference{Kind: "EgressIP", Name: eIP.Name}, corev1.EventTypeWarning,
+				"EgressIPPoolNotFound", "EgressIPPool %s requested by EgressIP %s does not exist", request.Name, eIP.Name)
+		} else {
+			prefixes = parseEgressIPPoolCIDRs(pool)
+		}
+	}
+	specIPs := sets.New[string]()
+	for _, ip := range eIP.Spec.EgressIPs {
+		if addr, err := netip.ParseAddr(ip); err == nil {
+			specIPs.Insert(addr.String())
+		}
+	}
+
+	// the EgressIP might be stale, so the egress IPs reserved for it are
+	// considered previously allocated as well
+	previous := slices.Clone(eIP.Status.AllocatedEgressIPs)
+	owned := []string{}
+	for ip, owner := range eIPC.poolAllocations {
+		if owner == eIP.Name && !slices.Contains(previous, ip) {
+			owned = append(owned, ip)
+		}
+	}
+	slices.Sort(owned)
+	previous = append(previous, owned...)
+
+	allocated := make([]string, 0, count)
+	for _, ip := range previous {
+		owner, reserved := eIPC.poolAllocations[ip]
+		addr, err := netip.ParseAddr(ip)
+		if len(allocated) < count && err == nil && (!reserved || owner == eIP.Name) &&
+			isEgressIPPoolAddr(prefixes, addr) && !specIPs.Has(ip) {
+			eIPC.poolAllocations[ip] = eIP.Name
+			allocated = append(allocated, ip)
+			continue
+		}
+		if owner == eIP.Name {
+			klog.Infof("Releasing egress IP %s of EgressIP %s", ip, eIP.Name)
+			delete(eIPC.poolAllocations, ip)
+		}
+	}
+	if len(allocated) == count {
+		return allocated, nil
+	}
+
+	usedIPs, err := eIPC.getUsedEgressIPs()
+	if err != nil {
+		return nil, err
+	}
+	networks, err := eIPC.getEgressNodeNetworks()
+	if err != nil {
+		return nil, err
+	}
+	for _, candidates := range getEgressIPPoolCandidateRanges(prefixes, networks) {
+		// every address skipped below is either used, reserved, or the network
+		// or broadcast address of the pool or of the node network, which bounds
+		// the number of addresses to walk to find the missing ones
+		budget := count - len(allocated) + len(usedIPs) + len(eIPC.poolAllocations) + 4
+		for addr := candidates.Addr(); len(allocated) < count && budget > 0 && candidates.Contains(addr); addr = addr.Next() {
+			budget--
+			ip := addr.String()
+			if !isEgressIPPoolAddr(prefixes, addr) || !isEgressIPPoolAddr(networks, addr) || usedIPs.Has(ip) {
+				continue
+			}
+			if _, reserved := eIPC.poolAllocations[ip]; reserved {
+				continue
+			}
+			klog.Infof("Allocated egress IP %s from EgressIPPool %s to EgressIP %s", ip, eIP.Spec.EgressIPPool.Name, eIP.Name)
+			eIPC.poolAllocations[ip] = eIP.Name
+			allocated = append(allocated, ip)
+		}
+	}
+	if len(allocated) < count && len(prefixes) > 0 {
+		eIPC.recorder.Eventf(&corev1.ObjectReference{Kind: "EgressIP", Name: eIP.Name}, corev1.EventTypeWarning,
+			"EgressIPPoolExhausted", "Only %d of the %d egress IPs requested by EgressIP %s could be allocated from EgressIPPool %s",
+			len(allocated), count, eIP.Name, eIP.Spec.EgressIPPool.Name)
+	}
+	return allocated, nil
+}
+
+// releasePoolEgressIPs releases all the egress IPs allocated to the EgressIP
+// from EgressIPPools. egressIPAssignmentMutex must be held.
+func (eIPC *egressIPClusterController) releasePoolEgressIPs(name string) {
+	for ip, owner := range eIPC.poolAllocations {
+		if owner == name {
+			delete(eIPC.poolAllocations, ip)
+		}
+	}
+}
+
+// getUsedEgressIPs returns the addresses that can't be allocated from an
+// EgressIPPool: the egress IPs listed in the spec of any EgressIP, the egress
+// IPs assigned to the egress nodes and the addresses of the nodes.
+func (eIPC *egressIPClusterController) getUsedEgressIPs() (sets.Set[string], error) {
+	usedIPs := sets.New[string]()
+	egressIPs, err := eIPC.watchFactory.GetEgressIPs()
+	if err != nil {
+		return nil, fmt.Errorf("unable to list EgressIPs, err: %v", err)
+	}
+	for _, egressIP := range egressIPs {
+		for _, ip := range egressIP.Spec.EgressIPs {
+			if addr, err := netip.ParseAddr(ip); err == nil {
+				usedIPs.Insert(addr.String())
+			}
+		}
+	}
+	nodes, err := eIPC.watchFactory.GetNodes()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get nodes: %v", err)
+	}
+	for _, node := range nodes {
+		if util.NoHostSubnet(node) {
+			continue
+		}
+		nodeHostAddrsSet, err := util.ParseNodeHostCIDRsDropNetMask(node)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse node host cidrs for node %s: %v", node.Name, err)
+		}
+		usedIPs = usedIPs.Union(nodeHostAddrsSet)
+	}
+	eIPC.nodeAllocator.Lock()
+	defer eIPC.nodeAllocator.Unlock()
+	for _, eNode := range eIPC.nodeAllocator.cache {
+		for ip := range eNode.allocations {
+			usedIPs.Insert(ip)
+		}
+	}
+	return usedIPs, nil
+}
+
+// getEgressNodeNetworks returns the networks of the egress nodes that can host
+// egress IPs: the primary network of the nodes and, unless running on a cloud
+// platform, their secondary host networks.
+func (eIPC *egressIPClusterController) getEgressNodeNetworks() ([]netip.Prefix, error) {
+	eIPC.nodeAllocator.Lock()
+	defer eIPC.nodeAllocator.Unlock()
+	networks := sets.New[netip.Prefix]()
+	for _, eNode := range eIPC.nodeAllocator.cache {
+		if !eNode.isEgressAssignable {
+			continue
+		}
+		for _, ifAddr := range []util.ParsedIFAddr{eNode.egressIPConfig.V4, eNode.egressIPConfig.V6} {
+			if ifAddr.Net == nil {
+				continue
+			}
+			if prefix, err := netip.ParsePrefix(ifAddr.Net.String()); err == nil {
+				networks.Insert(prefix.Masked())
+			}
+		}
+		if util.PlatformTypeIsEgressIPCloudProvider() {
+			continue
+		}
+		node, err := eIPC.watchFactory.GetNode(eNode.name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get node %s: %w", eNode.name, err)
+		}
+		hostNetworks, err := util.ParseNodeHostCIDRsExcludeOVNNetworks(node)
+		if err != nil {
+			klog.Warningf("Failed to get the secondary host networks of node %s: %v", node.Name, err)
+			continue
+		}
+		for _, hostNetwork := range hostNetworks {
+			prefix, err := netip.ParsePrefix(hostNetwork)
+			if err != nil || !prefix.Addr().IsGlobalUnicast() {
+				continue
+			}
+			networks.Insert(prefix.Masked())
+		}
+	}
+	return networks.UnsortedList(), nil
+}
+
+// getEgressIPPoolCandidateRanges returns the ranges the egress IPs can be
+// allocated from: the intersections of the pool prefixes with the networks of
+// the egress nodes.
+func getEgressIPPoolCandidateRanges(prefixes, networks []netip.Prefix) []netip.Prefix {
+	candidates := sets.New[netip.Prefix]()
+	for _, prefix := range prefixes {
+		for _, network := range networks {
+			if !prefix.Overlaps(network) {
+				continue
+			}
+			// overlapping prefixes are nested, their intersection is the longest one
+			if prefix.Bits() >= network.Bits() {
+				candidates.Insert(prefix)
+			} else {
+				candidates.Insert(network)
+			}
+		}
+	}
+	ranges := candidates.UnsortedList()
+	slices.SortFunc(ranges, func(a, b netip.Prefix) int {
+		if c := a.Addr().Compare(b.Addr()); c != 0 {
+			return c
+		}
+		return a.Bits() - b.Bits()
+	})
+	return ranges
+}
+
+// reconcileEgressIPPool sends a synthetic update for all the EgressIPs
+// requesting egress IPs from the EgressIPPool, so that their allocations follow
+// the changes of the pool.
+func (eIPC *egressIPClusterController) reconcileEgressIPPool(name string) error {
+	egressIPs, err := eIPC.kube.GetEgressIPs()
+	if err != nil {
+		return fmt.Errorf("unable to list EgressIPs, err: %v", err)
+	}
+	var errors []error
+	for _, egressIP := range egressIPs {
+		if egressIP.Spec.EgressIPPool == nil || egressIP.Spec.EgressIPPool.Name != name {
+			continue
+		}
+		if err := eIPC.reconcileEgressIP(nil, egressIP); err != nil {
+			errors = append(errors, fmt.Errorf("synthetic update for EgressIP: %s failed, err: %v", egressIP.Name, err))
+		}
+	}
+	return utilerrors.Join(errors...)
+}
+
+// parseEgressIPPoolCIDRs returns the ranges of the EgressIPPool, ignoring the
+// invalid ones.
+func parseEgressIPPoolCIDRs(pool *egressipv1.EgressIPPool) []netip.Prefix {
+	prefixes := make([]netip.Prefix, 0, len(pool.Spec.CIDRs))
+	for _, cidr := range pool.Spec.CIDRs {
+		prefix, err := netip.ParsePrefix(cidr)
+		if err != nil {
+			klog.Errorf("Ignoring invalid CIDR %q of EgressIPPool %s: %v", cidr, pool.Name, err)
+			continue
+		}
+		prefixes = append(prefixes, prefix.Masked())
+	}
+	return prefixes
+}
+
+// isEgressIPPoolAddr returns true if addr belongs to one of the prefixes and
+// can be allocated, i.e. it is neither the network address nor the IPv4
+// broadcast address of the prefix.
+func isEgressIPPoolAddr(prefixes []netip.Prefix, addr netip.Addr) bool {
+	for _, prefix := range prefixes {
+		if !prefix.Contains(addr) {
+			continue
+		}
+		if prefix.Addr().Is4() && prefix.Bits() >= 31 || prefix.Addr().Is6() && prefix.Bits() >= 127 {
+			return true
+		}
+		if addr == prefix.Addr() {
+			return false
+		}
+		if addr.Is4() {
+			broadcast := prefix.Addr().As4()
+			hostBits := 32 - prefix.Bits()
+			for i := 3; i >= 0 && hostBits > 0; i-- {
+				n := min(hostBits, 8)
+				broadcast[i] |= byte(1<<n - 1)
+				hostBits -= n
+			}
+			if addr.As4() == broadcast {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
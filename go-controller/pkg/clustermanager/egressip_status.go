@@ -0,0 +1,330 @@
+package clustermanager
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+
+	egressipv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressip/v1"
+	egressipapply "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressip/v1/apis/applyconfiguration/egressip/v1"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
+)
+
+const (
+	egressIPAssignedReason          = "EgressIPsAssigned"
+	egressIPPartiallyAssignedReason = "PartiallyAssigned"
+	egressIPNotAssignedReason       = "NotAssigned"
+	egressIPNodesReachableReason    = "EgressNodesReachable"
+	egressIPProgrammedReason        = "Programmed"
+	egressIPProgrammingFailedReason = "ProgrammingFailed"
+	egressIPProgrammingPending      = "Pending"
+
+	// egressIPFieldManager is the field manager of the conditions applied by
+	// cluster manager, it must be different from any zone name since the zones
+	// apply their conditions with their name as field manager.
+	egressIPFieldManager = "clustermanager-egressip-controller"
+)
+
+// newUnassignedEgressIPItem returns the status of an egress IP which could not
+// be assigned for the given reason, unless egress nodes able to host it are
+// unreachable. nodeAllocator lock must be held.
+func (eIPC *egressIPClusterController) newUnassignedEgressIPItem(eIP net.IP, reason, message string) egressipv1.EgressIPUnassignedStatusItem {
+	if nodes := eIPC.getUnreachableEgressNodes(eIP); len(nodes) > 0 {
+		reason = egressipv1.EgressIPReasonNodeUnreachable
+		message = fmt.Sprintf("egress nodes able to host the egress IP are unreachable: %s", strings.Join(nodes, ", "))
+	}
+	return egressipv1.EgressIPUnassignedStatusItem{
+		EgressIP: eIP.String(),
+		Reason:   reason,
+		Message:  message,
+	}
+}
+
+// getUnreachableEgressNodes returns the sorted names of the egress assignable
+// and ready nodes which are able to host the egress IP but are unreachable.
+// nodeAllocator lock must be held.
+func (eIPC *egressIPClusterController) getUnreachableEgressNodes(eIP net.IP) []string {
+	var nodes []string
+	for _, eNode := range eIPC.nodeAllocator.cache {
+		if !eNode.isEgressAssignable || !eNode.isReady || eNode.isReachable {
+			continue
+		}
+		node, err := eIPC.watchFactory.GetNode(eNode.name)
+		if err != nil {
+			continue
+		}
+		if network, err := util.GetEgressIPNetwork(node, eNode.egressIPConfig, eIP); err == nil && network != "" {
+			nodes = append(nodes, eNode.name)
+		}
+	}
+	slices.Sort(nodes)
+	return nodes
+}
+
+// buildEgressIPStatus returns the status of the EgressIP with the given
+// assignments and the conditions derived from them. The requested egress IPs
+// neither assigned nor part of unassigned are reported as pending. It also
+// returns whether the unassigned egress IPs or the conditions changed.
+func (eIPC *egressIPClusterController) buildEgressIPStatus(eIP *egressipv1.EgressIP, items []egressipv1.EgressIPStatusItem,
+	allocatedEgressIPs []string, unassigned []egressipv1.EgressIPUnassignedStatusItem) (egressipv1.EgressIPStatus, bool) {
+	status := egressipv1.EgressIPStatus{
+		Items:              items,
+		AllocatedEgressIPs: allocatedEgressIPs,
+		Conditions:         slices.Clone(eIP.Status.Conditions),
+	}
+
+	requested := sets.New[string]()
+	for _, egressIP := range append(slices.Clone(eIP.Spec.EgressIPs), allocatedEgressIPs...) {
+		if ip := net.ParseIP(egressIP); ip != nil {
+			requested.Insert(ip.String())
+		}
+	}
+	assigned := sets.New[string]()
+	for _, item := range items {
+		assigned.Insert(item.EgressIP)
+	}
+	reported := sets.New[string]()
+	for _, item := range unassigned {
+		if requested.Has(item.EgressIP) && !assigned.Has(item.EgressIP) && !reported.Has(item.EgressIP) {
+			reported.Insert(item.EgressIP)
+			status.UnassignedItems = append(status.UnassignedItems, item)
+		}
+	}
+	for egressIP := range requested.Difference(assigned).Difference(reported) {
+		status.UnassignedItems = append(status.UnassignedItems, egressipv1.EgressIPUnassignedStatusItem{
+			EgressIP: egressIP,
+			Reason:   egressipv1.EgressIPReasonPending,
+			Message:  "the egress IP is not assigned yet",
+		})
+	}
+	slices.SortFunc(status.UnassignedItems, func(a, b egressipv1.EgressIPUnassignedStatusItem) int {
+		return strings.Compare(a.EgressIP, b.EgressIP)
+	})
+	changed := !slices.Equal(status.UnassignedItems, eIP.Status.UnassignedItems)
+
+	assignedCondition := metav1.Condition{
+		Type:    egressipv1.EgressIPAssignedCondition,
+		Status:  metav1.ConditionTrue,
+		Reason:  egressIPAssignedReason,
+		Message: fmt.Sprintf("All %d egress IPs are assigned", requested.Len()),
+	}
+	if len(status.UnassignedItems) > 0 || requested.Len() == 0 {
+		assignedCondition.Status = metav1.ConditionFalse
+		assignedCondition.Reason = egressIPNotAssignedReason
+		if len(status.UnassignedItems) > 0 && assigned.Len() > 0 {
+			assignedCondition.Reason = egressIPPartiallyAssignedReason
+		}
+		assignedCondition.Message = fmt.Sprintf("%d of %d egress IPs are assigned", requested.Len()-len(status.UnassignedItems), requested.Len())
+	}
+	changed = meta.SetStatusCondition(&status.Conditions, assignedCondition) || changed
+
+	reachableCondition := metav1.Condition{
+		Type:    egressipv1.EgressIPReachableCondition,
+		Status:  metav1.ConditionTrue,
+		Reason:  egressIPNodesReachableReason,
+		Message: "No egress IP is unassigned because of unreachable egress nodes",
+	}
+	var unreachableEgressIPs []string
+	for _, item := range status.UnassignedItems {
+		if item.Reason == egressipv1.EgressIPReasonNodeUnreachable {
+			unreachableEgressIPs = append(unreachableEgressIPs, item.EgressIP)
+		}
+	}
+	if len(unreachableEgressIPs) > 0 {
+		reachableCondition.Status = metav1.ConditionFalse
+		reachableCondition.Reason = egressipv1.EgressIPReasonNodeUnreachable
+		reachableCondition.Message = fmt.Sprintf("The egress nodes able to host egress IPs %s are unreachable",
+			strings.Join(unreachableEgressIPs, ", "))
+	}
+	changed = meta.SetStatusCondition(&status.Conditions, reachableCondition) || changed
+
+	zones, err := eIPC.getZones()
+	if err != nil {
+		klog.Warningf("Unable to determine the zones for the status of EgressIP %s: %v", eIP.Name, err)
+		return status, changed
+	}
+	for _, condition := range eIP.Status.Conditions {
+		zone, isZoneCondition := strings.CutPrefix(condition.Type, egressipv1.EgressIPProgrammedInZoneConditionPrefix)
+		if isZoneCondition && !zones.Has(zone) {
+			// the zone doesn't exist anymore
+			changed = meta.RemoveStatusCondition(&status.Conditions, condition.Type) || changed
+		}
+	}
+	var failedZones, pendingZones []string
+	for _, zone := range sets.List(zones) {
+		condition := meta.FindStatusCondition(status.Conditions, egressipv1.EgressIPProgrammedInZoneConditionPrefix+zone)
+		switch {
+		case condition == nil || condition.Status == metav1.ConditionUnknown:
+			pendingZones = append(pendingZones, zone)
+		case condition.Status == metav1.ConditionFalse:
+			failedZones = append(failedZones, zone)
+		}
+	}
+	programmedCondition := metav1.Condition{
+		Type:    egressipv1.EgressIPProgrammedCondition,
+		Status:  metav1.ConditionTrue,
+		Reason:  egressIPProgrammedReason,
+		Message: "The EgressIP is programmed in all zones",
+	}
+	switch {
+	case len(failedZones) > 0:
+		programmedCondition.Status = metav1.ConditionFalse
+		programmedCondition.Reason = egressIPProgrammingFailedReason
+		programmedCondition.Message = fmt.Sprintf("The EgressIP failed to be programmed in zones: %s", strings.Join(failedZones, ", "))
+	case zones.Len() == 0:
+		programmedCondition.Status = metav1.ConditionUnknown
+		programmedCondition.Reason = egressIPProgrammingPending
+		programmedCondition.Message = "No zone found"
+	case len(pendingZones) > 0:
+		programmedCondition.Status = metav1.ConditionUnknown
+		programmedCondition.Reason = egressIPProgrammingPending
+		programmedCondition.Message = fmt.Sprintf("Waiting for zones: %s", strings.Join(pendingZones, ", "))
+	}
+	changed = meta.SetStatusCondition(&status.Conditions, programmedCondition) || changed
+	return status, changed
+}
+
+// getZones returns the zones of all the nodes.
+func (eIPC *egressIPClusterController) getZones() (sets.Set[string], error) {
+	nodes, err := eIPC.watchFactory.GetNodes()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get nodes: %w", err)
+	}
+	zones := sets.New[string]()
+	for _, node := range nodes {
+		zones.Insert(util.GetNodeZone(node))
+	}
+	return zones, nil
+}
+
+// egressIPStatusApplyConfiguration is the configuration of the EgressIP mark and
+// status fields owned by cluster manager. Unlike the generated apply
+// configuration, it keeps the empty lists, so that they replace the lists
+// patched before the status was applied.
+type egressIPStatusApplyConfiguration struct {
+	metav1.TypeMeta `json:",inline"`
+	Metadata        struct {
+		Name        string            `json:"name"`
+		Annotations map[string]string `json:"annotations,omitempty"`
+	} `json:"metadata"`
+	Status struct {
+		Items              []egressipv1.EgressIPStatusItem           `json:"items"`
+		AllocatedEgressIPs []string                                  `json:"allocatedEgressIPs"`
+		UnassignedItems    []egressipv1.EgressIPUnassignedStatusItem `json:"unassignedItems"`
+		Conditions         []metav1.Condition                        `json:"conditions"`
+	} `json:"status"`
+}
+
+// patchEgressIPStatus applies the status of the EgressIP and records an event
+// for every condition which changed between True and False, or which is
+// initially False.
+func (eIPC *egressIPClusterController) patchEgressIPStatus(eIP *egressipv1.EgressIP, status egressipv1.EgressIPStatus) error {
+	if err := eIPC.applyEgressIPStatus(eIP, status); err != nil {
+		return err
+	}
+	for _, conditionType := range []string{egressipv1.EgressIPAssignedCondition, egressipv1.EgressIPReachableCondition,
+		egressipv1.EgressIPProgrammedCondition} {
+		condition := meta.FindStatusCondition(status.Conditions, conditionType)
+		if condition == nil || condition.Status == metav1.ConditionUnknown {
+			continue
+		}
+		previous := meta.FindStatusCondition(eIP.Status.Conditions, conditionType)
+		if previous == nil && condition.Status == metav1.ConditionTrue || previous != nil && previous.Status == condition.Status {
+			continue
+		}
+		eventType := corev1.EventTypeNormal
+		if condition.Status == metav1.ConditionFalse {
+			eventType = corev1.EventTypeWarning
+		}
+		eIPC.recorder.Eventf(&corev1.ObjectReference{Kind: "EgressIP", Name: eIP.Name}, eventType, condition.Reason,
+			"%s condition of EgressIP %s is %s: %s", conditionType, eIP.Name, condition.Status, condition.Message)
+	}
+	return nil
+}
+
+// applyEgressIPStatus server side applies the mark and the status fields owned
+// by cluster manager: the zones apply their conditions concurrently with their
+// name as field manager, which are kept. The conditions of the zones of the
+// EgressIP which are not part of the given status anymore are removed by
+// applying an empty status on behalf of these zones.
+// The mark is always applied, otherwise it would be removed once owned. If it
+// fails to allocate a mark, it logs an error instead of failing because we do
+// not wish to block primary default network egress IP assignments due to
+// potential mark range exhaustion. Primary default network egress IP currently
+// does not utilize marks to config EgressIP. The preferred nodes of the egress
+// IPs are applied along, and removed once the EgressIP has none.
+func (eIPC *egressIPClusterController) applyEgressIPStatus(eIP *egressipv1.EgressIP, status egressipv1.EgressIPStatus) error {
+	applyObj := egressIPStatusApplyConfiguration{}
+	applyObj.APIVersion = egressipv1.SchemeGroupVersion.String()
+	applyObj.Kind = "EgressIP"
+	applyObj.Metadata.Name = eIP.Name
+	applyObj.Metadata.Annotations = map[string]string{}
+	if util.IsEgressIPMarkSet(eIP.Annotations) {
+		applyObj.Metadata.Annotations[util.EgressIPMarkAnnotation] = eIP.Annotations[util.EgressIPMarkAnnotation]
+	} else if mark, _, err := eIPC.getOrAllocMark(eIP.Name); err != nil {
+		klog.Errorf("Failed to get mark for EgressIP %s: %v", eIP.Name, err)
+	} else {
+		applyObj.Metadata.Annotations = createAnnotWithMark(mark)
+	}
+	preferredNodes, err := eIPC.getPreferredEgressNodesAnnotation(eIP.Name)
+	if err != nil {
+		return err
+	}
+	if preferredNodes != "" {
+		applyObj.Metadata.Annotations[util.EgressIPPreferredNodesAnnotation] = preferredNodes
+	}
+	applyObj.Status.Items = nonNilSlice(status.Items)
+	applyObj.Status.AllocatedEgressIPs = nonNilSlice(status.AllocatedEgressIPs)
+	applyObj.Status.UnassignedItems = nonNilSlice(status.UnassignedItems)
+	applyObj.Status.Conditions = []metav1.Condition{}
+	for _, condition := range status.Conditions {
+		if !strings.HasPrefix(condition.Type, egressipv1.EgressIPProgrammedInZoneConditionPrefix) {
+			applyObj.Status.Conditions = append(applyObj.Status.Conditions, condition)
+		}
+	}
+	data, err := json.Marshal(applyObj)
+	if err != nil {
+		return fmt.Errorf("failed to serialize the status of EgressIP %s: %w", eIP.Name, err)
+	}
+	klog.Infof("Applying status on EgressIP %s: %s", eIP.Name, data)
+	_, err = eIPC.kube.EIPClient.K8sV1().EgressIPs().Patch(context.TODO(), eIP.Name, k8stypes.ApplyPatchType, data,
+		metav1.PatchOptions{FieldManager: egressIPFieldManager, Force: ptr.To(true)})
+	if err != nil {
+		return fmt.Errorf("failed to apply the status of EgressIP %s: %w", eIP.Name, err)
+	}
+
+	for _, condition := range eIP.Status.Conditions {
+		zone, isZoneCondition := strings.CutPrefix(condition.Type, egressipv1.EgressIPProgrammedInZoneConditionPrefix)
+		if !isZoneCondition || meta.FindStatusCondition(status.Conditions, condition.Type) != nil {
+			continue
+		}
+		klog.Infof("Removing the condition of stale zone %s from EgressIP %s", zone, eIP.Name)
+		zoneApplyObj := egressipapply.EgressIP(eIP.Name).WithStatus(egressipapply.EgressIPStatus())
+		_, err = eIPC.kube.EIPClient.K8sV1().EgressIPs().Apply(context.TODO(), zoneApplyObj,
+			metav1.ApplyOptions{FieldManager: zone, Force: true})
+		if err != nil {
+			return fmt.Errorf("failed to remove the condition of stale zone %s from EgressIP %s: %w", zone, eIP.Name, err)
+		}
+	}
+	return nil
+}
+
+// nonNilSlice returns an empty slice instead of a nil one, so that it is
+// serialized as an empty list.
+func nonNilSlice[T any](s []T) []T {
+	if s == nil {
+		return []T{}
+	}
+	return s
+}
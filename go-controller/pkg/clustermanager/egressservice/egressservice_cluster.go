@@ -3,6 +3,7 @@ package egressservice
 import (
 	"fmt"
 	"net"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -12,6 +13,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/workqueue"
@@ -72,7 +74,7 @@ type Controller struct {
 }
 
 type svcState struct {
-	node     string
+	nodes    []string // the nodes allocated for the service, the first one is reported as its host
 	selector labels.Selector
 	stale    bool
 }
@@ -272,19 +274,9 @@ func (c *Controller) repair() error {
 		}
 
 		nodeSelector := &es.Spec.NodeSelector
-		svcHost := es.Status.Host
+		svcHosts := util.GetEgressServiceHosts(es)
 
-		if svcHost == "" {
-			continue
-		}
-
-		node, err := c.watchFactory.GetNode(svcHost)
-		if err != nil {
-			klog.Errorf("Node %s could not be retrieved from lister, err: %v", svcHost, err)
-			continue
-		}
-		if !nodeIsReady(node) {
-			klog.Infof("Node %s is not ready, it can not be used for egress service %s", svcHost, key)
+		if len(svcHosts) == 0 {
 			continue
 		}
 
@@ -301,7 +293,7 @@ func (c *Controller) repair() error {
 
 		if len(epsNodes) != 0 && svc.Spec.ExternalTrafficPolicy == corev1.ServiceExternalTrafficPolicyTypeLocal {
 			// If the service is ETP=Local only a node with local eps can be used.
-			// We want to verify that the current selected node has a local ep.
+			// We want to verify that the current selected nodes have a local ep.
 			matchEpsNodes := metav1.LabelSelectorRequirement{
 				Key:      "kubernetes.io/hostname",
 				Operator: metav1.LabelSelectorOpIn,
@@ -316,23 +308,44 @@ func (c *Controller) repair() error {
 			continue
 		}
 
-		if !selector.Matches(labels.Set(node.Labels)) {
-			klog.Infof("Node %s does no longer match service %s selectors %s", svcHost, key, selector.String())
-			continue
-		}
+		svcState := &svcState{selector: selector, stale: false}
+		for _, svcHost := range svcHosts {
+			if len(svcState.nodes) == hostCountFor(es) {
+				break
+			}
 
-		nodeState, ok := c.nodes[svcHost]
-		if !ok {
-			nodeState, err = c.nodeStateFor(svcHost)
+			node, err := c.watchFactory.GetNode(svcHost)
 			if err != nil {
-				klog.Errorf("Can't fetch egress service %s node %s state, err: %v", key, svcHost, err)
+				klog.Errorf("Node %s could not be retrieved from lister, err: %v", svcHost, err)
+				continue
+			}
+			if !nodeIsReady(node) {
+				klog.Infof("Node %s is not ready, it can not be used for egress service %s", svcHost, key)
 				continue
 			}
+
+			if !selector.Matches(labels.Set(node.Labels)) {
+				klog.Infof("Node %s does no longer match service %s selectors %s", svcHost, key, selector.String())
+				continue
+			}
+
+			nodeState, ok := c.nodes[svcHost]
+			if !ok {
+				nodeState, err = c.nodeStateFor(svcHost)
+				if err != nil {
+					klog.Errorf("Can't fetch egress service %s node %s state, err: %v", key, svcHost, err)
+					continue
+				}
+			}
+
+			nodeState.allocations[key] = svcState
+			c.nodes[svcHost] = nodeState
+			svcState.nodes = append(svcState.nodes, svcHost)
 		}
 
-		svcState := &svcState{node: svcHost, selector: selector, stale: false}
-		nodeState.allocations[key] = svcState
-		c.nodes[svcHost] = nodeState
+		if len(svcState.nodes) == 0 {
+			continue
+		}
 		c.services[key] = svcState
 	}
 
@@ -368,16 +381,16 @@ func (c *Controller) repair() error {
 
 	// now remove any stale egress service labels on nodes
 	nodes, _ := c.watchFactory.GetNodes()
-	svcLabelToNode := map[string]string{}
+	svcLabelToNodes := map[string]sets.Set[string]{}
 	for key, state := range c.services {
 		namespace, name, _ := cache.SplitMetaNamespaceKey(key)
-		svcLabelToNode[c.nodeLabelForService(namespace, name)] = state.node
+		svcLabelToNodes[c.nodeLabelForService(namespace, name)] = sets.New(state.nodes...)
 	}
 
 	for _, node := range nodes {
 		labelsToRemove := map[string]any{}
 		for labelKey := range node.Labels {
-			if strings.HasPrefix(labelKey, egressSVCLabelPrefix) && svcLabelToNode[labelKey] != node.Name {
+			if strings.HasPrefix(labelKey, egressSVCLabelPrefix) && !svcLabelToNodes[labelKey].Has(node.Name) {
 				labelsToRemove[labelKey] = nil // Patching with a nil value results in the delete of the key
 			}
 		}
@@ -592,14 +605,14 @@ func (c *Controller) syncEgressService(key string) error {
 		// This means we need to select a node for it that matches its selector.
 		c.unallocatedServices[key] = selector
 
-		node, err := c.selectNodeFor(selector)
+		node, err := c.selectNodeFor(selector, nil)
 		if err != nil {
 			return err
 		}
 
 		// We found a node - update the caches with the new objects.
 		delete(c.unallocatedServices, key)
-		newState := &svcState{node: node.name, selector: selector, stale: false}
+		newState := &svcState{nodes: []string{node.name}, selector: selector, stale: false}
 		c.services[key] = newState
 		node.allocations[key] = newState
 		c.nodes[node.name] = node
@@ -607,24 +620,71 @@ func (c *Controller) syncEgressService(key string) error {
 	}
 
 	state.selector = selector
-	node := c.nodes[state.node]
+	hostCount := hostCountFor(es)
+
+	// Release the nodes that no longer match the selector, and the nodes exceeding
+	// the requested amount of hosts. The other nodes keep handling the service's traffic.
+	for i := len(state.nodes) - 1; i >= 0; i-- {
+		nodeName := state.nodes[i]
+		node := c.nodes[nodeName]
+		if node != nil && state.selector.Matches(labels.Set(node.labels)) && i < hostCount {
+			continue
+		}
+		if err := c.releaseServiceNode(key, state, nodeName); err != nil {
+			return err
+		}
+	}
 
-	if !state.selector.Matches(labels.Set(node.labels)) {
-		// The node no longer matches the selector.
-		// We clear its configured resources and requeue it to attempt
-		// selecting a new node for it.
+	if len(state.nodes) == 0 {
+		// None of the nodes match the selector anymore.
+		// We clear the service's configured resources and requeue it to attempt
+		// selecting new nodes for it.
 		return c.clearServiceResourcesAndRequeue(key, state, noHost)
 	}
 
-	// Node allocation is done - the last step is to label the node and set the status
-	// to mark it as the node holding the service.
+	// Select additional nodes for the service until it has the requested amount of hosts.
+	for len(state.nodes) < hostCount {
+		node, err := c.selectNodeFor(selector, sets.New(state.nodes...))
+		if err != nil {
+			klog.V(4).Infof("EgressService %s/%s has %d hosts out of %d requested: %v", namespace, name, len(state.nodes), hostCount, err)
+			break
+		}
+		state.nodes = append(state.nodes, node.name)
+		node.allocations[key] = state
+		c.nodes[node.name] = node
+	}
 
-	err = c.setEgressServiceHost(namespace, name, state.node) // set the EgressService status, will also override manual changes
+	if len(state.nodes) < hostCount {
+		// Keep the service in the unallocated cache so it is queued again
+		// when a node matching its selector becomes available.
+		c.unallocatedServices[key] = selector
+	} else {
+		delete(c.unallocatedServices, key)
+	}
+
+	// Node allocation is done - the last step is to label the nodes and set the status
+	// to mark them as the nodes holding the service.
+
+	err = c.setEgressServiceHosts(namespace, name, state.nodes) // set the EgressService status, will also override manual changes
 	if err != nil {
 		return err
 	}
 
-	return c.labelNodeForService(namespace, name, node.name)
+	for _, node := range state.nodes {
+		if err := c.labelNodeForService(namespace, name, node); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Returns the number of nodes that should handle the traffic of the given EgressService.
+func hostCountFor(es *egressserviceapi.EgressService) int {
+	if es.Spec.HostCount > 1 {
+		return int(es.Spec.HostCount)
+	}
+	return 1
 }
 
 // Removes the status of an egress service.
@@ -645,10 +705,13 @@ func (c *Controller) clearServiceResourcesAndRequeue(key string, svcState *svcSt
 		return err
 	}
 
-	nodeState, found := c.nodes[svcState.node]
-	if found {
-		if err := c.removeNodeServiceLabel(namespace, name, svcState.node); err != nil {
-			return fmt.Errorf("failed to remove svc node label for %s, err: %v", svcState.node, err)
+	for _, node := range svcState.nodes {
+		nodeState, found := c.nodes[node]
+		if !found {
+			continue
+		}
+		if err := c.removeNodeServiceLabel(namespace, name, node); err != nil {
+			return fmt.Errorf("failed to remove svc node label for %s, err: %v", node, err)
 		}
 		delete(nodeState.allocations, key)
 	}
@@ -658,8 +721,61 @@ func (c *Controller) clearServiceResourcesAndRequeue(key string, svcState *svcSt
 	return nil
 }
 
+// Removes the given node from the nodes allocated for a service, removing its label
+// and updating the caches. The status of the service is not updated.
+// This should only be called with the controller locked.
+func (c *Controller) releaseServiceNode(key string, svcState *svcState, node string) error {
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+
+	if nodeState, found := c.nodes[node]; found {
+		if err := c.removeNodeServiceLabel(namespace, name, node); err != nil {
+			return fmt.Errorf("failed to remove svc node label for %s, err: %v", node, err)
+		}
+		delete(nodeState.allocations, key)
+	}
+
+	svcState.nodes = slices.DeleteFunc(svcState.nodes, func(n string) bool { return n == node })
+	return nil
+}
+
+// Removes the given node from the nodes allocated for a service that is no longer
+// able to use it, and requeues the service to attempt selecting another node in its place.
+// The status is updated right away so the other nodes keep handling the service's traffic.
+// If it is the last node of the service all of its resources are cleared.
+// This should only be called with the controller locked.
+func (c *Controller) releaseServiceNodeAndRequeue(key string, svcState *svcState, node string) error {
+	if len(svcState.nodes) <= 1 {
+		return c.clearServiceResourcesAndRequeue(key, svcState, noHost)
+	}
+
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+
+	nodes := slices.DeleteFunc(slices.Clone(svcState.nodes), func(n string) bool { return n == node })
+	if err := c.setEgressServiceHosts(namespace, name, nodes); err != nil {
+		return err
+	}
+
+	if err := c.releaseServiceNode(key, svcState, node); err != nil {
+		return err
+	}
+
+	c.egressServiceQueue.Add(key)
+	return nil
+}
+
+// Sets the status of an egress service to the given nodes.
+func (c *Controller) setEgressServiceHosts(namespace, name string, hosts []string) error {
+	return c.kubeOVN.UpdateEgressServiceStatus(namespace, name, hosts[0], hosts)
+}
+
 func (c *Controller) setEgressServiceHost(namespace, name, host string) error {
-	err := c.kubeOVN.UpdateEgressServiceStatus(namespace, name, host)
+	err := c.kubeOVN.UpdateEgressServiceStatus(namespace, name, host, nil)
 	if err != nil {
 		if host != "" {
 			return err
@@ -15,6 +15,7 @@ import (
 	discovery "k8s.io/api/discovery/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
 
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
 	egressserviceapi "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressservice/v1"
@@ -1551,6 +1552,148 @@ var _ = ginkgo.Describe("Cluster manager Egress Service operations", func() {
 			err := app.Run([]string{app.Name})
 			gomega.Expect(err).ToNot(gomega.HaveOccurred())
 		})
+
+		ginkgo.It("should select multiple hosts and replace a host that no longer matches", func() {
+			app.Action = func(*cli.Context) error {
+				namespaceT := *newNamespace("testns")
+				config.IPv6Mode = true
+				node1 := nodeFor(node1Name, node1IPv4, node1IPv6, node1IPv4Subnet, node1IPv6Subnet)
+				node1.Labels["home"] = "pineapple"
+				node2 := nodeFor(node2Name, node2IPv4, node2IPv6, node2IPv4Subnet, node2IPv6Subnet)
+				node2.Labels["home"] = "pineapple"
+				node3 := nodeFor("node3", "30.30.30.0", "fc00:f853:ccd:e793::3", "10.128.3.0/24", "fe00:10:128:3::/64")
+				node3.Labels["home"] = "rock"
+
+				ginkgo.By("creating a service requesting two hosts")
+				esvc1 := egressserviceapi.EgressService{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "svc1",
+						Namespace: "testns",
+					},
+					Spec: egressserviceapi.EgressServiceSpec{
+						SourceIPBy: egressserviceapi.SourceIPLoadBalancer,
+						NodeSelector: metav1.LabelSelector{
+							MatchLabels: map[string]string{
+								"home": "pineapple",
+							},
+						},
+						HostCount: 2,
+					},
+				}
+				svc1 := lbSvcFor("testns", "svc1")
+				svc1EpSlice := discovery.EndpointSlice{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "svc1-epslice",
+						Namespace: "testns",
+						Labels: map[string]string{
+							discovery.LabelServiceName: "svc1",
+						},
+					},
+					AddressType: discovery.AddressTypeIPv4,
+					Endpoints: []discovery.Endpoint{
+						{
+							Addresses: []string{"10.128.1.5"},
+							NodeName:  &node1.Name,
+						},
+					},
+				}
+
+				objs := []runtime.Object{
+					&corev1.NamespaceList{
+						Items: []corev1.Namespace{
+							namespaceT,
+						},
+					},
+					&corev1.NodeList{
+						Items: []corev1.Node{
+							*node1,
+							*node2,
+							*node3,
+						},
+					},
+					&corev1.ServiceList{
+						Items: []corev1.Service{
+							svc1,
+						},
+					},
+					&discovery.EndpointSliceList{
+						Items: []discovery.EndpointSlice{
+							svc1EpSlice,
+						},
+					},
+					&egressserviceapi.EgressServiceList{
+						Items: []egressserviceapi.EgressService{
+							esvc1,
+						},
+					},
+				}
+
+				fakeCM.start(objs...)
+
+				svcLabel := fmt.Sprintf("%s/testns-svc1", egressSVCLabelPrefix)
+				expectHosts := func(hosts ...string) {
+					gomega.Eventually(func() error {
+						es, err := fakeCM.fakeClient.EgressServiceClient.K8sV1().EgressServices("testns").Get(context.TODO(), esvc1.Name, metav1.GetOptions{})
+						if err != nil {
+							return err
+						}
+
+						if !sets.New(es.Status.Hosts...).Equal(sets.New(hosts...)) || len(es.Status.Hosts) != len(hosts) {
+							return fmt.Errorf("expected svc1's hosts %v to be %v", es.Status.Hosts, hosts)
+						}
+
+						if es.Status.Host != es.Status.Hosts[0] {
+							return fmt.Errorf("expected svc1's host value %s to be the first of its hosts %v", es.Status.Host, es.Status.Hosts)
+						}
+
+						for _, nodeName := range []string{node1Name, node2Name, "node3"} {
+							node, err := fakeCM.fakeClient.KubeClient.CoreV1().Nodes().Get(context.TODO(), nodeName, metav1.GetOptions{})
+							if err != nil {
+								return err
+							}
+							_, labeled := node.Labels[svcLabel]
+							if labeled != sets.New(hosts...).Has(nodeName) {
+								return fmt.Errorf("unexpected svc1 label on node %s: %v", nodeName, node.Labels)
+							}
+						}
+
+						return nil
+					}).ShouldNot(gomega.HaveOccurred())
+				}
+
+				expectHosts(node1Name, node2Name)
+
+				ginkgo.By("updating the nodes' labels the host that no longer matches is replaced")
+				node3.Labels["home"] = "pineapple"
+				node3.ResourceVersion = "2"
+				_, err := fakeCM.fakeClient.KubeClient.CoreV1().Nodes().Update(context.TODO(), node3, metav1.UpdateOptions{})
+				gomega.Expect(err).ToNot(gomega.HaveOccurred())
+
+				node2, err = fakeCM.fakeClient.KubeClient.CoreV1().Nodes().Get(context.TODO(), node2Name, metav1.GetOptions{})
+				gomega.Expect(err).ToNot(gomega.HaveOccurred())
+				node2.Labels["home"] = "rock"
+				node2.ResourceVersion = "2"
+				_, err = fakeCM.fakeClient.KubeClient.CoreV1().Nodes().Update(context.TODO(), node2, metav1.UpdateOptions{})
+				gomega.Expect(err).ToNot(gomega.HaveOccurred())
+
+				expectHosts(node1Name, "node3")
+
+				ginkgo.By("lowering the amount of requested hosts a host is released")
+				es, err := fakeCM.fakeClient.EgressServiceClient.K8sV1().EgressServices("testns").Get(context.TODO(), esvc1.Name, metav1.GetOptions{})
+				gomega.Expect(err).ToNot(gomega.HaveOccurred())
+				remainingHost := es.Status.Hosts[0]
+				es.Spec.HostCount = 1
+				es.ResourceVersion = "3"
+				_, err = fakeCM.fakeClient.EgressServiceClient.K8sV1().EgressServices("testns").Update(context.TODO(), es, metav1.UpdateOptions{})
+				gomega.Expect(err).ToNot(gomega.HaveOccurred())
+
+				expectHosts(remainingHost)
+
+				return nil
+			}
+			err := app.Run([]string{app.Name})
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+		})
 	})
 
 })
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
 	"net"
 	"reflect"
 	"sync"
@@ -13,6 +14,7 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
 	cache "k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/retry"
@@ -25,8 +27,10 @@ import (
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/clustermanager/node"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/clustermanager/pod"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
+	userdefinednetworkv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/userdefinednetwork/v1"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/factory"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/kube"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/metrics"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/networkmanager"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/persistentips"
 	objretry "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/retry"
@@ -36,6 +40,12 @@ import (
 
 type NetworkStatusReporter func(networkName string, fieldManager string, condition *metav1.Condition, events ...*util.EventDetails) error
 
+type NetworkUsageReporter func(networkName string, fieldManager string, usage *userdefinednetworkv1.NetworkUsage) error
+
+// networkUsageReportInterval is how often the usage of user-defined networks
+// is refreshed in their metrics and UDN status
+const networkUsageReportInterval = 30 * time.Second
+
 // networkClusterController is the cluster controller for the networks. An
 // instance of this struct is expected to be created for each network. A network
 // is identified by its name and its unique id. It handles events at a cluster
@@ -72,6 +82,10 @@ type networkClusterController struct {
 	recorder record.EventRecorder
 
 	statusReporter NetworkStatusReporter
+	usageReporter  NetworkUsageReporter
+	// last usage reported through usageReporter, only accessed by the usage
+	// reporting goroutine
+	reportedUsage *userdefinednetworkv1.NetworkUsage
 
 	// nodeName: errMessage
 	nodeErrors     map[string]string
@@ -90,6 +104,7 @@ func newNetworkClusterController(
 	recorder record.EventRecorder,
 	networkManager networkmanager.Interface,
 	errorReporter NetworkStatusReporter,
+	usageReporter NetworkUsageReporter,
 ) *networkClusterController {
 	kube := &kube.KubeOVN{
 		Kube: kube.Kube{
@@ -109,6 +124,7 @@ func newNetworkClusterController(
 		recorder:            recorder,
 		networkManager:      networkManager,
 		statusReporter:      errorReporter,
+		usageReporter:       usageReporter,
 		nodeErrors:          make(map[string]string),
 		nodeErrorsLock:      sync.Mutex{},
 	}
@@ -126,7 +142,7 @@ func newDefaultNetworkClusterController(netInfo util.NetInfo, ovnClient *util.OV
 		panic(fmt.Errorf("could not reserve default network ID: %w", err))
 	}
 
-	return newNetworkClusterController(netInfo, ovnClient, wf, recorder, networkmanager.Default().Interface(), nil)
+	return newNetworkClusterController(netInfo, ovnClient, wf, recorder, networkmanager.Default().Interface(), nil, nil)
 }
 
 func (ncc *networkClusterController) hasPodAllocation() bool {
@@ -367,6 +383,81 @@ func getNetworkAllocationUDNCondition(errorNode string) *metav1.Condition {
 	return condition
 }
 
+// getNetworkUsage returns the usage of the network allocations handled by the
+// cluster manager: host subnets for Layer3 networks, IPs for Layer2 and
+// Localnet networks and the IPAMClaims holding persistent IPs.
+func (ncc *networkClusterController) getNetworkUsage() (*userdefinednetworkv1.NetworkUsage, error) {
+	usage := &userdefinednetworkv1.NetworkUsage{}
+	if ncc.nodeAllocator != nil {
+		if v4used, v6used, v4count, v6count, ok := ncc.nodeAllocator.HostSubnetUsage(); ok {
+			if v4count > 0 {
+				usage.HostSubnets = append(usage.HostSubnets, newIPFamilyUsage(userdefinednetworkv1.IPFamilyIPv4, v4used, v4count-v4used))
+			}
+			if v6count > 0 {
+				usage.HostSubnets = append(usage.HostSubnets, newIPFamilyUsage(userdefinednetworkv1.IPFamilyIPv6, v6used, v6count-v6used))
+			}
+		}
+	}
+	if ncc.subnetAllocator != nil {
+		v4, v6 := ncc.subnetAllocator.Usage()
+		if v4.Allocated+v4.Free > 0 {
+			usage.IPs = append(usage.IPs, newIPFamilyUsage(userdefinednetworkv1.IPFamilyIPv4, v4.Allocated, v4.Free))
+		}
+		if v6.Allocated+v6.Free > 0 {
+			usage.IPs = append(usage.IPs, newIPFamilyUsage(userdefinednetworkv1.IPFamilyIPv6, v6.Allocated, v6.Free))
+		}
+	}
+	if ncc.ipamClaimReconciler != nil {
+		claims, err := ncc.ipamClaimReconciler.CountIPAMClaims()
+		if err != nil {
+			return nil, err
+		}
+		usage.PersistentIPClaims = int64(claims)
+	}
+	return usage, nil
+}
+
+func newIPFamilyUsage(ipFamily userdefinednetworkv1.IPFamily, allocated, free uint64) userdefinednetworkv1.IPFamilyUsage {
+	toInt64 := func(v uint64) int64 {
+		if v > math.MaxInt64 {
+			return math.MaxInt64
+		}
+		return int64(v)
+	}
+	return userdefinednetworkv1.IPFamilyUsage{
+		IPFamily:  ipFamily,
+		Allocated: toInt64(allocated),
+		Free:      toInt64(free),
+	}
+}
+
+// reportNetworkUsage records the network usage in metrics and, when it
+// changed since the last report, in the status of the UDN or CUDN the network
+// was created by. Host subnet metrics are recorded by the node allocator.
+func (ncc *networkClusterController) reportNetworkUsage() {
+	netName := ncc.GetNetworkName()
+	usage, err := ncc.getNetworkUsage()
+	if err != nil {
+		klog.Errorf("Failed to get usage of network %s: %v", netName, err)
+		return
+	}
+	for _, ipUsage := range usage.IPs {
+		metrics.RecordNetworkIPUsage(float64(ipUsage.Allocated), float64(ipUsage.Free), string(ipUsage.IPFamily), netName)
+	}
+	if ncc.ipamClaimReconciler != nil {
+		metrics.RecordNetworkPersistentIPClaims(float64(usage.PersistentIPClaims), netName)
+	}
+
+	if ncc.usageReporter == nil || reflect.DeepEqual(usage, ncc.reportedUsage) {
+		return
+	}
+	if err := ncc.usageReporter(netName, "NetworkUsageReporter", usage); err != nil {
+		klog.Errorf("Failed to report usage of network %s: %v", netName, err)
+		return
+	}
+	ncc.reportedUsage = usage
+}
+
 // Start the network cluster controller. Depending on the cluster configuration
 // and type of network, it does the following:
 //   - initializes the node allocator and starts listening to node events
@@ -418,6 +509,14 @@ func (ncc *networkClusterController) Start(_ context.Context) error {
 		klog.Infof("Cluster manager network controller %q completed watch Pods. Took: %v", ncc.GetNetworkName(), time.Since(start))
 	}
 
+	if ncc.IsUserDefinedNetwork() {
+		ncc.wg.Add(1)
+		go func() {
+			defer ncc.wg.Done()
+			wait.Until(ncc.reportNetworkUsage, networkUsageReportInterval, ncc.stopChan)
+		}()
+	}
+
 	return nil
 }
 
@@ -425,6 +524,10 @@ func (ncc *networkClusterController) Stop() {
 	close(ncc.stopChan)
 	ncc.wg.Wait()
 
+	if ncc.IsUserDefinedNetwork() {
+		metrics.DeleteNetworkUsage(ncc.GetNetworkName())
+	}
+
 	if ncc.ipamClaimHandler != nil {
 		ncc.watchFactory.RemoveIPAMClaimsHandler(ncc.ipamClaimHandler)
 	}
@@ -470,11 +573,19 @@ func (ncc *networkClusterController) Cleanup() error {
 
 func (ncc *networkClusterController) Reconcile(netInfo util.NetInfo) error {
 	reconcilePendingPods := !ncc.ReconcilableNetInfo.EqualNADs(netInfo.GetNADs()...)
+	oldSubnets := ncc.Subnets()
 	// update network information, point of no return
 	err := util.ReconcileNetInfo(ncc.ReconcilableNetInfo, netInfo)
 	if err != nil {
 		klog.Errorf("Failed to reconcile network %s: %v", ncc.GetNetworkName(), err)
 	}
+	if addedSubnets := util.GetAddedSubnets(oldSubnets, ncc.Subnets()); len(addedSubnets) > 0 {
+		klog.Infof("Subnets %v appended to network %s", addedSubnets, ncc.GetNetworkName())
+		if err := ncc.addSubnets(addedSubnets); err != nil {
+			klog.Errorf("Failed to add subnets %v to network %s: %v", addedSubnets, ncc.GetNetworkName(), err)
+		}
+		reconcilePendingPods = true
+	}
 	if reconcilePendingPods && ncc.retryPods != nil {
 		if err := objretry.RequeuePendingPods(ncc.watchFactory, ncc.GetNetInfo(), ncc.retryPods); err != nil {
 			klog.Errorf("Failed to requeue pending pods for network %s: %v", ncc.GetNetworkName(), err)
@@ -483,6 +594,36 @@ func (ncc *networkClusterController) Reconcile(netInfo util.NetInfo) error {
 	return nil
 }
 
+// addSubnets extends the host subnet and IP allocators of the network with
+// subnets appended to it, and retries the nodes that might have failed to be
+// allocated a host subnet
+func (ncc *networkClusterController) addSubnets(subnets []config.CIDRNetworkEntry) error {
+	if ncc.hasNodeAllocation() {
+		if err := ncc.nodeAllocator.AddClusterSubnets(subnets); err != nil {
+			return err
+		}
+		ncc.retryNodes.RequestRetryObjs()
+	}
+	if ncc.hasPodAllocation() {
+		ipNets := make([]*net.IPNet, 0, len(subnets))
+		for _, subnet := range subnets {
+			ipNets = append(ipNets, subnet.CIDR)
+		}
+		var excludeSubnets []*net.IPNet
+		if isLayer2UserDefinedPrimaryNetwork(ncc.GetNetInfo()) && len(ncc.InfrastructureSubnets()) == 0 {
+			excludeSubnets = infrastructureExcludeCIDRs(ncc.GetNetInfo(), subnets)
+		}
+		if err := ncc.subnetAllocator.AddSubnets(subnet.SubnetConfig{
+			Name:           ncc.GetNetworkName(),
+			Subnets:        ipNets,
+			ExcludeSubnets: excludeSubnets,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // networkClusterControllerEventHandler object handles the events
 // from retry framework.
 type networkClusterControllerEventHandler struct {
@@ -788,7 +929,7 @@ func newIPAllocatorForNetwork(netInfo util.NetInfo) (subnet.Allocator, error) {
 	}
 
 	if isLayer2UserDefinedPrimaryNetwork(netInfo) && len(netInfo.InfrastructureSubnets()) == 0 {
-		excludeSubnets = append(excludeSubnets, infrastructureExcludeCIDRs(netInfo)...)
+		excludeSubnets = append(excludeSubnets, infrastructureExcludeCIDRs(netInfo, subnets)...)
 	}
 
 	if err := ipAllocator.AddOrUpdateSubnet(subnet.SubnetConfig{
@@ -807,11 +948,12 @@ func isLayer2UserDefinedPrimaryNetwork(netInfo util.NetInfo) bool {
 	return netInfo.IsPrimaryNetwork() && netInfo.TopologyType() == types.Layer2Topology
 }
 
-// infrastructureExcludeCIDRs returns a list of IPs that should be excluded from IP allocation (gateway and management port IPs)
-func infrastructureExcludeCIDRs(netInfo util.NetInfo) []*net.IPNet {
+// infrastructureExcludeCIDRs returns a list of IPs of the provided subnets that
+// should be excluded from IP allocation (gateway and management port IPs)
+func infrastructureExcludeCIDRs(netInfo util.NetInfo, subnets []config.CIDRNetworkEntry) []*net.IPNet {
 	var excludeCIDRs []*net.IPNet
 
-	for _, subnet := range netInfo.Subnets() {
+	for _, subnet := range subnets {
 		gwIP := netInfo.GetNodeGatewayIP(subnet.CIDR).IP
 		mgmtPortIP := netInfo.GetNodeManagementIP(subnet.CIDR).IP
 		excludeCIDRs = append(excludeCIDRs,
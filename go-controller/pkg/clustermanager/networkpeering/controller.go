@@ -0,0 +1,330 @@
+package networkpeering
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	metaapply "k8s.io/client-go/applyconfigurations/meta/v1"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/allocator/id"
+	controllerutil "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/controller"
+	userdefinednetworkv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/userdefinednetwork/v1"
+	udnapply "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/userdefinednetwork/v1/apis/applyconfiguration/userdefinednetwork/v1"
+	udnclientset "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/userdefinednetwork/v1/apis/clientset/versioned"
+	udnlisters "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/userdefinednetwork/v1/apis/listers/userdefinednetwork/v1"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/factory"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/networkmanager"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
+)
+
+const (
+	fieldManager = "clustermanager-networkpeering-controller"
+
+	conditionTypeAccepted = "Accepted"
+
+	reasonAccepted           = "Accepted"
+	reasonNetworkNotFound    = "NetworkNotFound"
+	reasonUnsupportedNetwork = "UnsupportedNetwork"
+	reasonSubnetsOverlap     = "SubnetsOverlap"
+	reasonInternalError      = "InternalError"
+)
+
+// peeringError is a NetworkPeering configuration error, reported in the
+// status of the NetworkPeering with the given reason
+type peeringError struct {
+	reason string
+	msg    string
+}
+
+func (e *peeringError) Error() string {
+	return e.msg
+}
+
+func newPeeringError(reason, format string, args ...interface{}) error {
+	return &peeringError{reason: reason, msg: fmt.Sprintf(format, args...)}
+}
+
+// Controller validates NetworkPeerings and publishes, for the valid ones, the
+// peering ID and the subnets of the peered networks in the
+// util.NetworkPeeringAnnotation for the zone controllers to consume.
+type Controller struct {
+	npLister     udnlisters.NetworkPeeringLister
+	udnClient    udnclientset.Interface
+	npController controllerutil.Controller
+	nm           networkmanager.Interface
+	idAllocator  id.Allocator
+}
+
+// NewController builds a controller that reconciles NetworkPeerings
+func NewController(
+	nm networkmanager.Interface,
+	wf *factory.WatchFactory,
+	ovnClient *util.OVNClusterManagerClientset,
+) *Controller {
+	c := &Controller{
+		npLister:    wf.NetworkPeeringInformer().Lister(),
+		udnClient:   ovnClient.UserDefinedNetworkClient,
+		nm:          nm,
+		idAllocator: id.NewIDAllocator("NetworkPeeringIDs", util.MaxNetworkPeerings),
+	}
+
+	npConfig := &controllerutil.ControllerConfig[userdefinednetworkv1.NetworkPeering]{
+		RateLimiter:    workqueue.DefaultTypedControllerRateLimiter[string](),
+		Reconcile:      c.reconcile,
+		Threadiness:    1,
+		Informer:       wf.NetworkPeeringInformer().Informer(),
+		Lister:         wf.NetworkPeeringInformer().Lister().List,
+		ObjNeedsUpdate: networkPeeringNeedsUpdate,
+	}
+	c.npController = controllerutil.NewController("clustermanager networkpeering controller", npConfig)
+
+	return c
+}
+
+func (c *Controller) Start() error {
+	defer klog.Infof("Cluster manager networkpeering started")
+	// reserve the IDs of the existing peerings so that they are kept
+	peerings, err := c.npLister.List(labels.Everything())
+	if err != nil {
+		return fmt.Errorf("failed to list NetworkPeerings: %w", err)
+	}
+	for _, peering := range peerings {
+		info, err := util.ParseNetworkPeeringAnnotation(peering.Annotations)
+		if err != nil {
+			if !util.IsAnnotationNotSetError(err) {
+				klog.Warningf("Failed to parse NetworkPeering %q annotation, a new ID will be allocated: %v", peering.Name, err)
+			}
+			continue
+		}
+		if err := c.idAllocator.ReserveID(peering.Name, info.ID); err != nil {
+			klog.Warningf("Failed to reserve ID %d for NetworkPeering %q, a new ID will be allocated: %v", info.ID, peering.Name, err)
+		}
+	}
+	return controllerutil.Start(c.npController)
+}
+
+func (c *Controller) Stop() {
+	controllerutil.Stop(c.npController)
+	klog.Infof("Cluster manager networkpeering stopped")
+}
+
+// ReconcileNetwork reconciles the NetworkPeerings of the given network when
+// the network is created, deleted or updated.
+func (c *Controller) ReconcileNetwork(name string, _, _ util.NetInfo) {
+	peerings, err := c.npLister.List(labels.Everything())
+	if err != nil {
+		klog.Errorf("Failed to list NetworkPeerings to reconcile network %q: %v", name, err)
+		return
+	}
+	for _, peering := range peerings {
+		for _, network := range peering.Spec.Networks {
+			if peeredNetworkName(network) == name {
+				c.npController.Reconcile(peering.Name)
+				break
+			}
+		}
+	}
+}
+
+// reconcile validates the NetworkPeering: both networks must be known, be
+// primary networks of the Layer3 or Layer2 topology and have non-overlapping
+// subnets.
+// Valid peerings are annotated with an ID and the subnets of the networks,
+// the annotation is removed from invalid peerings. Finally, the status of the
+// NetworkPeering is updated.
+func (c *Controller) reconcile(name string) error {
+	startTime := time.Now()
+	klog.V(5).Infof("Syncing networkpeering %q", name)
+	defer func() {
+		klog.V(4).Infof("Finished syncing networkpeering %q, took %v", name, time.Since(startTime))
+	}()
+
+	peering, err := c.npLister.Get(name)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to get NetworkPeering %q: %w", name, err)
+	}
+	if peering == nil {
+		c.idAllocator.ReleaseID(name)
+		return nil
+	}
+
+	var annotation string
+	networks, cfgErr := c.getPeeredNetworks(peering)
+	if cfgErr == nil {
+		var peeringID int
+		peeringID, err = c.idAllocator.AllocateID(name)
+		if err != nil {
+			cfgErr = fmt.Errorf("failed to allocate an ID: %w", err)
+		} else {
+			annotation, err = util.MarshalNetworkPeeringAnnotation(&util.NetworkPeeringInfo{ID: peeringID, Networks: networks})
+			if err != nil {
+				cfgErr = err
+			}
+		}
+	}
+
+	if err := c.updateAnnotation(peering, annotation); err != nil {
+		return err
+	}
+	if cfgErr != nil {
+		c.idAllocator.ReleaseID(name)
+	}
+
+	return c.updateStatus(peering, cfgErr)
+}
+
+// getPeeredNetworks returns the subnets of the networks of the peering, or a
+// peeringError if they can't be peered.
+func (c *Controller) getPeeredNetworks(peering *userdefinednetworkv1.NetworkPeering) (map[string][]string, error) {
+	if len(peering.Spec.Networks) != 2 {
+		return nil, newPeeringError(reasonUnsupportedNetwork, "expected 2 networks, got %d", len(peering.Spec.Networks))
+	}
+	networks := map[string][]string{}
+	var subnets [][]*net.IPNet
+	for _, network := range peering.Spec.Networks {
+		networkName := peeredNetworkName(network)
+		netInfo := c.nm.GetNetwork(networkName)
+		if netInfo == nil || netInfo.IsDefault() {
+			return nil, newPeeringError(reasonNetworkNotFound, "%s %s not found", network.Kind, peeredNetworkRef(network))
+		}
+		if !netInfo.IsPrimaryNetwork() {
+			return nil, newPeeringError(reasonUnsupportedNetwork, "%s %s is not a primary network", network.Kind, peeredNetworkRef(network))
+		}
+		if netInfo.TopologyType() != types.Layer3Topology && netInfo.TopologyType() != types.Layer2Topology {
+			return nil, newPeeringError(reasonUnsupportedNetwork, "%s %s has unsupported topology %s, only %s and %s are supported",
+				network.Kind, peeredNetworkRef(network), netInfo.TopologyType(), types.Layer3Topology, types.Layer2Topology)
+		}
+		if _, ok := networks[networkName]; ok {
+			return nil, newPeeringError(reasonUnsupportedNetwork, "%s %s can't be peered with itself", network.Kind, peeredNetworkRef(network))
+		}
+		var cidrs []string
+		var networkSubnets []*net.IPNet
+		for _, subnet := range netInfo.Subnets() {
+			cidrs = append(cidrs, subnet.CIDR.String())
+			networkSubnets = append(networkSubnets, subnet.CIDR)
+		}
+		networks[networkName] = cidrs
+		subnets = append(subnets, networkSubnets)
+	}
+	for _, a := range subnets[0] {
+		for _, b := range subnets[1] {
+			if a.Contains(b.IP) || b.Contains(a.IP) {
+				return nil, newPeeringError(reasonSubnetsOverlap, "subnet %s of %s %s overlaps with subnet %s of %s %s",
+					a, peering.Spec.Networks[0].Kind, peeredNetworkRef(peering.Spec.Networks[0]),
+					b, peering.Spec.Networks[1].Kind, peeredNetworkRef(peering.Spec.Networks[1]))
+			}
+		}
+	}
+	return networks, nil
+}
+
+// updateAnnotation sets the util.NetworkPeeringAnnotation of the peering to
+// the given value, or removes it if the value is empty.
+func (c *Controller) updateAnnotation(peering *userdefinednetworkv1.NetworkPeering, annotation string) error {
+	current, set := peering.Annotations[util.NetworkPeeringAnnotation]
+	if current == annotation && set == (annotation != "") {
+		return nil
+	}
+	var value interface{}
+	if annotation != "" {
+		value = annotation
+	}
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]interface{}{util.NetworkPeeringAnnotation: value},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to build annotation patch for NetworkPeering %q: %w", peering.Name, err)
+	}
+	_, err = c.udnClient.K8sV1().NetworkPeerings().Patch(context.Background(), peering.Name, k8stypes.MergePatchType, patch, metav1.PatchOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to annotate NetworkPeering %q: %w", peering.Name, err)
+	}
+	return nil
+}
+
+func (c *Controller) updateStatus(peering *userdefinednetworkv1.NetworkPeering, cfgErr error) error {
+	cstatus := metav1.ConditionTrue
+	reason := reasonAccepted
+	msg := "ovn-kubernetes cluster-manager validated the peered networks"
+	if cfgErr != nil {
+		cstatus = metav1.ConditionFalse
+		msg = cfgErr.Error()
+		reason = reasonInternalError
+		var peeringErr *peeringError
+		if errors.As(cfgErr, &peeringErr) {
+			reason = peeringErr.reason
+		}
+	}
+
+	condition := meta.FindStatusCondition(peering.Status.Conditions, conditionTypeAccepted)
+	if condition != nil && condition.ObservedGeneration == peering.Generation && condition.Status == cstatus &&
+		condition.Reason == reason && condition.Message == msg {
+		return nil
+	}
+	lastTransitionTime := metav1.NewTime(time.Now())
+	if condition != nil && condition.Status == cstatus {
+		lastTransitionTime = condition.LastTransitionTime
+	}
+
+	_, err := c.udnClient.K8sV1().NetworkPeerings().ApplyStatus(
+		context.Background(),
+		udnapply.NetworkPeering(peering.Name).WithStatus(
+			udnapply.NetworkPeeringStatus().WithConditions(
+				metaapply.Condition().
+					WithType(conditionTypeAccepted).
+					WithStatus(cstatus).
+					WithLastTransitionTime(lastTransitionTime).
+					WithReason(reason).
+					WithMessage(msg).
+					WithObservedGeneration(peering.Generation),
+			),
+		),
+		metav1.ApplyOptions{
+			FieldManager: fieldManager,
+			Force:        true,
+		},
+	)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to apply status for NetworkPeering %q: %w", peering.Name, err)
+	}
+	return nil
+}
+
+func networkPeeringNeedsUpdate(oldObj, newObj *userdefinednetworkv1.NetworkPeering) bool {
+	if oldObj == nil || newObj == nil {
+		return true
+	}
+	oldAnnotation, oldSet := oldObj.Annotations[util.NetworkPeeringAnnotation]
+	newAnnotation, newSet := newObj.Annotations[util.NetworkPeeringAnnotation]
+	return oldObj.Generation != newObj.Generation || oldAnnotation != newAnnotation || oldSet != newSet
+}
+
+// peeredNetworkName returns the name of the network referenced by the given
+// PeeredNetwork
+func peeredNetworkName(network userdefinednetworkv1.PeeredNetwork) string {
+	if network.Kind == userdefinednetworkv1.PeeredNetworkKindUserDefinedNetwork {
+		return util.GenerateUDNNetworkName(network.Namespace, network.Name)
+	}
+	return util.GenerateCUDNNetworkName(network.Name)
+}
+
+func peeredNetworkRef(network userdefinednetworkv1.PeeredNetwork) string {
+	if network.Namespace != "" {
+		return network.Namespace + "/" + network.Name
+	}
+	return network.Name
+}
@@ -0,0 +1,217 @@
+package networkpeering
+
+import (
+	"context"
+	"testing"
+
+	cnitypes "github.com/containernetworking/cni/pkg/types"
+	"github.com/onsi/gomega"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+
+	ovncnitypes "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/cni/types"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
+	userdefinednetworkv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/userdefinednetwork/v1"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/factory"
+	nmtest "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/testing/networkmanager"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
+)
+
+type testNetwork struct {
+	Namespace string
+	Name      string
+	Topology  string
+	Role      string
+	Subnets   string
+}
+
+func (tn testNetwork) NetInfo(t *testing.T) util.NetInfo {
+	netInfo, err := util.NewNetInfo(&ovncnitypes.NetConf{
+		NetConf:  cnitypes.NetConf{Name: util.GenerateUDNNetworkName(tn.Namespace, tn.Name)},
+		Topology: tn.Topology,
+		Role:     tn.Role,
+		Subnets:  tn.Subnets,
+		NADName:  tn.Namespace + "/" + tn.Name,
+	})
+	if err != nil {
+		t.Fatalf("failed to build network %s/%s: %v", tn.Namespace, tn.Name, err)
+	}
+	return netInfo
+}
+
+func udnPeering(name string, networks ...testNetwork) *userdefinednetworkv1.NetworkPeering {
+	peering := &userdefinednetworkv1.NetworkPeering{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+	}
+	for _, network := range networks {
+		peering.Spec.Networks = append(peering.Spec.Networks, userdefinednetworkv1.PeeredNetwork{
+			Kind:      userdefinednetworkv1.PeeredNetworkKindUserDefinedNetwork,
+			Namespace: network.Namespace,
+			Name:      network.Name,
+		})
+	}
+	return peering
+}
+
+func TestController_reconcile(t *testing.T) {
+	red := testNetwork{Namespace: "red", Name: "net", Topology: types.Layer3Topology, Role: types.NetworkRolePrimary, Subnets: "10.10.0.0/16/24"}
+	blue := testNetwork{Namespace: "blue", Name: "net", Topology: types.Layer3Topology, Role: types.NetworkRolePrimary, Subnets: "10.20.0.0/16/24"}
+	overlapping := testNetwork{Namespace: "green", Name: "net", Topology: types.Layer3Topology, Role: types.NetworkRolePrimary, Subnets: "10.10.128.0/17/24"}
+	layer2 := testNetwork{Namespace: "yellow", Name: "net", Topology: types.Layer2Topology, Role: types.NetworkRolePrimary, Subnets: "10.30.0.0/16"}
+	otherLayer2 := testNetwork{Namespace: "orange", Name: "net", Topology: types.Layer2Topology, Role: types.NetworkRolePrimary, Subnets: "10.50.0.0/16"}
+	secondary := testNetwork{Namespace: "purple", Name: "net", Topology: types.Layer3Topology, Role: types.NetworkRoleSecondary, Subnets: "10.40.0.0/16/24"}
+
+	tests := []struct {
+		name             string
+		networks         []testNetwork
+		peering          *userdefinednetworkv1.NetworkPeering
+		expectStatus     metav1.ConditionStatus
+		expectReason     string
+		expectAnnotation *util.NetworkPeeringInfo
+	}{
+		{
+			name:         "accepts a peering of primary Layer3 networks with non-overlapping subnets",
+			networks:     []testNetwork{red, blue},
+			peering:      udnPeering("peering", red, blue),
+			expectStatus: metav1.ConditionTrue,
+			expectReason: reasonAccepted,
+			expectAnnotation: &util.NetworkPeeringInfo{
+				ID: 0,
+				Networks: map[string][]string{
+					"red_net":  {"10.10.0.0/16"},
+					"blue_net": {"10.20.0.0/16"},
+				},
+			},
+		},
+		{
+			name:         "reports overlapping subnets",
+			networks:     []testNetwork{red, overlapping},
+			peering:      udnPeering("peering", red, overlapping),
+			expectStatus: metav1.ConditionFalse,
+			expectReason: reasonSubnetsOverlap,
+		},
+		{
+			name:         "reports a missing network",
+			networks:     []testNetwork{red},
+			peering:      udnPeering("peering", red, blue),
+			expectStatus: metav1.ConditionFalse,
+			expectReason: reasonNetworkNotFound,
+		},
+		{
+			name:         "accepts a peering of a primary Layer3 network with a primary Layer2 network",
+			networks:     []testNetwork{red, layer2},
+			peering:      udnPeering("peering", red, layer2),
+			expectStatus: metav1.ConditionTrue,
+			expectReason: reasonAccepted,
+			expectAnnotation: &util.NetworkPeeringInfo{
+				ID: 0,
+				Networks: map[string][]string{
+					"red_net":    {"10.10.0.0/16"},
+					"yellow_net": {"10.30.0.0/16"},
+				},
+			},
+		},
+		{
+			name:         "accepts a peering of primary Layer2 networks",
+			networks:     []testNetwork{layer2, otherLayer2},
+			peering:      udnPeering("peering", layer2, otherLayer2),
+			expectStatus: metav1.ConditionTrue,
+			expectReason: reasonAccepted,
+			expectAnnotation: &util.NetworkPeeringInfo{
+				ID: 0,
+				Networks: map[string][]string{
+					"yellow_net": {"10.30.0.0/16"},
+					"orange_net": {"10.50.0.0/16"},
+				},
+			},
+		},
+		{
+			name:         "reports a secondary network as unsupported",
+			networks:     []testNetwork{red, secondary},
+			peering:      udnPeering("peering", red, secondary),
+			expectStatus: metav1.ConditionFalse,
+			expectReason: reasonUnsupportedNetwork,
+		},
+		{
+			name:     "removes the annotation of a peering that is no longer valid",
+			networks: []testNetwork{red, overlapping},
+			peering: func() *userdefinednetworkv1.NetworkPeering {
+				peering := udnPeering("peering", red, overlapping)
+				peering.Annotations = map[string]string{util.NetworkPeeringAnnotation: `{"id":3,"networks":{"red_net":["10.10.0.0/16"],"green_net":["10.10.128.0/17"]}}`}
+				return peering
+			}(),
+			expectStatus: metav1.ConditionFalse,
+			expectReason: reasonSubnetsOverlap,
+		},
+		{
+			name:     "keeps the ID of an accepted peering",
+			networks: []testNetwork{red, blue},
+			peering: func() *userdefinednetworkv1.NetworkPeering {
+				peering := udnPeering("peering", red, blue)
+				peering.Annotations = map[string]string{util.NetworkPeeringAnnotation: `{"id":3,"networks":{"red_net":["10.10.0.0/16"],"blue_net":["10.20.0.0/16"]}}`}
+				return peering
+			}(),
+			expectStatus: metav1.ConditionTrue,
+			expectReason: reasonAccepted,
+			expectAnnotation: &util.NetworkPeeringInfo{
+				ID: 3,
+				Networks: map[string][]string{
+					"red_net":  {"10.10.0.0/16"},
+					"blue_net": {"10.20.0.0/16"},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+
+			g.Expect(config.PrepareTestConfig()).To(gomega.Succeed())
+			config.OVNKubernetesFeature.EnableMultiNetwork = true
+			config.OVNKubernetesFeature.EnableNetworkSegmentation = true
+
+			fakeClientset := util.GetOVNClientset().GetClusterManagerClientset()
+			_, err := fakeClientset.UserDefinedNetworkClient.K8sV1().NetworkPeerings().Create(context.Background(), tt.peering, metav1.CreateOptions{})
+			g.Expect(err).ToNot(gomega.HaveOccurred())
+
+			nm := &nmtest.FakeNetworkManager{PrimaryNetworks: map[string]util.NetInfo{}}
+			for _, network := range tt.networks {
+				nm.PrimaryNetworks[network.Namespace] = network.NetInfo(t)
+			}
+
+			wf, err := factory.NewClusterManagerWatchFactory(fakeClientset)
+			g.Expect(err).ToNot(gomega.HaveOccurred())
+
+			c := NewController(nm, wf, fakeClientset)
+
+			g.Expect(wf.Start()).To(gomega.Succeed())
+			defer wf.Shutdown()
+			cache.WaitForCacheSync(context.Background().Done(), wf.NetworkPeeringInformer().Informer().HasSynced)
+
+			// reserve the existing IDs as the controller does on start
+			if info, err := util.ParseNetworkPeeringAnnotation(tt.peering.Annotations); err == nil {
+				g.Expect(c.idAllocator.ReserveID(tt.peering.Name, info.ID)).To(gomega.Succeed())
+			}
+
+			g.Expect(c.reconcile(tt.peering.Name)).To(gomega.Succeed())
+
+			peering, err := fakeClientset.UserDefinedNetworkClient.K8sV1().NetworkPeerings().Get(context.Background(), tt.peering.Name, metav1.GetOptions{})
+			g.Expect(err).ToNot(gomega.HaveOccurred())
+			accepted := meta.FindStatusCondition(peering.Status.Conditions, conditionTypeAccepted)
+			g.Expect(accepted).NotTo(gomega.BeNil())
+			g.Expect(accepted.Status).To(gomega.Equal(tt.expectStatus), accepted.Message)
+			g.Expect(accepted.Reason).To(gomega.Equal(tt.expectReason), accepted.Message)
+
+			if tt.expectAnnotation == nil {
+				g.Expect(peering.Annotations).NotTo(gomega.HaveKey(util.NetworkPeeringAnnotation))
+				return
+			}
+			info, err := util.ParseNetworkPeeringAnnotation(peering.Annotations)
+			g.Expect(err).ToNot(gomega.HaveOccurred())
+			g.Expect(info).To(gomega.Equal(tt.expectAnnotation))
+		})
+	}
+}
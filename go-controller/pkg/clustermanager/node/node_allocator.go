@@ -97,6 +97,25 @@ func (na *NodeAllocator) Init() error {
 	return nil
 }
 
+// AddClusterSubnets makes the provided subnets, appended to the network after
+// Init, available for host subnet allocation
+func (na *NodeAllocator) AddClusterSubnets(clusterSubnets []config.CIDRNetworkEntry) error {
+	if !na.hasNodeSubnetAllocation() {
+		return nil
+	}
+	for _, clusterSubnet := range clusterSubnets {
+		if err := na.clusterSubnetAllocator.AddNetworkRange(clusterSubnet.CIDR, clusterSubnet.HostSubnetLength); err != nil {
+			return err
+		}
+		klog.V(5).Infof("Added network range %s to cluster subnet allocator", clusterSubnet.CIDR)
+	}
+
+	// update metrics for cluster subnets
+	na.recordSubnetCount()
+
+	return nil
+}
+
 // CleanupStaleAnnotation cleans up the stale annotations on all nodes.
 // If an error occurs, it logs the error and continues to the next node.
 func (na *NodeAllocator) CleanupStaleAnnotation() {
@@ -147,6 +166,18 @@ func (na *NodeAllocator) recordSubnetUsage() {
 	}
 }
 
+// HostSubnetUsage returns the number of host subnets of each IP family that
+// are allocated and that can be allocated in total. It returns false for
+// networks that don't allocate host subnets.
+func (na *NodeAllocator) HostSubnetUsage() (v4used, v6used, v4count, v6count uint64, ok bool) {
+	if !na.hasNodeSubnetAllocation() {
+		return 0, 0, 0, 0, false
+	}
+	v4used, v6used = na.clusterSubnetAllocator.Usage()
+	v4count, v6count = na.clusterSubnetAllocator.Count()
+	return v4used, v6used, v4count, v6count, true
+}
+
 // hybridOverlayNodeEnsureSubnet allocates a subnet and sets the
 // hybrid overlay subnet annotation. It returns any newly allocated subnet
 // or an error. If an error occurs, the newly allocated subnet will be released.
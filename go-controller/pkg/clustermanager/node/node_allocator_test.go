@@ -400,6 +400,55 @@ func TestController_allocateNodeSubnets_ReleaseOnError(t *testing.T) {
 	}
 }
 
+func TestController_AddClusterSubnets(t *testing.T) {
+	netInfo, err := util.NewNetInfo(
+		&ovncnitypes.NetConf{
+			NetConf:  cnitypes.NetConf{Name: "l3-network"},
+			Topology: types.Layer3Topology,
+			Subnets:  "172.16.0.0/24/25",
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	na := &NodeAllocator{
+		netInfo:                netInfo,
+		clusterSubnetAllocator: NewSubnetAllocator(),
+		nodeLister:             newFakeNodeLister([]*corev1.Node{}),
+	}
+
+	if err := na.Init(); err != nil {
+		t.Fatalf("Failed to initialize node allocator: %v", err)
+	}
+
+	for _, node := range []string{"node1", "node2"} {
+		if _, _, err := na.allocateNodeSubnets(na.clusterSubnetAllocator, node, nil, true, false); err != nil {
+			t.Fatalf("allocateNodeSubnets() for %s expected success but got: %v", node, err)
+		}
+	}
+	if _, _, err := na.allocateNodeSubnets(na.clusterSubnetAllocator, "node3", nil, true, false); err == nil {
+		t.Fatalf("allocateNodeSubnets() expected error with exhausted cluster subnets but got success")
+	}
+
+	ranges, err := rangesFromStrings([]string{"172.16.1.0/24"}, []int{25})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := na.AddClusterSubnets(ranges); err != nil {
+		t.Fatalf("AddClusterSubnets() expected no error but got: %v", err)
+	}
+
+	_, allocated, err := na.allocateNodeSubnets(na.clusterSubnetAllocator, "node3", nil, true, false)
+	if err != nil {
+		t.Fatalf("allocateNodeSubnets() expected success but got: %v", err)
+	}
+	expected := []*net.IPNet{ovntest.MustParseIPNet("172.16.1.0/25")}
+	if !reflect.DeepEqual(allocated, expected) {
+		t.Fatalf("allocateNodeSubnets() expected %v to be allocated, got %v", expected, allocated)
+	}
+}
+
 func newFakeNodeLister(nodes []*corev1.Node) v1.NodeLister {
 	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
 	for _, node := range nodes {
@@ -86,6 +86,10 @@ func (a *ipAllocatorStub) AddOrUpdateSubnet(_ subnet.SubnetConfig) error {
 	panic("not implemented") // TODO: Implement
 }
 
+func (a *ipAllocatorStub) AddSubnets(_ subnet.SubnetConfig) error {
+	panic("not implemented") // TODO: Implement
+}
+
 func (a ipAllocatorStub) DeleteSubnet(string) {
 	panic("not implemented") // TODO: Implement
 }
@@ -126,6 +130,10 @@ func (a *ipAllocatorStub) GetSubnetName([]*net.IPNet) (string, bool) {
 	panic("not implemented") // TODO: Implement
 }
 
+func (a *ipAllocatorStub) Usage() (subnet.IPUsage, subnet.IPUsage) {
+	panic("not implemented") // TODO: Implement
+}
+
 type idAllocatorStub struct {
 	released bool
 }
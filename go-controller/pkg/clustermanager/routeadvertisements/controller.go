@@ -18,6 +18,7 @@ import (
 	frrlisters "github.com/metallb/frr-k8s/pkg/client/listers/api/v1beta1"
 
 	corev1 "k8s.io/api/core/v1"
+	discovery "k8s.io/api/discovery/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -25,6 +26,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 	metaapply "k8s.io/client-go/applyconfigurations/meta/v1"
 	corelisters "k8s.io/client-go/listers/core/v1"
+	discoverylisters "k8s.io/client-go/listers/discovery/v1"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/workqueue"
 	"k8s.io/klog/v2"
@@ -62,23 +64,27 @@ var (
 type Controller struct {
 	wf *factory.WatchFactory
 
-	eipLister       egressiplisters.EgressIPLister
-	frrLister       frrlisters.FRRConfigurationLister
-	nadLister       nadlisters.NetworkAttachmentDefinitionLister
-	nodeLister      corelisters.NodeLister
-	raLister        ralisters.RouteAdvertisementsLister
-	namespaceLister corelisters.NamespaceLister
+	eipLister           egressiplisters.EgressIPLister
+	frrLister           frrlisters.FRRConfigurationLister
+	nadLister           nadlisters.NetworkAttachmentDefinitionLister
+	nodeLister          corelisters.NodeLister
+	raLister            ralisters.RouteAdvertisementsLister
+	namespaceLister     corelisters.NamespaceLister
+	serviceLister       corelisters.ServiceLister
+	endpointSliceLister discoverylisters.EndpointSliceLister
 
 	frrClient frrclientset.Interface
 	nadClient nadclientset.Interface
 	raClient  raclientset.Interface
 
-	eipController  controllerutil.Controller
-	frrController  controllerutil.Controller
-	nadController  controllerutil.Controller
-	nodeController controllerutil.Controller
-	raController   controllerutil.Controller
-	nsController   controllerutil.Controller
+	eipController           controllerutil.Controller
+	frrController           controllerutil.Controller
+	nadController           controllerutil.Controller
+	nodeController          controllerutil.Controller
+	raController            controllerutil.Controller
+	nsController            controllerutil.Controller
+	serviceController       controllerutil.Controller
+	endpointSliceController controllerutil.Controller
 
 	nm networkmanager.Interface
 }
@@ -90,17 +96,19 @@ func NewController(
 	ovnClient *util.OVNClusterManagerClientset,
 ) *Controller {
 	c := &Controller{
-		wf:              wf,
-		eipLister:       wf.EgressIPInformer().Lister(),
-		frrLister:       wf.FRRConfigurationsInformer().Lister(),
-		nadLister:       wf.NADInformer().Lister(),
-		nodeLister:      wf.NodeCoreInformer().Lister(),
-		raLister:        wf.RouteAdvertisementsInformer().Lister(),
-		namespaceLister: wf.NamespaceInformer().Lister(),
-		frrClient:       ovnClient.FRRClient,
-		nadClient:       ovnClient.NetworkAttchDefClient,
-		raClient:        ovnClient.RouteAdvertisementsClient,
-		nm:              nm,
+		wf:                  wf,
+		eipLister:           wf.EgressIPInformer().Lister(),
+		frrLister:           wf.FRRConfigurationsInformer().Lister(),
+		nadLister:           wf.NADInformer().Lister(),
+		nodeLister:          wf.NodeCoreInformer().Lister(),
+		raLister:            wf.RouteAdvertisementsInformer().Lister(),
+		namespaceLister:     wf.NamespaceInformer().Lister(),
+		serviceLister:       wf.ServiceCoreInformer().Lister(),
+		endpointSliceLister: wf.EndpointSliceCoreInformer().Lister(),
+		frrClient:           ovnClient.FRRClient,
+		nadClient:           ovnClient.NetworkAttchDefClient,
+		raClient:            ovnClient.RouteAdvertisementsClient,
+		nm:                  nm,
 	}
 
 	handleError := func(key string, errorstatus error) error {
@@ -180,6 +188,26 @@ func NewController(
 	}
 	c.nsController = controllerutil.NewController("clustermanager routeadvertisements namespace controller", nsConfig)
 
+	serviceConfig := &controllerutil.ControllerConfig[corev1.Service]{
+		RateLimiter:    workqueue.DefaultTypedControllerRateLimiter[string](),
+		Reconcile:      c.reconcileServices,
+		Threadiness:    1,
+		Informer:       wf.ServiceCoreInformer().Informer(),
+		Lister:         wf.ServiceCoreInformer().Lister().List,
+		ObjNeedsUpdate: serviceNeedsUpdate,
+	}
+	c.serviceController = controllerutil.NewController("clustermanager routeadvertisements service controller", serviceConfig)
+
+	endpointSliceConfig := &controllerutil.ControllerConfig[discovery.EndpointSlice]{
+		RateLimiter:    workqueue.DefaultTypedControllerRateLimiter[string](),
+		Reconcile:      c.reconcileServices,
+		Threadiness:    1,
+		Informer:       wf.EndpointSliceCoreInformer().Informer(),
+		Lister:         wf.EndpointSliceCoreInformer().Lister().List,
+		ObjNeedsUpdate: c.endpointSliceNeedsUpdate,
+	}
+	c.endpointSliceController = controllerutil.NewController("clustermanager routeadvertisements endpointslice controller", endpointSliceConfig)
+
 	return c
 }
 
@@ -191,6 +219,8 @@ func (c *Controller) Start() error {
 		c.nadController,
 		c.nodeController,
 		c.nsController,
+		c.serviceController,
+		c.endpointSliceController,
 		c.raController,
 	)
 }
@@ -202,6 +232,8 @@ func (c *Controller) Stop() {
 		c.nadController,
 		c.nodeController,
 		c.nsController,
+		c.serviceController,
+		c.endpointSliceController,
 		c.raController,
 	)
 	klog.Infof("Cluster manager routeadvertisements stopped")
@@ -245,9 +277,20 @@ func (c *Controller) ReconcileNetwork(_ string, old, new util.NetInfo) {
 // VRFs. Selected EgressIP are those that serve the same namespaces as the
 // selected networks. Target VRF `auto` is not supported for EgressIPs.
 //
+// - If service advertisements are enabled, the generated FRRConfiguration will
+// announce from the node the LoadBalancer ingress IPs, and optionally the
+// ClusterIPs and ExternalIPs, of the services of the selected networks. The
+// LoadBalancer ingress IPs and ExternalIPs of services with
+// externalTrafficPolicy set to Local are only announced from nodes that have
+// local ready endpoints for the service.
+//
 // - If pod network advertisements are enabled, the generated FRRConfiguration
 // will import the target VRFs on the selected networks as required.
 //
+// - The prefixes advertised in the generated FRRConfiguration will be tagged
+// with the communities and local preference configured for their
+// advertisement type.
+//
 // - The generated FRRConfiguration will be labeled with the RouteAdvertisements
 // name and annotated with an internal key to facilitate updating it when
 // needed.
@@ -259,7 +302,8 @@ func (c *Controller) ReconcileNetwork(_ string, old, new util.NetInfo) {
 // Finally, it will update the status of the RouteAdvertisements.
 //
 // The controller processes selected events of RouteAdvertisements,
-// FRRConfigurations, Nodes, EgressIPs, NADs and namespaces.
+// FRRConfigurations, Nodes, EgressIPs, NADs, namespaces, services and
+// endpoint slices.
 func (c *Controller) reconcile(name string) error {
 	startTime := time.Now()
 	klog.V(5).Infof("Syncing routeadvertisements %q", name)
@@ -320,6 +364,9 @@ type selectedNetworks struct {
 	networkSubnets map[string][]string
 	// hostNetworkSubnets is a map of selected network names to their ordered network subnets specific for a node
 	hostNetworkSubnets map[string][]string
+	// prefixAdvertisements is a map of the prefixes specific to a node to the
+	// advertisement types they are advertised for
+	prefixAdvertisements map[string]sets.Set[ratypes.AdvertisementType]
 	// prefixLength is a map of selected network to their prefix length
 	prefixLength map[string]uint32
 	// networkType is a map of selected network to their topology
@@ -419,8 +466,10 @@ func (c *Controller) generateFRRConfigurations(ra *ratypes.RouteAdvertisements)
 	// prepare a map of selected nodes to the FRRConfigurations that apply to
 	// them
 	nodeToFRRConfig := map[string][]*frrtypes.FRRConfiguration{}
+	selectedNodes := sets.New[string]()
 	for _, node := range nodes {
 		nodeToFRRConfig[node.Name] = nil
+		selectedNodes.Insert(node.Name)
 	}
 
 	// gather selected FRRConfigurations, map them to the selected nodes
@@ -491,8 +540,21 @@ func (c *Controller) generateFRRConfigurations(ra *ratypes.RouteAdvertisements)
 		return eipsByNodesByNetworks[nodeName], nil
 	}
 
+	// helper to gather service IPs and cache during reconcile
+	var serviceIPsByNodesByNetworks map[string]map[string]sets.Set[string]
+	getServiceIPsByNode := func(nodeName string) (map[string]sets.Set[string], error) {
+		if serviceIPsByNodesByNetworks == nil {
+			serviceIPsByNodesByNetworks, err = c.getServiceIPsByNodesByNetworks(networkSet, selectedNodes, ra.Spec.Services)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return serviceIPsByNodesByNetworks[nodeName], nil
+	}
+
 	// helper to gather the following prefixes:
 	//  - EgressIPs
+	//  - service IPs
 	//  - host subnets for networks with networkTopology layer3
 	//  - network subnets for networks with networkTopology layer2
 	getPrefixes := func(nodeName, network, networkTopology string, networkSubnets []string) ([]string, error) {
@@ -521,10 +583,35 @@ func (c *Controller) generateFRRConfigurations(ra *ratypes.RouteAdvertisements)
 			}
 			eips = eipsByNode[network].UnsortedList()
 		}
+		// gather service IPs
+		var serviceIPs []string
+		if advertisements.Has(ratypes.Services) {
+			serviceIPsByNode, err := getServiceIPsByNode(nodeName)
+			if err != nil {
+				return nil, err
+			}
+			serviceIPs = serviceIPsByNode[network].UnsortedList()
+		}
 
-		prefixes := make([]string, 0, len(subnets)+len(eips))
+		prefixes := make([]string, 0, len(subnets)+len(eips)+len(serviceIPs))
 		prefixes = append(prefixes, subnets...)
 		prefixes = append(prefixes, eips...)
+		prefixes = append(prefixes, serviceIPs...)
+
+		// track the advertisement types of each prefix to tag them with the
+		// corresponding BGP attributes
+		addPrefixAdvertisements := func(advertisement ratypes.AdvertisementType, prefixes []string) {
+			for _, prefix := range prefixes {
+				if selectedNetworks.prefixAdvertisements[prefix] == nil {
+					selectedNetworks.prefixAdvertisements[prefix] = sets.New[ratypes.AdvertisementType]()
+				}
+				selectedNetworks.prefixAdvertisements[prefix].Insert(advertisement)
+			}
+		}
+		addPrefixAdvertisements(ratypes.PodNetwork, subnets)
+		addPrefixAdvertisements(ratypes.EgressIP, eips)
+		addPrefixAdvertisements(ratypes.Services, serviceIPs)
+
 		return prefixes, nil
 	}
 
@@ -533,6 +620,7 @@ func (c *Controller) generateFRRConfigurations(ra *ratypes.RouteAdvertisements)
 		// reset node specific information
 		selectedNetworks.hostNetworkSubnets = map[string][]string{}
 		selectedNetworks.hostSubnets = []string{}
+		selectedNetworks.prefixAdvertisements = map[string]sets.Set[ratypes.AdvertisementType]{}
 
 		// gather node specific information
 		for _, network := range selectedNetworks.networks {
@@ -664,11 +752,22 @@ func (c *Controller) generateFRRConfiguration(
 				continue
 			}
 
+			withCommunity, withLocalPref, err := getPrefixesWithBGPAttributes(
+				ra.Spec.BGPAttributes,
+				advertisePrefixes,
+				selectedNetworks.prefixAdvertisements,
+			)
+			if err != nil {
+				return nil, err
+			}
+
 			neighbor.ToAdvertise = frrtypes.Advertise{
 				Allowed: frrtypes.AllowedOutPrefixes{
 					Mode:     frrtypes.AllowRestricted,
 					Prefixes: advertisePrefixes,
 				},
+				PrefixesWithCommunity: withCommunity,
+				PrefixesWithLocalPref: withLocalPref,
 			}
 			targetRouter.Neighbors = append(targetRouter.Neighbors, neighbor)
 		}
@@ -751,6 +850,73 @@ func (c *Controller) generateFRRConfiguration(
 	return new, nil
 }
 
+// getPrefixesWithBGPAttributes returns, out of the provided prefixes, those
+// that have to be tagged with communities or advertised with a local
+// preference as determined by the BGP attributes of their advertisement types.
+// Results are ordered to generate consistent FRRConfigurations.
+func getPrefixesWithBGPAttributes(
+	attributes []ratypes.BGPAttributes,
+	prefixes []string,
+	prefixAdvertisements map[string]sets.Set[ratypes.AdvertisementType],
+) ([]frrtypes.CommunityPrefixes, []frrtypes.LocalPrefPrefixes, error) {
+	if len(attributes) == 0 {
+		return nil, nil, nil
+	}
+
+	communityPrefixes := map[string]sets.Set[string]{}
+	localPrefPrefixes := map[uint32]sets.Set[string]{}
+	prefixLocalPref := map[string]uint32{}
+	for _, prefix := range prefixes {
+		for _, attribute := range attributes {
+			if !prefixAdvertisements[prefix].Has(attribute.Advertisement) {
+				continue
+			}
+			communities := make([]string, 0, len(attribute.Communities)+len(attribute.LargeCommunities))
+			communities = append(communities, attribute.Communities...)
+			for _, largeCommunity := range attribute.LargeCommunities {
+				// large communities are prefixed as expected by frr-k8s
+				communities = append(communities, "large:"+largeCommunity)
+			}
+			for _, community := range communities {
+				if communityPrefixes[community] == nil {
+					communityPrefixes[community] = sets.New[string]()
+				}
+				communityPrefixes[community].Insert(prefix)
+			}
+			if attribute.LocalPref == nil {
+				continue
+			}
+			localPref, hasLocalPref := prefixLocalPref[prefix]
+			if hasLocalPref && localPref != *attribute.LocalPref {
+				return nil, nil, fmt.Errorf("%w: prefix %s is advertised with conflicting local preferences %d and %d",
+					errConfig, prefix, localPref, *attribute.LocalPref)
+			}
+			prefixLocalPref[prefix] = *attribute.LocalPref
+			if localPrefPrefixes[*attribute.LocalPref] == nil {
+				localPrefPrefixes[*attribute.LocalPref] = sets.New[string]()
+			}
+			localPrefPrefixes[*attribute.LocalPref].Insert(prefix)
+		}
+	}
+
+	var withCommunity []frrtypes.CommunityPrefixes
+	for _, community := range sets.List(sets.KeySet(communityPrefixes)) {
+		withCommunity = append(withCommunity, frrtypes.CommunityPrefixes{
+			Community: community,
+			Prefixes:  sets.List(communityPrefixes[community]),
+		})
+	}
+	var withLocalPref []frrtypes.LocalPrefPrefixes
+	for _, localPref := range sets.List(sets.KeySet(localPrefPrefixes)) {
+		withLocalPref = append(withLocalPref, frrtypes.LocalPrefPrefixes{
+			LocalPref: localPref,
+			Prefixes:  sets.List(localPrefPrefixes[localPref]),
+		})
+	}
+
+	return withCommunity, withLocalPref, nil
+}
+
 // updateFRRConfigurations updates the FRRConfigurations that apply for a
 // RouteAdvertisements. It fetches existing FRRConfigurations by label and
 // indexes them by the annotated key. Then compares this state with desired
@@ -996,29 +1162,68 @@ func (c *Controller) getSelectedNADs(networkSelectors apitypes.NetworkSelectors)
 			}
 			selected = append(selected, nad)
 		case apitypes.ClusterUserDefinedNetworks:
-			nadSelector, err := metav1.LabelSelectorAsSelector(&networkSelector.ClusterUserDefinedNetworkSelector.NetworkSelector)
+			nads, err := c.getSelectedCUDNNADs(networkSelector.ClusterUserDefinedNetworkSelector)
 			if err != nil {
 				return nil, err
 			}
-			nads, err := c.nadLister.List(nadSelector)
+			selected = append(selected, nads...)
+		default:
+			return nil, fmt.Errorf("%w: unsupported network selection type %s", errConfig, networkSelector.NetworkSelectionType)
+		}
+	}
+
+	return selected, nil
+}
+
+// getSelectedCUDNNADs returns the NADs controlled by a CUDN that match the
+// provided selector.
+func (c *Controller) getSelectedCUDNNADs(cudnSelector *apitypes.ClusterUserDefinedNetworkSelector) ([]*nadtypes.NetworkAttachmentDefinition, error) {
+	nadSelector, err := metav1.LabelSelectorAsSelector(&cudnSelector.NetworkSelector)
+	if err != nil {
+		return nil, err
+	}
+	nads, err := c.nadLister.List(nadSelector)
+	if err != nil {
+		return nil, err
+	}
+	var selected []*nadtypes.NetworkAttachmentDefinition
+	for _, nad := range nads {
+		// check this NAD is controlled by a CUDN
+		controller := metav1.GetControllerOfNoCopy(nad)
+		isCUDN := controller != nil && controller.Kind == cudnController.Kind && controller.APIVersion == cudnController.GroupVersion().String()
+		if !isCUDN {
+			continue
+		}
+		selected = append(selected, nad)
+	}
+	return selected, nil
+}
+
+// selectsNetwork tells whether the RouteAdvertisements selects the provided
+// network. Unlike getSelectedNADs, it does not ensure the default network NAD
+// exists so that it can be used when filtering events.
+func (c *Controller) selectsNetwork(ra *ratypes.RouteAdvertisements, network string) (bool, error) {
+	for _, networkSelector := range ra.Spec.NetworkSelectors {
+		switch networkSelector.NetworkSelectionType {
+		case apitypes.DefaultNetwork:
+			if network == types.DefaultNetworkName {
+				return true, nil
+			}
+		case apitypes.ClusterUserDefinedNetworks:
+			nads, err := c.getSelectedCUDNNADs(networkSelector.ClusterUserDefinedNetworkSelector)
 			if err != nil {
-				return nil, err
+				return false, err
 			}
 			for _, nad := range nads {
-				// check this NAD is controlled by a CUDN
-				controller := metav1.GetControllerOfNoCopy(nad)
-				isCUDN := controller != nil && controller.Kind == cudnController.Kind && controller.APIVersion == cudnController.GroupVersion().String()
-				if !isCUDN {
-					continue
+				if util.GetAnnotatedNetworkName(nad) == network {
+					return true, nil
 				}
-				selected = append(selected, nad)
 			}
 		default:
-			return nil, fmt.Errorf("%w: unsupported network selection type %s", errConfig, networkSelector.NetworkSelectionType)
+			return false, fmt.Errorf("%w: unsupported network selection type %s", errConfig, networkSelector.NetworkSelectionType)
 		}
 	}
-
-	return selected, nil
+	return false, nil
 }
 
 // getEgressIPsByNodesByNetworks iterates all existing egress IPs that apply to
@@ -1086,6 +1291,99 @@ func (c *Controller) getEgressIPsByNodesByNetworks(networks sets.Set[string]) (m
 	return eipsByNodesByNetworks, nil
 }
 
+// getServiceIPsByNodesByNetworks iterates all existing services that belong to
+// any of the provided networks and returns a "node -> network -> ips" map with
+// the service IPs that should be advertised from each of the provided nodes.
+func (c *Controller) getServiceIPsByNodesByNetworks(networks, nodes sets.Set[string], advertisement *ratypes.ServiceAdvertisement) (map[string]map[string]sets.Set[string], error) {
+	ipsByNodesByNetworks := map[string]map[string]sets.Set[string]{}
+	addIPsByNodesByNetwork := func(nodes sets.Set[string], network string, ips []string) {
+		for node := range nodes {
+			if ipsByNodesByNetworks[node] == nil {
+				ipsByNodesByNetworks[node] = map[string]sets.Set[string]{}
+			}
+			if ipsByNodesByNetworks[node][network] == nil {
+				ipsByNodesByNetworks[node][network] = sets.New[string]()
+			}
+			for _, ip := range ips {
+				ipsByNodesByNetworks[node][network].Insert(ip + util.GetIPFullMaskString(ip))
+			}
+		}
+	}
+
+	// helper to gather the nodes with local ready endpoints for a service
+	getNodesWithLocalEndpoints := func(service *corev1.Service, network string) (sets.Set[string], error) {
+		endpointSlices, err := util.GetServiceEndpointSlices(service.Namespace, service.Name, network, c.endpointSliceLister)
+		if err != nil {
+			return nil, err
+		}
+		localNodes := sets.New[string]()
+		for _, endpointSlice := range endpointSlices {
+			for _, endpoint := range endpointSlice.Endpoints {
+				if endpoint.NodeName == nil || !util.IsEndpointReady(endpoint) {
+					continue
+				}
+				if nodes.Has(*endpoint.NodeName) {
+					localNodes.Insert(*endpoint.NodeName)
+				}
+			}
+		}
+		return localNodes, nil
+	}
+
+	services, err := c.serviceLister.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	for _, service := range services {
+		if !util.ServiceTypeHasClusterIP(service) || !util.IsClusterIPSet(service) {
+			continue
+		}
+		namespaceNetwork := c.nm.GetActiveNetworkForNamespaceFast(service.Namespace)
+		network := namespaceNetwork.GetNetworkName()
+		if !networks.Has(network) {
+			continue
+		}
+
+		// LoadBalancer ingress IPs and, if requested, ExternalIPs are subject to
+		// the external traffic policy
+		var externalIPs []string
+		if util.ServiceTypeHasLoadBalancer(service) {
+			for _, ingress := range service.Status.LoadBalancer.Ingress {
+				ip := utilnet.ParseIPSloppy(ingress.IP)
+				if ip != nil {
+					externalIPs = append(externalIPs, ip.String())
+				}
+			}
+		}
+		if advertisement != nil && advertisement.ExternalIPs {
+			for _, externalIP := range service.Spec.ExternalIPs {
+				ip := utilnet.ParseIPSloppy(externalIP)
+				if ip != nil {
+					externalIPs = append(externalIPs, ip.String())
+				}
+			}
+		}
+		if len(externalIPs) > 0 {
+			externalNodes := nodes
+			if util.ServiceExternalTrafficPolicyLocal(service) {
+				externalNodes, err = getNodesWithLocalEndpoints(service, network)
+				if err != nil {
+					return nil, err
+				}
+			}
+			addIPsByNodesByNetwork(externalNodes, network, externalIPs)
+		}
+
+		// ClusterIPs are advertised from all nodes
+		if advertisement != nil && advertisement.ClusterIPs {
+			addIPsByNodesByNetwork(nodes, network, util.GetClusterIPs(service))
+		}
+	}
+
+	return ipsByNodesByNetworks, nil
+}
+
 // isOwnUpdate checks if an object was updated by us last, as indicated by its
 // managed fields. Used to avoid reconciling an update that we made ourselves.
 func isOwnUpdate(managedFields []metav1.ManagedFieldsEntry) bool {
@@ -1158,6 +1456,68 @@ func nsNeedsUpdate(oldObj, newObj *corev1.Namespace) bool {
 	return oldObj != nil && newObj != nil && !reflect.DeepEqual(oldObj.Labels, newObj.Labels)
 }
 
+func serviceNeedsUpdate(oldObj, newObj *corev1.Service) bool {
+	if oldObj == nil || newObj == nil {
+		return true
+	}
+	return !reflect.DeepEqual(oldObj.Spec.ClusterIPs, newObj.Spec.ClusterIPs) ||
+		!reflect.DeepEqual(oldObj.Spec.ExternalIPs, newObj.Spec.ExternalIPs) ||
+		oldObj.Spec.Type != newObj.Spec.Type ||
+		oldObj.Spec.ExternalTrafficPolicy != newObj.Spec.ExternalTrafficPolicy ||
+		!reflect.DeepEqual(oldObj.Status.LoadBalancer, newObj.Status.LoadBalancer)
+}
+
+func (c *Controller) endpointSliceNeedsUpdate(oldObj, newObj *discovery.EndpointSlice) bool {
+	// we only care about the nodes with ready endpoints
+	readyNodes := func(endpointSlice *discovery.EndpointSlice) sets.Set[string] {
+		nodes := sets.New[string]()
+		if endpointSlice == nil {
+			return nodes
+		}
+		for _, endpoint := range endpointSlice.Endpoints {
+			if endpoint.NodeName != nil && util.IsEndpointReady(endpoint) {
+				nodes.Insert(*endpoint.NodeName)
+			}
+		}
+		return nodes
+	}
+	if readyNodes(oldObj).Equal(readyNodes(newObj)) {
+		return false
+	}
+	// and only for services whose advertisement depends on them
+	return c.isAdvertisedFromLocalEndpoints(newObj)
+}
+
+// isAdvertisedFromLocalEndpoints tells whether the service of the provided
+// endpoint slice has external IPs that are only advertised from the nodes with
+// local endpoints, which is the case of LoadBalancer ingress IPs and
+// ExternalIPs with a local external traffic policy.
+func (c *Controller) isAdvertisedFromLocalEndpoints(endpointSlice *discovery.EndpointSlice) bool {
+	// the service endpoints of a primary UDN are tracked by the mirrored
+	// endpoint slices of that network
+	var serviceName string
+	network := c.nm.GetActiveNetworkForNamespaceFast(endpointSlice.Namespace).GetNetworkName()
+	switch {
+	case network == types.DefaultNetworkName:
+		serviceName = endpointSlice.Labels[discovery.LabelServiceName]
+	case endpointSlice.Annotations[types.UserDefinedNetworkEndpointSliceAnnotation] == network:
+		serviceName = endpointSlice.Labels[types.LabelUserDefinedServiceName]
+	}
+	if serviceName == "" {
+		return false
+	}
+	// a deleted service is reconciled on its own delete event
+	service, err := c.serviceLister.Services(endpointSlice.Namespace).Get(serviceName)
+	if err != nil {
+		return false
+	}
+	if !util.ServiceExternalTrafficPolicyLocal(service) {
+		return false
+	}
+	hasIngressIPs := util.ServiceTypeHasLoadBalancer(service) && len(service.Status.LoadBalancer.Ingress) > 0
+	return hasIngressIPs || len(service.Spec.ExternalIPs) > 0
+}
+
 func (c *Controller) reconcileFRRConfiguration(key string) error {
 	namespace, name, err := cache.SplitMetaNamespaceKey(key)
 	if err != nil {
@@ -1235,3 +1595,37 @@ func (c *Controller) reconcileEgressIPs(string) error {
 
 	return nil
 }
+
+func (c *Controller) reconcileServices(key string) error {
+	// both services and their endpoint slices belong to the active network of
+	// their namespace
+	namespace, _, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		klog.Errorf("Failed spliting service or endpoint slice reconcile key %q: %v", key, err)
+		return nil
+	}
+	network := c.nm.GetActiveNetworkForNamespaceFast(namespace).GetNetworkName()
+
+	// reconcile RAs that advertise services of that network
+	ras, err := c.raLister.List(labels.Everything())
+	if err != nil {
+		return err
+	}
+
+	for _, ra := range ras {
+		if !sets.New(ra.Spec.Advertisements...).Has(ratypes.Services) {
+			continue
+		}
+		selected, err := c.selectsNetwork(ra, network)
+		if err != nil {
+			// let the RA reconciliation report its configuration error
+			klog.Warningf("Failed to check if RouteAdvertisements %q selects network %q: %v", ra.Name, network, err)
+			selected = true
+		}
+		if selected {
+			c.raController.Reconcile(ra.Name)
+		}
+	}
+
+	return nil
+}
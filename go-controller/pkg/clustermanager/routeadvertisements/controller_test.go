@@ -16,6 +16,7 @@ import (
 	"github.com/onsi/gomega/format"
 
 	corev1 "k8s.io/api/core/v1"
+	discovery "k8s.io/api/discovery/v1"
 	meta "k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -47,6 +48,9 @@ type testRA struct {
 	SelectsDefault           bool
 	AdvertisePods            bool
 	AdvertiseEgressIPs       bool
+	AdvertiseServices        bool
+	Services                 *ratypes.ServiceAdvertisement
+	BGPAttributes            []ratypes.BGPAttributes
 	Status                   *metav1.ConditionStatus
 }
 
@@ -58,6 +62,7 @@ func (tra testRA) RouteAdvertisements() *ratypes.RouteAdvertisements {
 		Spec: ratypes.RouteAdvertisementsSpec{
 			TargetVRF:                tra.TargetVRF,
 			Advertisements:           []ratypes.AdvertisementType{},
+			BGPAttributes:            tra.BGPAttributes,
 			NodeSelector:             metav1.LabelSelector{},
 			FRRConfigurationSelector: metav1.LabelSelector{},
 		},
@@ -68,6 +73,10 @@ func (tra testRA) RouteAdvertisements() *ratypes.RouteAdvertisements {
 	if tra.AdvertiseEgressIPs {
 		ra.Spec.Advertisements = append(ra.Spec.Advertisements, ratypes.EgressIP)
 	}
+	if tra.AdvertiseServices {
+		ra.Spec.Advertisements = append(ra.Spec.Advertisements, ratypes.Services)
+		ra.Spec.Services = tra.Services
+	}
 	if tra.NetworkSelector != nil {
 		ra.Spec.NetworkSelectors = append(ra.Spec.NetworkSelectors, apitypes.NetworkSelector{
 			NetworkSelectionType: apitypes.ClusterUserDefinedNetworks,
@@ -149,10 +158,12 @@ func (tn testNode) Node() *corev1.Node {
 }
 
 type testNeighbor struct {
-	ASN       uint32
-	Address   string
-	DisableMP *bool
-	Advertise []string
+	ASN           uint32
+	Address       string
+	DisableMP     *bool
+	Advertise     []string
+	WithCommunity []frrapi.CommunityPrefixes
+	WithLocalPref []frrapi.LocalPrefPrefixes
 }
 
 func (tn testNeighbor) Neighbor() frrapi.Neighbor {
@@ -165,6 +176,8 @@ func (tn testNeighbor) Neighbor() frrapi.Neighbor {
 				Mode:     frrapi.AllowRestricted,
 				Prefixes: tn.Advertise,
 			},
+			PrefixesWithCommunity: tn.WithCommunity,
+			PrefixesWithLocalPref: tn.WithLocalPref,
 		},
 	}
 	if tn.DisableMP != nil {
@@ -263,6 +276,75 @@ func (te testEIP) EgressIP() *eiptypes.EgressIP {
 	return &eip
 }
 
+type testService struct {
+	Name             string
+	Namespace        string
+	ClusterIP        string
+	ExternalIPs      []string
+	LoadBalancerIPs  []string
+	ETPLocal         bool
+	LocalEndpointsOn []string
+	// EndpointsNotReadyOn are the nodes with endpoints that are not ready
+	EndpointsNotReadyOn []string
+}
+
+func (ts testService) Service() *corev1.Service {
+	service := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ts.Name,
+			Namespace: ts.Namespace,
+		},
+		Spec: corev1.ServiceSpec{
+			Type:        corev1.ServiceTypeClusterIP,
+			ClusterIP:   ts.ClusterIP,
+			ClusterIPs:  []string{ts.ClusterIP},
+			ExternalIPs: ts.ExternalIPs,
+		},
+	}
+	if len(ts.LoadBalancerIPs) > 0 {
+		service.Spec.Type = corev1.ServiceTypeLoadBalancer
+		for _, ip := range ts.LoadBalancerIPs {
+			service.Status.LoadBalancer.Ingress = append(service.Status.LoadBalancer.Ingress, corev1.LoadBalancerIngress{IP: ip})
+		}
+	}
+	if ts.ETPLocal {
+		service.Spec.ExternalTrafficPolicy = corev1.ServiceExternalTrafficPolicyTypeLocal
+	}
+	return service
+}
+
+func (ts testService) EndpointSlice() *discovery.EndpointSlice {
+	endpointSlice := &discovery.EndpointSlice{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ts.Name + "-ab12c",
+			Namespace: ts.Namespace,
+			Labels:    map[string]string{discovery.LabelServiceName: ts.Name},
+		},
+		AddressType: discovery.AddressTypeIPv4,
+	}
+	for i, node := range ts.LocalEndpointsOn {
+		endpointSlice.Endpoints = append(endpointSlice.Endpoints, discovery.Endpoint{
+			Addresses:  []string{fmt.Sprintf("1.1.0.%d", i+10)},
+			Conditions: discovery.EndpointConditions{Ready: ptr.To(true)},
+			NodeName:   ptr.To(node),
+		})
+	}
+	for i, node := range ts.EndpointsNotReadyOn {
+		endpointSlice.Endpoints = append(endpointSlice.Endpoints, discovery.Endpoint{
+			Addresses:  []string{fmt.Sprintf("1.1.1.%d", i+10)},
+			Conditions: discovery.EndpointConditions{Ready: ptr.To(false)},
+			NodeName:   ptr.To(node),
+		})
+	}
+	return endpointSlice
+}
+
+// testEndpointSlice is the endpoint slice of a test service which is created
+// along with it
+type testEndpointSlice struct {
+	testService
+}
+
 type testNAD struct {
 	Name        string
 	Namespace   string
@@ -371,6 +453,7 @@ func TestController_reconcile(t *testing.T) {
 		nodes                []*testNode
 		namespaces           []*testNamespace
 		eips                 []*testEIP
+		services             []*testService
 		reconcile            string
 		wantErr              bool
 		expectAcceptedStatus metav1.ConditionStatus
@@ -903,6 +986,183 @@ func TestController_reconcile(t *testing.T) {
 			reconcile:            "ra",
 			expectAcceptedStatus: metav1.ConditionFalse,
 		},
+		{
+			name: "reconciles service RouteAdvertisement for a single FRR config, multiple nodes and default network",
+			ra:   &testRA{Name: "ra", AdvertiseServices: true, SelectsDefault: true},
+			frrConfigs: []*testFRRConfig{
+				{
+					Name:      "frrConfig",
+					Namespace: frrNamespace,
+					Routers: []*testRouter{
+						{ASN: 1, Prefixes: []string{"1.1.1.0/24"}, Neighbors: []*testNeighbor{
+							{ASN: 1, Address: "1.0.0.100"},
+						}},
+					},
+				},
+			},
+			nodes: []*testNode{
+				{Name: "node1", SubnetsAnnotation: "{\"default\":\"1.1.1.0/24\"}"},
+				{Name: "node2", SubnetsAnnotation: "{\"default\":\"1.1.2.0/24\"}"},
+			},
+			services: []*testService{
+				{Name: "cluster", Namespace: "default", ClusterIP: "172.30.0.10", ExternalIPs: []string{"5.5.5.10"}, LoadBalancerIPs: []string{"5.5.6.10"}},
+				{Name: "local", Namespace: "default", ClusterIP: "172.30.0.11", LoadBalancerIPs: []string{"5.5.6.11"}, ETPLocal: true, LocalEndpointsOn: []string{"node2"}},
+				{Name: "nolb", Namespace: "default", ClusterIP: "172.30.0.12"},
+			},
+			reconcile:            "ra",
+			expectAcceptedStatus: metav1.ConditionTrue,
+			expectFRRConfigs: []*testFRRConfig{
+				{
+					Labels:       map[string]string{types.OvnRouteAdvertisementsKey: "ra"},
+					Annotations:  map[string]string{types.OvnRouteAdvertisementsKey: "ra/frrConfig/node1"},
+					NodeSelector: map[string]string{"kubernetes.io/hostname": "node1"},
+					Routers: []*testRouter{
+						{ASN: 1, Prefixes: []string{"5.5.6.10/32"}, Neighbors: []*testNeighbor{
+							{ASN: 1, Address: "1.0.0.100", Advertise: []string{"5.5.6.10/32"}},
+						}},
+					}},
+				{
+					Labels:       map[string]string{types.OvnRouteAdvertisementsKey: "ra"},
+					Annotations:  map[string]string{types.OvnRouteAdvertisementsKey: "ra/frrConfig/node2"},
+					NodeSelector: map[string]string{"kubernetes.io/hostname": "node2"},
+					Routers: []*testRouter{
+						{ASN: 1, Prefixes: []string{"5.5.6.10/32", "5.5.6.11/32"}, Neighbors: []*testNeighbor{
+							{ASN: 1, Address: "1.0.0.100", Advertise: []string{"5.5.6.10/32", "5.5.6.11/32"}},
+						}},
+					}},
+			},
+		},
+		{
+			name: "reconciles service RouteAdvertisement advertising ClusterIPs and ExternalIPs",
+			ra: &testRA{
+				Name:              "ra",
+				AdvertiseServices: true,
+				Services:          &ratypes.ServiceAdvertisement{ClusterIPs: true, ExternalIPs: true},
+				SelectsDefault:    true,
+			},
+			frrConfigs: []*testFRRConfig{
+				{
+					Name:      "frrConfig",
+					Namespace: frrNamespace,
+					Routers: []*testRouter{
+						{ASN: 1, Prefixes: []string{"1.1.1.0/24"}, Neighbors: []*testNeighbor{
+							{ASN: 1, Address: "1.0.0.100"},
+						}},
+					},
+				},
+			},
+			nodes: []*testNode{
+				{Name: "node1", SubnetsAnnotation: "{\"default\":\"1.1.1.0/24\"}"},
+				{Name: "node2", SubnetsAnnotation: "{\"default\":\"1.1.2.0/24\"}"},
+			},
+			services: []*testService{
+				{Name: "cluster", Namespace: "default", ClusterIP: "172.30.0.10", ExternalIPs: []string{"5.5.5.10"}, LoadBalancerIPs: []string{"5.5.6.10"}},
+				{Name: "local", Namespace: "default", ClusterIP: "172.30.0.11", ExternalIPs: []string{"5.5.5.11"}, LoadBalancerIPs: []string{"5.5.6.11"}, ETPLocal: true, LocalEndpointsOn: []string{"node2"}},
+			},
+			reconcile:            "ra",
+			expectAcceptedStatus: metav1.ConditionTrue,
+			expectFRRConfigs: []*testFRRConfig{
+				{
+					Labels:       map[string]string{types.OvnRouteAdvertisementsKey: "ra"},
+					Annotations:  map[string]string{types.OvnRouteAdvertisementsKey: "ra/frrConfig/node1"},
+					NodeSelector: map[string]string{"kubernetes.io/hostname": "node1"},
+					Routers: []*testRouter{
+						{ASN: 1, Prefixes: []string{"172.30.0.10/32", "172.30.0.11/32", "5.5.5.10/32", "5.5.6.10/32"}, Neighbors: []*testNeighbor{
+							{ASN: 1, Address: "1.0.0.100", Advertise: []string{"172.30.0.10/32", "172.30.0.11/32", "5.5.5.10/32", "5.5.6.10/32"}},
+						}},
+					}},
+				{
+					Labels:       map[string]string{types.OvnRouteAdvertisementsKey: "ra"},
+					Annotations:  map[string]string{types.OvnRouteAdvertisementsKey: "ra/frrConfig/node2"},
+					NodeSelector: map[string]string{"kubernetes.io/hostname": "node2"},
+					Routers: []*testRouter{
+						{ASN: 1, Prefixes: []string{"172.30.0.10/32", "172.30.0.11/32", "5.5.5.10/32", "5.5.5.11/32", "5.5.6.10/32", "5.5.6.11/32"}, Neighbors: []*testNeighbor{
+							{ASN: 1, Address: "1.0.0.100", Advertise: []string{"172.30.0.10/32", "172.30.0.11/32", "5.5.5.10/32", "5.5.5.11/32", "5.5.6.10/32", "5.5.6.11/32"}},
+						}},
+					}},
+			},
+		},
+		{
+			name: "reconciles pod+eip RouteAdvertisement with BGP attributes per advertisement type",
+			ra: &testRA{
+				Name:               "ra",
+				AdvertisePods:      true,
+				AdvertiseEgressIPs: true,
+				SelectsDefault:     true,
+				BGPAttributes: []ratypes.BGPAttributes{
+					{Advertisement: ratypes.PodNetwork, Communities: []string{"65000:100"}, LocalPref: ptr.To(uint32(200))},
+					{Advertisement: ratypes.EgressIP, Communities: []string{"65000:200", "65000:300"}, LargeCommunities: []string{"65000:1:2"}},
+				},
+			},
+			frrConfigs: []*testFRRConfig{
+				{
+					Name:      "frrConfig",
+					Namespace: frrNamespace,
+					Routers: []*testRouter{
+						{ASN: 1, Prefixes: []string{"1.1.1.0/24"}, Neighbors: []*testNeighbor{
+							{ASN: 1, Address: "1.0.0.100"},
+						}},
+					},
+				},
+			},
+			nodes:                []*testNode{{Name: "node", SubnetsAnnotation: "{\"default\":\"1.1.0.0/24\"}"}},
+			eips:                 []*testEIP{{Name: "eip", EIPs: map[string]string{"node": "1.0.1.1"}}},
+			reconcile:            "ra",
+			expectAcceptedStatus: metav1.ConditionTrue,
+			expectFRRConfigs: []*testFRRConfig{
+				{
+					Labels:       map[string]string{types.OvnRouteAdvertisementsKey: "ra"},
+					Annotations:  map[string]string{types.OvnRouteAdvertisementsKey: "ra/frrConfig/node"},
+					NodeSelector: map[string]string{"kubernetes.io/hostname": "node"},
+					Routers: []*testRouter{
+						{ASN: 1, Prefixes: []string{"1.0.1.1/32", "1.1.0.0/24"}, Neighbors: []*testNeighbor{
+							{
+								ASN:       1,
+								Address:   "1.0.0.100",
+								Advertise: []string{"1.0.1.1/32", "1.1.0.0/24"},
+								WithCommunity: []frrapi.CommunityPrefixes{
+									{Community: "65000:100", Prefixes: []string{"1.1.0.0/24"}},
+									{Community: "65000:200", Prefixes: []string{"1.0.1.1/32"}},
+									{Community: "65000:300", Prefixes: []string{"1.0.1.1/32"}},
+									{Community: "large:65000:1:2", Prefixes: []string{"1.0.1.1/32"}},
+								},
+								WithLocalPref: []frrapi.LocalPrefPrefixes{
+									{LocalPref: 200, Prefixes: []string{"1.1.0.0/24"}},
+								},
+							},
+						}},
+					}},
+			},
+		},
+		{
+			name: "fails to reconcile if a prefix is advertised with conflicting local preferences",
+			ra: &testRA{
+				Name:               "ra",
+				AdvertiseEgressIPs: true,
+				AdvertiseServices:  true,
+				SelectsDefault:     true,
+				BGPAttributes: []ratypes.BGPAttributes{
+					{Advertisement: ratypes.EgressIP, LocalPref: ptr.To(uint32(100))},
+					{Advertisement: ratypes.Services, LocalPref: ptr.To(uint32(200))},
+				},
+			},
+			frrConfigs: []*testFRRConfig{
+				{
+					Name:      "frrConfig",
+					Namespace: frrNamespace,
+					Routers: []*testRouter{
+						{ASN: 1, Prefixes: []string{"1.1.1.0/24"}, Neighbors: []*testNeighbor{
+							{ASN: 1, Address: "1.0.0.100"},
+						}},
+					},
+				},
+			},
+			nodes:                []*testNode{{Name: "node", SubnetsAnnotation: "{\"default\":\"1.1.0.0/24\"}"}},
+			eips:                 []*testEIP{{Name: "eip", EIPs: map[string]string{"node": "5.5.6.10"}}},
+			services:             []*testService{{Name: "service", Namespace: "default", ClusterIP: "172.30.0.10", LoadBalancerIPs: []string{"5.5.6.10"}}},
+			reconcile:            "ra",
+			expectAcceptedStatus: metav1.ConditionFalse,
+		},
 		{
 			name: "fails to reconcile if EgressIP is advertised with 'auto' target VRF",
 			ra:   &testRA{Name: "ra", TargetVRF: "auto", AdvertiseEgressIPs: true, NetworkSelector: map[string]string{"selected": "true"}},
@@ -1002,6 +1262,13 @@ func TestController_reconcile(t *testing.T) {
 				g.Expect(err).ToNot(gomega.HaveOccurred())
 			}
 
+			for _, service := range tt.services {
+				_, err := fakeClientset.KubeClient.CoreV1().Services(service.Namespace).Create(context.Background(), service.Service(), metav1.CreateOptions{})
+				g.Expect(err).ToNot(gomega.HaveOccurred())
+				_, err = fakeClientset.KubeClient.DiscoveryV1().EndpointSlices(service.Namespace).Create(context.Background(), service.EndpointSlice(), metav1.CreateOptions{})
+				g.Expect(err).ToNot(gomega.HaveOccurred())
+			}
+
 			wf, err := factory.NewClusterManagerWatchFactory(fakeClientset)
 			g.Expect(err).ToNot(gomega.HaveOccurred())
 
@@ -1040,6 +1307,8 @@ func TestController_reconcile(t *testing.T) {
 				wf.NADInformer().Informer().HasSynced,
 				wf.NodeCoreInformer().Informer().HasSynced,
 				wf.EgressIPInformer().Informer().HasSynced,
+				wf.ServiceCoreInformer().Informer().HasSynced,
+				wf.EndpointSliceCoreInformer().Informer().HasSynced,
 			)
 
 			err = nm.Start()
@@ -1119,14 +1388,17 @@ func TestUpdates(t *testing.T) {
 			Name:                     "ra1",
 			FRRConfigurationSelector: map[string]string{"select": "1"},
 			NetworkSelector:          map[string]string{"select": "1"},
+			SelectsDefault:           true,
 			AdvertiseEgressIPs:       true,
 			AdvertisePods:            true,
+			AdvertiseServices:        true,
 		},
 		{
 			Name:                     "ra2",
 			FRRConfigurationSelector: map[string]string{"select": "2"},
 			NetworkSelector:          map[string]string{"select": "2"},
 			NodeSelector:             map[string]string{"select": "2"},
+			AdvertiseServices:        true,
 		},
 		{
 			Name:                     "ra3",
@@ -1304,6 +1576,54 @@ func TestUpdates(t *testing.T) {
 			oldObject: &testNode{Name: "eip", Generation: 1},
 			newObject: &testNode{Name: "eip", Generation: 2},
 		},
+		{
+			name:              "reconciles the RAs that advertise the services of its network on new service",
+			newObject:         &testService{Name: "svc", Namespace: "ns", ClusterIP: "10.96.0.10"},
+			expectedReconcile: []string{"ra1"},
+		},
+		{
+			name:              "reconciles the RAs that advertise the services of its network on deleted service",
+			oldObject:         &testService{Name: "svc", Namespace: "ns", ClusterIP: "10.96.0.10"},
+			expectedReconcile: []string{"ra1"},
+		},
+		{
+			name:              "reconciles the RAs that advertise the services of its network on updated service external IPs",
+			oldObject:         &testService{Name: "svc", Namespace: "ns", ClusterIP: "10.96.0.10"},
+			newObject:         &testService{Name: "svc", Namespace: "ns", ClusterIP: "10.96.0.10", ExternalIPs: []string{"1.1.1.1"}},
+			expectedReconcile: []string{"ra1"},
+		},
+		{
+			name:      "does not reconcile RAs on service irrelevant change",
+			oldObject: &testService{Name: "svc", Namespace: "ns", ClusterIP: "10.96.0.10"},
+			newObject: &testService{Name: "svc", Namespace: "ns", ClusterIP: "10.96.0.10", LocalEndpointsOn: []string{"node"}},
+		},
+		{
+			name:              "reconciles the RAs that advertise the services of its network on updated ready nodes of a local load balancer service",
+			oldObject:         &testEndpointSlice{testService{Name: "svc", Namespace: "ns", ClusterIP: "10.96.0.10", LoadBalancerIPs: []string{"1.1.1.1"}, ETPLocal: true}},
+			newObject:         &testEndpointSlice{testService{Name: "svc", Namespace: "ns", ClusterIP: "10.96.0.10", LoadBalancerIPs: []string{"1.1.1.1"}, ETPLocal: true, LocalEndpointsOn: []string{"node"}}},
+			expectedReconcile: []string{"ra1"},
+		},
+		{
+			name:              "reconciles the RAs that advertise the services of its network on updated ready nodes of a local external IP service",
+			oldObject:         &testEndpointSlice{testService{Name: "svc", Namespace: "ns", ClusterIP: "10.96.0.10", ExternalIPs: []string{"1.1.1.1"}, ETPLocal: true}},
+			newObject:         &testEndpointSlice{testService{Name: "svc", Namespace: "ns", ClusterIP: "10.96.0.10", ExternalIPs: []string{"1.1.1.1"}, ETPLocal: true, LocalEndpointsOn: []string{"node"}}},
+			expectedReconcile: []string{"ra1"},
+		},
+		{
+			name:      "does not reconcile RAs on updated ready nodes of a cluster IP service",
+			oldObject: &testEndpointSlice{testService{Name: "svc", Namespace: "ns", ClusterIP: "10.96.0.10"}},
+			newObject: &testEndpointSlice{testService{Name: "svc", Namespace: "ns", ClusterIP: "10.96.0.10", LocalEndpointsOn: []string{"node"}}},
+		},
+		{
+			name:      "does not reconcile RAs on updated ready nodes of a cluster load balancer service",
+			oldObject: &testEndpointSlice{testService{Name: "svc", Namespace: "ns", ClusterIP: "10.96.0.10", LoadBalancerIPs: []string{"1.1.1.1"}}},
+			newObject: &testEndpointSlice{testService{Name: "svc", Namespace: "ns", ClusterIP: "10.96.0.10", LoadBalancerIPs: []string{"1.1.1.1"}, LocalEndpointsOn: []string{"node"}}},
+		},
+		{
+			name:      "does not reconcile RAs on endpoint slice irrelevant change",
+			oldObject: &testEndpointSlice{testService{Name: "svc", Namespace: "ns", ClusterIP: "10.96.0.10", LoadBalancerIPs: []string{"1.1.1.1"}, ETPLocal: true, LocalEndpointsOn: []string{"node"}}},
+			newObject: &testEndpointSlice{testService{Name: "svc", Namespace: "ns", ClusterIP: "10.96.0.10", LoadBalancerIPs: []string{"1.1.1.1"}, ETPLocal: true, LocalEndpointsOn: []string{"node"}, EndpointsNotReadyOn: []string{"node2"}}},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1371,6 +1691,14 @@ func TestUpdates(t *testing.T) {
 					_, err = fakeClientset.KubeClient.CoreV1().Nodes().Create(context.Background(), t.Node(), metav1.CreateOptions{})
 				case *testNamespace:
 					_, err = fakeClientset.KubeClient.CoreV1().Namespaces().Create(context.Background(), t.Namespace(), metav1.CreateOptions{})
+				case *testService:
+					_, err = fakeClientset.KubeClient.CoreV1().Services(t.Namespace).Create(context.Background(), t.Service(), metav1.CreateOptions{})
+				case *testEndpointSlice:
+					_, err = fakeClientset.KubeClient.CoreV1().Services(t.Namespace).Create(context.Background(), t.Service(), metav1.CreateOptions{})
+					if err != nil {
+						return err
+					}
+					_, err = fakeClientset.KubeClient.DiscoveryV1().EndpointSlices(t.Namespace).Create(context.Background(), t.EndpointSlice(), metav1.CreateOptions{})
 				}
 				return err
 			}
@@ -1387,6 +1715,10 @@ func TestUpdates(t *testing.T) {
 					_, err = fakeClientset.KubeClient.CoreV1().Nodes().Update(context.Background(), t.Node(), metav1.UpdateOptions{})
 				case *testNamespace:
 					_, err = fakeClientset.KubeClient.CoreV1().Namespaces().Update(context.Background(), t.Namespace(), metav1.UpdateOptions{})
+				case *testService:
+					_, err = fakeClientset.KubeClient.CoreV1().Services(t.Namespace).Update(context.Background(), t.Service(), metav1.UpdateOptions{})
+				case *testEndpointSlice:
+					_, err = fakeClientset.KubeClient.DiscoveryV1().EndpointSlices(t.Namespace).Update(context.Background(), t.EndpointSlice(), metav1.UpdateOptions{})
 				}
 				return err
 			}
@@ -1403,6 +1735,8 @@ func TestUpdates(t *testing.T) {
 					err = fakeClientset.KubeClient.CoreV1().Nodes().Delete(context.Background(), t.Name, metav1.DeleteOptions{})
 				case *testNamespace:
 					err = fakeClientset.KubeClient.CoreV1().Namespaces().Delete(context.Background(), t.Name, metav1.DeleteOptions{})
+				case *testService:
+					err = fakeClientset.KubeClient.CoreV1().Services(t.Namespace).Delete(context.Background(), t.Name, metav1.DeleteOptions{})
 				}
 				return err
 			}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -115,21 +116,35 @@ func (m *typedStatusManager[T]) updateStatus(key string) error {
 		}
 
 		// first, make sure no stale zones are present.
-		// every zone has exactly 1 status message
-		if len(messages) > zones.Len() {
+		// every zone has exactly 1 status message, unless the status is reported per network
+		staleZones := sets.New[string]()
+		reportedZones := len(messages)
+		if isReportedPerNetwork(m.resource) {
+			zonesWithMessages := sets.New[string]()
+			for _, message := range messages {
+				zonesWithMessages.Insert(types.GetZoneFromStatus(message))
+			}
+			staleZones = zonesWithMessages.Difference(zones)
+			reportedZones = zonesWithMessages.Len() - staleZones.Len()
+		} else if len(messages) > zones.Len() {
 			for _, message := range messages {
 				if zoneID := types.GetZoneFromStatus(message); !zones.Has(zoneID) {
-					// stale zone, remove
-					// use zoneID as fieldManager to reset fields owned by that zone
-					applyAsZoneController := &metav1.ApplyOptions{
-						Force:        true,
-						FieldManager: zoneID,
-					}
-					klog.Infof("StatusManager %s: delete stale zone %s", m.name, zoneID)
-					err = m.resource.cleanupStatus(obj, applyAsZoneController)
-					if err != nil {
-						return err
-					}
+					staleZones.Insert(zoneID)
+				}
+			}
+		}
+		for _, zoneID := range sets.List(staleZones) {
+			// stale zone, remove
+			// use the field managers of the zone to reset fields owned by that zone
+			klog.Infof("StatusManager %s: delete stale zone %s", m.name, zoneID)
+			for _, fieldManager := range getZoneFieldManagers(obj, zoneID) {
+				applyAsZoneController := &metav1.ApplyOptions{
+					Force:        true,
+					FieldManager: fieldManager,
+				}
+				err = m.resource.cleanupStatus(obj, applyAsZoneController)
+				if err != nil {
+					return err
 				}
 			}
 		}
@@ -140,11 +155,39 @@ func (m *typedStatusManager[T]) updateStatus(key string) error {
 			Force:        true,
 			FieldManager: clusterManagerName,
 		}
-		applyEmptyOrFailed := len(messages) < zones.Len()
+		applyEmptyOrFailed := reportedZones < zones.Len()
 		return m.resource.updateStatus(obj, applyAsStatusManager, applyEmptyOrFailed)
 	})
 }
 
+// perNetworkResourceManager is implemented by the managers of resources whose status is reported by every network
+// of a zone, with one message and field manager per network, see types.GetZoneNetworkFieldManager.
+type perNetworkResourceManager interface {
+	reportedPerNetwork() bool
+}
+
+func isReportedPerNetwork(resource any) bool {
+	perNetworkResource, ok := resource.(perNetworkResourceManager)
+	return ok && perNetworkResource.reportedPerNetwork()
+}
+
+// getZoneFieldManagers returns the field managers that reported status for the given zone, or the zone if they
+// are not known.
+func getZoneFieldManagers(obj any, zoneID string) []string {
+	fieldManagers := sets.New[string]()
+	if accessor, err := meta.Accessor(obj); err == nil {
+		for _, managedField := range accessor.GetManagedFields() {
+			if types.IsZoneFieldManager(zoneID, managedField.Manager) {
+				fieldManagers.Insert(managedField.Manager)
+			}
+		}
+	}
+	if fieldManagers.Len() == 0 {
+		fieldManagers.Insert(zoneID)
+	}
+	return sets.List(fieldManagers)
+}
+
 func (m *typedStatusManager[T]) ReconcileAll() {
 	m.objController.ReconcileAll()
 }
@@ -193,6 +236,14 @@ func NewStatusManager(wf *factory.WatchFactory, ovnClient *util.OVNClusterManage
 			sm.withZonesRLock,
 		)
 		sm.typedManagers["egressfirewalls"] = egressFirewallManager
+		clusterEgressFirewallManager := newStatusManager[egressfirewallapi.ClusterEgressFirewall](
+			"clusteregressfirewalls_statusmanager",
+			wf.ClusterEgressFirewallInformer().Informer(),
+			wf.ClusterEgressFirewallInformer().Lister().List,
+			newClusterEgressFirewallManager(wf.ClusterEgressFirewallInformer().Lister(), ovnClient.EgressFirewallClient),
+			sm.withZonesRLock,
+		)
+		sm.typedManagers["clusteregressfirewalls"] = clusterEgressFirewallManager
 	}
 	if config.OVNKubernetesFeature.EnableEgressQoS {
 		egressQoSManager := newStatusManager[egressqosapi.EgressQoS](
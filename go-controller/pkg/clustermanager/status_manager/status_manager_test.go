@@ -116,6 +116,43 @@ func checkEmptyEFStatusConsistently(egressFirewall *egressfirewallapi.EgressFire
 	}).Should(BeTrue(), "expected Status to be consistently empty")
 }
 
+func newClusterEgressFirewall(name string) *egressfirewallapi.ClusterEgressFirewall {
+	return &egressfirewallapi.ClusterEgressFirewall{
+		ObjectMeta: util.NewObjectMeta(name, ""),
+		Spec: egressfirewallapi.ClusterEgressFirewallSpec{
+			Egress: []egressfirewallapi.EgressFirewallRule{
+				{
+					Type: "Allow",
+					To: egressfirewallapi.EgressFirewallDestination{
+						CIDRSelector: "1.2.3.4/23",
+					},
+				},
+			},
+		},
+	}
+}
+
+func updateClusterEgressFirewallStatus(clusterEgressFirewall *egressfirewallapi.ClusterEgressFirewall,
+	status *egressfirewallapi.EgressFirewallStatus, fakeClient *util.OVNClusterManagerClientset) {
+	clusterEgressFirewall.Status = *status
+	_, err := fakeClient.EgressFirewallClient.K8sV1().ClusterEgressFirewalls().
+		Update(context.TODO(), clusterEgressFirewall, metav1.UpdateOptions{})
+	Expect(err).ToNot(HaveOccurred())
+}
+
+func checkCEFStatusEventually(clusterEgressFirewall *egressfirewallapi.ClusterEgressFirewall, expectFailure bool,
+	fakeClient *util.OVNClusterManagerClientset) {
+	Eventually(func() bool {
+		cef, err := fakeClient.EgressFirewallClient.K8sV1().ClusterEgressFirewalls().
+			Get(context.TODO(), clusterEgressFirewall.Name, metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		if expectFailure {
+			return strings.Contains(cef.Status.Status, types.EgressFirewallErrorMsg)
+		}
+		return strings.Contains(cef.Status.Status, "applied")
+	}).Should(BeTrue(), fmt.Sprintf("expected cluster egress firewall status with expectFailure=%v", expectFailure))
+}
+
 func newAPBRoute(name string) *adminpolicybasedrouteapi.AdminPolicyBasedExternalRoute {
 	return &adminpolicybasedrouteapi.AdminPolicyBasedExternalRoute{
 		ObjectMeta: util.NewObjectMeta(name, ""),
@@ -376,6 +413,64 @@ var _ = Describe("Cluster Manager Status Manager", func() {
 		}, fakeClient)
 		checkEFStatusEventually(egressFirewall, false, false, fakeClient)
 	})
+	It("updates ClusterEgressFirewall status with 2 zones", func() {
+		config.OVNKubernetesFeature.EnableEgressFirewall = true
+		zones := sets.New("zone1", "zone2")
+		clusterEgressFirewall := newClusterEgressFirewall("cef1")
+		start(zones, clusterEgressFirewall)
+
+		updateClusterEgressFirewallStatus(clusterEgressFirewall, &egressfirewallapi.EgressFirewallStatus{
+			Messages: []string{types.GetZoneStatus("zone1", "OK"), types.GetZoneStatus("zone2", "OK")},
+		}, fakeClient)
+		checkCEFStatusEventually(clusterEgressFirewall, false, fakeClient)
+
+		clusterEgressFirewall, err := fakeClient.EgressFirewallClient.K8sV1().ClusterEgressFirewalls().
+			Get(context.TODO(), clusterEgressFirewall.Name, metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		updateClusterEgressFirewallStatus(clusterEgressFirewall, &egressfirewallapi.EgressFirewallStatus{
+			Messages: []string{types.GetZoneStatus("zone1", "OK"), types.GetZoneStatus("zone2", types.EgressFirewallErrorMsg)},
+		}, fakeClient)
+		checkCEFStatusEventually(clusterEgressFirewall, true, fakeClient)
+	})
+
+	It("aggregates the ClusterEgressFirewall status of the networks of a zone", func() {
+		config.OVNKubernetesFeature.EnableEgressFirewall = true
+		zones := sets.New("zone1", "zone2")
+		clusterEgressFirewall := newClusterEgressFirewall("cef1")
+		start(zones, clusterEgressFirewall)
+
+		// 2 networks of zone1 reported status, but zone2 didn't
+		updateClusterEgressFirewallStatus(clusterEgressFirewall, &egressfirewallapi.EgressFirewallStatus{
+			Messages: []string{types.GetZoneStatus("zone1", "OK"), types.GetZoneStatus("zone1", "OK on network net1")},
+		}, fakeClient)
+		Consistently(func() string {
+			cef, err := fakeClient.EgressFirewallClient.K8sV1().ClusterEgressFirewalls().
+				Get(context.TODO(), clusterEgressFirewall.Name, metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			return cef.Status.Status
+		}).Should(BeEmpty())
+
+		clusterEgressFirewall, err := fakeClient.EgressFirewallClient.K8sV1().ClusterEgressFirewalls().
+			Get(context.TODO(), clusterEgressFirewall.Name, metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		updateClusterEgressFirewallStatus(clusterEgressFirewall, &egressfirewallapi.EgressFirewallStatus{
+			Messages: []string{types.GetZoneStatus("zone1", "OK"), types.GetZoneStatus("zone1", "OK on network net1"),
+				types.GetZoneStatus("zone2", "OK")},
+		}, fakeClient)
+		checkCEFStatusEventually(clusterEgressFirewall, false, fakeClient)
+
+		// a failure on one network of a zone fails the cluster egress firewall
+		clusterEgressFirewall, err = fakeClient.EgressFirewallClient.K8sV1().ClusterEgressFirewalls().
+			Get(context.TODO(), clusterEgressFirewall.Name, metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		updateClusterEgressFirewallStatus(clusterEgressFirewall, &egressfirewallapi.EgressFirewallStatus{
+			Messages: []string{types.GetZoneStatus("zone1", "OK"),
+				types.GetZoneStatus("zone1", types.EgressFirewallErrorMsg+" on network net1: error"),
+				types.GetZoneStatus("zone2", "OK")},
+		}, fakeClient)
+		checkCEFStatusEventually(clusterEgressFirewall, true, fakeClient)
+	})
+
 	It("updates APBRoute status with 1 zone", func() {
 		config.OVNKubernetesFeature.EnableMultiExternalGateway = true
 		zones := sets.New("zone1")
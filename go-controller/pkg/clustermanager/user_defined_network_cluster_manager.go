@@ -27,6 +27,7 @@ type userDefinedNetworkClusterManager struct {
 	recorder record.EventRecorder
 
 	errorReporter NetworkStatusReporter
+	usageReporter NetworkUsageReporter
 }
 
 func newUserDefinedNetworkClusterManager(
@@ -49,6 +50,10 @@ func (sncm *userDefinedNetworkClusterManager) SetNetworkStatusReporter(errorRepo
 	sncm.errorReporter = errorReporter
 }
 
+func (sncm *userDefinedNetworkClusterManager) SetNetworkUsageReporter(usageReporter NetworkUsageReporter) {
+	sncm.usageReporter = usageReporter
+}
+
 func (sncm *userDefinedNetworkClusterManager) GetDefaultNetworkController() networkmanager.ReconcilableNetworkController {
 	return nil
 }
@@ -69,6 +74,7 @@ func (sncm *userDefinedNetworkClusterManager) NewNetworkController(nInfo util.Ne
 		sncm.recorder,
 		sncm.networkManager,
 		sncm.errorReporter,
+		sncm.usageReporter,
 	)
 	return sncc, nil
 }
@@ -156,6 +162,7 @@ func (sncm *userDefinedNetworkClusterManager) newDummyLayer3NetworkController(ne
 		sncm.recorder,
 		sncm.networkManager,
 		nil,
+		nil,
 	)
 	err := nc.init()
 	return nc, err
@@ -22,6 +22,7 @@ import (
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/allocator/ip"
 	ovncnitypes "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/cni/types"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
+	userdefinednetworkv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/userdefinednetwork/v1"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/factory"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/networkmanager"
 	ovntypes "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
@@ -191,6 +192,7 @@ var _ = ginkgo.Describe("Cluster Controller Manager", func() {
 						sncm.recorder,
 						sncm.networkManager,
 						nil,
+						nil,
 					)
 					gomega.Expect(nc.init()).To(gomega.Succeed())
 					gomega.Expect(nc.Start(ctx.Context)).To(gomega.Succeed())
@@ -397,6 +399,7 @@ var _ = ginkgo.Describe("Cluster Controller Manager", func() {
 					sncm.recorder,
 					sncm.networkManager,
 					nil,
+					nil,
 				)
 				err = oc.init()
 				gomega.Expect(err).NotTo(gomega.HaveOccurred())
@@ -486,6 +489,7 @@ var _ = ginkgo.Describe("Cluster Controller Manager", func() {
 							sncm.recorder,
 							sncm.networkManager,
 							nil,
+							nil,
 						)
 						gomega.Expect(nc.init()).To(gomega.Succeed())
 						gomega.Expect(nc.Start(ctx.Context)).To(gomega.Succeed())
@@ -506,6 +510,69 @@ var _ = ginkgo.Describe("Cluster Controller Manager", func() {
 					gomega.Expect(app.Run([]string{app.Name})).To(gomega.Succeed())
 				})
 
+				ginkgo.It("reports the IP and IPAMClaim usage of the network", func() {
+					app.Action = func(ctx *cli.Context) error {
+						fakeClient := &util.OVNClusterManagerClientset{
+							KubeClient: fake.NewSimpleClientset(),
+							IPAMClaimsClient: fakeipamclaimclient.NewSimpleClientset(
+								ipamClaimWithIPAddr(claimName, namespace, networkName, subnetIP),
+								ipamClaimWithIPAddr(claimName2, namespace, networkName, subnetIP2),
+							),
+							NetworkAttchDefClient: fakenadclient.NewSimpleClientset(),
+						}
+
+						gomega.Expect(
+							initConfig(ctx, config.OVNKubernetesFeatureConfig{
+								EnableMultiNetwork:  true,
+								EnableInterconnect:  true,
+								EnablePersistentIPs: true},
+							)).To(gomega.Succeed())
+						var err error
+						f, err = factory.NewClusterManagerWatchFactory(fakeClient)
+						gomega.Expect(err).NotTo(gomega.HaveOccurred())
+						gomega.Expect(f.Start()).To(gomega.Succeed())
+
+						sncm, err := newUserDefinedNetworkClusterManager(fakeClient, f, networkmanager.Default().Interface(), recorder)
+						gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+						var usageLock sync.Mutex
+						var reportedUsage *userdefinednetworkv1.NetworkUsage
+						usageReporter := func(networkName, fieldManager string, usage *userdefinednetworkv1.NetworkUsage) error {
+							usageLock.Lock()
+							defer usageLock.Unlock()
+							reportedUsage = usage
+							return nil
+						}
+
+						nc := newNetworkClusterController(
+							netInfo,
+							sncm.ovnClient,
+							sncm.watchFactory,
+							sncm.recorder,
+							sncm.networkManager,
+							nil,
+							usageReporter,
+						)
+						gomega.Expect(nc.Start(ctx.Context)).To(gomega.Succeed())
+						defer nc.Stop()
+
+						gomega.Eventually(func() *userdefinednetworkv1.NetworkUsage {
+							usageLock.Lock()
+							defer usageLock.Unlock()
+							return reportedUsage
+						}).Should(gomega.Equal(&userdefinednetworkv1.NetworkUsage{
+							IPs: []userdefinednetworkv1.IPFamilyUsage{
+								{IPFamily: userdefinednetworkv1.IPFamilyIPv4, Allocated: 2, Free: 252},
+							},
+							PersistentIPClaims: 2,
+						}))
+
+						return nil
+					}
+
+					gomega.Expect(app.Run([]string{app.Name})).To(gomega.Succeed())
+				})
+
 				ginkgo.It("ignores IPs on IPAMClaims for networks it does not manage", func() {
 					app.Action = func(ctx *cli.Context) error {
 						const someOtherNetwork = "othernet"
@@ -539,6 +606,7 @@ var _ = ginkgo.Describe("Cluster Controller Manager", func() {
 							sncm.recorder,
 							sncm.networkManager,
 							nil,
+							nil,
 						)
 						gomega.Expect(nc.init()).To(gomega.Succeed())
 						gomega.Expect(nc.Start(ctx.Context)).To(gomega.Succeed())
@@ -588,6 +656,7 @@ var _ = ginkgo.Describe("Cluster Controller Manager", func() {
 							sncm.recorder,
 							sncm.networkManager,
 							nil,
+							nil,
 						)
 						gomega.Expect(nc.init()).To(gomega.Succeed())
 						gomega.Expect(nc.Start(ctx.Context)).To(gomega.Succeed())
@@ -657,6 +726,7 @@ var _ = ginkgo.Describe("Cluster Controller Manager", func() {
 							sncm.recorder,
 							sncm.networkManager,
 							nil,
+							nil,
 						)
 						gomega.Expect(nc.init()).To(gomega.Succeed())
 						gomega.Expect(nc.Start(ctx.Context)).To(gomega.Succeed())
@@ -729,6 +799,7 @@ var _ = ginkgo.Describe("Cluster Controller Manager", func() {
 							sncm.recorder,
 							sncm.networkManager,
 							nil,
+							nil,
 						)
 						gomega.Expect(nc.init()).To(gomega.Succeed())
 						gomega.Expect(nc.Start(ctx.Context)).To(gomega.Succeed())
@@ -795,6 +866,7 @@ var _ = ginkgo.Describe("Cluster Controller Manager", func() {
 						sncm.recorder,
 						sncm.networkManager,
 						nil,
+						nil,
 					)
 					gomega.Expect(nc.init()).To(gomega.Succeed())
 					gomega.Expect(nc.Start(ctx.Context)).To(gomega.Succeed())
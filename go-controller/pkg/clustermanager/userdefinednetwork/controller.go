@@ -360,6 +360,65 @@ func (c *Controller) UpdateSubsystemCondition(
 	return nil
 }
 
+// UpdateNetworkUsage may be used by other controllers handling network allocations to report the network IP address
+// usage in the status of the UDN or CUDN the network was created by.
+// FieldManager should be unique for every reporter, and must not be used to report conditions, as the applied status
+// only holds the usage.
+// If given network is not managed by a UDN or CUDN, no usage will be reported and no error will be returned.
+func (c *Controller) UpdateNetworkUsage(networkName, fieldManager string, usage *userdefinednetworkv1.NetworkUsage) error {
+	udnNamespace, udnName := util.ParseNetworkName(networkName)
+	if udnName == "" {
+		return nil
+	}
+	opts := metav1.ApplyOptions{
+		FieldManager: fieldManager,
+		Force:        true,
+	}
+	var err error
+	if udnNamespace == "" {
+		if _, err = c.cudnLister.Get(udnName); err != nil {
+			return nil
+		}
+		applyCUDN := udnapplyconfkv1.ClusterUserDefinedNetwork(udnName).
+			WithStatus(udnapplyconfkv1.ClusterUserDefinedNetworkStatus().WithUsage(newNetworkUsageApplyConfiguration(usage)))
+		_, err = c.udnClient.K8sV1().ClusterUserDefinedNetworks().ApplyStatus(context.Background(), applyCUDN, opts)
+	} else {
+		if _, err = c.udnLister.UserDefinedNetworks(udnNamespace).Get(udnName); err != nil {
+			return nil
+		}
+		applyUDN := udnapplyconfkv1.UserDefinedNetwork(udnName, udnNamespace).
+			WithStatus(udnapplyconfkv1.UserDefinedNetworkStatus().WithUsage(newNetworkUsageApplyConfiguration(usage)))
+		_, err = c.udnClient.K8sV1().UserDefinedNetworks(udnNamespace).ApplyStatus(context.Background(), applyUDN, opts)
+	}
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to update network %s usage: %w", networkName, err)
+	}
+	return nil
+}
+
+func newNetworkUsageApplyConfiguration(usage *userdefinednetworkv1.NetworkUsage) *udnapplyconfkv1.NetworkUsageApplyConfiguration {
+	familyUsageApply := func(familyUsages []userdefinednetworkv1.IPFamilyUsage) []*udnapplyconfkv1.IPFamilyUsageApplyConfiguration {
+		var applyConfs []*udnapplyconfkv1.IPFamilyUsageApplyConfiguration
+		for _, familyUsage := range familyUsages {
+			applyConfs = append(applyConfs, udnapplyconfkv1.IPFamilyUsage().
+				WithIPFamily(familyUsage.IPFamily).
+				WithAllocated(familyUsage.Allocated).
+				WithFree(familyUsage.Free))
+		}
+		return applyConfs
+	}
+	usageApply := udnapplyconfkv1.NetworkUsage().
+		WithHostSubnets(familyUsageApply(usage.HostSubnets)...).
+		WithIPs(familyUsageApply(usage.IPs)...)
+	if usage.PersistentIPClaims > 0 {
+		usageApply.WithPersistentIPClaims(usage.PersistentIPClaims)
+	}
+	return usageApply
+}
+
 func (c *Controller) udnNeedUpdate(_, _ *userdefinednetworkv1.UserDefinedNetwork) bool {
 	return true
 }
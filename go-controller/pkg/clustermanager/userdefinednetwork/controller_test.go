@@ -411,7 +411,7 @@ var _ = Describe("User Defined Network Controller", func() {
 				}
 				cudn := testClusterUDN("test", testNamespaces...)
 				cudn.Spec.Network = udnv1.NetworkSpec{Topology: udnv1.NetworkTopologyLayer2, Layer2: &udnv1.Layer2Config{
-					Subnets: udnv1.DualStackCIDRs{"10.10.10.0/24"},
+					Subnets: udnv1.SubnetCIDRs{"10.10.10.0/24"},
 				}}
 				objs = append(objs, cudn)
 
@@ -453,7 +453,7 @@ var _ = Describe("User Defined Network Controller", func() {
 				}
 				cudn := testClusterUDN("test", testNamespaces...)
 				cudn.Spec.Network = udnv1.NetworkSpec{Topology: udnv1.NetworkTopologyLayer2, Layer2: &udnv1.Layer2Config{
-					Subnets: udnv1.DualStackCIDRs{"10.10.10.0/24"},
+					Subnets: udnv1.SubnetCIDRs{"10.10.10.0/24"},
 				}}
 				cudn.Annotations = map[string]string{"foo": "bar"}
 
@@ -151,6 +151,7 @@ func renderCNINetworkConfig(networkName, nadName string, spec SpecGetter) (map[s
 		netConfSpec.Role = strings.ToLower(string(cfg.Role))
 		netConfSpec.MTU = int(cfg.MTU)
 		netConfSpec.Subnets = layer3SubnetsString(cfg.Subnets)
+		netConfSpec.ExcludeSubnets = cidrString(cfg.ExcludeSubnets)
 		netConfSpec.JoinSubnet = cidrString(renderJoinSubnets(cfg.Role, cfg.JoinSubnets))
 	case userdefinednetworkv1.NetworkTopologyLayer2:
 		cfg := spec.GetLayer2()
@@ -186,6 +187,12 @@ func renderCNINetworkConfig(networkName, nadName string, spec SpecGetter) (map[s
 		if cfg.VLAN != nil && cfg.VLAN.Access != nil {
 			netConfSpec.VLANID = int(cfg.VLAN.Access.ID)
 		}
+		if cfg.VLAN != nil && cfg.VLAN.Trunk != nil {
+			netConfSpec.AllowedVLANs = vlanIDRangesString(cfg.VLAN.Trunk.AllowedVLANs)
+			if cfg.VLAN.Trunk.NativeVLAN != nil {
+				netConfSpec.NativeVLAN = int(*cfg.VLAN.Trunk.NativeVLAN)
+			}
+		}
 	}
 	if netConfSpec.AllowPersistentIPs && !config.OVNKubernetesFeature.EnablePersistentIPs {
 		return nil, fmt.Errorf("allowPersistentIPs is set but persistentIPs is Disabled")
@@ -234,6 +241,12 @@ func renderCNINetworkConfig(networkName, nadName string, spec SpecGetter) (map[s
 	if netConfSpec.VLANID != 0 {
 		cniNetConf["vlanID"] = netConfSpec.VLANID
 	}
+	if netConfSpec.AllowedVLANs != "" {
+		cniNetConf["allowedVLANs"] = netConfSpec.AllowedVLANs
+	}
+	if netConfSpec.NativeVLAN != 0 {
+		cniNetConf["nativeVLAN"] = netConfSpec.NativeVLAN
+	}
 	if util.IsPreconfiguredUDNAddressesEnabled() {
 		if len(netConfSpec.ReservedSubnets) > 0 {
 			cniNetConf["reservedSubnets"] = netConfSpec.ReservedSubnets
@@ -302,7 +315,7 @@ func layer3SubnetsString(subnets []userdefinednetworkv1.Layer3Subnet) string {
 }
 
 type cidr interface {
-	userdefinednetworkv1.DualStackCIDRs | []userdefinednetworkv1.CIDR
+	userdefinednetworkv1.DualStackCIDRs | userdefinednetworkv1.SubnetCIDRs | []userdefinednetworkv1.CIDR
 }
 
 func cidrString[T cidr](subnets T) string {
@@ -321,6 +334,14 @@ func ipString(ips userdefinednetworkv1.DualStackIPs) string {
 	return strings.Join(ipStrings, ",")
 }
 
+func vlanIDRangesString(ranges []userdefinednetworkv1.VLANIDRange) string {
+	var rangeStrings []string
+	for _, r := range ranges {
+		rangeStrings = append(rangeStrings, string(r))
+	}
+	return strings.Join(rangeStrings, ",")
+}
+
 func GetSpec(obj client.Object) SpecGetter {
 	switch o := obj.(type) {
 	case *userdefinednetworkv1.UserDefinedNetwork:
@@ -32,7 +32,7 @@ var _ = Describe("NetAttachDefTemplate", func() {
 			&udnv1.UserDefinedNetworkSpec{
 				Topology: udnv1.NetworkTopologyLayer2,
 				Layer2: &udnv1.Layer2Config{
-					Subnets: udnv1.DualStackCIDRs{"abc"},
+					Subnets: udnv1.SubnetCIDRs{"abc"},
 				},
 			},
 			config.NewCIDRNotProperlyFormattedError("abc").Error(),
@@ -95,7 +95,7 @@ var _ = Describe("NetAttachDefTemplate", func() {
 				Topology: udnv1.NetworkTopologyLayer2,
 				Layer2: &udnv1.Layer2Config{
 					Role:        udnv1.NetworkRolePrimary,
-					Subnets:     udnv1.DualStackCIDRs{"10.10.0.0/24"},
+					Subnets:     udnv1.SubnetCIDRs{"10.10.0.0/24"},
 					JoinSubnets: udnv1.DualStackCIDRs{"abc"},
 				},
 			},
@@ -106,7 +106,7 @@ var _ = Describe("NetAttachDefTemplate", func() {
 				Topology: udnv1.NetworkTopologyLayer2,
 				Layer2: &udnv1.Layer2Config{
 					Role:        udnv1.NetworkRolePrimary,
-					Subnets:     udnv1.DualStackCIDRs{"10.10.0.0/24"},
+					Subnets:     udnv1.SubnetCIDRs{"10.10.0.0/24"},
 					JoinSubnets: udnv1.DualStackCIDRs{"fd50::0/125", "!"},
 				},
 			},
@@ -117,7 +117,7 @@ var _ = Describe("NetAttachDefTemplate", func() {
 				Topology: udnv1.NetworkTopologyLayer2,
 				Layer2: &udnv1.Layer2Config{
 					Role:        udnv1.NetworkRolePrimary,
-					Subnets:     udnv1.DualStackCIDRs{"10.10.0.0/24"},
+					Subnets:     udnv1.SubnetCIDRs{"10.10.0.0/24"},
 					JoinSubnets: udnv1.DualStackCIDRs{"10.10.0.0/24", "!"},
 				},
 			},
@@ -130,7 +130,7 @@ var _ = Describe("NetAttachDefTemplate", func() {
 				Topology: udnv1.NetworkTopologyLayer2,
 				Layer2: &udnv1.Layer2Config{
 					Role:        udnv1.NetworkRolePrimary,
-					Subnets:     udnv1.DualStackCIDRs{"10.10.0.0/24"},
+					Subnets:     udnv1.SubnetCIDRs{"10.10.0.0/24"},
 					JoinSubnets: udnv1.DualStackCIDRs{"100.64.10.0/24"},
 				},
 			},
@@ -143,7 +143,7 @@ var _ = Describe("NetAttachDefTemplate", func() {
 				Topology: udnv1.NetworkTopologyLayer2,
 				Layer2: &udnv1.Layer2Config{
 					Role:        udnv1.NetworkRolePrimary,
-					Subnets:     udnv1.DualStackCIDRs{"10.10.0.0/24"},
+					Subnets:     udnv1.SubnetCIDRs{"10.10.0.0/24"},
 					JoinSubnets: udnv1.DualStackCIDRs{"fd98::4/127"},
 				},
 			},
@@ -156,7 +156,7 @@ var _ = Describe("NetAttachDefTemplate", func() {
 				Topology: udnv1.NetworkTopologyLayer2,
 				Layer2: &udnv1.Layer2Config{
 					Role:        udnv1.NetworkRolePrimary,
-					Subnets:     udnv1.DualStackCIDRs{"10.10.0.0/24"},
+					Subnets:     udnv1.SubnetCIDRs{"10.10.0.0/24"},
 					JoinSubnets: udnv1.DualStackCIDRs{"100.64.10.0/24", "fd98::4/127"},
 				},
 			},
@@ -186,7 +186,7 @@ var _ = Describe("NetAttachDefTemplate", func() {
 				Topology: udnv1.NetworkTopologyLayer2,
 				Layer2: &udnv1.Layer2Config{
 					Role:    udnv1.NetworkRoleSecondary,
-					Subnets: udnv1.DualStackCIDRs{"192.168.100.0/16"},
+					Subnets: udnv1.SubnetCIDRs{"192.168.100.0/16"},
 					IPAM: &udnv1.IPAMConfig{
 						Lifecycle: udnv1.IPAMLifecyclePersistent,
 						Mode:      udnv1.IPAMDisabled,
@@ -200,7 +200,7 @@ var _ = Describe("NetAttachDefTemplate", func() {
 				Topology: udnv1.NetworkTopologyLayer2,
 				Layer2: &udnv1.Layer2Config{
 					Role:    udnv1.NetworkRoleSecondary,
-					Subnets: udnv1.DualStackCIDRs{},
+					Subnets: udnv1.SubnetCIDRs{},
 					IPAM: &udnv1.IPAMConfig{
 						Mode: udnv1.IPAMEnabled,
 					},
@@ -213,7 +213,7 @@ var _ = Describe("NetAttachDefTemplate", func() {
 				Topology: udnv1.NetworkTopologyLayer2,
 				Layer2: &udnv1.Layer2Config{
 					Role:    udnv1.NetworkRoleSecondary,
-					Subnets: udnv1.DualStackCIDRs{"192.168.100.0/16"},
+					Subnets: udnv1.SubnetCIDRs{"192.168.100.0/16"},
 					IPAM: &udnv1.IPAMConfig{
 						Mode: udnv1.IPAMDisabled,
 					},
@@ -271,6 +271,26 @@ var _ = Describe("NetAttachDefTemplate", func() {
 			}}},
 			config.NewExcludedSubnetNotContainedError("2001:aaa::/127").Error(),
 		),
+		Entry("UDN, layer3: excludeSubnets spanning multiple host subnets",
+			&udnv1.UserDefinedNetwork{Spec: udnv1.UserDefinedNetworkSpec{
+				Topology: udnv1.NetworkTopologyLayer3,
+				Layer3: &udnv1.Layer3Config{Role: udnv1.NetworkRoleSecondary,
+					Subnets:        []udnv1.Layer3Subnet{{CIDR: "192.168.0.0/16", HostSubnet: 24}},
+					ExcludeSubnets: []udnv1.CIDR{"192.168.2.0/23"},
+				},
+			}},
+			config.NewExcludedSubnetSpansHostSubnetsError("192.168.2.0/23", 24).Error(),
+		),
+		Entry("UDN, layer3: excludeSubnets containing the node gateway IP",
+			&udnv1.UserDefinedNetwork{Spec: udnv1.UserDefinedNetworkSpec{
+				Topology: udnv1.NetworkTopologyLayer3,
+				Layer3: &udnv1.Layer3Config{Role: udnv1.NetworkRoleSecondary,
+					Subnets:        []udnv1.Layer3Subnet{{CIDR: "192.168.0.0/16", HostSubnet: 24}},
+					ExcludeSubnets: []udnv1.CIDR{"192.168.2.0/30"},
+				},
+			}},
+			config.NewExcludedSubnetContainsNodeIPError("192.168.2.0/30", "192.168.2.1").Error(),
+		),
 	)
 
 	It("should return no error given no UDN", func() {
@@ -353,12 +373,38 @@ var _ = Describe("NetAttachDefTemplate", func() {
 				"mtu": 1500
 			}`,
 		),
+		Entry("primary network, layer3, with excludeSubnets",
+			udnv1.UserDefinedNetworkSpec{
+				Topology: udnv1.NetworkTopologyLayer3,
+				Layer3: &udnv1.Layer3Config{
+					Role: udnv1.NetworkRolePrimary,
+					Subnets: []udnv1.Layer3Subnet{
+						{CIDR: "192.168.100.0/16", HostSubnet: 24},
+						{CIDR: "2001:dbb::/60"},
+					},
+					ExcludeSubnets: []udnv1.CIDR{"192.168.100.200/30", "2001:dbb:0:0:1::/80"},
+					MTU:            1500,
+				},
+			},
+			`{
+				"cniVersion": "1.0.0",
+				"type": "ovn-k8s-cni-overlay",
+				"name": "mynamespace_test-net",
+				"netAttachDefName": "mynamespace/test-net",
+				"role": "primary",
+				"topology": "layer3",
+				"joinSubnet": "100.65.0.0/16,fd99::/64",
+				"subnets": "192.168.100.0/16/24,2001:dbb::/60",
+				"excludeSubnets": "192.168.100.200/30,2001:dbb:0:0:1::/80",
+				"mtu": 1500
+			}`,
+		),
 		Entry("primary network, layer2",
 			udnv1.UserDefinedNetworkSpec{
 				Topology: udnv1.NetworkTopologyLayer2,
 				Layer2: &udnv1.Layer2Config{
 					Role:    udnv1.NetworkRolePrimary,
-					Subnets: udnv1.DualStackCIDRs{"192.168.100.0/24", "2001:dbb::/64"},
+					Subnets: udnv1.SubnetCIDRs{"192.168.100.0/24", "2001:dbb::/64"},
 					MTU:     1500,
 					IPAM: &udnv1.IPAMConfig{
 						Lifecycle: udnv1.IPAMLifecyclePersistent,
@@ -383,7 +429,7 @@ var _ = Describe("NetAttachDefTemplate", func() {
 				Topology: udnv1.NetworkTopologyLayer2,
 				Layer2: &udnv1.Layer2Config{
 					Role:        udnv1.NetworkRolePrimary,
-					Subnets:     udnv1.DualStackCIDRs{"192.168.100.0/24", "2001:dbb::/64"},
+					Subnets:     udnv1.SubnetCIDRs{"192.168.100.0/24", "2001:dbb::/64"},
 					JoinSubnets: udnv1.DualStackCIDRs{"100.62.0.0/24", "fd92::/64"},
 					MTU:         1500,
 					IPAM: &udnv1.IPAMConfig{
@@ -409,7 +455,7 @@ var _ = Describe("NetAttachDefTemplate", func() {
 				Topology: udnv1.NetworkTopologyLayer2,
 				Layer2: &udnv1.Layer2Config{
 					Role:    udnv1.NetworkRoleSecondary,
-					Subnets: udnv1.DualStackCIDRs{"192.168.100.0/24", "2001:dbb::/64"},
+					Subnets: udnv1.SubnetCIDRs{"192.168.100.0/24", "2001:dbb::/64"},
 					MTU:     1500,
 					IPAM: &udnv1.IPAMConfig{
 						Lifecycle: udnv1.IPAMLifecyclePersistent,
@@ -494,7 +540,7 @@ var _ = Describe("NetAttachDefTemplate", func() {
 				Topology: udnv1.NetworkTopologyLayer2,
 				Layer2: &udnv1.Layer2Config{
 					Role:    udnv1.NetworkRolePrimary,
-					Subnets: udnv1.DualStackCIDRs{"192.168.100.0/24", "2001:dbb::/64"},
+					Subnets: udnv1.SubnetCIDRs{"192.168.100.0/24", "2001:dbb::/64"},
 					MTU:     1500,
 					IPAM: &udnv1.IPAMConfig{
 						Lifecycle: udnv1.IPAMLifecyclePersistent,
@@ -519,7 +565,7 @@ var _ = Describe("NetAttachDefTemplate", func() {
 				Topology: udnv1.NetworkTopologyLayer2,
 				Layer2: &udnv1.Layer2Config{
 					Role:        udnv1.NetworkRolePrimary,
-					Subnets:     udnv1.DualStackCIDRs{"192.168.100.0/24", "2001:dbb::/64"},
+					Subnets:     udnv1.SubnetCIDRs{"192.168.100.0/24", "2001:dbb::/64"},
 					JoinSubnets: udnv1.DualStackCIDRs{"100.62.0.0/24", "fd92::/64"},
 					MTU:         1500,
 					IPAM: &udnv1.IPAMConfig{
@@ -545,7 +591,7 @@ var _ = Describe("NetAttachDefTemplate", func() {
 				Topology: udnv1.NetworkTopologyLayer2,
 				Layer2: &udnv1.Layer2Config{
 					Role:    udnv1.NetworkRoleSecondary,
-					Subnets: udnv1.DualStackCIDRs{"192.168.100.0/24", "2001:dbb::/64"},
+					Subnets: udnv1.SubnetCIDRs{"192.168.100.0/24", "2001:dbb::/64"},
 					MTU:     1500,
 					IPAM: &udnv1.IPAMConfig{
 						Lifecycle: udnv1.IPAMLifecyclePersistent,
@@ -594,6 +640,35 @@ var _ = Describe("NetAttachDefTemplate", func() {
 			  "allowPersistentIPs": true
 			}`,
 		),
+		Entry("secondary network, localnet, VLAN trunk",
+			udnv1.NetworkSpec{
+				Topology: udnv1.NetworkTopologyLocalnet,
+				Localnet: &udnv1.LocalnetConfig{
+					Role:                udnv1.NetworkRoleSecondary,
+					PhysicalNetworkName: "mylocalnet1",
+					MTU:                 1600,
+					VLAN: &udnv1.VLANConfig{Mode: udnv1.VLANModeTrunk, Trunk: &udnv1.TrunkVLANConfig{
+						AllowedVLANs: []udnv1.VLANIDRange{"10", "20-30"},
+						NativeVLAN:   ptr.To[int32](5),
+					}},
+					IPAM: &udnv1.IPAMConfig{
+						Mode: udnv1.IPAMDisabled,
+					},
+				},
+			},
+			`{
+			  "cniVersion": "1.0.0",
+			  "type": "ovn-k8s-cni-overlay",
+			  "name": "cluster_udn_test-net",
+			  "netAttachDefName": "mynamespace/test-net",
+			  "role": "secondary",
+			  "topology": "localnet",
+		      "physicalNetworkName": "mylocalnet1",
+			  "mtu": 1600,
+			  "allowedVLANs": "10,20-30",
+			  "nativeVLAN": 5
+			}`,
+		),
 		Entry("secondary network, localnet, when MTU is unset it should set default MTU",
 			udnv1.NetworkSpec{
 				Topology: udnv1.NetworkTopologyLocalnet,
@@ -61,6 +61,15 @@ type NetConf struct {
 	DefaultGatewayIPs string `json:"defaultGatewayIPs,omitempty"`
 	// VLANID, valid in localnet topology network only
 	VLANID int `json:"vlanID,omitempty"`
+	// comma-separated list of VLAN IDs, or ranges of VLAN IDs, the tagged
+	// traffic of the pods is allowed on, eg. "10,20-30". When set, the network
+	// is in trunk mode. Mutually exclusive with VLANID.
+	// valid in localnet topology network only
+	AllowedVLANs string `json:"allowedVLANs,omitempty"`
+	// NativeVLAN is the VLAN ID the untagged traffic of the pods is sent and
+	// received on when the network is in trunk mode.
+	// valid in localnet topology network only
+	NativeVLAN int `json:"nativeVLAN,omitempty"`
 	// AllowPersistentIPs is valid on both localnet / layer topologies.
 	// It allows for having IP allocations that outlive the pod for which
 	// they are originally created - e.g. a KubeVirt VM's migration, or
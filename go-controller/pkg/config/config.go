@@ -134,6 +134,9 @@ var (
 	// OVNKubernetesFeatureConfig holds OVN-Kubernetes feature enhancement config file parameters and command-line overrides
 	OVNKubernetesFeature = OVNKubernetesFeatureConfig{
 		EgressIPReachabiltyTotalTimeout: 1,
+		EgressIPReachabilityMode:        EgressIPReachabilityModeGRPC,
+		EgressIPBFDInterval:             100,
+		EgressIPBFDMultiplier:           3,
 		AdvertisedUDNIsolationMode:      AdvertisedUDNIsolationModeStrict,
 	}
 
@@ -422,6 +425,12 @@ type OVNKubernetesFeatureConfig struct {
 	EnableEgressQoS                 bool `gcfg:"enable-egress-qos"`
 	EnableEgressService             bool `gcfg:"enable-egress-service"`
 	EgressIPNodeHealthCheckPort     int  `gcfg:"egressip-node-healthcheck-port"`
+	// EgressIP node reachability mode, either "grpc" or "bfd"
+	EgressIPReachabilityMode string `gcfg:"egressip-reachability-mode"`
+	// EgressIP BFD session transmit and receive interval in milliseconds
+	EgressIPBFDInterval int `gcfg:"egressip-bfd-interval"`
+	// EgressIP BFD session detection multiplier
+	EgressIPBFDMultiplier           int  `gcfg:"egressip-bfd-multiplier"`
 	EnableMultiNetwork              bool `gcfg:"enable-multi-network"`
 	EnableNetworkSegmentation       bool `gcfg:"enable-network-segmentation"`
 	EnablePreconfiguredUDNAddresses bool `gcfg:"enable-preconfigured-udn-addresses"`
@@ -432,6 +441,7 @@ type OVNKubernetesFeatureConfig struct {
 	EnableMultiExternalGateway      bool `gcfg:"enable-multi-external-gateway"`
 	EnablePersistentIPs             bool `gcfg:"enable-persistent-ips"`
 	EnableDNSNameResolver           bool `gcfg:"enable-dns-name-resolver"`
+	EnableDNSSnooping               bool `gcfg:"enable-dns-snooping"`
 	EnableServiceTemplateSupport    bool `gcfg:"enable-svc-template-support"`
 	EnableObservability             bool `gcfg:"enable-observability"`
 	EnableNetworkQoS                bool `gcfg:"enable-network-qos"`
@@ -452,6 +462,15 @@ const (
 	GatewayModeLocal GatewayMode = "local"
 )
 
+const (
+	// EgressIPReachabilityModeGRPC checks the EgressIP node reachability with the gRPC health check, or by
+	// dialing the node when egressip-node-healthcheck-port is not set.
+	EgressIPReachabilityModeGRPC = "grpc"
+	// EgressIPReachabilityModeBFD checks the EgressIP node reachability with BFD sessions between the egress
+	// nodes, falling back to EgressIPReachabilityModeGRPC when the BFD sessions don't tell.
+	EgressIPReachabilityModeBFD = "bfd"
+)
+
 const (
 	// AdvertisedUDNIsolationModeStrict pod isolation across advertised UDN networks is enabled.
 	AdvertisedUDNIsolationModeStrict = "strict"
@@ -1084,6 +1103,25 @@ var OVNK8sFeatureFlags = []cli.Flag{
 		Usage:       "Configure EgressIP node reachability using gRPC on this TCP port.",
 		Destination: &cliConfig.OVNKubernetesFeature.EgressIPNodeHealthCheckPort,
 	},
+	&cli.StringFlag{
+		Name: "egressip-reachability-mode",
+		Usage: "Configure how EgressIP node reachability is checked. Valid values are 'grpc' or 'bfd'. " +
+			"'bfd' uses BFD sessions between the egress nodes and falls back to 'grpc'.",
+		Destination: &cliConfig.OVNKubernetesFeature.EgressIPReachabilityMode,
+		Value:       OVNKubernetesFeature.EgressIPReachabilityMode,
+	},
+	&cli.IntFlag{
+		Name:        "egressip-bfd-interval",
+		Usage:       "EgressIP BFD session transmit and receive interval in milliseconds (default: 100)",
+		Destination: &cliConfig.OVNKubernetesFeature.EgressIPBFDInterval,
+		Value:       OVNKubernetesFeature.EgressIPBFDInterval,
+	},
+	&cli.IntFlag{
+		Name:        "egressip-bfd-multiplier",
+		Usage:       "EgressIP BFD session detection multiplier (default: 3)",
+		Destination: &cliConfig.OVNKubernetesFeature.EgressIPBFDMultiplier,
+		Value:       OVNKubernetesFeature.EgressIPBFDMultiplier,
+	},
 	&cli.BoolFlag{
 		Name:        "enable-multi-network",
 		Usage:       "Configure to use multiple NetworkAttachmentDefinition CRD feature with ovn-kubernetes.",
@@ -1156,6 +1194,14 @@ var OVNK8sFeatureFlags = []cli.Flag{
 		Destination: &cliConfig.OVNKubernetesFeature.EnableDNSNameResolver,
 		Value:       OVNKubernetesFeature.EnableDNSNameResolver,
 	},
+	&cli.BoolFlag{
+		Name: "enable-dns-snooping",
+		Usage: "Configure to resolve EgressFirewall DNS names by snooping the DNS responses received by local pods. " +
+			"Requires observability and interconnect with single node zones, can't be used together with the DNSNameResolver CRD feature. " +
+			"The DNS names of the user defined networks are still polled.",
+		Destination: &cliConfig.OVNKubernetesFeature.EnableDNSSnooping,
+		Value:       OVNKubernetesFeature.EnableDNSSnooping,
+	},
 	&cli.BoolFlag{
 		Name:        "enable-svc-template-support",
 		Usage:       "Configure to use svc-template with ovn-kubernetes.",
@@ -2036,6 +2082,23 @@ func buildOVNKubernetesFeatureConfig(cli, file *config) error {
 		return fmt.Errorf("invalid advertised-udn-isolation-mode %q: expect one of %s or %s",
 			OVNKubernetesFeature.AdvertisedUDNIsolationMode, AdvertisedUDNIsolationModeStrict, AdvertisedUDNIsolationModeLoose)
 	}
+	if OVNKubernetesFeature.EgressIPReachabilityMode != EgressIPReachabilityModeGRPC && OVNKubernetesFeature.EgressIPReachabilityMode != EgressIPReachabilityModeBFD {
+		return fmt.Errorf("invalid egressip-reachability-mode %q: expect one of %s or %s",
+			OVNKubernetesFeature.EgressIPReachabilityMode, EgressIPReachabilityModeGRPC, EgressIPReachabilityModeBFD)
+	}
+	if OVNKubernetesFeature.EgressIPReachabilityMode == EgressIPReachabilityModeBFD &&
+		(OVNKubernetesFeature.EgressIPBFDInterval <= 0 || OVNKubernetesFeature.EgressIPBFDMultiplier <= 0) {
+		return fmt.Errorf("egressip-bfd-interval and egressip-bfd-multiplier must be positive, got %d and %d",
+			OVNKubernetesFeature.EgressIPBFDInterval, OVNKubernetesFeature.EgressIPBFDMultiplier)
+	}
+	if OVNKubernetesFeature.EnableDNSSnooping {
+		if OVNKubernetesFeature.EnableDNSNameResolver {
+			return fmt.Errorf("enable-dns-snooping can't be used together with enable-dns-name-resolver")
+		}
+		if !OVNKubernetesFeature.EnableObservability || !OVNKubernetesFeature.EnableInterconnect {
+			return fmt.Errorf("enable-dns-snooping requires enable-observability and enable-interconnect")
+		}
+	}
 	return nil
 }
 
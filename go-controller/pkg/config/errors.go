@@ -11,6 +11,8 @@ const (
 	ErrInvalidIPv4HostSubnet            ValidationErrorType = "InvalidIPv4HostSubnet"
 	ErrSubnetOverlap                    ValidationErrorType = "SubnetOverlap"
 	ErrExcludedSubnetNotContained       ValidationErrorType = "ExcludedSubnetNotContained"
+	ErrExcludedSubnetSpansHostSubnets   ValidationErrorType = "ExcludedSubnetSpansHostSubnets"
+	ErrExcludedSubnetContainsNodeIP     ValidationErrorType = "ExcludedSubnetContainsNodeIP"
 	ErrReservedSubnetNotContained       ValidationErrorType = "ReservedSubnetNotContained"
 	ErrInfrastructureSubnetNotContained ValidationErrorType = "InfrastructureSubnetNotContained"
 	ErrTopologyConfigMismatch           ValidationErrorType = "TopologyConfigMismatch"
@@ -75,6 +77,20 @@ func NewExcludedSubnetNotContainedError(excludeSubnet interface{}) *ValidationEr
 	}
 }
 
+func NewExcludedSubnetSpansHostSubnetsError(excludeSubnet interface{}, hostSubnetLength int) *ValidationError {
+	return &ValidationError{
+		Type:    ErrExcludedSubnetSpansHostSubnets,
+		Message: fmt.Sprintf("excluded subnet %v spans multiple /%d host subnets", excludeSubnet, hostSubnetLength),
+	}
+}
+
+func NewExcludedSubnetContainsNodeIPError(excludeSubnet, ip interface{}) *ValidationError {
+	return &ValidationError{
+		Type:    ErrExcludedSubnetContainsNodeIP,
+		Message: fmt.Sprintf("excluded subnet %v contains node gateway or management port IP %v", excludeSubnet, ip),
+	}
+}
+
 func NewReservedSubnetNotContainedError(reservedSubnet interface{}) *ValidationError {
 	return &ValidationError{
 		Type:    ErrReservedSubnetNotContained,
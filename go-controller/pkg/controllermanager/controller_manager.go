@@ -21,6 +21,7 @@ import (
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/factory"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/kube"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb"
 	libovsdbops "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
 	libovsdbutil "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/util"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/metrics"
@@ -266,7 +267,7 @@ func NewControllerManager(ovnClient *util.OVNClientset, wf *factory.WatchFactory
 		if !config.OVNKubernetesFeature.EnableInterconnect {
 			return nil, fmt.Errorf("RouteAdvertisements can only be used if Interconnect is enabled")
 		}
-		cm.routeImportManager = routeimport.New(config.Default.Zone, cm.nbClient)
+		cm.routeImportManager = routeimport.New(config.Default.Zone, cm.nbClient, wf, ovnClient.RouteAdvertisementsClient)
 	}
 
 	return cm, nil
@@ -342,6 +343,42 @@ func (cm *ControllerManager) newCommonNetworkControllerInfo(wf *factory.WatchFac
 		cm.sbClient, cm.podRecorder, cm.SCTPSupport, cm.multicastSupport, cm.svcTemplateSupport)
 }
 
+// startLocalSampling configures the local OVS to send the observability samples to psample and starts
+// counting the received samples.
+func (cm *ControllerManager) startLocalSampling(observabilityManager *observability.Manager) error {
+	ovsClient, err := libovsdb.NewObservOVSClient(cm.stopChan)
+	if err != nil {
+		return fmt.Errorf("failed to initialize libovsdb vswitchd client: %w", err)
+	}
+	if err = observability.EnsureOVSCollector(ovsClient); err != nil {
+		return err
+	}
+	return observabilityManager.StartSampleCounter(cm.stopChan)
+}
+
+// validateDNSSnoopingZone returns an error if the zone is not a single node zone. DNS snooping relies on the
+// observability samples received on the local node, so the DNS traffic of the pods of the other nodes of the
+// zone would never be snooped.
+func (cm *ControllerManager) validateDNSSnoopingZone(zone string) error {
+	if !config.OVNKubernetesFeature.EnableInterconnect || zone == ovntypes.OvnDefaultZone {
+		return fmt.Errorf("DNS snooping requires interconnect with single node zones, zone is %q", zone)
+	}
+	nodes, err := cm.watchFactory.GetNodes()
+	if err != nil {
+		return fmt.Errorf("failed to get nodes: %w", err)
+	}
+	var zoneNodes []string
+	for _, node := range nodes {
+		if util.GetNodeZone(node) == zone {
+			zoneNodes = append(zoneNodes, node.Name)
+		}
+	}
+	if len(zoneNodes) > 1 {
+		return fmt.Errorf("DNS snooping requires interconnect with single node zones, zone %q has nodes %v", zone, zoneNodes)
+	}
+	return nil
+}
+
 // initDefaultNetworkController creates the controller for default network
 func (cm *ControllerManager) initDefaultNetworkController(observManager *observability.Manager) error {
 	cnci, err := cm.newCommonNetworkControllerInfo(cm.watchFactory)
@@ -453,12 +490,28 @@ func (cm *ControllerManager) Start(ctx context.Context) error {
 		}
 	}
 
+	if config.OVNKubernetesFeature.EnableDNSSnooping {
+		if err = cm.validateDNSSnoopingZone(zone); err != nil {
+			return err
+		}
+	}
+
 	var observabilityManager *observability.Manager
 	if config.OVNKubernetesFeature.EnableObservability {
 		observabilityManager = observability.NewManager(cm.nbClient)
 		if err = observabilityManager.Init(); err != nil {
 			return fmt.Errorf("failed to init observability manager: %w", err)
 		}
+		// samples are only received on the node that generated them, so they can only be used
+		// per zone when ovnkube-controller runs on the node of its zone.
+		if config.OVNKubernetesFeature.EnableInterconnect && zone != ovntypes.OvnDefaultZone {
+			if err = cm.startLocalSampling(observabilityManager); err != nil {
+				if config.OVNKubernetesFeature.EnableDNSSnooping {
+					return fmt.Errorf("failed to receive the observability samples required by DNS snooping: %w", err)
+				}
+				klog.Warningf("Failed to receive observability samples, egress firewall rule hits won't be reported: %v", err)
+			}
+		}
 	} else {
 		err = observability.Cleanup(cm.nbClient)
 		if err != nil {
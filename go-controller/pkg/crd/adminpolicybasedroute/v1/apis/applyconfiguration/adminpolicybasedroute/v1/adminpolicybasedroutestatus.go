@@ -19,15 +19,17 @@ package v1
 
 import (
 	adminpolicybasedroutev1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/adminpolicybasedroute/v1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apismetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1 "k8s.io/client-go/applyconfigurations/meta/v1"
 )
 
 // AdminPolicyBasedRouteStatusApplyConfiguration represents a declarative configuration of the AdminPolicyBasedRouteStatus type for use
 // with apply.
 type AdminPolicyBasedRouteStatusApplyConfiguration struct {
-	LastTransitionTime *metav1.Time                        `json:"lastTransitionTime,omitempty"`
-	Messages           []string                            `json:"messages,omitempty"`
-	Status             *adminpolicybasedroutev1.StatusType `json:"status,omitempty"`
+	LastTransitionTime *apismetav1.Time                     `json:"lastTransitionTime,omitempty"`
+	Messages           []string                             `json:"messages,omitempty"`
+	Status             *adminpolicybasedroutev1.StatusType  `json:"status,omitempty"`
+	Conditions         []metav1.ConditionApplyConfiguration `json:"conditions,omitempty"`
 }
 
 // AdminPolicyBasedRouteStatusApplyConfiguration constructs a declarative configuration of the AdminPolicyBasedRouteStatus type for use with
@@ -39,7 +41,7 @@ func AdminPolicyBasedRouteStatus() *AdminPolicyBasedRouteStatusApplyConfiguratio
 // WithLastTransitionTime sets the LastTransitionTime field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the LastTransitionTime field is set to the value of the last call.
-func (b *AdminPolicyBasedRouteStatusApplyConfiguration) WithLastTransitionTime(value metav1.Time) *AdminPolicyBasedRouteStatusApplyConfiguration {
+func (b *AdminPolicyBasedRouteStatusApplyConfiguration) WithLastTransitionTime(value apismetav1.Time) *AdminPolicyBasedRouteStatusApplyConfiguration {
 	b.LastTransitionTime = &value
 	return b
 }
@@ -61,3 +63,16 @@ func (b *AdminPolicyBasedRouteStatusApplyConfiguration) WithStatus(value adminpo
 	b.Status = &value
 	return b
 }
+
+// WithConditions adds the given value to the Conditions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Conditions field.
+func (b *AdminPolicyBasedRouteStatusApplyConfiguration) WithConditions(values ...*metav1.ConditionApplyConfiguration) *AdminPolicyBasedRouteStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithConditions")
+		}
+		b.Conditions = append(b.Conditions, *values[i])
+	}
+	return b
+}
@@ -28,6 +28,8 @@ type DynamicHopApplyConfiguration struct {
 	NamespaceSelector     *metav1.LabelSelectorApplyConfiguration `json:"namespaceSelector,omitempty"`
 	NetworkAttachmentName *string                                 `json:"networkAttachmentName,omitempty"`
 	BFDEnabled            *bool                                   `json:"bfdEnabled,omitempty"`
+	BFDConfig             *BFDConfigApplyConfiguration            `json:"bfdConfig,omitempty"`
+	Priority              *int32                                  `json:"priority,omitempty"`
 }
 
 // DynamicHopApplyConfiguration constructs a declarative configuration of the DynamicHop type for use with
@@ -67,3 +69,19 @@ func (b *DynamicHopApplyConfiguration) WithBFDEnabled(value bool) *DynamicHopApp
 	b.BFDEnabled = &value
 	return b
 }
+
+// WithBFDConfig sets the BFDConfig field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the BFDConfig field is set to the value of the last call.
+func (b *DynamicHopApplyConfiguration) WithBFDConfig(value *BFDConfigApplyConfiguration) *DynamicHopApplyConfiguration {
+	b.BFDConfig = value
+	return b
+}
+
+// WithPriority sets the Priority field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Priority field is set to the value of the last call.
+func (b *DynamicHopApplyConfiguration) WithPriority(value int32) *DynamicHopApplyConfiguration {
+	b.Priority = &value
+	return b
+}
@@ -18,6 +18,7 @@ limitations under the License.
 package v1
 
 import (
+	types "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/types"
 	metav1 "k8s.io/client-go/applyconfigurations/meta/v1"
 )
 
@@ -25,6 +26,8 @@ import (
 // with apply.
 type ExternalNetworkSourceApplyConfiguration struct {
 	NamespaceSelector *metav1.LabelSelectorApplyConfiguration `json:"namespaceSelector,omitempty"`
+	PodSelector       *metav1.LabelSelectorApplyConfiguration `json:"podSelector,omitempty"`
+	NetworkSelectors  *types.NetworkSelectors                 `json:"networkSelectors,omitempty"`
 }
 
 // ExternalNetworkSourceApplyConfiguration constructs a declarative configuration of the ExternalNetworkSource type for use with
@@ -40,3 +43,19 @@ func (b *ExternalNetworkSourceApplyConfiguration) WithNamespaceSelector(value *m
 	b.NamespaceSelector = value
 	return b
 }
+
+// WithPodSelector sets the PodSelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PodSelector field is set to the value of the last call.
+func (b *ExternalNetworkSourceApplyConfiguration) WithPodSelector(value *metav1.LabelSelectorApplyConfiguration) *ExternalNetworkSourceApplyConfiguration {
+	b.PodSelector = value
+	return b
+}
+
+// WithNetworkSelectors sets the NetworkSelectors field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NetworkSelectors field is set to the value of the last call.
+func (b *ExternalNetworkSourceApplyConfiguration) WithNetworkSelectors(value types.NetworkSelectors) *ExternalNetworkSourceApplyConfiguration {
+	b.NetworkSelectors = &value
+	return b
+}
@@ -20,8 +20,10 @@ package v1
 // StaticHopApplyConfiguration represents a declarative configuration of the StaticHop type for use
 // with apply.
 type StaticHopApplyConfiguration struct {
-	IP         *string `json:"ip,omitempty"`
-	BFDEnabled *bool   `json:"bfdEnabled,omitempty"`
+	IP         *string                      `json:"ip,omitempty"`
+	BFDEnabled *bool                        `json:"bfdEnabled,omitempty"`
+	BFDConfig  *BFDConfigApplyConfiguration `json:"bfdConfig,omitempty"`
+	Priority   *int32                       `json:"priority,omitempty"`
 }
 
 // StaticHopApplyConfiguration constructs a declarative configuration of the StaticHop type for use with
@@ -45,3 +47,19 @@ func (b *StaticHopApplyConfiguration) WithBFDEnabled(value bool) *StaticHopApply
 	b.BFDEnabled = &value
 	return b
 }
+
+// WithBFDConfig sets the BFDConfig field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the BFDConfig field is set to the value of the last call.
+func (b *StaticHopApplyConfiguration) WithBFDConfig(value *BFDConfigApplyConfiguration) *StaticHopApplyConfiguration {
+	b.BFDConfig = value
+	return b
+}
+
+// WithPriority sets the Priority field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Priority field is set to the value of the last call.
+func (b *StaticHopApplyConfiguration) WithPriority(value int32) *StaticHopApplyConfiguration {
+	b.Priority = &value
+	return b
+}
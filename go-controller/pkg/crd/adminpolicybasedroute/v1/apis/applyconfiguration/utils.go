@@ -37,6 +37,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &adminpolicybasedroutev1.AdminPolicyBasedExternalRouteSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("AdminPolicyBasedRouteStatus"):
 		return &adminpolicybasedroutev1.AdminPolicyBasedRouteStatusApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("BFDConfig"):
+		return &adminpolicybasedroutev1.BFDConfigApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("DynamicHop"):
 		return &adminpolicybasedroutev1.DynamicHopApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ExternalNetworkSource"):
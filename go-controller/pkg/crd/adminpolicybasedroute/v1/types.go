@@ -18,6 +18,8 @@ package v1
 
 import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	crdtypes "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/types"
 )
 
 // AdminPolicyBasedExternalRoute is a CRD allowing the cluster administrators to configure policies for external gateway IPs to be applied to all the pods contained in selected namespaces.
@@ -42,16 +44,27 @@ type AdminPolicyBasedExternalRoute struct {
 
 // AdminPolicyBasedExternalRouteSpec defines the desired state of AdminPolicyBasedExternalRoute
 type AdminPolicyBasedExternalRouteSpec struct {
-	// From defines the selectors that will determine the target namespaces to this CR.
+	// From defines the selectors that will determine the target namespaces and pods to this CR.
 	From ExternalNetworkSource `json:"from"`
 	// NextHops defines two types of hops: Static and Dynamic. Each hop defines at least one external gateway IP.
 	NextHops ExternalNextHops `json:"nextHops"`
 }
 
-// ExternalNetworkSource contains the selectors used to determine the namespaces where the policy will be applied to
+// ExternalNetworkSource contains the selectors used to determine the namespaces and pods where the policy will be applied to
 type ExternalNetworkSource struct {
 	// NamespaceSelector defines a selector to be used to determine which namespaces will be targeted by this CR
 	NamespaceSelector metav1.LabelSelector `json:"namespaceSelector"`
+	// PodSelector defines a selector to be used to determine which pods in the selected namespaces
+	// will be targeted by this CR. When it is not specified all the pods in the selected namespaces are targeted.
+	// +optional
+	PodSelector metav1.LabelSelector `json:"podSelector,omitempty"`
+	// NetworkSelectors defines the networks serving the namespaces that will be targeted by this CR.
+	// Only the `DefaultNetwork` type is supported: the routes are programmed on the gateway routers of the default
+	// network, so the namespaces served by primary user defined networks can't be targeted.
+	// When it is not specified the namespaces are targeted regardless of the network serving them.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="self.all(sel, sel.networkSelectionType == 'DefaultNetwork')", message="Unsupported network selection type"
+	NetworkSelectors crdtypes.NetworkSelectors `json:"networkSelectors,omitempty"`
 }
 
 // +kubebuilder:validation:MinProperties:=1
@@ -64,6 +77,7 @@ type ExternalNextHops struct {
 }
 
 // StaticHop defines the configuration of a static IP that acts as an external Gateway Interface. IP field is mandatory.
+// +kubebuilder:validation:XValidation:rule="!has(self.bfdConfig) || self.bfdEnabled",message="bfdConfig requires bfdEnabled to be true"
 type StaticHop struct {
 	//IP defines the static IP to be used for egress traffic. The IP can be either IPv4 or IPv6.
 	// + Regex taken from: https://blog.markhatton.co.uk/2011/03/15/regular-expressions-for-ip-addresses-cidr-ranges-and-hostnames/
@@ -76,6 +90,22 @@ type StaticHop struct {
 	// +kubebuilder:default:=false
 	// +default=false
 	BFDEnabled bool `json:"bfdEnabled,omitempty"`
+	// BFDConfig defines the parameters of the Bidirectional Forward Detection session with the next hop.
+	// When not set, the OVN defaults are used. It requires BFDEnabled to be true.
+	// +optional
+	BFDConfig *BFDConfig `json:"bfdConfig,omitempty"`
+	// Priority defines the priority group of the next hop. The next hops of the group with the lowest priority
+	// value are the primary next hops and share the traffic as equal cost routes. The next hops of a group with a
+	// higher priority value are backup next hops and only route traffic when every next hop of the groups with a
+	// lower priority value has BFD enabled and is reported down by BFD. Weights are not supported, the next hops
+	// of a priority group always share the traffic equally since OVN has no weighted equal cost routes.
+	// Defaults to 0.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=255
+	// +kubebuilder:default:=0
+	// +default=0
+	Priority int32 `json:"priority,omitempty"`
 	// SkipHostSNAT determines whether to disable Source NAT to the host IP. Defaults to false.
 	// +optional
 	// +kubebuilder:default:=false
@@ -87,6 +117,7 @@ type StaticHop struct {
 // These interfaces are wrapped around a pod object that resides inside the cluster.
 // The field NetworkAttachmentName captures the name of the multus network name to use when retrieving the gateway IP to use.
 // The PodSelector and the NamespaceSelector are mandatory fields.
+// +kubebuilder:validation:XValidation:rule="!has(self.bfdConfig) || self.bfdEnabled",message="bfdConfig requires bfdEnabled to be true"
 type DynamicHop struct {
 	// PodSelector defines the selector to filter the pods that are external gateways.
 	// +kubebuilder:validation:Required
@@ -107,6 +138,22 @@ type DynamicHop struct {
 	// +kubebuilder:default:=false
 	// +default=false
 	BFDEnabled bool `json:"bfdEnabled,omitempty"`
+	// BFDConfig defines the parameters of the Bidirectional Forward Detection session with the next hop.
+	// When not set, the OVN defaults are used. It requires BFDEnabled to be true.
+	// +optional
+	BFDConfig *BFDConfig `json:"bfdConfig,omitempty"`
+	// Priority defines the priority group of the next hop. The next hops of the group with the lowest priority
+	// value are the primary next hops and share the traffic as equal cost routes. The next hops of a group with a
+	// higher priority value are backup next hops and only route traffic when every next hop of the groups with a
+	// lower priority value has BFD enabled and is reported down by BFD. Weights are not supported, the next hops
+	// of a priority group always share the traffic equally since OVN has no weighted equal cost routes.
+	// Defaults to 0.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=255
+	// +kubebuilder:default:=0
+	// +default=0
+	Priority int32 `json:"priority,omitempty"`
 	// SkipHostSNAT determines whether to disable Source NAT to the host IP. Defaults to false
 	// +optional
 	// +kubebuilder:default:=false
@@ -114,6 +161,26 @@ type DynamicHop struct {
 	// SkipHostSNAT bool `json:"skipHostSNAT,omitempty"`
 }
 
+// BFDConfig defines the parameters of a Bidirectional Forward Detection session.
+type BFDConfig struct {
+	// MinTx defines the minimum interval, in milliseconds, between the transmission of two BFD control packets.
+	// When not set, the OVN default of 1000 milliseconds is used.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MinTx *int32 `json:"minTx,omitempty"`
+	// MinRx defines the minimum interval, in milliseconds, between the reception of two BFD control packets
+	// requested to the next hop. When not set, the OVN default of 1000 milliseconds is used.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MinRx *int32 `json:"minRx,omitempty"`
+	// DetectMult defines the number of BFD control packets that can be missed before the next hop is declared down.
+	// When not set, the OVN default of 5 is used.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=255
+	DetectMult *int32 `json:"detectMult,omitempty"`
+}
+
 // AdminPolicyBasedExternalRouteList contains a list of AdminPolicyBasedExternalRoutes
 // +kubebuilder:object:root=true
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -136,6 +203,15 @@ type AdminPolicyBasedRouteStatus struct {
 	// A concise indication of whether the AdminPolicyBasedRoute resource is applied with success
 	// +optional
 	Status StatusType `json:"status,omitempty"`
+	// Conditions report the state of the BFD sessions with the next hops that have BFD enabled. Every zone reports
+	// one condition per next hop, of type NextHopBFD-<next hop IP>-In-Zone-<zone>, where the colons of IPv6 addresses
+	// are replaced by dots. The condition is True when all the BFD sessions of the zone with the next hop are up.
+	// +optional
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
 }
 
 // StatusType defines the types of status used in the Status field. The value determines if the
@@ -146,3 +222,26 @@ const (
 	SuccessStatus StatusType = "Success"
 	FailStatus    StatusType = "Fail"
 )
+
+const (
+	// NextHopBFDConditionPrefix prefixes the next hop IP in the type of the condition reporting the state of the
+	// BFD sessions of a zone with a next hop.
+	NextHopBFDConditionPrefix = "NextHopBFD-"
+	// NextHopBFDConditionZoneInfix separates the next hop IP from the zone name in the type of the condition
+	// reporting the state of the BFD sessions of a zone with a next hop.
+	NextHopBFDConditionZoneInfix = "-In-Zone-"
+)
+
+// Reasons of the next hop BFD conditions.
+const (
+	// NextHopBFDReasonUp means all the BFD sessions with the next hop are up.
+	NextHopBFDReasonUp = "SessionUp"
+	// NextHopBFDReasonDown means at least one BFD session with the next hop is down.
+	NextHopBFDReasonDown = "SessionDown"
+	// NextHopBFDReasonAdminDown means at least one BFD session with the next hop is administratively down.
+	NextHopBFDReasonAdminDown = "SessionAdminDown"
+	// NextHopBFDReasonInit means at least one BFD session with the next hop is being established.
+	NextHopBFDReasonInit = "SessionInit"
+	// NextHopBFDReasonPending means OVN did not report the state of at least one BFD session with the next hop yet.
+	NextHopBFDReasonPending = "SessionPending"
+)
@@ -21,6 +21,8 @@ limitations under the License.
 package v1
 
 import (
+	types "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -112,6 +114,13 @@ func (in *AdminPolicyBasedRouteStatus) DeepCopyInto(out *AdminPolicyBasedRouteSt
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -125,11 +134,47 @@ func (in *AdminPolicyBasedRouteStatus) DeepCopy() *AdminPolicyBasedRouteStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BFDConfig) DeepCopyInto(out *BFDConfig) {
+	*out = *in
+	if in.MinTx != nil {
+		in, out := &in.MinTx, &out.MinTx
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MinRx != nil {
+		in, out := &in.MinRx, &out.MinRx
+		*out = new(int32)
+		**out = **in
+	}
+	if in.DetectMult != nil {
+		in, out := &in.DetectMult, &out.DetectMult
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BFDConfig.
+func (in *BFDConfig) DeepCopy() *BFDConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(BFDConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DynamicHop) DeepCopyInto(out *DynamicHop) {
 	*out = *in
 	in.PodSelector.DeepCopyInto(&out.PodSelector)
 	in.NamespaceSelector.DeepCopyInto(&out.NamespaceSelector)
+	if in.BFDConfig != nil {
+		in, out := &in.BFDConfig, &out.BFDConfig
+		*out = new(BFDConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -147,6 +192,14 @@ func (in *DynamicHop) DeepCopy() *DynamicHop {
 func (in *ExternalNetworkSource) DeepCopyInto(out *ExternalNetworkSource) {
 	*out = *in
 	in.NamespaceSelector.DeepCopyInto(&out.NamespaceSelector)
+	in.PodSelector.DeepCopyInto(&out.PodSelector)
+	if in.NetworkSelectors != nil {
+		in, out := &in.NetworkSelectors, &out.NetworkSelectors
+		*out = make(types.NetworkSelectors, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -170,7 +223,7 @@ func (in *ExternalNextHops) DeepCopyInto(out *ExternalNextHops) {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
 				*out = new(StaticHop)
-				**out = **in
+				(*in).DeepCopyInto(*out)
 			}
 		}
 	}
@@ -201,6 +254,11 @@ func (in *ExternalNextHops) DeepCopy() *ExternalNextHops {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StaticHop) DeepCopyInto(out *StaticHop) {
 	*out = *in
+	if in.BFDConfig != nil {
+		in, out := &in.BFDConfig, &out.BFDConfig
+		*out = new(BFDConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -22,6 +22,7 @@ package v1
 type EgressFirewallPortApplyConfiguration struct {
 	Protocol *string `json:"protocol,omitempty"`
 	Port     *int32  `json:"port,omitempty"`
+	EndPort  *int32  `json:"endPort,omitempty"`
 }
 
 // EgressFirewallPortApplyConfiguration constructs a declarative configuration of the EgressFirewallPort type for use with
@@ -45,3 +46,11 @@ func (b *EgressFirewallPortApplyConfiguration) WithPort(value int32) *EgressFire
 	b.Port = &value
 	return b
 }
+
+// WithEndPort sets the EndPort field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the EndPort field is set to the value of the last call.
+func (b *EgressFirewallPortApplyConfiguration) WithEndPort(value int32) *EgressFirewallPortApplyConfiguration {
+	b.EndPort = &value
+	return b
+}
@@ -20,8 +20,9 @@ package v1
 // EgressFirewallStatusApplyConfiguration represents a declarative configuration of the EgressFirewallStatus type for use
 // with apply.
 type EgressFirewallStatusApplyConfiguration struct {
-	Status   *string  `json:"status,omitempty"`
-	Messages []string `json:"messages,omitempty"`
+	Status   *string                                      `json:"status,omitempty"`
+	Messages []string                                     `json:"messages,omitempty"`
+	Rules    []EgressFirewallRuleStatusApplyConfiguration `json:"rules,omitempty"`
 }
 
 // EgressFirewallStatusApplyConfiguration constructs a declarative configuration of the EgressFirewallStatus type for use with
@@ -47,3 +48,16 @@ func (b *EgressFirewallStatusApplyConfiguration) WithMessages(values ...string)
 	}
 	return b
 }
+
+// WithRules adds the given value to the Rules field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Rules field.
+func (b *EgressFirewallStatusApplyConfiguration) WithRules(values ...*EgressFirewallRuleStatusApplyConfiguration) *EgressFirewallStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithRules")
+		}
+		b.Rules = append(b.Rules, *values[i])
+	}
+	return b
+}
@@ -31,6 +31,10 @@ import (
 func ForKind(kind schema.GroupVersionKind) interface{} {
 	switch kind {
 	// Group=k8s.ovn.org, Version=v1
+	case v1.SchemeGroupVersion.WithKind("ClusterEgressFirewall"):
+		return &egressfirewallv1.ClusterEgressFirewallApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ClusterEgressFirewallSpec"):
+		return &egressfirewallv1.ClusterEgressFirewallSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("EgressFirewall"):
 		return &egressfirewallv1.EgressFirewallApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("EgressFirewallDestination"):
@@ -39,6 +43,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &egressfirewallv1.EgressFirewallPortApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("EgressFirewallRule"):
 		return &egressfirewallv1.EgressFirewallRuleApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("EgressFirewallRuleStatus"):
+		return &egressfirewallv1.EgressFirewallRuleStatusApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("EgressFirewallSpec"):
 		return &egressfirewallv1.EgressFirewallSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("EgressFirewallStatus"):
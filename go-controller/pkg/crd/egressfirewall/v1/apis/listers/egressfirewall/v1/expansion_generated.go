@@ -17,6 +17,10 @@ limitations under the License.
 
 package v1
 
+// ClusterEgressFirewallListerExpansion allows custom methods to be added to
+// ClusterEgressFirewallLister.
+type ClusterEgressFirewallListerExpansion interface{}
+
 // EgressFirewallListerExpansion allows custom methods to be added to
 // EgressFirewallLister.
 type EgressFirewallListerExpansion interface{}
@@ -0,0 +1,74 @@
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ClusterEgressFirewallTier indicates whether the rules of a ClusterEgressFirewall are evaluated before or after
+// the rules of the namespaced EgressFirewall.
+// +kubebuilder:validation:Enum=Before;After
+type ClusterEgressFirewallTier string
+
+const (
+	ClusterEgressFirewallTierBefore ClusterEgressFirewallTier = "Before"
+	ClusterEgressFirewallTierAfter  ClusterEgressFirewallTier = "After"
+)
+
+// ClusterEgressFirewall describes an egress firewall applied to all the namespaces selected by its namespaceSelector.
+// Traffic from a pod in a selected namespace to an IP address outside the cluster will be checked against each
+// EgressFirewallRule of the ClusterEgressFirewall, in order, either before or after the EgressFirewall of the
+// pod's namespace, depending on the tier.
+//
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:path=clusteregressfirewalls,scope=Cluster
+// +kubebuilder:singular=clusteregressfirewall
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name="Tier",type=string,JSONPath=".spec.tier"
+// +kubebuilder:printcolumn:name="Priority",type=integer,JSONPath=".spec.priority"
+// +kubebuilder:printcolumn:name="ClusterEgressFirewall Status",type=string,JSONPath=".status.status"
+// +kubebuilder:subresource:status
+type ClusterEgressFirewall struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Specification of the desired behavior of ClusterEgressFirewall.
+	Spec ClusterEgressFirewallSpec `json:"spec"`
+	// Observed status of ClusterEgressFirewall
+	// +optional
+	Status EgressFirewallStatus `json:"status,omitempty"`
+}
+
+// ClusterEgressFirewallSpec is a desired state description of ClusterEgressFirewall.
+type ClusterEgressFirewallSpec struct {
+	// namespaceSelector selects the namespaces the egress firewall rules apply to.
+	// An empty selector selects all namespaces.
+	NamespaceSelector metav1.LabelSelector `json:"namespaceSelector"`
+	// tier defines whether the rules are evaluated "Before" or "After" the rules of the
+	// EgressFirewall of the selected namespaces. Defaults to "Before".
+	// +kubebuilder:default=Before
+	// +optional
+	Tier ClusterEgressFirewallTier `json:"tier,omitempty"`
+	// priority orders the ClusterEgressFirewalls of the same tier that select the same namespace.
+	// Rules of a ClusterEgressFirewall with a lower priority value are evaluated first.
+	// The order of ClusterEgressFirewalls with the same tier and priority is undefined.
+	// +kubebuilder:validation:Minimum:=0
+	// +kubebuilder:validation:Maximum:=9
+	// +optional
+	Priority int32 `json:"priority,omitempty"`
+	// a collection of egress firewall rule objects
+	// +kubebuilder:validation:MaxItems:=80
+	Egress []EgressFirewallRule `json:"egress"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// ClusterEgressFirewallList is the list of ClusterEgressFirewalls.
+type ClusterEgressFirewallList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// List of ClusterEgressFirewalls.
+	Items []ClusterEgressFirewall `json:"items"`
+}
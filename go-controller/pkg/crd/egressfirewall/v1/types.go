@@ -41,6 +41,53 @@ type EgressFirewallStatus struct {
 	// +listType=set
 	// +optional
 	Messages []string `json:"messages,omitempty"`
+	// rules reports the state of the egress rules in every zone.
+	// When the egress firewall fails to be applied in a zone, only the rules that caused the failure are reported
+	// for that zone.
+	// +listType=map
+	// +listMapKey=zone
+	// +listMapKey=index
+	// +optional
+	Rules []EgressFirewallRuleStatus `json:"rules,omitempty"`
+}
+
+// EgressFirewallRuleState is the state of an egress firewall rule in a zone.
+// +kubebuilder:validation:Enum=Applied;DNSUnresolved;Invalid
+type EgressFirewallRuleState string
+
+const (
+	// EgressFirewallRuleApplied means the rule is enforced.
+	EgressFirewallRuleApplied EgressFirewallRuleState = "Applied"
+	// EgressFirewallRuleDNSUnresolved means the rule is enforced, but its dnsName could not be resolved
+	// the last time it was looked up. The rule keeps matching the last resolved addresses, if any.
+	EgressFirewallRuleDNSUnresolved EgressFirewallRuleState = "DNSUnresolved"
+	// EgressFirewallRuleInvalid means the rule was rejected.
+	EgressFirewallRuleInvalid EgressFirewallRuleState = "Invalid"
+)
+
+// EgressFirewallRuleStatus is the state of a single egress rule in a zone.
+type EgressFirewallRuleStatus struct {
+	// zone that reports the state of the rule.
+	Zone string `json:"zone"`
+	// index of the rule in the egress rules of the spec.
+	// +kubebuilder:validation:Minimum=0
+	Index int32 `json:"index"`
+	// state of the rule.
+	State EgressFirewallRuleState `json:"state"`
+	// message gives details about the state of the rule.
+	// +optional
+	Message string `json:"message,omitempty"`
+	// resolvedAddresses are the IP addresses the dnsName of the rule was last resolved to.
+	// Only set for rules with a dnsName destination.
+	// +listType=set
+	// +optional
+	ResolvedAddresses []string `json:"resolvedAddresses,omitempty"`
+	// hits is the number of packets matching the rule that were sampled in the zone since the rule was last
+	// rendered. It is rounded down to its most significant digit (e.g. 4567 is reported as 4000) to limit the
+	// status updates, so it can be lower than the ovnkube_controller_egress_firewall_rule_hits_total metric of the
+	// zone, which is not rounded. Only reported when observability is enabled.
+	// +optional
+	Hits *int64 `json:"hits,omitempty"`
 }
 
 // EgressFirewallSpec is a desired state description of EgressFirewall.
@@ -60,15 +107,26 @@ type EgressFirewallRule struct {
 	To EgressFirewallDestination `json:"to"`
 }
 
-// EgressFirewallPort specifies the port to allow or deny traffic to
+// EgressFirewallPort specifies the port or port range to allow or deny traffic to.
+// If port is not set, the rule applies to any port of the given protocol.
+// +kubebuilder:validation:XValidation:rule="!has(self.endPort) || has(self.port)", message="endPort requires port to be set"
+// +kubebuilder:validation:XValidation:rule="!has(self.endPort) || !has(self.port) || self.endPort >= self.port", message="endPort must be greater than or equal to port"
 type EgressFirewallPort struct {
 	// protocol (tcp, udp, sctp) that the traffic must match.
 	// +kubebuilder:validation:Pattern=^TCP|UDP|SCTP$
 	Protocol string `json:"protocol"`
-	// port that the traffic must match
+	// port that the traffic must match. If endPort is also set, this is the first port of the range.
+	// If unset, the traffic must match any port of protocol.
 	// +kubebuilder:validation:Minimum:=1
 	// +kubebuilder:validation:Maximum:=65535
-	Port int32 `json:"port"`
+	// +optional
+	Port int32 `json:"port,omitempty"`
+	// endPort indicates that the traffic must match a range of ports from port to endPort, inclusive.
+	// It can only be set together with port and must be greater than or equal to port.
+	// +kubebuilder:validation:Minimum:=1
+	// +kubebuilder:validation:Maximum:=65535
+	// +optional
+	EndPort int32 `json:"endPort,omitempty"`
 }
 
 // +kubebuilder:validation:MinProperties:=1
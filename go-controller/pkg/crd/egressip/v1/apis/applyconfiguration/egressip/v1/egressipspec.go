@@ -18,15 +18,19 @@ limitations under the License.
 package v1
 
 import (
+	egressipv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressip/v1"
 	metav1 "k8s.io/client-go/applyconfigurations/meta/v1"
 )
 
 // EgressIPSpecApplyConfiguration represents a declarative configuration of the EgressIPSpec type for use
 // with apply.
 type EgressIPSpecApplyConfiguration struct {
-	EgressIPs         []string                                `json:"egressIPs,omitempty"`
-	NamespaceSelector *metav1.LabelSelectorApplyConfiguration `json:"namespaceSelector,omitempty"`
-	PodSelector       *metav1.LabelSelectorApplyConfiguration `json:"podSelector,omitempty"`
+	EgressIPs           []string                                       `json:"egressIPs,omitempty"`
+	EgressIPPool        *EgressIPPoolRequestApplyConfiguration         `json:"egressIPPool,omitempty"`
+	NamespaceSelector   *metav1.LabelSelectorApplyConfiguration        `json:"namespaceSelector,omitempty"`
+	PodSelector         *metav1.LabelSelectorApplyConfiguration        `json:"podSelector,omitempty"`
+	DestinationSelector *EgressIPDestinationSelectorApplyConfiguration `json:"destinationSelector,omitempty"`
+	FailoverPolicy      *egressipv1.EgressIPFailoverPolicy             `json:"failoverPolicy,omitempty"`
 }
 
 // EgressIPSpecApplyConfiguration constructs a declarative configuration of the EgressIPSpec type for use with
@@ -45,6 +49,14 @@ func (b *EgressIPSpecApplyConfiguration) WithEgressIPs(values ...string) *Egress
 	return b
 }
 
+// WithEgressIPPool sets the EgressIPPool field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the EgressIPPool field is set to the value of the last call.
+func (b *EgressIPSpecApplyConfiguration) WithEgressIPPool(value *EgressIPPoolRequestApplyConfiguration) *EgressIPSpecApplyConfiguration {
+	b.EgressIPPool = value
+	return b
+}
+
 // WithNamespaceSelector sets the NamespaceSelector field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the NamespaceSelector field is set to the value of the last call.
@@ -60,3 +72,19 @@ func (b *EgressIPSpecApplyConfiguration) WithPodSelector(value *metav1.LabelSele
 	b.PodSelector = value
 	return b
 }
+
+// WithDestinationSelector sets the DestinationSelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DestinationSelector field is set to the value of the last call.
+func (b *EgressIPSpecApplyConfiguration) WithDestinationSelector(value *EgressIPDestinationSelectorApplyConfiguration) *EgressIPSpecApplyConfiguration {
+	b.DestinationSelector = value
+	return b
+}
+
+// WithFailoverPolicy sets the FailoverPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the FailoverPolicy field is set to the value of the last call.
+func (b *EgressIPSpecApplyConfiguration) WithFailoverPolicy(value egressipv1.EgressIPFailoverPolicy) *EgressIPSpecApplyConfiguration {
+	b.FailoverPolicy = &value
+	return b
+}
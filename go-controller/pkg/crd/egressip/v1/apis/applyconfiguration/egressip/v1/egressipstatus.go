@@ -17,10 +17,17 @@ limitations under the License.
 
 package v1
 
+import (
+	metav1 "k8s.io/client-go/applyconfigurations/meta/v1"
+)
+
 // EgressIPStatusApplyConfiguration represents a declarative configuration of the EgressIPStatus type for use
 // with apply.
 type EgressIPStatusApplyConfiguration struct {
-	Items []EgressIPStatusItemApplyConfiguration `json:"items,omitempty"`
+	Items              []EgressIPStatusItemApplyConfiguration           `json:"items,omitempty"`
+	AllocatedEgressIPs []string                                         `json:"allocatedEgressIPs,omitempty"`
+	UnassignedItems    []EgressIPUnassignedStatusItemApplyConfiguration `json:"unassignedItems,omitempty"`
+	Conditions         []metav1.ConditionApplyConfiguration             `json:"conditions,omitempty"`
 }
 
 // EgressIPStatusApplyConfiguration constructs a declarative configuration of the EgressIPStatus type for use with
@@ -41,3 +48,39 @@ func (b *EgressIPStatusApplyConfiguration) WithItems(values ...*EgressIPStatusIt
 	}
 	return b
 }
+
+// WithAllocatedEgressIPs adds the given value to the AllocatedEgressIPs field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the AllocatedEgressIPs field.
+func (b *EgressIPStatusApplyConfiguration) WithAllocatedEgressIPs(values ...string) *EgressIPStatusApplyConfiguration {
+	for i := range values {
+		b.AllocatedEgressIPs = append(b.AllocatedEgressIPs, values[i])
+	}
+	return b
+}
+
+// WithUnassignedItems adds the given value to the UnassignedItems field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the UnassignedItems field.
+func (b *EgressIPStatusApplyConfiguration) WithUnassignedItems(values ...*EgressIPUnassignedStatusItemApplyConfiguration) *EgressIPStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithUnassignedItems")
+		}
+		b.UnassignedItems = append(b.UnassignedItems, *values[i])
+	}
+	return b
+}
+
+// WithConditions adds the given value to the Conditions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Conditions field.
+func (b *EgressIPStatusApplyConfiguration) WithConditions(values ...*metav1.ConditionApplyConfiguration) *EgressIPStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithConditions")
+		}
+		b.Conditions = append(b.Conditions, *values[i])
+	}
+	return b
+}
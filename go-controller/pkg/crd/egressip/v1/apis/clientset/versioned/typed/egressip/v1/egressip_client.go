@@ -28,6 +28,7 @@ import (
 type K8sV1Interface interface {
 	RESTClient() rest.Interface
 	EgressIPsGetter
+	EgressIPPoolsGetter
 }
 
 // K8sV1Client is used to interact with features provided by the k8s.ovn.org group.
@@ -39,6 +40,10 @@ func (c *K8sV1Client) EgressIPs() EgressIPInterface {
 	return newEgressIPs(c)
 }
 
+func (c *K8sV1Client) EgressIPPools() EgressIPPoolInterface {
+	return newEgressIPPools(c)
+}
+
 // NewForConfig creates a new K8sV1Client for the given config.
 // NewForConfig is equivalent to NewForConfigAndClient(c, httpClient),
 // where httpClient was generated with rest.HTTPClientFor(c).
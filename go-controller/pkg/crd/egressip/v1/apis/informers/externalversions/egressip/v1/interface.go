@@ -25,6 +25,8 @@ import (
 type Interface interface {
 	// EgressIPs returns a EgressIPInformer.
 	EgressIPs() EgressIPInformer
+	// EgressIPPools returns a EgressIPPoolInformer.
+	EgressIPPools() EgressIPPoolInformer
 }
 
 type version struct {
@@ -42,3 +44,8 @@ func New(f internalinterfaces.SharedInformerFactory, namespace string, tweakList
 func (v *version) EgressIPs() EgressIPInformer {
 	return &egressIPInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
 }
+
+// EgressIPPools returns a EgressIPPoolInformer.
+func (v *version) EgressIPPools() EgressIPPoolInformer {
+	return &egressIPPoolInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
@@ -0,0 +1,46 @@
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// EgressIPPool is a CRD defining ranges of addresses egress IPs are allocated
+// from automatically, for the EgressIPs requesting egress IPs from the pool.
+//
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:noStatus
+// +resource:path=egressippool
+// +kubebuilder:resource:shortName=eippool,scope=Cluster
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:printcolumn:name="CIDRs",type=string,JSONPath=".spec.cidrs[*]"
+type EgressIPPool struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Specification of the desired behavior of EgressIPPool.
+	Spec EgressIPPoolSpec `json:"spec"`
+}
+
+// EgressIPPoolSpec is a desired state description of EgressIPPool.
+type EgressIPPoolSpec struct {
+	// CIDRs is the list of ranges egress IPs are allocated from. Can be IPv4
+	// and/or IPv6. Every range is expected to be contained in a subnet of the
+	// egress nodes, an egress IP allocated from a range can only be assigned to
+	// the nodes attached to the subnet containing it. The network and broadcast
+	// addresses of the ranges are never allocated.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:XValidation:rule="self.all(c, isCIDR(c))", message="cidrs must be valid CIDRs"
+	CIDRs []string `json:"cidrs"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +resource:path=egressippool
+// EgressIPPoolList is the list of EgressIPPool.
+type EgressIPPoolList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// List of EgressIPPool.
+	Items []EgressIPPool `json:"items"`
+}
@@ -16,6 +16,7 @@ import (
 // +kubebuilder:printcolumn:name="EgressIPs",type=string,JSONPath=".spec.egressIPs[*]"
 // +kubebuilder:printcolumn:name="Assigned Node",type=string,JSONPath=".status.items[*].node"
 // +kubebuilder:printcolumn:name="Assigned EgressIPs",type=string,JSONPath=".status.items[*].egressIP"
+// +kubebuilder:validation:XValidation:rule="has(self.spec.egressIPs) || has(self.spec.egressIPPool)", message="egressIPs or egressIPPool must be set"
 // EgressIP is a CRD allowing the user to define a fixed
 // source IP for all egress traffic originating from any pods which
 // match the EgressIP resource according to its spec definition.
@@ -33,8 +34,72 @@ type EgressIP struct {
 type EgressIPStatus struct {
 	// The list of assigned egress IPs and their corresponding node assignment.
 	Items []EgressIPStatusItem `json:"items"`
+	// AllocatedEgressIPs is the list of egress IPs allocated from the EgressIPPool
+	// requested in the spec.
+	// +optional
+	AllocatedEgressIPs []string `json:"allocatedEgressIPs,omitempty"`
+	// UnassignedItems is the list of requested egress IPs which are not assigned
+	// to any node, with the reason why.
+	// +optional
+	UnassignedItems []EgressIPUnassignedStatusItem `json:"unassignedItems,omitempty"`
+	// Conditions report whether the egress IPs are assigned, whether the egress
+	// nodes hosting them are reachable and whether they are programmed in every
+	// zone. Every zone reports its own Programmed-In-Zone-<zone> condition.
+	// +optional
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+}
+
+// The per egress IP status, for those egress IPs who could not be assigned.
+type EgressIPUnassignedStatusItem struct {
+	// Unassigned egress IP
+	EgressIP string `json:"egressIP"`
+	// Reason is a CamelCase reason why the egress IP is not assigned
+	Reason string `json:"reason"`
+	// Message is a human readable message explaining the reason
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
+const (
+	// EgressIPAssignedCondition is True when all the requested egress IPs are
+	// assigned to a node.
+	EgressIPAssignedCondition = "Assigned"
+	// EgressIPReachableCondition is False when egress IPs can't be assigned
+	// because the egress nodes able to host them are unreachable.
+	EgressIPReachableCondition = "Reachable"
+	// EgressIPProgrammedCondition is True when all the zones programmed the
+	// EgressIP.
+	EgressIPProgrammedCondition = "Programmed"
+	// EgressIPProgrammedInZoneConditionPrefix prefixes the zone name in the
+	// type of the condition every zone reports once it programmed the EgressIP.
+	EgressIPProgrammedInZoneConditionPrefix = "Programmed-In-Zone-"
+)
+
+// Reasons of the unassigned egress IPs.
+const (
+	// EgressIPReasonNoAssignableNodes means no node is labeled as egress
+	// assignable, ready and reachable.
+	EgressIPReasonNoAssignableNodes = "NoAssignableNodes"
+	// EgressIPReasonNoMatchingNode means no egress node has a network able to
+	// host the egress IP.
+	EgressIPReasonNoMatchingNode = "NoMatchingNode"
+	// EgressIPReasonNodeUnreachable means the egress nodes able to host the
+	// egress IP are unreachable.
+	EgressIPReasonNodeUnreachable = "NodeUnreachable"
+	// EgressIPReasonIPConflict means the egress IP is already used by a host.
+	EgressIPReasonIPConflict = "IPConflict"
+	// EgressIPReasonAlreadyAllocated means the egress IP is assigned to
+	// another EgressIP.
+	EgressIPReasonAlreadyAllocated = "AlreadyAllocated"
+	// EgressIPReasonPending means the assignment of the egress IP is in
+	// progress.
+	EgressIPReasonPending = "Pending"
+)
+
 // The per node status, for those egress IPs who have been assigned.
 type EgressIPStatusItem struct {
 	// Assigned node name
@@ -46,8 +111,14 @@ type EgressIPStatusItem struct {
 // EgressIPSpec is a desired state description of EgressIP.
 type EgressIPSpec struct {
 	// EgressIPs is the list of egress IP addresses requested. Can be IPv4 and/or IPv6.
-	// This field is mandatory.
-	EgressIPs []string `json:"egressIPs"`
+	// This field is mandatory, unless EgressIPPool is set.
+	// +optional
+	EgressIPs []string `json:"egressIPs,omitempty"`
+	// EgressIPPool requests egress IPs to be allocated automatically from an
+	// EgressIPPool, in addition to the egress IPs listed in EgressIPs. The
+	// allocated egress IPs are reported in the status.
+	// +optional
+	EgressIPPool *EgressIPPoolRequest `json:"egressIPPool,omitempty"`
 	// NamespaceSelector applies the egress IP only to the namespace(s) whose label
 	// matches this definition. This field is mandatory.
 	NamespaceSelector metav1.LabelSelector `json:"namespaceSelector"`
@@ -60,6 +131,56 @@ type EgressIPSpec struct {
 	// match this pod selector.
 	// +optional
 	PodSelector metav1.LabelSelector `json:"podSelector,omitempty"`
+	// DestinationSelector applies the egress IP only to the traffic toward the
+	// selected destinations. This field is optional, and in case it is not set
+	// the egress IP is applied to all the egress traffic of the selected pods.
+	// In case it is set, the traffic toward other destinations keeps egressing
+	// with the IP of the node hosting the pod.
+	// +optional
+	DestinationSelector *EgressIPDestinationSelector `json:"destinationSelector,omitempty"`
+	// FailoverPolicy defines when the egress IPs move between egress nodes.
+	// Preemptive moves the egress IPs away from a node which can't host them
+	// anymore, and moves them back to the node they were first assigned to
+	// once it can host them again. NonPreemptive moves the egress IPs away
+	// from a node which can't host them anymore, and keeps them on the node
+	// they moved to. MaintenanceDrain keeps the egress IPs on a node which is
+	// not ready or unreachable, and moves them only when the node is drained,
+	// is not labeled as an egress node anymore or is deleted.
+	// Defaults to NonPreemptive.
+	// +kubebuilder:validation:Enum=Preemptive;NonPreemptive;MaintenanceDrain
+	// +optional
+	FailoverPolicy EgressIPFailoverPolicy `json:"failoverPolicy,omitempty"`
+}
+
+// EgressIPFailoverPolicy defines when the egress IPs move between egress nodes.
+type EgressIPFailoverPolicy string
+
+const (
+	EgressIPFailoverPolicyPreemptive       EgressIPFailoverPolicy = "Preemptive"
+	EgressIPFailoverPolicyNonPreemptive    EgressIPFailoverPolicy = "NonPreemptive"
+	EgressIPFailoverPolicyMaintenanceDrain EgressIPFailoverPolicy = "MaintenanceDrain"
+)
+
+// EgressIPDestinationSelector selects the destinations an egress IP applies to.
+type EgressIPDestinationSelector struct {
+	// CIDRs is the list of destination ranges the egress IP applies to. Can be
+	// IPv4 and/or IPv6. An egress IP is not applied to any traffic of the IP
+	// family none of the ranges belongs to.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:XValidation:rule="self.all(c, isCIDR(c))", message="cidrs must be valid CIDRs"
+	CIDRs []string `json:"cidrs"`
+}
+
+// EgressIPPoolRequest is a request for egress IPs allocated from an EgressIPPool.
+type EgressIPPoolRequest struct {
+	// Name is the name of the EgressIPPool to allocate the egress IPs from.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+	// Count is the number of egress IPs to allocate from the EgressIPPool.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:default=1
+	// +optional
+	Count int32 `json:"count,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -27,6 +27,7 @@ type EgressQoSRuleApplyConfiguration struct {
 	DSCP        *int                                    `json:"dscp,omitempty"`
 	DstCIDR     *string                                 `json:"dstCIDR,omitempty"`
 	PodSelector *metav1.LabelSelectorApplyConfiguration `json:"podSelector,omitempty"`
+	Ports       []EgressQoSPortApplyConfiguration       `json:"ports,omitempty"`
 }
 
 // EgressQoSRuleApplyConfiguration constructs a declarative configuration of the EgressQoSRule type for use with
@@ -58,3 +59,16 @@ func (b *EgressQoSRuleApplyConfiguration) WithPodSelector(value *metav1.LabelSel
 	b.PodSelector = value
 	return b
 }
+
+// WithPorts adds the given value to the Ports field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Ports field.
+func (b *EgressQoSRuleApplyConfiguration) WithPorts(values ...*EgressQoSPortApplyConfiguration) *EgressQoSRuleApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithPorts")
+		}
+		b.Ports = append(b.Ports, *values[i])
+	}
+	return b
+}
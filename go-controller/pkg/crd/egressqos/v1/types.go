@@ -68,6 +68,34 @@ type EgressQoSRule struct {
 	// results in the rule being applied to all pods in the namespace.
 	// +optional
 	PodSelector metav1.LabelSelector `json:"podSelector,omitempty"`
+
+	// Ports restricts the QoS rule to traffic heading to the given protocols and
+	// destination ports. This field is optional, and in case it is not set the rule
+	// is applied to all traffic regardless of the protocol and port.
+	// +optional
+	Ports []EgressQoSPort `json:"ports,omitempty"`
+}
+
+// EgressQoSPort specifies the destination port or port range of the traffic to mark.
+// If port is not set, the rule applies to any port of the given protocol.
+// +kubebuilder:validation:XValidation:rule="!has(self.endPort) || has(self.port)", message="endPort requires port to be set"
+// +kubebuilder:validation:XValidation:rule="!has(self.endPort) || !has(self.port) || self.endPort >= self.port", message="endPort must be greater than or equal to port"
+type EgressQoSPort struct {
+	// protocol (TCP, UDP, SCTP) that the traffic must match.
+	// +kubebuilder:validation:Enum=TCP;UDP;SCTP
+	Protocol string `json:"protocol"`
+	// port that the traffic must match. If endPort is also set, this is the first port of the range.
+	// If unset, the traffic must match any port of protocol.
+	// +kubebuilder:validation:Minimum:=1
+	// +kubebuilder:validation:Maximum:=65535
+	// +optional
+	Port int32 `json:"port,omitempty"`
+	// endPort indicates that the traffic must match a range of ports from port to endPort, inclusive.
+	// It can only be set together with port and must be greater than or equal to port.
+	// +kubebuilder:validation:Minimum:=1
+	// +kubebuilder:validation:Maximum:=65535
+	// +optional
+	EndPort int32 `json:"endPort,omitempty"`
 }
 
 // EgressQoSStatus defines the observed state of EgressQoS
@@ -86,6 +86,22 @@ func (in *EgressQoSList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EgressQoSPort) DeepCopyInto(out *EgressQoSPort) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressQoSPort.
+func (in *EgressQoSPort) DeepCopy() *EgressQoSPort {
+	if in == nil {
+		return nil
+	}
+	out := new(EgressQoSPort)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EgressQoSRule) DeepCopyInto(out *EgressQoSRule) {
 	*out = *in
@@ -95,6 +111,11 @@ func (in *EgressQoSRule) DeepCopyInto(out *EgressQoSRule) {
 		**out = **in
 	}
 	in.PodSelector.DeepCopyInto(&out.PodSelector)
+	if in.Ports != nil {
+		in, out := &in.Ports, &out.Ports
+		*out = make([]EgressQoSPort, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -27,6 +27,7 @@ import (
 type EgressServiceSpecApplyConfiguration struct {
 	SourceIPBy   *egressservicev1.SourceIPMode           `json:"sourceIPBy,omitempty"`
 	NodeSelector *metav1.LabelSelectorApplyConfiguration `json:"nodeSelector,omitempty"`
+	HostCount    *int32                                  `json:"hostCount,omitempty"`
 	Network      *string                                 `json:"network,omitempty"`
 }
 
@@ -52,6 +53,14 @@ func (b *EgressServiceSpecApplyConfiguration) WithNodeSelector(value *metav1.Lab
 	return b
 }
 
+// WithHostCount sets the HostCount field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the HostCount field is set to the value of the last call.
+func (b *EgressServiceSpecApplyConfiguration) WithHostCount(value int32) *EgressServiceSpecApplyConfiguration {
+	b.HostCount = &value
+	return b
+}
+
 // WithNetwork sets the Network field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Network field is set to the value of the last call.
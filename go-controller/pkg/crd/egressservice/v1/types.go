@@ -58,6 +58,16 @@ type EgressServiceSpec struct {
 	// +optional
 	NodeSelector metav1.LabelSelector `json:"nodeSelector,omitempty"`
 
+	// The number of nodes that handle the service's traffic at the same time when sourceIPBy=LoadBalancerIP.
+	// When greater than 1 the egress traffic of the service's endpoints is spread across the selected nodes using ECMP.
+	// A selected node that no longer matches the nodeSelector or is no longer usable is replaced by another
+	// matching node, when one is available.
+	// When it is not specified a single node handles the service's traffic.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=16
+	// +optional
+	HostCount int32 `json:"hostCount,omitempty"`
+
 	// The network which this service should send egress and corresponding ingress replies to.
 	// This is typically implemented as VRF mapping, representing a numeric id or string name
 	// of a routing table which by omission uses the default host routing.
@@ -79,8 +89,13 @@ const (
 // EgressServiceStatus defines the observed state of EgressService
 type EgressServiceStatus struct {
 	// The name of the node selected to handle the service's traffic.
+	// When multiple nodes are selected this is the first of hosts.
 	// In case sourceIPBy=Network the field will be set to "ALL".
 	Host string `json:"host"`
+
+	// The names of all the nodes selected to handle the service's traffic when sourceIPBy=LoadBalancerIP.
+	// +optional
+	Hosts []string `json:"hosts,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -17,11 +17,16 @@ limitations under the License.
 
 package v1alpha1
 
+import (
+	networkqosv1alpha1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/networkqos/v1alpha1"
+)
+
 // BandwidthApplyConfiguration represents a declarative configuration of the Bandwidth type for use
 // with apply.
 type BandwidthApplyConfiguration struct {
-	Rate  *uint32 `json:"rate,omitempty"`
-	Burst *uint32 `json:"burst,omitempty"`
+	Rate  *uint32                            `json:"rate,omitempty"`
+	Burst *uint32                            `json:"burst,omitempty"`
+	Scope *networkqosv1alpha1.BandwidthScope `json:"scope,omitempty"`
 }
 
 // BandwidthApplyConfiguration constructs a declarative configuration of the Bandwidth type for use with
@@ -45,3 +50,11 @@ func (b *BandwidthApplyConfiguration) WithBurst(value uint32) *BandwidthApplyCon
 	b.Burst = &value
 	return b
 }
+
+// WithScope sets the Scope field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Scope field is set to the value of the last call.
+func (b *BandwidthApplyConfiguration) WithScope(value networkqosv1alpha1.BandwidthScope) *BandwidthApplyConfiguration {
+	b.Scope = &value
+	return b
+}
@@ -25,6 +25,7 @@ import (
 // with apply.
 type ClassifierApplyConfiguration struct {
 	To    []DestinationApplyConfiguration `json:"to,omitempty"`
+	From  []DestinationApplyConfiguration `json:"from,omitempty"`
 	Ports []*networkqosv1alpha1.Port      `json:"ports,omitempty"`
 }
 
@@ -47,6 +48,19 @@ func (b *ClassifierApplyConfiguration) WithTo(values ...*DestinationApplyConfigu
 	return b
 }
 
+// WithFrom adds the given value to the From field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the From field.
+func (b *ClassifierApplyConfiguration) WithFrom(values ...*DestinationApplyConfiguration) *ClassifierApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithFrom")
+		}
+		b.From = append(b.From, *values[i])
+	}
+	return b
+}
+
 // WithPorts adds the given value to the Ports field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, values provided by each call will be appended to the Ports field.
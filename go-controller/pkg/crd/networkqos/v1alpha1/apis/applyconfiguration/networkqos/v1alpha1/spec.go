@@ -29,6 +29,7 @@ type SpecApplyConfiguration struct {
 	PodSelector      *v1.LabelSelectorApplyConfiguration `json:"podSelector,omitempty"`
 	Priority         *int                                `json:"priority,omitempty"`
 	Egress           []RuleApplyConfiguration            `json:"egress,omitempty"`
+	Ingress          []RuleApplyConfiguration            `json:"ingress,omitempty"`
 }
 
 // SpecApplyConfiguration constructs a declarative configuration of the Spec type for use with
@@ -73,3 +74,16 @@ func (b *SpecApplyConfiguration) WithEgress(values ...*RuleApplyConfiguration) *
 	}
 	return b
 }
+
+// WithIngress adds the given value to the Ingress field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Ingress field.
+func (b *SpecApplyConfiguration) WithIngress(values ...*RuleApplyConfiguration) *SpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithIngress")
+		}
+		b.Ingress = append(b.Ingress, *values[i])
+	}
+	return b
+}
@@ -70,8 +70,19 @@ type Spec struct {
 	// within a single NetworkQos object (all of which share the priority) will be
 	// determined by the order in which the rule is written. Thus, a rule that appears
 	// first in the list of egress rules would take the lower precedence.
+	// +optional
+	// +kubebuilder:validation:MaxItems=20
+	// +kubebuilder:validation:XValidation:rule="self.all(r, !has(r.classifier) || !has(r.classifier.from))", message="classifier.from is only allowed in ingress rules"
+	Egress []Rule `json:"egress,omitempty"`
+
+	// ingress a collection of Ingress NetworkQoS rule objects, applied to the traffic
+	// received by the selected pods. A total of 20 rules will be allowed in each
+	// NetworkQoS instance. The relative precedence of ingress rules within a single
+	// NetworkQoS object follows the same ordering as the egress rules.
+	// +optional
 	// +kubebuilder:validation:MaxItems=20
-	Egress []Rule `json:"egress"`
+	// +kubebuilder:validation:XValidation:rule="self.all(r, !has(r.classifier) || !has(r.classifier.to))", message="classifier.to is only allowed in egress rules"
+	Ingress []Rule `json:"ingress,omitempty"`
 }
 
 type Rule struct {
@@ -83,7 +94,8 @@ type Rule struct {
 	// classifier The classifier on which packets should match
 	// to apply the NetworkQoS Rule.
 	// This field is optional, and in case it is not set the rule is applied
-	// to all egress traffic regardless of the destination.
+	// to all egress traffic regardless of the destination, or to all ingress
+	// traffic regardless of the source.
 	// +optional
 	Classifier Classifier `json:"classifier"`
 
@@ -92,9 +104,16 @@ type Rule struct {
 }
 
 type Classifier struct {
+	// to the destinations of the egress traffic. Only allowed in egress rules.
 	// +optional
 	To []Destination `json:"to"`
 
+	// from the sources of the ingress traffic. Only allowed in ingress rules.
+	// +optional
+	From []Destination `json:"from,omitempty"`
+
+	// ports the destination protocol and ports of the traffic. For ingress
+	// rules these are the ports of the selected pods.
 	// +optional
 	Ports []*Port `json:"ports"`
 }
@@ -115,8 +134,27 @@ type Bandwidth struct {
 	// +kubebuilder:validation:Maximum:=4294967295
 	// +optional
 	Burst uint32 `json:"burst"`
+
+	// scope defines how the rate and burst limits are shared between the
+	// selected pods. With Node, the default, the selected pods on each node
+	// share a single bucket of the given rate and burst. With Cluster, the
+	// given rate and burst are a budget for all the selected pods in the
+	// cluster, split evenly across the nodes running them.
+	// +optional
+	Scope BandwidthScope `json:"scope,omitempty"`
 }
 
+// BandwidthScope defines how the bandwidth limits of a rule are shared
+// +kubebuilder:validation:Enum=Node;Cluster
+type BandwidthScope string
+
+const (
+	// BandwidthScopeNode shares the limits between the selected pods of each node.
+	BandwidthScopeNode BandwidthScope = "Node"
+	// BandwidthScopeCluster splits the limits between the nodes running selected pods.
+	BandwidthScopeCluster BandwidthScope = "Cluster"
+)
+
 // Port specifies destination protocol and port on which NetworkQoS
 // rule is applied
 type Port struct {
@@ -132,7 +170,8 @@ type Port struct {
 	Port *int32 `json:"port"`
 }
 
-// Destination describes a peer to apply NetworkQoS configuration for the outgoing traffic.
+// Destination describes a peer to apply NetworkQoS configuration for the outgoing traffic,
+// or for the incoming traffic when used in the classifier of an ingress rule.
 // Only certain combinations of fields are allowed.
 // +kubebuilder:validation:XValidation:rule="!(has(self.ipBlock) && (has(self.podSelector) || has(self.namespaceSelector)))",message="Can't specify both podSelector/namespaceSelector and ipBlock"
 type Destination struct {
@@ -31,6 +31,8 @@ type RouteAdvertisementsSpecApplyConfiguration struct {
 	NodeSelector             *metav1.LabelSelectorApplyConfiguration   `json:"nodeSelector,omitempty"`
 	FRRConfigurationSelector *metav1.LabelSelectorApplyConfiguration   `json:"frrConfigurationSelector,omitempty"`
 	Advertisements           []routeadvertisementsv1.AdvertisementType `json:"advertisements,omitempty"`
+	Services                 *ServiceAdvertisementApplyConfiguration   `json:"services,omitempty"`
+	BGPAttributes            []BGPAttributesApplyConfiguration         `json:"bgpAttributes,omitempty"`
 }
 
 // RouteAdvertisementsSpecApplyConfiguration constructs a declarative configuration of the RouteAdvertisementsSpec type for use with
@@ -80,3 +82,24 @@ func (b *RouteAdvertisementsSpecApplyConfiguration) WithAdvertisements(values ..
 	}
 	return b
 }
+
+// WithServices sets the Services field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Services field is set to the value of the last call.
+func (b *RouteAdvertisementsSpecApplyConfiguration) WithServices(value *ServiceAdvertisementApplyConfiguration) *RouteAdvertisementsSpecApplyConfiguration {
+	b.Services = value
+	return b
+}
+
+// WithBGPAttributes adds the given value to the BGPAttributes field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the BGPAttributes field.
+func (b *RouteAdvertisementsSpecApplyConfiguration) WithBGPAttributes(values ...*BGPAttributesApplyConfiguration) *RouteAdvertisementsSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithBGPAttributes")
+		}
+		b.BGPAttributes = append(b.BGPAttributes, *values[i])
+	}
+	return b
+}
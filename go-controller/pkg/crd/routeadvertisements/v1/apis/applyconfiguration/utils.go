@@ -31,12 +31,24 @@ import (
 func ForKind(kind schema.GroupVersionKind) interface{} {
 	switch kind {
 	// Group=k8s.ovn.org, Version=v1
+	case v1.SchemeGroupVersion.WithKind("BGPAttributes"):
+		return &routeadvertisementsv1.BGPAttributesApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("RouteAdvertisements"):
 		return &routeadvertisementsv1.RouteAdvertisementsApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("RouteAdvertisementsSpec"):
 		return &routeadvertisementsv1.RouteAdvertisementsSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("RouteAdvertisementsStatus"):
 		return &routeadvertisementsv1.RouteAdvertisementsStatusApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("RouteImportPolicy"):
+		return &routeadvertisementsv1.RouteImportPolicyApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("RouteImportPolicySpec"):
+		return &routeadvertisementsv1.RouteImportPolicySpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("RouteImportPolicyStatus"):
+		return &routeadvertisementsv1.RouteImportPolicyStatusApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("RouteImportRule"):
+		return &routeadvertisementsv1.RouteImportRuleApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ServiceAdvertisement"):
+		return &routeadvertisementsv1.ServiceAdvertisementApplyConfiguration{}
 
 	}
 	return nil
@@ -31,6 +31,10 @@ func (c *FakeK8sV1) RouteAdvertisements() v1.RouteAdvertisementsInterface {
 	return newFakeRouteAdvertisements(c)
 }
 
+func (c *FakeK8sV1) RouteImportPolicies() v1.RouteImportPolicyInterface {
+	return newFakeRouteImportPolicies(c)
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeK8sV1) RESTClient() rest.Interface {
@@ -28,6 +28,7 @@ import (
 type K8sV1Interface interface {
 	RESTClient() rest.Interface
 	RouteAdvertisementsGetter
+	RouteImportPoliciesGetter
 }
 
 // K8sV1Client is used to interact with features provided by the k8s.ovn.org group.
@@ -39,6 +40,10 @@ func (c *K8sV1Client) RouteAdvertisements() RouteAdvertisementsInterface {
 	return newRouteAdvertisements(c)
 }
 
+func (c *K8sV1Client) RouteImportPolicies() RouteImportPolicyInterface {
+	return newRouteImportPolicies(c)
+}
+
 // NewForConfig creates a new K8sV1Client for the given config.
 // NewForConfig is equivalent to NewForConfigAndClient(c, httpClient),
 // where httpClient was generated with rest.HTTPClientFor(c).
@@ -54,6 +54,8 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 	// Group=k8s.ovn.org, Version=v1
 	case v1.SchemeGroupVersion.WithResource("routeadvertisements"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.K8s().V1().RouteAdvertisements().Informer()}, nil
+	case v1.SchemeGroupVersion.WithResource("routeimportpolicies"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.K8s().V1().RouteImportPolicies().Informer()}, nil
 
 	}
 
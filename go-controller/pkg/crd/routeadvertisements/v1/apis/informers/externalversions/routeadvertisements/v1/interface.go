@@ -25,6 +25,8 @@ import (
 type Interface interface {
 	// RouteAdvertisements returns a RouteAdvertisementsInformer.
 	RouteAdvertisements() RouteAdvertisementsInformer
+	// RouteImportPolicies returns a RouteImportPolicyInformer.
+	RouteImportPolicies() RouteImportPolicyInformer
 }
 
 type version struct {
@@ -42,3 +44,8 @@ func New(f internalinterfaces.SharedInformerFactory, namespace string, tweakList
 func (v *version) RouteAdvertisements() RouteAdvertisementsInformer {
 	return &routeAdvertisementsInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
 }
+
+// RouteImportPolicies returns a RouteImportPolicyInformer.
+func (v *version) RouteImportPolicies() RouteImportPolicyInformer {
+	return &routeImportPolicyInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
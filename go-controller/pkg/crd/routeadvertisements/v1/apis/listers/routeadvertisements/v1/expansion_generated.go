@@ -20,3 +20,7 @@ package v1
 // RouteAdvertisementsListerExpansion allows custom methods to be added to
 // RouteAdvertisementsLister.
 type RouteAdvertisementsListerExpansion interface{}
+
+// RouteImportPolicyListerExpansion allows custom methods to be added to
+// RouteImportPolicyLister.
+type RouteImportPolicyListerExpansion interface{}
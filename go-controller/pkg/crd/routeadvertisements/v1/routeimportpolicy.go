@@ -0,0 +1,117 @@
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/types"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:path=routeimportpolicies,scope=Cluster,shortName=rip,singular=routeimportpolicy
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// RouteImportPolicy is the Schema for the routeimportpolicies API. It
+// determines which of the BGP routes learned in the VRF of the selected
+// networks are imported into OVN.
+type RouteImportPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RouteImportPolicySpec   `json:"spec,omitempty"`
+	Status RouteImportPolicyStatus `json:"status,omitempty"`
+}
+
+// RouteImportPolicySpec defines the desired state of RouteImportPolicy
+// +kubebuilder:validation:XValidation:rule="!self.networkSelectors.exists(i, i.networkSelectionType != 'DefaultNetwork' && i.networkSelectionType != 'ClusterUserDefinedNetworks')",message="Only DefaultNetwork or ClusterUserDefinedNetworks can be selected"
+type RouteImportPolicySpec struct {
+	// networkSelectors determines which networks the policy applies to. Only
+	// ClusterUserDefinedNetworks and the default network can be selected.
+	// +kubebuilder:validation:Required
+	NetworkSelectors types.NetworkSelectors `json:"networkSelectors"`
+
+	// rules is an ordered list of prefix rules. A route is matched against
+	// the rules in order and the action of the first matching rule is
+	// applied. If no rule matches, defaultAction is applied.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxItems=128
+	// +listType=atomic
+	Rules []RouteImportRule `json:"rules,omitempty"`
+
+	// defaultAction is the action applied to the routes not matching any
+	// rule.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=Allow
+	DefaultAction RouteImportAction `json:"defaultAction,omitempty"`
+
+	// maxRoutes is the maximum number of routes imported on each node for
+	// each of the selected networks. Routes in excess are not imported and
+	// the RouteImportLimitReached condition is reported. If not specified,
+	// the number of imported routes is not limited.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	MaxRoutes *int32 `json:"maxRoutes,omitempty"`
+}
+
+// RouteImportRule matches routes by destination prefix, in the same way as a
+// prefix list entry does.
+// +kubebuilder:validation:XValidation:rule="!has(self.ge) || !has(self.le) || self.ge <= self.le",message="'ge' must not be greater than 'le'"
+type RouteImportRule struct {
+	// prefix is the CIDR the destination of the route has to be contained
+	// in. Without ge or le, the destination has to match the prefix exactly.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule="isCIDR(self)",message="prefix must be a valid CIDR"
+	Prefix string `json:"prefix"`
+
+	// ge is the minimum prefix length of the destination of the route.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=128
+	GE *int32 `json:"ge,omitempty"`
+
+	// le is the maximum prefix length of the destination of the route.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=128
+	LE *int32 `json:"le,omitempty"`
+
+	// action determines whether a matching route is imported or not.
+	// +kubebuilder:validation:Required
+	Action RouteImportAction `json:"action"`
+}
+
+// RouteImportAction determines whether a route is imported.
+// +kubebuilder:validation:Enum=Allow;Deny
+type RouteImportAction string
+
+const (
+	// RouteImportAllow determines that a route is imported.
+	RouteImportAllow RouteImportAction = "Allow"
+
+	// RouteImportDeny determines that a route is not imported.
+	RouteImportDeny RouteImportAction = "Deny"
+)
+
+// RouteImportPolicyStatus defines the observed state of RouteImportPolicy.
+type RouteImportPolicyStatus struct {
+	// conditions is an array of condition objects indicating details about
+	// status of RouteImportPolicy object. Each zone reports whether the
+	// maxRoutes limit has been reached through its own condition.
+	// +kubebuilder:validation:Optional
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+}
+
+// RouteImportPolicyList contains a list of RouteImportPolicy
+// +kubebuilder:object:root=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type RouteImportPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RouteImportPolicy `json:"items"`
+}
@@ -27,6 +27,8 @@ type RouteAdvertisements struct {
 // RouteAdvertisementsSpec defines the desired state of RouteAdvertisements
 // +kubebuilder:validation:XValidation:rule="(!has(self.nodeSelector.matchLabels) && !has(self.nodeSelector.matchExpressions)) || !('PodNetwork' in self.advertisements)",message="If 'PodNetwork' is selected for advertisement, a 'nodeSelector' can't be specified as it needs to be advertised on all nodes"
 // +kubebuilder:validation:XValidation:rule="!self.networkSelectors.exists(i, i.networkSelectionType != 'DefaultNetwork' && i.networkSelectionType != 'ClusterUserDefinedNetworks')",message="Only DefaultNetwork or ClusterUserDefinedNetworks can be selected"
+// +kubebuilder:validation:XValidation:rule="!has(self.services) || 'Services' in self.advertisements",message="'services' can only be specified if 'Services' is selected for advertisement"
+// +kubebuilder:validation:XValidation:rule="!has(self.bgpAttributes) || self.bgpAttributes.all(a, a.advertisement in self.advertisements)",message="'bgpAttributes' can only be specified for selected advertisements"
 type RouteAdvertisementsSpec struct {
 	// targetVRF determines which VRF the routes should be advertised in.
 	// +kubebuilder:validation:Optional
@@ -51,13 +53,77 @@ type RouteAdvertisementsSpec struct {
 	// advertisements determines what is advertised.
 	// +kubebuilder:validation:Required
 	// +kubebuilder:validation:MinItems=1
-	// +kubebuilder:validation:MaxItems=2
+	// +kubebuilder:validation:MaxItems=3
 	// +kubebuilder:validation:XValidation:rule="self.all(x, self.exists_one(y, x == y))"
 	Advertisements []AdvertisementType `json:"advertisements,omitempty"`
+
+	// services determines which service addresses are advertised when
+	// 'Services' is selected for advertisement. LoadBalancer ingress IPs are
+	// always advertised.
+	// +kubebuilder:validation:Optional
+	Services *ServiceAdvertisement `json:"services,omitempty"`
+
+	// bgpAttributes determines the BGP attributes the advertised prefixes are
+	// tagged with, per advertisement type.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxItems=3
+	// +listType=map
+	// +listMapKey=advertisement
+	BGPAttributes []BGPAttributes `json:"bgpAttributes,omitempty"`
+}
+
+// BGPAttributes determines the BGP attributes of the prefixes advertised for
+// an advertisement type.
+// +kubebuilder:validation:XValidation:rule="has(self.communities) || has(self.largeCommunities) || has(self.localPref)",message="At least one of 'communities', 'largeCommunities' or 'localPref' has to be specified"
+type BGPAttributes struct {
+	// advertisement is the advertisement type whose prefixes are tagged with
+	// these attributes.
+	// +kubebuilder:validation:Required
+	Advertisement AdvertisementType `json:"advertisement"`
+
+	// communities is a list of BGP standard communities, in the
+	// `<as number>:<value>` format, the prefixes are tagged with.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxItems=16
+	// +kubebuilder:validation:items:Pattern=`^[0-9]{1,5}:[0-9]{1,5}$`
+	// +kubebuilder:validation:XValidation:rule="self.all(c, c.split(':').all(v, int(v) <= 65535))",message="community values must not be greater than 65535"
+	// +listType=set
+	Communities []string `json:"communities,omitempty"`
+
+	// largeCommunities is a list of BGP large communities, in the
+	// `<global administrator>:<local data 1>:<local data 2>` format, the
+	// prefixes are tagged with.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxItems=16
+	// +kubebuilder:validation:items:Pattern=`^[0-9]{1,10}:[0-9]{1,10}:[0-9]{1,10}$`
+	// +kubebuilder:validation:XValidation:rule="self.all(c, c.split(':').all(v, int(v) <= 4294967295))",message="large community values must not be greater than 4294967295"
+	// +listType=set
+	LargeCommunities []string `json:"largeCommunities,omitempty"`
+
+	// localPref is the BGP local preference the prefixes are advertised with.
+	// It is only sent to iBGP neighbors.
+	// +kubebuilder:validation:Optional
+	LocalPref *uint32 `json:"localPref,omitempty"`
+}
+
+// ServiceAdvertisement determines which service addresses are advertised in
+// addition to the LoadBalancer ingress IPs. The LoadBalancer ingress IPs and
+// ExternalIPs of services with externalTrafficPolicy set to Local are only
+// advertised from the nodes that have local ready endpoints for the service.
+type ServiceAdvertisement struct {
+	// clusterIPs determines if the ClusterIPs of the services are advertised.
+	// ClusterIPs are advertised from all the selected nodes.
+	// +kubebuilder:validation:Optional
+	ClusterIPs bool `json:"clusterIPs,omitempty"`
+
+	// externalIPs determines if the ExternalIPs of the services are
+	// advertised.
+	// +kubebuilder:validation:Optional
+	ExternalIPs bool `json:"externalIPs,omitempty"`
 }
 
 // AdvertisementType determines the type of advertisement.
-// +kubebuilder:validation:Enum=PodNetwork;EgressIP
+// +kubebuilder:validation:Enum=PodNetwork;EgressIP;Services
 type AdvertisementType string
 
 const (
@@ -66,6 +132,9 @@ const (
 
 	// EgressIP determines that egress IPs are being advertised.
 	EgressIP AdvertisementType = "EgressIP"
+
+	// Services determines that the IPs of the services are being advertised.
+	Services AdvertisementType = "Services"
 )
 
 // RouteAdvertisementsStatus defines the observed state of RouteAdvertisements.
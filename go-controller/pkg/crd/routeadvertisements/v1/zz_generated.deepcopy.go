@@ -26,6 +26,37 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BGPAttributes) DeepCopyInto(out *BGPAttributes) {
+	*out = *in
+	if in.Communities != nil {
+		in, out := &in.Communities, &out.Communities
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LargeCommunities != nil {
+		in, out := &in.LargeCommunities, &out.LargeCommunities
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LocalPref != nil {
+		in, out := &in.LocalPref, &out.LocalPref
+		*out = new(uint32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BGPAttributes.
+func (in *BGPAttributes) DeepCopy() *BGPAttributes {
+	if in == nil {
+		return nil
+	}
+	out := new(BGPAttributes)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RouteAdvertisements) DeepCopyInto(out *RouteAdvertisements) {
 	*out = *in
@@ -104,6 +135,18 @@ func (in *RouteAdvertisementsSpec) DeepCopyInto(out *RouteAdvertisementsSpec) {
 		*out = make([]AdvertisementType, len(*in))
 		copy(*out, *in)
 	}
+	if in.Services != nil {
+		in, out := &in.Services, &out.Services
+		*out = new(ServiceAdvertisement)
+		**out = **in
+	}
+	if in.BGPAttributes != nil {
+		in, out := &in.BGPAttributes, &out.BGPAttributes
+		*out = make([]BGPAttributes, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -139,3 +182,164 @@ func (in *RouteAdvertisementsStatus) DeepCopy() *RouteAdvertisementsStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteImportPolicy) DeepCopyInto(out *RouteImportPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteImportPolicy.
+func (in *RouteImportPolicy) DeepCopy() *RouteImportPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteImportPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RouteImportPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteImportPolicyList) DeepCopyInto(out *RouteImportPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RouteImportPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteImportPolicyList.
+func (in *RouteImportPolicyList) DeepCopy() *RouteImportPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteImportPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RouteImportPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteImportPolicySpec) DeepCopyInto(out *RouteImportPolicySpec) {
+	*out = *in
+	if in.NetworkSelectors != nil {
+		in, out := &in.NetworkSelectors, &out.NetworkSelectors
+		*out = make(types.NetworkSelectors, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]RouteImportRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MaxRoutes != nil {
+		in, out := &in.MaxRoutes, &out.MaxRoutes
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteImportPolicySpec.
+func (in *RouteImportPolicySpec) DeepCopy() *RouteImportPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteImportPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteImportPolicyStatus) DeepCopyInto(out *RouteImportPolicyStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteImportPolicyStatus.
+func (in *RouteImportPolicyStatus) DeepCopy() *RouteImportPolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteImportPolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteImportRule) DeepCopyInto(out *RouteImportRule) {
+	*out = *in
+	if in.GE != nil {
+		in, out := &in.GE, &out.GE
+		*out = new(int32)
+		**out = **in
+	}
+	if in.LE != nil {
+		in, out := &in.LE, &out.LE
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteImportRule.
+func (in *RouteImportRule) DeepCopy() *RouteImportRule {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteImportRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceAdvertisement) DeepCopyInto(out *ServiceAdvertisement) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAdvertisement.
+func (in *ServiceAdvertisement) DeepCopy() *ServiceAdvertisement {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceAdvertisement)
+	in.DeepCopyInto(out)
+	return out
+}
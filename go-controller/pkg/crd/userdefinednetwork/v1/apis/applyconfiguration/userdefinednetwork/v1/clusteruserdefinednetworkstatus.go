@@ -25,6 +25,7 @@ import (
 // with apply.
 type ClusterUserDefinedNetworkStatusApplyConfiguration struct {
 	Conditions []metav1.ConditionApplyConfiguration `json:"conditions,omitempty"`
+	Usage      *NetworkUsageApplyConfiguration      `json:"usage,omitempty"`
 }
 
 // ClusterUserDefinedNetworkStatusApplyConfiguration constructs a declarative configuration of the ClusterUserDefinedNetworkStatus type for use with
@@ -45,3 +46,11 @@ func (b *ClusterUserDefinedNetworkStatusApplyConfiguration) WithConditions(value
 	}
 	return b
 }
+
+// WithUsage sets the Usage field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Usage field is set to the value of the last call.
+func (b *ClusterUserDefinedNetworkStatusApplyConfiguration) WithUsage(value *NetworkUsageApplyConfiguration) *ClusterUserDefinedNetworkStatusApplyConfiguration {
+	b.Usage = value
+	return b
+}
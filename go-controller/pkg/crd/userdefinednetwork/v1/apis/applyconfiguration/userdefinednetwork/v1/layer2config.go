@@ -26,7 +26,7 @@ import (
 type Layer2ConfigApplyConfiguration struct {
 	Role                  *userdefinednetworkv1.NetworkRole    `json:"role,omitempty"`
 	MTU                   *int32                               `json:"mtu,omitempty"`
-	Subnets               *userdefinednetworkv1.DualStackCIDRs `json:"subnets,omitempty"`
+	Subnets               *userdefinednetworkv1.SubnetCIDRs    `json:"subnets,omitempty"`
 	ReservedSubnets       []userdefinednetworkv1.CIDR          `json:"reservedSubnets,omitempty"`
 	InfrastructureSubnets []userdefinednetworkv1.CIDR          `json:"infrastructureSubnets,omitempty"`
 	DefaultGatewayIPs     *userdefinednetworkv1.DualStackIPs   `json:"defaultGatewayIPs,omitempty"`
@@ -59,7 +59,7 @@ func (b *Layer2ConfigApplyConfiguration) WithMTU(value int32) *Layer2ConfigApply
 // WithSubnets sets the Subnets field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Subnets field is set to the value of the last call.
-func (b *Layer2ConfigApplyConfiguration) WithSubnets(value userdefinednetworkv1.DualStackCIDRs) *Layer2ConfigApplyConfiguration {
+func (b *Layer2ConfigApplyConfiguration) WithSubnets(value userdefinednetworkv1.SubnetCIDRs) *Layer2ConfigApplyConfiguration {
 	b.Subnets = &value
 	return b
 }
@@ -24,10 +24,11 @@ import (
 // Layer3ConfigApplyConfiguration represents a declarative configuration of the Layer3Config type for use
 // with apply.
 type Layer3ConfigApplyConfiguration struct {
-	Role        *userdefinednetworkv1.NetworkRole    `json:"role,omitempty"`
-	MTU         *int32                               `json:"mtu,omitempty"`
-	Subnets     []Layer3SubnetApplyConfiguration     `json:"subnets,omitempty"`
-	JoinSubnets *userdefinednetworkv1.DualStackCIDRs `json:"joinSubnets,omitempty"`
+	Role           *userdefinednetworkv1.NetworkRole    `json:"role,omitempty"`
+	MTU            *int32                               `json:"mtu,omitempty"`
+	Subnets        []Layer3SubnetApplyConfiguration     `json:"subnets,omitempty"`
+	ExcludeSubnets []userdefinednetworkv1.CIDR          `json:"excludeSubnets,omitempty"`
+	JoinSubnets    *userdefinednetworkv1.DualStackCIDRs `json:"joinSubnets,omitempty"`
 }
 
 // Layer3ConfigApplyConfiguration constructs a declarative configuration of the Layer3Config type for use with
@@ -65,6 +66,16 @@ func (b *Layer3ConfigApplyConfiguration) WithSubnets(values ...*Layer3SubnetAppl
 	return b
 }
 
+// WithExcludeSubnets adds the given value to the ExcludeSubnets field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the ExcludeSubnets field.
+func (b *Layer3ConfigApplyConfiguration) WithExcludeSubnets(values ...userdefinednetworkv1.CIDR) *Layer3ConfigApplyConfiguration {
+	for i := range values {
+		b.ExcludeSubnets = append(b.ExcludeSubnets, values[i])
+	}
+	return b
+}
+
 // WithJoinSubnets sets the JoinSubnets field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the JoinSubnets field is set to the value of the last call.
@@ -25,6 +25,7 @@ import (
 // with apply.
 type UserDefinedNetworkStatusApplyConfiguration struct {
 	Conditions []metav1.ConditionApplyConfiguration `json:"conditions,omitempty"`
+	Usage      *NetworkUsageApplyConfiguration      `json:"usage,omitempty"`
 }
 
 // UserDefinedNetworkStatusApplyConfiguration constructs a declarative configuration of the UserDefinedNetworkStatus type for use with
@@ -45,3 +46,11 @@ func (b *UserDefinedNetworkStatusApplyConfiguration) WithConditions(values ...*m
 	}
 	return b
 }
+
+// WithUsage sets the Usage field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Usage field is set to the value of the last call.
+func (b *UserDefinedNetworkStatusApplyConfiguration) WithUsage(value *NetworkUsageApplyConfiguration) *UserDefinedNetworkStatusApplyConfiguration {
+	b.Usage = value
+	return b
+}
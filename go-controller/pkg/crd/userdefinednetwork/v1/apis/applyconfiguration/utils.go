@@ -41,6 +41,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &userdefinednetworkv1.ClusterUserDefinedNetworkStatusApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("IPAMConfig"):
 		return &userdefinednetworkv1.IPAMConfigApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("IPFamilyUsage"):
+		return &userdefinednetworkv1.IPFamilyUsageApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("Layer2Config"):
 		return &userdefinednetworkv1.Layer2ConfigApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("Layer3Config"):
@@ -49,8 +51,22 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &userdefinednetworkv1.Layer3SubnetApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("LocalnetConfig"):
 		return &userdefinednetworkv1.LocalnetConfigApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("NetworkPeering"):
+		return &userdefinednetworkv1.NetworkPeeringApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("NetworkPeeringSpec"):
+		return &userdefinednetworkv1.NetworkPeeringSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("NetworkPeeringStatus"):
+		return &userdefinednetworkv1.NetworkPeeringStatusApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("NetworkSpec"):
 		return &userdefinednetworkv1.NetworkSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("NetworkUsage"):
+		return &userdefinednetworkv1.NetworkUsageApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("PeeredNetwork"):
+		return &userdefinednetworkv1.PeeredNetworkApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("PeeringPort"):
+		return &userdefinednetworkv1.PeeringPortApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("TrunkVLANConfig"):
+		return &userdefinednetworkv1.TrunkVLANConfigApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("UserDefinedNetwork"):
 		return &userdefinednetworkv1.UserDefinedNetworkApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("UserDefinedNetworkSpec"):
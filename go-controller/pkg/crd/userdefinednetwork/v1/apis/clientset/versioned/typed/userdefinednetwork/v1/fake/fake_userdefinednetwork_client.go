@@ -31,6 +31,10 @@ func (c *FakeK8sV1) ClusterUserDefinedNetworks() v1.ClusterUserDefinedNetworkInt
 	return newFakeClusterUserDefinedNetworks(c)
 }
 
+func (c *FakeK8sV1) NetworkPeerings() v1.NetworkPeeringInterface {
+	return newFakeNetworkPeerings(c)
+}
+
 func (c *FakeK8sV1) UserDefinedNetworks(namespace string) v1.UserDefinedNetworkInterface {
 	return newFakeUserDefinedNetworks(c, namespace)
 }
@@ -54,6 +54,8 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 	// Group=k8s.ovn.org, Version=v1
 	case v1.SchemeGroupVersion.WithResource("clusteruserdefinednetworks"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.K8s().V1().ClusterUserDefinedNetworks().Informer()}, nil
+	case v1.SchemeGroupVersion.WithResource("networkpeerings"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.K8s().V1().NetworkPeerings().Informer()}, nil
 	case v1.SchemeGroupVersion.WithResource("userdefinednetworks"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.K8s().V1().UserDefinedNetworks().Informer()}, nil
 
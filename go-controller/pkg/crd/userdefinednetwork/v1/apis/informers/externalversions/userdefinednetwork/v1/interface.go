@@ -25,6 +25,8 @@ import (
 type Interface interface {
 	// ClusterUserDefinedNetworks returns a ClusterUserDefinedNetworkInformer.
 	ClusterUserDefinedNetworks() ClusterUserDefinedNetworkInformer
+	// NetworkPeerings returns a NetworkPeeringInformer.
+	NetworkPeerings() NetworkPeeringInformer
 	// UserDefinedNetworks returns a UserDefinedNetworkInformer.
 	UserDefinedNetworks() UserDefinedNetworkInformer
 }
@@ -45,6 +47,11 @@ func (v *version) ClusterUserDefinedNetworks() ClusterUserDefinedNetworkInformer
 	return &clusterUserDefinedNetworkInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
 }
 
+// NetworkPeerings returns a NetworkPeeringInformer.
+func (v *version) NetworkPeerings() NetworkPeeringInformer {
+	return &networkPeeringInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // UserDefinedNetworks returns a UserDefinedNetworkInformer.
 func (v *version) UserDefinedNetworks() UserDefinedNetworkInformer {
 	return &userDefinedNetworkInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
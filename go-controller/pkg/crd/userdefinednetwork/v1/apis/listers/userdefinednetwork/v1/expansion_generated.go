@@ -21,6 +21,10 @@ package v1
 // ClusterUserDefinedNetworkLister.
 type ClusterUserDefinedNetworkListerExpansion interface{}
 
+// NetworkPeeringListerExpansion allows custom methods to be added to
+// NetworkPeeringLister.
+type NetworkPeeringListerExpansion interface{}
+
 // UserDefinedNetworkListerExpansion allows custom methods to be added to
 // UserDefinedNetworkLister.
 type UserDefinedNetworkListerExpansion interface{}
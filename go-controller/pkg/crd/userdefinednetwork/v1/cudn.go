@@ -33,7 +33,8 @@ type ClusterUserDefinedNetworkSpec struct {
 	// +kubebuilder:validation:XValidation:rule="has(self.topology) && self.topology == 'Layer3' ? has(self.layer3): !has(self.layer3)", message="spec.layer3 is required when topology is Layer3 and forbidden otherwise"
 	// +kubebuilder:validation:XValidation:rule="has(self.topology) && self.topology == 'Layer2' ? has(self.layer2): !has(self.layer2)", message="spec.layer2 is required when topology is Layer2 and forbidden otherwise"
 	// +kubebuilder:validation:XValidation:rule="has(self.topology) && self.topology == 'Localnet' ? has(self.localnet): !has(self.localnet)", message="spec.localnet is required when topology is Localnet and forbidden otherwise"
-	// +kubebuilder:validation:XValidation:rule="self == oldSelf", message="Network spec is immutable"
+	// +kubebuilder:validation:XValidation:rule="self.topology == oldSelf.topology", message="Network topology is immutable"
+	// +kubebuilder:validation:XValidation:rule="has(self.localnet) == has(oldSelf.localnet) && (!has(self.localnet) || self.localnet == oldSelf.localnet)", message="Localnet spec is immutable"
 	// +required
 	Network NetworkSpec `json:"network"`
 }
@@ -74,6 +75,10 @@ type ClusterUserDefinedNetworkStatus struct {
 	// +listMapKey=type
 	// +optional
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// Usage reports how much of the network address space is in use.
+	// +optional
+	Usage *NetworkUsage `json:"usage,omitempty"`
 }
 
 // ClusterUserDefinedNetworkList contains a list of ClusterUserDefinedNetwork.
@@ -181,6 +186,10 @@ type LocalnetConfig struct {
 	//   When "Access" is set, OVN-Kubernetes configures the network logical switch port in access mode.
 	// vlan.access is the access VLAN configuration.
 	// vlan.access.id is the VLAN ID (VID) to be set on the network logical switch port.
+	//   When "Trunk" is set, OVN-Kubernetes lets the 802.1Q tagged traffic of the connected pods through.
+	// vlan.trunk is the trunk VLAN configuration.
+	// vlan.trunk.allowedVLANs is the list of VLAN IDs, or ranges of VLAN IDs, the pods are allowed to send and receive.
+	// vlan.trunk.nativeVLAN is the VLAN ID untagged traffic of the pods is sent and received on.
 	// vlan is optional, when omitted the underlying network default VLAN will be used (usually `1`).
 	// When set, OVN-Kubernetes will apply VLAN configuration to the SDN infra and to the connected pods.
 	//
@@ -198,18 +207,49 @@ type AccessVLANConfig struct {
 	ID int32 `json:"id"`
 }
 
-// +kubebuilder:validation:Enum=Access
+// TrunkVLANConfig describes a trunk VLAN configuration.
+type TrunkVLANConfig struct {
+	// allowedVLANs is the list of VLAN IDs, or ranges of VLAN IDs in the
+	// "<start>-<end>" format, the tagged traffic of the pods is allowed on.
+	// +required
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=64
+	// +listType=set
+	AllowedVLANs []VLANIDRange `json:"allowedVLANs"`
+
+	// nativeVLAN is the VLAN ID the untagged traffic of the pods is sent and
+	// received on. When omitted, untagged traffic is dropped.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=4094
+	NativeVLAN *int32 `json:"nativeVLAN,omitempty"`
+}
+
+// VLANIDRange is a VLAN ID, or a range of VLAN IDs in the "<start>-<end>"
+// format. VLAN IDs should be higher than 0 and lower than 4095.
+// +kubebuilder:validation:MaxLength=9
+// +kubebuilder:validation:Pattern=`^[0-9]{1,4}(-[0-9]{1,4})?$`
+// +kubebuilder:validation:XValidation:rule="self.split('-').all(id, int(id) >= 1 && int(id) <= 4094)", message="VLAN IDs should be higher than 0 and lower than 4095"
+// +kubebuilder:validation:XValidation:rule="!self.contains('-') || int(self.split('-')[0]) <= int(self.split('-')[1])", message="VLAN ID range start should not be higher than its end"
+type VLANIDRange string
+
+// +kubebuilder:validation:Enum=Access;Trunk
 type VLANMode string
 
-const VLANModeAccess VLANMode = "Access"
+const (
+	VLANModeAccess VLANMode = "Access"
+	VLANModeTrunk  VLANMode = "Trunk"
+)
 
 // VLANConfig describes the network VLAN configuration.
 // +union
 // +kubebuilder:validation:XValidation:rule="has(self.mode) && self.mode == 'Access' ? has(self.access): !has(self.access)", message="vlan access config is required when vlan mode is 'Access', and forbidden otherwise"
+// +kubebuilder:validation:XValidation:rule="has(self.mode) && self.mode == 'Trunk' ? has(self.trunk): !has(self.trunk)", message="vlan trunk config is required when vlan mode is 'Trunk', and forbidden otherwise"
 type VLANConfig struct {
 	// mode describe the network VLAN mode.
-	// Allowed value is "Access".
+	// Allowed values are "Access" and "Trunk".
 	// Access sets the network logical switch port in access mode, according to the config.
+	// Trunk lets the tagged traffic of the allowed VLANs through, according to the config.
 	// +required
 	// +unionDiscriminator
 	Mode VLANMode `json:"mode"`
@@ -217,4 +257,8 @@ type VLANConfig struct {
 	// Access is the access VLAN configuration
 	// +optional
 	Access *AccessVLANConfig `json:"access"`
+
+	// Trunk is the trunk VLAN configuration
+	// +optional
+	Trunk *TrunkVLANConfig `json:"trunk,omitempty"`
 }
@@ -0,0 +1,131 @@
+package v1
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+// NetworkPeering connects two primary user-defined networks through a shared router, allowing their pods to talk
+// to each other.
+//
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:path=networkpeerings,scope=Cluster
+// +kubebuilder:singular=networkpeering
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+type NetworkPeering struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// +kubebuilder:validation:Required
+	// +required
+	Spec NetworkPeeringSpec `json:"spec"`
+	// +optional
+	Status NetworkPeeringStatus `json:"status,omitempty"`
+}
+
+// NetworkPeeringSpec defines the desired state of NetworkPeering.
+type NetworkPeeringSpec struct {
+	// networks are the two networks connected by the peering.
+	// Both networks must be primary networks of the Layer3 or Layer2 topology, and their subnets must not overlap.
+	//
+	// +kubebuilder:validation:MinItems=2
+	// +kubebuilder:validation:MaxItems=2
+	// +kubebuilder:validation:XValidation:rule="size(self) != 2 || self[0].kind != self[1].kind || self[0].name != self[1].name || (has(self[0].__namespace__) ? self[0].__namespace__ : '') != (has(self[1].__namespace__) ? self[1].__namespace__ : '')", message="networks must be different"
+	// +required
+	Networks []PeeredNetwork `json:"networks"`
+}
+
+// PeeredNetworkKind is the kind of the object a peered network is created by.
+// +kubebuilder:validation:Enum=UserDefinedNetwork;ClusterUserDefinedNetwork
+type PeeredNetworkKind string
+
+const (
+	PeeredNetworkKindUserDefinedNetwork        PeeredNetworkKind = "UserDefinedNetwork"
+	PeeredNetworkKindClusterUserDefinedNetwork PeeredNetworkKind = "ClusterUserDefinedNetwork"
+)
+
+// PeeredNetwork references a network connected by a NetworkPeering, and restricts the traffic the peer network may
+// exchange with it.
+//
+// +kubebuilder:validation:XValidation:rule="self.kind == 'UserDefinedNetwork' ? has(self.__namespace__) : !has(self.__namespace__)", message="namespace is required for UserDefinedNetwork and forbidden otherwise"
+// +kubebuilder:validation:XValidation:rule="self.kind == 'ClusterUserDefinedNetwork' || !has(self.namespaceSelector)", message="namespaceSelector is only allowed for ClusterUserDefinedNetwork"
+type PeeredNetwork struct {
+	// kind is the kind of the object the network is created by.
+	//
+	// +required
+	Kind PeeredNetworkKind `json:"kind"`
+
+	// name is the name of the UserDefinedNetwork or ClusterUserDefinedNetwork the network is created by.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	// +required
+	Name string `json:"name"`
+
+	// namespace is the namespace of the UserDefinedNetwork the network is created by.
+	// Required for UserDefinedNetwork, forbidden for ClusterUserDefinedNetwork.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// namespaceSelector restricts the namespaces of a ClusterUserDefinedNetwork whose pods may talk to the peer
+	// network. When omitted, pods of all the namespaces of the network may talk to the peer network.
+	// Only allowed for ClusterUserDefinedNetwork.
+	//
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+
+	// ports restricts the ports of the pods of this network the peer network may connect to.
+	// When omitted, the peer network may connect to any port.
+	// Replies to connections initiated by the pods of this network are always allowed.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=20
+	// +optional
+	Ports []PeeringPort `json:"ports,omitempty"`
+}
+
+// PeeringProtocol is the protocol of a PeeringPort.
+// +kubebuilder:validation:Enum=TCP;UDP;SCTP
+type PeeringProtocol string
+
+const (
+	PeeringProtocolTCP  PeeringProtocol = "TCP"
+	PeeringProtocolUDP  PeeringProtocol = "UDP"
+	PeeringProtocolSCTP PeeringProtocol = "SCTP"
+)
+
+// PeeringPort is a port the peer network may connect to.
+type PeeringPort struct {
+	// protocol is the protocol of the port.
+	//
+	// +required
+	Protocol PeeringProtocol `json:"protocol"`
+
+	// port is the port number. When omitted, all the ports of the protocol are allowed.
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +optional
+	Port int32 `json:"port,omitempty"`
+}
+
+// NetworkPeeringStatus contains the observed status of the NetworkPeering.
+type NetworkPeeringStatus struct {
+	// conditions report whether the networks are connected, or why they can't be.
+	//
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// NetworkPeeringList contains a list of NetworkPeering.
+// +kubebuilder:object:root=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type NetworkPeeringList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NetworkPeering `json:"items"`
+}
@@ -25,6 +25,11 @@ const (
 
 // +kubebuilder:validation:XValidation:rule="!has(self.joinSubnets) || has(self.role) && self.role == 'Primary'", message="JoinSubnets is only supported for Primary network"
 // +kubebuilder:validation:XValidation:rule="!has(self.subnets) || !has(self.mtu) || !self.subnets.exists_one(i, isCIDR(i.cidr) && cidr(i.cidr).ip().family() == 6) || self.mtu >= 1280", message="MTU should be greater than or equal to 1280 when IPv6 subnet is used"
+// +kubebuilder:validation:XValidation:rule="!has(self.excludeSubnets) || self.excludeSubnets.all(e, self.subnets.exists(s, cidr(s.cidr).containsCIDR(cidr(e))))",message="excludeSubnets must be subnetworks of the networks specified in the subnets field",fieldPath=".excludeSubnets"
+// +kubebuilder:validation:XValidation:rule="!has(self.excludeSubnets) || self.excludeSubnets.all(e, self.subnets.all(s, !cidr(s.cidr).containsCIDR(cidr(e)) || !has(s.hostSubnet) || cidr(e).prefixLength() >= s.hostSubnet))",message="excludeSubnets must not span multiple host subnets",fieldPath=".excludeSubnets"
+// +kubebuilder:validation:XValidation:rule="self.role == oldSelf.role && has(self.mtu) == has(oldSelf.mtu) && (!has(self.mtu) || self.mtu == oldSelf.mtu) && has(self.joinSubnets) == has(oldSelf.joinSubnets) && (!has(self.joinSubnets) || self.joinSubnets == oldSelf.joinSubnets) && has(self.excludeSubnets) == has(oldSelf.excludeSubnets) && (!has(self.excludeSubnets) || self.excludeSubnets == oldSelf.excludeSubnets)", message="Layer3 fields other than subnets are immutable"
+// +kubebuilder:validation:XValidation:rule="!has(oldSelf.subnets) || has(self.subnets) && oldSelf.subnets.all(s, self.subnets.exists(n, n == s))", message="Subnets can only be appended to"
+// +kubebuilder:validation:XValidation:rule="!has(oldSelf.subnets) || !has(self.subnets) || self.subnets.all(n, !isCIDR(n.cidr) || oldSelf.subnets.exists(s, isCIDR(s.cidr) && cidr(s.cidr).ip().family() == cidr(n.cidr).ip().family()))", message="Appended subnets must be of the IP families already used by the network"
 type Layer3Config struct {
 	// Role describes the network role in the pod.
 	//
@@ -48,15 +53,34 @@ type Layer3Config struct {
 
 	// Subnets are used for the pod network across the cluster.
 	//
-	// Dual-stack clusters may set 2 subnets (one for each IP family), otherwise only 1 subnet is allowed.
+	// Dual-stack clusters may set subnets of both IP families. Subnets must not overlap.
 	// Given subnet is split into smaller subnets for every node.
+	// Subnets can be appended to on a live network when the existing ones run out of node subnets,
+	// as long as the appended subnets are of the IP families already used by the network.
+	// Existing subnets can't be changed or removed.
 	//
 	// +kubebuilder:validation:MinItems=1
-	// +kubebuilder:validation:MaxItems=2
+	// +kubebuilder:validation:MaxItems=8
 	// +required
-	// +kubebuilder:validation:XValidation:rule="size(self) != 2 || !isCIDR(self[0].cidr) || !isCIDR(self[1].cidr) || cidr(self[0].cidr).ip().family() != cidr(self[1].cidr).ip().family()", message="When 2 CIDRs are set, they must be from different IP families"
+	// +kubebuilder:validation:XValidation:rule="!self.all(x, isCIDR(x.cidr)) || self.all(x, self.exists_one(y, cidr(x.cidr).containsCIDR(cidr(y.cidr)) || cidr(y.cidr).containsCIDR(cidr(x.cidr))))", message="Subnets must not overlap"
 	Subnets []Layer3Subnet `json:"subnets,omitempty"`
 
+	// excludeSubnets is a list of CIDRs that OVN-Kubernetes won't assign to pods.
+	// excludeSubnets is optional. When omitted, all IP addresses of the node subnets are subject to assignment.
+	// Each item must be in range of one of the CIDRs in `subnets`, and must not span multiple node subnets:
+	// its prefix length can't be shorter than the `hostSubnet` of the subnet it belongs to.
+	// An excluded CIDR is applied on whichever node gets the node subnet containing it, and can't contain
+	// the node subnet addresses used by OVN-Kubernetes for the gateway and management port (the first and second ones).
+	// For example, given: `subnets: [{cidr: "10.0.0.0/16", hostSubnet: 24}]`, `excludeSubnets: ["10.0.3.200/30"]`,
+	// the addresses `10.0.3.200` to `10.0.3.203` won't be assigned to pods on the node given the `10.0.3.0/24` subnet.
+	// The maximum number of entries allowed is 25.
+	// The format should match standard CIDR notation (for example, "10.128.0.0/16").
+	//
+	// +optional
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=25
+	ExcludeSubnets []CIDR `json:"excludeSubnets,omitempty"`
+
 	// JoinSubnets are used inside the OVN network topology.
 	//
 	// Dual-stack clusters may set 2 subnets (one for each IP family), otherwise only 1 subnet is allowed.
@@ -93,7 +117,7 @@ type Layer3Subnet struct {
 // +kubebuilder:validation:XValidation:rule="!has(self.subnets) || !has(self.mtu) || !self.subnets.exists_one(i, isCIDR(i) && cidr(i).ip().family() == 6) || self.mtu >= 1280", message="MTU should be greater than or equal to 1280 when IPv6 subnet is used"
 // +kubebuilder:validation:XValidation:rule="!has(self.defaultGatewayIPs) || has(self.role) && self.role == 'Primary'", message="defaultGatewayIPs is only supported for Primary network"
 // +kubebuilder:validation:XValidation:rule="!has(self.defaultGatewayIPs) || self.defaultGatewayIPs.all(ip, self.subnets.exists(subnet, cidr(subnet).containsIP(ip)))", message="defaultGatewayIPs must belong to one of the subnets specified in the subnets field"
-// +kubebuilder:validation:XValidation:rule="!has(self.defaultGatewayIPs) || size(self.defaultGatewayIPs) == size(self.subnets)", message="defaultGatewayIPs must be specified for all IP families"
+// +kubebuilder:validation:XValidation:rule="!has(self.defaultGatewayIPs) || self.subnets.all(subnet, !isCIDR(subnet) || self.defaultGatewayIPs.exists(ip, isIP(ip) && ip(ip).family() == cidr(subnet).ip().family()))", message="defaultGatewayIPs must be specified for all IP families"
 // +kubebuilder:validation:XValidation:rule="!has(self.reservedSubnets) || has(self.subnets)", message="reservedSubnets must be unset when subnets is unset"
 // +kubebuilder:validation:XValidation:rule="!has(self.reservedSubnets) || has(self.role) && self.role == 'Primary'", message="reservedSubnets is only supported for Primary network"
 // +kubebuilder:validation:XValidation:rule="!has(self.infrastructureSubnets) || has(self.subnets)", message="infrastructureSubnets must be unset when subnets is unset"
@@ -102,6 +126,11 @@ type Layer3Subnet struct {
 // +kubebuilder:validation:XValidation:rule="!has(self.reservedSubnets) || self.reservedSubnets.all(e, self.subnets.exists(s, cidr(s).containsCIDR(cidr(e))))",message="reservedSubnets must be subnetworks of the networks specified in the subnets field",fieldPath=".reservedSubnets"
 // +kubebuilder:validation:XValidation:rule="!has(self.infrastructureSubnets) || self.infrastructureSubnets.all(e, self.subnets.exists(s, cidr(s).containsCIDR(cidr(e))))",message="infrastructureSubnets must be subnetworks of the networks specified in the subnets field",fieldPath=".infrastructureSubnets"
 // +kubebuilder:validation:XValidation:rule="!has(self.infrastructureSubnets) || !has(self.reservedSubnets) || self.infrastructureSubnets.all(infra, !self.reservedSubnets.exists(reserved, cidr(infra).containsCIDR(reserved) || cidr(reserved).containsCIDR(infra)))", message="infrastructureSubnets and reservedSubnets must not overlap"
+// +kubebuilder:validation:XValidation:rule="self.role == oldSelf.role && has(self.mtu) == has(oldSelf.mtu) && (!has(self.mtu) || self.mtu == oldSelf.mtu) && has(self.joinSubnets) == has(oldSelf.joinSubnets) && (!has(self.joinSubnets) || self.joinSubnets == oldSelf.joinSubnets) && has(self.ipam) == has(oldSelf.ipam) && (!has(self.ipam) || self.ipam == oldSelf.ipam)", message="Layer2 fields other than subnets are immutable"
+// +kubebuilder:validation:XValidation:rule="has(self.reservedSubnets) == has(oldSelf.reservedSubnets) && (!has(self.reservedSubnets) || self.reservedSubnets == oldSelf.reservedSubnets) && has(self.infrastructureSubnets) == has(oldSelf.infrastructureSubnets) && (!has(self.infrastructureSubnets) || self.infrastructureSubnets == oldSelf.infrastructureSubnets) && has(self.defaultGatewayIPs) == has(oldSelf.defaultGatewayIPs) && (!has(self.defaultGatewayIPs) || self.defaultGatewayIPs == oldSelf.defaultGatewayIPs)", message="Layer2 infrastructure fields are immutable"
+// +kubebuilder:validation:XValidation:rule="has(self.subnets) == has(oldSelf.subnets) && (!has(oldSelf.subnets) || oldSelf.subnets.all(s, s in self.subnets))", message="Subnets can only be appended to"
+// +kubebuilder:validation:XValidation:rule="!has(oldSelf.subnets) || !has(self.subnets) || self.subnets.all(n, !isCIDR(n) || oldSelf.subnets.exists(s, isCIDR(s) && cidr(s).ip().family() == cidr(n).ip().family()))", message="Appended subnets must be of the IP families already used by the network"
+// +kubebuilder:validation:XValidation:rule="!has(oldSelf.subnets) || !has(self.subnets) || size(self.subnets) == size(oldSelf.subnets) || !has(self.defaultGatewayIPs) && !has(self.infrastructureSubnets)", message="Subnets can't be appended to when defaultGatewayIPs or infrastructureSubnets are set"
 type Layer2Config struct {
 	// Role describes the network role in the pod.
 	//
@@ -122,13 +151,15 @@ type Layer2Config struct {
 	MTU int32 `json:"mtu,omitempty"`
 
 	// Subnets are used for the pod network across the cluster.
-	// Dual-stack clusters may set 2 subnets (one for each IP family), otherwise only 1 subnet is allowed.
+	// Dual-stack clusters may set subnets of both IP families. Subnets must not overlap.
+	// Subnets can be appended to on a live network as long as the appended subnets are of the IP families
+	// already used by the network. Existing subnets can't be changed or removed.
 	//
 	// The format should match standard CIDR notation (for example, "10.128.0.0/16").
 	// This field must be omitted if `ipam.mode` is `Disabled`.
 	//
 	// +optional
-	Subnets DualStackCIDRs `json:"subnets,omitempty"`
+	Subnets SubnetCIDRs `json:"subnets,omitempty"`
 
 	// reservedSubnets specifies a list of CIDRs reserved for static IP assignment, excluded from automatic allocation.
 	// reservedSubnets is optional. When omitted, all IP addresses in `subnets` are available for automatic assignment.
@@ -236,6 +267,11 @@ type CIDR string
 // +kubebuilder:validation:XValidation:rule="size(self) != 2 || !isCIDR(self[0]) || !isCIDR(self[1]) || cidr(self[0]).ip().family() != cidr(self[1]).ip().family()", message="When 2 CIDRs are set, they must be from different IP families"
 type DualStackCIDRs []CIDR
 
+// +kubebuilder:validation:MinItems=1
+// +kubebuilder:validation:MaxItems=8
+// +kubebuilder:validation:XValidation:rule="!self.all(x, isCIDR(x)) || self.all(x, self.exists_one(y, cidr(x).containsCIDR(cidr(y)) || cidr(y).containsCIDR(cidr(x))))", message="CIDRs must not overlap"
+type SubnetCIDRs []CIDR
+
 // +kubebuilder:validation:XValidation:rule="isIP(self)", message="IP is invalid"
 type IP string
 
@@ -243,3 +279,54 @@ type IP string
 // +kubebuilder:validation:MaxItems=2
 // +kubebuilder:validation:XValidation:rule="size(self) != 2 || !isIP(self[0]) || !isIP(self[1]) || ip(self[0]).family() != ip(self[1]).family()", message="When 2 IPs are set, they must be from different IP families"
 type DualStackIPs []IP
+
+// +kubebuilder:validation:Enum=IPv4;IPv6
+type IPFamily string
+
+const (
+	IPFamilyIPv4 IPFamily = "IPv4"
+	IPFamilyIPv6 IPFamily = "IPv6"
+)
+
+// NetworkUsage reports how much of the network address space is in use.
+type NetworkUsage struct {
+	// hostSubnets reports, per IP family, how many node subnets of a Layer3 network are allocated to nodes
+	// and how many are still free.
+	//
+	// +listType=map
+	// +listMapKey=ipFamily
+	// +optional
+	HostSubnets []IPFamilyUsage `json:"hostSubnets,omitempty"`
+
+	// ips reports, per IP family, how many IP addresses of a Layer2 or Localnet network are allocated
+	// and how many are still free.
+	// IP addresses used by the network infrastructure or excluded from automatic assignment are reported as allocated.
+	//
+	// +listType=map
+	// +listMapKey=ipFamily
+	// +optional
+	IPs []IPFamilyUsage `json:"ips,omitempty"`
+
+	// persistentIPClaims is the number of IPAMClaims holding persistent IP addresses of the network.
+	//
+	// +optional
+	PersistentIPClaims int64 `json:"persistentIPClaims,omitempty"`
+}
+
+// IPFamilyUsage reports the usage of a network address pool for an IP family.
+type IPFamilyUsage struct {
+	// ipFamily is the IP family of the address pool.
+	//
+	// +required
+	IPFamily IPFamily `json:"ipFamily"`
+
+	// allocated is the number of allocated items of the address pool.
+	//
+	// +required
+	Allocated int64 `json:"allocated"`
+
+	// free is the number of items of the address pool still available for allocation.
+	//
+	// +required
+	Free int64 `json:"free"`
+}
@@ -130,6 +130,11 @@ func (in *ClusterUserDefinedNetworkStatus) DeepCopyInto(out *ClusterUserDefinedN
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Usage != nil {
+		in, out := &in.Usage, &out.Usage
+		*out = new(NetworkUsage)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -199,12 +204,28 @@ func (in *IPAMConfig) DeepCopy() *IPAMConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPFamilyUsage) DeepCopyInto(out *IPFamilyUsage) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPFamilyUsage.
+func (in *IPFamilyUsage) DeepCopy() *IPFamilyUsage {
+	if in == nil {
+		return nil
+	}
+	out := new(IPFamilyUsage)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Layer2Config) DeepCopyInto(out *Layer2Config) {
 	*out = *in
 	if in.Subnets != nil {
 		in, out := &in.Subnets, &out.Subnets
-		*out = make(DualStackCIDRs, len(*in))
+		*out = make(SubnetCIDRs, len(*in))
 		copy(*out, *in)
 	}
 	if in.ReservedSubnets != nil {
@@ -253,6 +274,11 @@ func (in *Layer3Config) DeepCopyInto(out *Layer3Config) {
 		*out = make([]Layer3Subnet, len(*in))
 		copy(*out, *in)
 	}
+	if in.ExcludeSubnets != nil {
+		in, out := &in.ExcludeSubnets, &out.ExcludeSubnets
+		*out = make([]CIDR, len(*in))
+		copy(*out, *in)
+	}
 	if in.JoinSubnets != nil {
 		in, out := &in.JoinSubnets, &out.JoinSubnets
 		*out = make(DualStackCIDRs, len(*in))
@@ -323,6 +349,113 @@ func (in *LocalnetConfig) DeepCopy() *LocalnetConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkPeering) DeepCopyInto(out *NetworkPeering) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkPeering.
+func (in *NetworkPeering) DeepCopy() *NetworkPeering {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkPeering)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NetworkPeering) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkPeeringList) DeepCopyInto(out *NetworkPeeringList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NetworkPeering, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkPeeringList.
+func (in *NetworkPeeringList) DeepCopy() *NetworkPeeringList {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkPeeringList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NetworkPeeringList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkPeeringSpec) DeepCopyInto(out *NetworkPeeringSpec) {
+	*out = *in
+	if in.Networks != nil {
+		in, out := &in.Networks, &out.Networks
+		*out = make([]PeeredNetwork, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkPeeringSpec.
+func (in *NetworkPeeringSpec) DeepCopy() *NetworkPeeringSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkPeeringSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkPeeringStatus) DeepCopyInto(out *NetworkPeeringStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkPeeringStatus.
+func (in *NetworkPeeringStatus) DeepCopy() *NetworkPeeringStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkPeeringStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NetworkSpec) DeepCopyInto(out *NetworkSpec) {
 	*out = *in
@@ -354,6 +487,120 @@ func (in *NetworkSpec) DeepCopy() *NetworkSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkUsage) DeepCopyInto(out *NetworkUsage) {
+	*out = *in
+	if in.HostSubnets != nil {
+		in, out := &in.HostSubnets, &out.HostSubnets
+		*out = make([]IPFamilyUsage, len(*in))
+		copy(*out, *in)
+	}
+	if in.IPs != nil {
+		in, out := &in.IPs, &out.IPs
+		*out = make([]IPFamilyUsage, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkUsage.
+func (in *NetworkUsage) DeepCopy() *NetworkUsage {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkUsage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PeeredNetwork) DeepCopyInto(out *PeeredNetwork) {
+	*out = *in
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Ports != nil {
+		in, out := &in.Ports, &out.Ports
+		*out = make([]PeeringPort, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PeeredNetwork.
+func (in *PeeredNetwork) DeepCopy() *PeeredNetwork {
+	if in == nil {
+		return nil
+	}
+	out := new(PeeredNetwork)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PeeringPort) DeepCopyInto(out *PeeringPort) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PeeringPort.
+func (in *PeeringPort) DeepCopy() *PeeringPort {
+	if in == nil {
+		return nil
+	}
+	out := new(PeeringPort)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in SubnetCIDRs) DeepCopyInto(out *SubnetCIDRs) {
+	{
+		in := &in
+		*out = make(SubnetCIDRs, len(*in))
+		copy(*out, *in)
+		return
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubnetCIDRs.
+func (in SubnetCIDRs) DeepCopy() SubnetCIDRs {
+	if in == nil {
+		return nil
+	}
+	out := new(SubnetCIDRs)
+	in.DeepCopyInto(out)
+	return *out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrunkVLANConfig) DeepCopyInto(out *TrunkVLANConfig) {
+	*out = *in
+	if in.AllowedVLANs != nil {
+		in, out := &in.AllowedVLANs, &out.AllowedVLANs
+		*out = make([]VLANIDRange, len(*in))
+		copy(*out, *in)
+	}
+	if in.NativeVLAN != nil {
+		in, out := &in.NativeVLAN, &out.NativeVLAN
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrunkVLANConfig.
+func (in *TrunkVLANConfig) DeepCopy() *TrunkVLANConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(TrunkVLANConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *UserDefinedNetwork) DeepCopyInto(out *UserDefinedNetwork) {
 	*out = *in
@@ -451,6 +698,11 @@ func (in *UserDefinedNetworkStatus) DeepCopyInto(out *UserDefinedNetworkStatus)
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Usage != nil {
+		in, out := &in.Usage, &out.Usage
+		*out = new(NetworkUsage)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -472,6 +724,11 @@ func (in *VLANConfig) DeepCopyInto(out *VLANConfig) {
 		*out = new(AccessVLANConfig)
 		**out = **in
 	}
+	if in.Trunk != nil {
+		in, out := &in.Trunk, &out.Trunk
+		*out = new(TrunkVLANConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
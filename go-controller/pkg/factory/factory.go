@@ -228,7 +228,9 @@ var (
 	NamespaceType                         reflect.Type = reflect.TypeOf(&corev1.Namespace{})
 	NodeType                              reflect.Type = reflect.TypeOf(&corev1.Node{})
 	EgressFirewallType                    reflect.Type = reflect.TypeOf(&egressfirewallapi.EgressFirewall{})
+	ClusterEgressFirewallType             reflect.Type = reflect.TypeOf(&egressfirewallapi.ClusterEgressFirewall{})
 	EgressIPType                          reflect.Type = reflect.TypeOf(&egressipapi.EgressIP{})
+	EgressIPPoolType                      reflect.Type = reflect.TypeOf(&egressipapi.EgressIPPool{})
 	EgressIPNamespaceType                 reflect.Type = reflect.TypeOf(&egressIPNamespace{})
 	EgressIPPodType                       reflect.Type = reflect.TypeOf(&egressIPPod{})
 	EgressNodeType                        reflect.Type = reflect.TypeOf(&egressNode{})
@@ -441,6 +443,11 @@ func NewOVNKubeControllerWatchFactory(ovnClientset *util.OVNKubeControllerClient
 		if err != nil {
 			return nil, err
 		}
+		wf.informers[ClusterEgressFirewallType], err = newQueuedInformer(eventQueueSize, ClusterEgressFirewallType,
+			wf.efFactory.K8s().V1().ClusterEgressFirewalls().Informer(), wf.stopChan, minNumEventQueues)
+		if err != nil {
+			return nil, err
+		}
 
 		if config.OVNKubernetesFeature.EnableDNSNameResolver {
 			// make sure shared informer is created for a factory, so on wf.dnsFactory.Start() it is initialized and caches are synced.
@@ -493,6 +500,8 @@ func NewOVNKubeControllerWatchFactory(ovnClientset *util.OVNKubeControllerClient
 		if err != nil {
 			return nil, err
 		}
+		// make sure shared informer is created for a factory, so on wf.udnFactory.Start() it is initialized and caches are synced.
+		wf.udnFactory.K8s().V1().NetworkPeerings().Informer()
 	}
 
 	if util.IsMultiNetworkPoliciesSupportEnabled() {
@@ -512,6 +521,7 @@ func NewOVNKubeControllerWatchFactory(ovnClientset *util.OVNKubeControllerClient
 		wf.raFactory = routeadvertisementsinformerfactory.NewSharedInformerFactory(ovnClientset.RouteAdvertisementsClient, resyncInterval)
 		// make sure shared informer is created for a factory, so on wf.raFactory.Start() it is initialized and caches are synced.
 		wf.raFactory.K8s().V1().RouteAdvertisements().Informer()
+		wf.raFactory.K8s().V1().RouteImportPolicies().Informer()
 	}
 
 	if config.OVNKubernetesFeature.EnableNetworkQoS {
@@ -957,6 +967,13 @@ func NewClusterManagerWatchFactory(ovnClientset *util.OVNClusterManagerClientset
 		if err != nil {
 			return nil, err
 		}
+		wf.informers[EgressIPPoolType], err = newQueuedInformer(eventQueueSize,
+			EgressIPPoolType,
+			wf.eipFactory.K8s().V1().EgressIPPools().Informer(),
+			wf.stopChan, minNumEventQueues)
+		if err != nil {
+			return nil, err
+		}
 	}
 	if util.PlatformTypeIsEgressIPCloudProvider() {
 		wf.informers[CloudPrivateIPConfigType], err = newQueuedInformer(eventQueueSize,
@@ -1017,6 +1034,7 @@ func NewClusterManagerWatchFactory(ovnClientset *util.OVNClusterManagerClientset
 	if config.OVNKubernetesFeature.EnableEgressFirewall {
 		// make sure shared informer is created for a factory, so on wf.efFactory.Start() it is initialized and caches are synced.
 		wf.efFactory.K8s().V1().EgressFirewalls().Informer()
+		wf.efFactory.K8s().V1().ClusterEgressFirewalls().Informer()
 
 		if config.OVNKubernetesFeature.EnableDNSNameResolver {
 			// make sure shared informer is created for a factory, so on wf.dnsFactory.Start() it is initialized and caches are synced.
@@ -1044,6 +1062,9 @@ func NewClusterManagerWatchFactory(ovnClientset *util.OVNClusterManagerClientset
 		// make sure namespace informer cache is initialized and synced on Start().
 		wf.iFactory.Core().V1().Namespaces().Informer()
 
+		// make sure network peering informer cache is initialized and synced on Start().
+		wf.udnFactory.K8s().V1().NetworkPeerings().Informer()
+
 		// make sure pod informer cache is initialized and synced when on Start().
 		wf.iFactory.Core().V1().Pods().Informer()
 	}
@@ -1112,10 +1133,18 @@ func getObjectMeta(objType reflect.Type, obj interface{}) (*metav1.ObjectMeta, e
 		if egressFirewall, ok := obj.(*egressfirewallapi.EgressFirewall); ok {
 			return &egressFirewall.ObjectMeta, nil
 		}
+	case ClusterEgressFirewallType:
+		if clusterEgressFirewall, ok := obj.(*egressfirewallapi.ClusterEgressFirewall); ok {
+			return &clusterEgressFirewall.ObjectMeta, nil
+		}
 	case EgressIPType:
 		if egressIP, ok := obj.(*egressipapi.EgressIP); ok {
 			return &egressIP.ObjectMeta, nil
 		}
+	case EgressIPPoolType:
+		if egressIPPool, ok := obj.(*egressipapi.EgressIPPool); ok {
+			return &egressIPPool.ObjectMeta, nil
+		}
 	case CloudPrivateIPConfigType:
 		if cloudPrivateIPConfig, ok := obj.(*ocpcloudnetworkapi.CloudPrivateIPConfig); ok {
 			return &cloudPrivateIPConfig.ObjectMeta, nil
@@ -1236,11 +1265,21 @@ func (wf *WatchFactory) GetResourceHandlerFunc(objType reflect.Type) (AddHandler
 			return wf.AddEgressFirewallHandler(funcs, processExisting)
 		}, nil
 
+	case ClusterEgressFirewallType:
+		return func(_ string, _ labels.Selector, funcs cache.ResourceEventHandler, processExisting func([]interface{}) error) (*Handler, error) {
+			return wf.AddClusterEgressFirewallHandler(funcs, processExisting)
+		}, nil
+
 	case EgressIPType:
 		return func(_ string, _ labels.Selector, funcs cache.ResourceEventHandler, processExisting func([]interface{}) error) (*Handler, error) {
 			return wf.AddEgressIPHandler(funcs, processExisting)
 		}, nil
 
+	case EgressIPPoolType:
+		return func(_ string, _ labels.Selector, funcs cache.ResourceEventHandler, processExisting func([]interface{}) error) (*Handler, error) {
+			return wf.AddEgressIPPoolHandler(funcs, processExisting)
+		}, nil
+
 	case CloudPrivateIPConfigType:
 		return func(_ string, _ labels.Selector, funcs cache.ResourceEventHandler, processExisting func([]interface{}) error) (*Handler, error) {
 			return wf.AddCloudPrivateIPConfigHandler(funcs, processExisting)
@@ -1401,6 +1440,16 @@ func (wf *WatchFactory) RemoveEgressFirewallHandler(handler *Handler) {
 	wf.removeHandler(EgressFirewallType, handler)
 }
 
+// AddClusterEgressFirewallHandler adds a handler function that will be executed on ClusterEgressFirewall object changes
+func (wf *WatchFactory) AddClusterEgressFirewallHandler(handlerFuncs cache.ResourceEventHandler, processExisting func([]interface{}) error) (*Handler, error) {
+	return wf.addHandler(ClusterEgressFirewallType, "", nil, handlerFuncs, processExisting, defaultHandlerPriority)
+}
+
+// RemoveClusterEgressFirewallHandler removes a ClusterEgressFirewall object event handler function
+func (wf *WatchFactory) RemoveClusterEgressFirewallHandler(handler *Handler) {
+	wf.removeHandler(ClusterEgressFirewallType, handler)
+}
+
 // RemoveEgressQoSHandler removes an EgressQoS object event handler function
 func (wf *WatchFactory) RemoveEgressQoSHandler(handler *Handler) {
 	wf.removeHandler(EgressQoSType, handler)
@@ -1447,6 +1496,16 @@ func (wf *WatchFactory) RemoveEgressIPHandler(handler *Handler) {
 	wf.removeHandler(EgressIPType, handler)
 }
 
+// AddEgressIPPoolHandler adds a handler function that will be executed on EgressIPPool object changes
+func (wf *WatchFactory) AddEgressIPPoolHandler(handlerFuncs cache.ResourceEventHandler, processExisting func([]interface{}) error) (*Handler, error) {
+	return wf.addHandler(EgressIPPoolType, "", nil, handlerFuncs, processExisting, defaultHandlerPriority)
+}
+
+// RemoveEgressIPPoolHandler removes an EgressIPPool object event handler function
+func (wf *WatchFactory) RemoveEgressIPPoolHandler(handler *Handler) {
+	wf.removeHandler(EgressIPPoolType, handler)
+}
+
 // AddCloudPrivateIPConfigHandler adds a handler function that will be executed on CloudPrivateIPConfig object changes
 func (wf *WatchFactory) AddCloudPrivateIPConfigHandler(handlerFuncs cache.ResourceEventHandler, processExisting func([]interface{}) error) (*Handler, error) {
 	return wf.addHandler(CloudPrivateIPConfigType, "", nil, handlerFuncs, processExisting, defaultHandlerPriority)
@@ -1593,6 +1652,11 @@ func (wf *WatchFactory) GetEgressIPs() ([]*egressipapi.EgressIP, error) {
 	return egressIPLister.List(labels.Everything())
 }
 
+func (wf *WatchFactory) GetEgressIPPool(name string) (*egressipapi.EgressIPPool, error) {
+	egressIPPoolLister := wf.informers[EgressIPPoolType].lister.(egressiplister.EgressIPPoolLister)
+	return egressIPPoolLister.Get(name)
+}
+
 // GetNamespace returns a specific namespace
 func (wf *WatchFactory) GetNamespace(name string) (*corev1.Namespace, error) {
 	namespaceLister := wf.informers[NamespaceType].lister.(listers.NamespaceLister)
@@ -1650,6 +1714,11 @@ func (wf *WatchFactory) GetEgressFirewall(namespace, name string) (*egressfirewa
 	return egressFirewallLister.EgressFirewalls(namespace).Get(name)
 }
 
+func (wf *WatchFactory) GetClusterEgressFirewall(name string) (*egressfirewallapi.ClusterEgressFirewall, error) {
+	clusterEgressFirewallLister := wf.informers[ClusterEgressFirewallType].lister.(egressfirewalllister.ClusterEgressFirewallLister)
+	return clusterEgressFirewallLister.Get(name)
+}
+
 func (wf *WatchFactory) GetNetworkQoSes() ([]*networkqosapi.NetworkQoS, error) {
 	networkQosLister := wf.informers[NetworkQoSType].lister.(networkqoslister.NetworkQoSLister)
 	return networkQosLister.List(labels.Everything())
@@ -1751,6 +1820,10 @@ func (wf *WatchFactory) EgressFirewallInformer() egressfirewallinformer.EgressFi
 	return wf.efFactory.K8s().V1().EgressFirewalls()
 }
 
+func (wf *WatchFactory) ClusterEgressFirewallInformer() egressfirewallinformer.ClusterEgressFirewallInformer {
+	return wf.efFactory.K8s().V1().ClusterEgressFirewalls()
+}
+
 func (wf *WatchFactory) IPAMClaimsInformer() ipamclaimsinformer.IPAMClaimInformer {
 	return wf.ipamClaimsFactory.K8s().V1alpha1().IPAMClaims()
 }
@@ -1767,6 +1840,10 @@ func (wf *WatchFactory) ClusterUserDefinedNetworkInformer() userdefinednetworkin
 	return wf.udnFactory.K8s().V1().ClusterUserDefinedNetworks()
 }
 
+func (wf *WatchFactory) NetworkPeeringInformer() userdefinednetworkinformer.NetworkPeeringInformer {
+	return wf.udnFactory.K8s().V1().NetworkPeerings()
+}
+
 func (wf *WatchFactory) DNSNameResolverInformer() ocpnetworkinformerv1alpha1.DNSNameResolverInformer {
 	return wf.dnsFactory.Network().V1alpha1().DNSNameResolvers()
 }
@@ -1775,6 +1852,10 @@ func (wf *WatchFactory) RouteAdvertisementsInformer() routeadvertisementsinforme
 	return wf.raFactory.K8s().V1().RouteAdvertisements()
 }
 
+func (wf *WatchFactory) RouteImportPolicyInformer() routeadvertisementsinformer.RouteImportPolicyInformer {
+	return wf.raFactory.K8s().V1().RouteImportPolicies()
+}
+
 func (wf *WatchFactory) FRRConfigurationsInformer() frrinformer.FRRConfigurationInformer {
 	return wf.frrFactory.Api().V1beta1().FRRConfigurations()
 }
@@ -485,12 +485,16 @@ func newInformerLister(oType reflect.Type, sharedInformer cache.SharedIndexInfor
 		return netlisters.NewNetworkPolicyLister(sharedInformer.GetIndexer()), nil
 	case EgressFirewallType:
 		return egressfirewalllister.NewEgressFirewallLister(sharedInformer.GetIndexer()), nil
+	case ClusterEgressFirewallType:
+		return egressfirewalllister.NewClusterEgressFirewallLister(sharedInformer.GetIndexer()), nil
 	case AdminNetworkPolicyType:
 		return anplister.NewAdminNetworkPolicyLister(sharedInformer.GetIndexer()), nil
 	case BaselineAdminNetworkPolicyType:
 		return anplister.NewBaselineAdminNetworkPolicyLister(sharedInformer.GetIndexer()), nil
 	case EgressIPType:
 		return egressiplister.NewEgressIPLister(sharedInformer.GetIndexer()), nil
+	case EgressIPPoolType:
+		return egressiplister.NewEgressIPPoolLister(sharedInformer.GetIndexer()), nil
 	case CloudPrivateIPConfigType:
 		return cloudprivateipconfiglister.NewCloudPrivateIPConfigLister(sharedInformer.GetIndexer()), nil
 	case EndpointSliceType:
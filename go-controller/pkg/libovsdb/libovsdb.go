@@ -26,6 +26,7 @@ import (
 	"github.com/ovn-kubernetes/libovsdb/client"
 	"github.com/ovn-kubernetes/libovsdb/model"
 
+	observovsdb "github.com/ovn-org/ovn-kubernetes/go-controller/observability-lib/ovsdb"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/sbdb"
@@ -266,6 +267,40 @@ func NewOVSClientWithConfig(cfg config.OvnAuthConfig, stopCh <-chan struct{}) (c
 	return c, nil
 }
 
+// NewObservOVSClient creates a new openvswitch Database client that monitors the tables used to configure
+// the sample collectors.
+func NewObservOVSClient(stopCh <-chan struct{}) (client.Client, error) {
+	dbModel, err := observovsdb.ObservDatabaseModel()
+	if err != nil {
+		return nil, err
+	}
+	c, err := newClient(config.OvnAuthConfig{
+		Scheme:  config.OvnDBSchemeUnix,
+		Address: "unix:/var/run/openvswitch/db.sock",
+	}, dbModel, stopCh)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), types.OVSDBTimeout)
+	go func() {
+		<-stopCh
+		cancel()
+	}()
+
+	_, err = c.Monitor(ctx,
+		c.NewMonitor(
+			client.WithTable(&observovsdb.FlowSampleCollectorSet{}),
+			client.WithTable(&observovsdb.Bridge{}),
+		),
+	)
+	if err != nil {
+		c.Close()
+		return nil, err
+	}
+
+	return c, nil
+}
+
 func createTLSConfig(certFile, privKeyFile, caCertFile, serverName string) (*tls.Config, error) {
 	cert, err := tls.LoadX509KeyPair(certFile, privKeyFile)
 	if err != nil {
@@ -10,12 +10,15 @@ const (
 	logicalRouterPolicy
 	qos
 	nat
+	logicalRouterStaticRoute
+	bfd
 )
 
 const (
 	// owner types
 	EgressFirewallDNSOwnerType          ownerType = "EgressFirewallDNS"
 	EgressFirewallOwnerType             ownerType = "EgressFirewall"
+	ClusterEgressFirewallOwnerType      ownerType = "ClusterEgressFirewall"
 	EgressQoSOwnerType                  ownerType = "EgressQoS"
 	AdminNetworkPolicyOwnerType         ownerType = "AdminNetworkPolicy"
 	BaselineAdminNetworkPolicyOwnerType ownerType = "BaselineAdminNetworkPolicy"
@@ -43,6 +46,15 @@ const (
 	ClusterOwnerType ownerType = "Cluster"
 	// UDNIsolationOwnerType means the object is needed to implement UserDefinedNetwork isolation
 	UDNIsolationOwnerType ownerType = "UDNIsolation"
+	// DNSSnoopingOwnerType means the object is needed to sample the DNS traffic of pods for EgressFirewall
+	// DNS rules
+	DNSSnoopingOwnerType ownerType = "DNSSnooping"
+	// NetworkPeeringOwnerType means the object is needed to connect a network to the peer network of a
+	// NetworkPeering
+	NetworkPeeringOwnerType ownerType = "NetworkPeering"
+	// LocalnetVLANOwnerType means the object is needed to enforce the allowed VLANs of a localnet network
+	// in VLAN trunk mode
+	LocalnetVLANOwnerType ownerType = "LocalnetVLAN"
 
 	// owner extra IDs, make sure to define only 1 ExternalIDKey for every string value
 	PriorityKey           ExternalIDKey = "priority"
@@ -57,6 +69,7 @@ const (
 	RuleIndex             ExternalIDKey = "rule-index"
 	CIDRKey               ExternalIDKey = types.OvnK8sPrefix + "/cidr"
 	PortPolicyProtocolKey ExternalIDKey = "port-policy-protocol"
+	PeerNodeKey           ExternalIDKey = "peer-node"
 )
 
 // ObjectIDsTypes should only be created here
@@ -146,6 +159,8 @@ var AddressSetUDNEnabledService = newObjectIDsType(addressSet, UDNEnabledService
 var AddressSetNetworkQoS = newObjectIDsType(addressSet, NetworkQoSOwnerType, []ExternalIDKey{
 	// nqos namespace:name
 	ObjectNameKey,
+	// egress or ingress, empty for the source address set
+	PolicyDirectionKey,
 	// rule index
 	RuleIndex,
 	IpBlockIndexKey,
@@ -165,6 +180,20 @@ var ACLAdvertisedNetwork = newObjectIDsType(acl, AdvertisedNetworkOwnerType, []E
 	NetworkKey,
 })
 
+var ACLNetworkPeering = newObjectIDsType(acl, NetworkPeeringOwnerType, []ExternalIDKey{
+	// NetworkPeering name
+	ObjectNameKey,
+	// egress or ingress
+	PolicyDirectionKey,
+	// allow, deny or pass
+	TypeKey,
+})
+
+var ACLLocalnetVLAN = newObjectIDsType(acl, LocalnetVLANOwnerType, []ExternalIDKey{
+	// network name
+	ObjectNameKey,
+})
+
 var ACLAdminNetworkPolicy = newObjectIDsType(acl, AdminNetworkPolicyOwnerType, []ExternalIDKey{
 	// anp name
 	ObjectNameKey,
@@ -276,6 +305,13 @@ var ACLEgressFirewall = newObjectIDsType(acl, EgressFirewallOwnerType, []Externa
 	RuleIndex,
 })
 
+var ACLClusterEgressFirewall = newObjectIDsType(acl, ClusterEgressFirewallOwnerType, []ExternalIDKey{
+	// cluster egress firewall name
+	ObjectNameKey,
+	// the index of the ClusterEgressFirewall.Spec.Egress rule.
+	RuleIndex,
+})
+
 var ACLUDN = newObjectIDsType(acl, UDNIsolationOwnerType, []ExternalIDKey{
 	// name of a UDN-related ACL
 	ObjectNameKey,
@@ -283,6 +319,11 @@ var ACLUDN = newObjectIDsType(acl, UDNIsolationOwnerType, []ExternalIDKey{
 	PolicyDirectionKey,
 })
 
+var ACLDNSSnooping = newObjectIDsType(acl, DNSSnoopingOwnerType, []ExternalIDKey{
+	// name of a DNS snooping ACL
+	ObjectNameKey,
+})
+
 var VirtualMachineDHCPOptions = newObjectIDsType(dhcpOptions, VirtualMachineOwnerType, []ExternalIDKey{
 	// We can have multiple VMs with same CIDR they  may have different
 	// hostname.
@@ -345,6 +386,13 @@ var LogicalRouterPolicyEgressIP = newObjectIDsType(logicalRouterPolicy, EgressIP
 	NetworkKey,
 })
 
+var LogicalRouterPolicyNetworkPeering = newObjectIDsType(logicalRouterPolicy, NetworkPeeringOwnerType, []ExternalIDKey{
+	// NetworkPeering name
+	ObjectNameKey,
+	// the IP Family for this policy, ip4 or ip6
+	IPFamilyKey,
+})
+
 var NATEgressIP = newObjectIDsType(nat, EgressIPOwnerType, []ExternalIDKey{
 	// for the NAT policy, it should be the "EIPName_Namespace/podName"
 	ObjectNameKey,
@@ -352,6 +400,31 @@ var NATEgressIP = newObjectIDsType(nat, EgressIPOwnerType, []ExternalIDKey{
 	IPFamilyKey,
 })
 
+var LogicalRouterStaticRouteEgressIP = newObjectIDsType(logicalRouterStaticRoute, EgressIPOwnerType, []ExternalIDKey{
+	// the egress node whose gateway router owns the route
+	ObjectNameKey,
+	// the egress node the route and its BFD session point to
+	PeerNodeKey,
+	// the IP Family for this route, ip4 or ip6
+	IPFamilyKey,
+})
+
+var LogicalRouterStaticRouteNetworkPeering = newObjectIDsType(logicalRouterStaticRoute, NetworkPeeringOwnerType, []ExternalIDKey{
+	// NetworkPeering name
+	ObjectNameKey,
+	// the subnet of the network the route points to
+	CIDRKey,
+})
+
+var BFDEgressIP = newObjectIDsType(bfd, EgressIPOwnerType, []ExternalIDKey{
+	// the egress node whose gateway router runs the BFD session
+	ObjectNameKey,
+	// the egress node at the other end of the BFD session
+	PeerNodeKey,
+	// the IP Family for this session, ip4 or ip6
+	IPFamilyKey,
+})
+
 var QoSEgressQoS = newObjectIDsType(qos, EgressQoSOwnerType, []ExternalIDKey{
 	// the priority of the QoSRule (OVN priority is the same as the rule index priority for this feature)
 	// this value will be unique in a given namespace
@@ -371,6 +444,8 @@ var QoSRuleEgressIP = newObjectIDsType(qos, EgressIPOwnerType, []ExternalIDKey{
 
 var NetworkQoS = newObjectIDsType(qos, NetworkQoSOwnerType, []ExternalIDKey{
 	ObjectNameKey,
+	// egress or ingress
+	PolicyDirectionKey,
 	// rule index
 	RuleIndex,
 })
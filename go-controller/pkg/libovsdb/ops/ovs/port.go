@@ -0,0 +1,31 @@
+package ovs
+
+import (
+	"context"
+
+	libovsdbclient "github.com/ovn-kubernetes/libovsdb/client"
+
+	libovsdbops "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/vswitchd"
+)
+
+// GetPort looks up an ovs port by name from the cache
+func GetPort(ovsClient libovsdbclient.Client, name string) (*vswitchd.Port, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), types.OVSDBTimeout)
+	defer cancel()
+	port := &vswitchd.Port{Name: name}
+	err := ovsClient.Get(ctx, port)
+	return port, err
+}
+
+// UpdatePortVLANConfig sets the VLAN configuration, that is the vlan_mode,
+// tag and trunks, of the provided ovs port
+func UpdatePortVLANConfig(ovsClient libovsdbclient.Client, port *vswitchd.Port) error {
+	ops, err := ovsClient.Where(port).Update(port, &port.VLANMode, &port.Tag, &port.Trunks)
+	if err != nil {
+		return err
+	}
+	_, err = libovsdbops.TransactAndCheck(ovsClient, ops)
+	return err
+}
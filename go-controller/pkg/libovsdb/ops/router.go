@@ -852,8 +852,24 @@ func CreateOrUpdateBFDOps(nbClient libovsdbclient.Client, ops []ovsdb.Operation,
 	return m.CreateOrUpdateOps(ops, opModels...)
 }
 
-// DeleteBFDs deletes the provided BFDs
-func DeleteBFDs(nbClient libovsdbclient.Client, bfds ...*nbdb.BFD) error {
+// CreateOrUpdateBFDWithTimersOps creates or updates the provided BFD and
+// returns the corresponding ops. The min_tx, min_rx and detect_mult columns
+// of an existing BFD are always updated, and cleared when not set, so that the
+// BFD session falls back to the OVN defaults.
+func CreateOrUpdateBFDWithTimersOps(nbClient libovsdbclient.Client, ops []ovsdb.Operation, bfd *nbdb.BFD) ([]ovsdb.Operation, error) {
+	opModel := operationModel{
+		Model:          bfd,
+		OnModelUpdates: []interface{}{&bfd.MinTx, &bfd.MinRx, &bfd.DetectMult},
+		ErrNotFound:    false,
+		BulkOp:         false,
+	}
+
+	m := newModelClient(nbClient)
+	return m.CreateOrUpdateOps(ops, opModel)
+}
+
+// DeleteBFDsOps returns the ops to delete the provided BFDs
+func DeleteBFDsOps(nbClient libovsdbclient.Client, ops []ovsdb.Operation, bfds ...*nbdb.BFD) ([]ovsdb.Operation, error) {
 	opModels := make([]operationModel, 0, len(bfds))
 	for i := range bfds {
 		bfd := bfds[i]
@@ -866,7 +882,28 @@ func DeleteBFDs(nbClient libovsdbclient.Client, bfds ...*nbdb.BFD) error {
 	}
 
 	m := newModelClient(nbClient)
-	return m.Delete(opModels...)
+	return m.DeleteOps(ops, opModels...)
+}
+
+// DeleteBFDs deletes the provided BFDs
+func DeleteBFDs(nbClient libovsdbclient.Client, bfds ...*nbdb.BFD) error {
+	ops, err := DeleteBFDsOps(nbClient, nil, bfds...)
+	if err != nil {
+		return err
+	}
+	_, err = TransactAndCheck(nbClient, ops)
+	return err
+}
+
+type bfdPredicate func(*nbdb.BFD) bool
+
+// FindBFDsWithPredicate looks up BFDs from the cache based on a given predicate
+func FindBFDsWithPredicate(nbClient libovsdbclient.Client, p bfdPredicate) ([]*nbdb.BFD, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), config.Default.OVSDBTxnTimeout)
+	defer cancel()
+	found := []*nbdb.BFD{}
+	err := nbClient.WhereCache(p).List(ctx, &found)
+	return found, err
 }
 
 func LookupBFD(nbClient libovsdbclient.Client, bfd *nbdb.BFD) (*nbdb.BFD, error) {
@@ -136,6 +136,7 @@ const (
 	AdminNetworkPolicySample SampleFeature = "AdminNetworkPolicy"
 	MulticastSample          SampleFeature = "Multicast"
 	UDNIsolationSample       SampleFeature = "UDNIsolation"
+	DNSSnoopingSample        SampleFeature = "DNSSnooping"
 )
 
 // SamplingConfig is used to configure sampling for different db objects.
@@ -214,10 +215,12 @@ func getACLSampleFeature(acl *nbdb.ACL) SampleFeature {
 		return MulticastSample
 	case NetpolNodeOwnerType, NetworkPolicyOwnerType, NetpolNamespaceOwnerType:
 		return NetworkPolicySample
-	case EgressFirewallOwnerType:
+	case EgressFirewallOwnerType, ClusterEgressFirewallOwnerType:
 		return EgressFirewallSample
 	case UDNIsolationOwnerType:
 		return UDNIsolationSample
+	case DNSSnoopingOwnerType:
+		return DNSSnoopingSample
 	}
 	return ""
 }
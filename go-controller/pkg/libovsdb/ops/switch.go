@@ -247,10 +247,22 @@ func RemoveACLsFromLogicalSwitchesWithPredicate(nbClient libovsdbclient.Client,
 // switch adding any missing, removing the ones set to an empty value and
 // updating existing
 func UpdateLogicalSwitchSetOtherConfig(nbClient libovsdbclient.Client, sw *nbdb.LogicalSwitch) error {
+	ops, err := UpdateLogicalSwitchSetOtherConfigOps(nbClient, nil, sw)
+	if err != nil {
+		return err
+	}
+	_, err = TransactAndCheck(nbClient, ops)
+	return err
+}
+
+// UpdateLogicalSwitchSetOtherConfigOps returns the ops to set other config on
+// the provided logical switch adding any missing, removing the ones set to an
+// empty value and updating existing
+func UpdateLogicalSwitchSetOtherConfigOps(nbClient libovsdbclient.Client, ops []ovsdb.Operation, sw *nbdb.LogicalSwitch) ([]ovsdb.Operation, error) {
 	otherConfig := sw.OtherConfig
 	sw, err := GetLogicalSwitch(nbClient, sw)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	if sw.OtherConfig == nil {
@@ -273,8 +285,7 @@ func UpdateLogicalSwitchSetOtherConfig(nbClient libovsdbclient.Client, sw *nbdb.
 	}
 
 	m := newModelClient(nbClient)
-	_, err = m.CreateOrUpdate(opModel)
-	return err
+	return m.CreateOrUpdateOps(ops, opModel)
 }
 
 // LOGICAL SWITCH PORT OPs
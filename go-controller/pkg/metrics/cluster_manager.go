@@ -67,6 +67,38 @@ var metricV6AllocatedHostSubnetCount = prometheus.NewGaugeVec(prometheus.GaugeOp
 	},
 )
 
+var metricNetworkAllocatedIPCount = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Namespace: types.MetricOvnkubeNamespace,
+	Subsystem: types.MetricOvnkubeSubsystemClusterManager,
+	Name:      "network_allocated_ips",
+	Help:      "The number of pod IPs currently allocated per network and IP family"},
+	[]string{
+		"network_name",
+		"ip_family",
+	},
+)
+
+var metricNetworkFreeIPCount = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Namespace: types.MetricOvnkubeNamespace,
+	Subsystem: types.MetricOvnkubeSubsystemClusterManager,
+	Name:      "network_free_ips",
+	Help:      "The number of pod IPs still available per network and IP family"},
+	[]string{
+		"network_name",
+		"ip_family",
+	},
+)
+
+var metricNetworkPersistentIPClaimCount = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Namespace: types.MetricOvnkubeNamespace,
+	Subsystem: types.MetricOvnkubeSubsystemClusterManager,
+	Name:      "network_persistent_ip_claims",
+	Help:      "The number of IPAMClaims holding persistent IPs per network"},
+	[]string{
+		"network_name",
+	},
+)
+
 /** EgressIP metrics recorded from cluster-manager begins**/
 var metricEgressIPCount = prometheus.NewGauge(prometheus.GaugeOpts{
 	Namespace: types.MetricOvnkubeNamespace,
@@ -147,6 +179,9 @@ func RegisterClusterManagerFunctional() {
 	prometheus.MustRegister(metricV6HostSubnetCount)
 	prometheus.MustRegister(metricV4AllocatedHostSubnetCount)
 	prometheus.MustRegister(metricV6AllocatedHostSubnetCount)
+	prometheus.MustRegister(metricNetworkAllocatedIPCount)
+	prometheus.MustRegister(metricNetworkFreeIPCount)
+	prometheus.MustRegister(metricNetworkPersistentIPClaimCount)
 	if config.OVNKubernetesFeature.EnableEgressIP {
 		prometheus.MustRegister(metricEgressIPNodeUnreacheableCount)
 		prometheus.MustRegister(metricEgressIPRebalanceCount)
@@ -174,6 +209,30 @@ func RecordSubnetCount(v4SubnetCount, v6SubnetCount float64, networkName string)
 	metricV6HostSubnetCount.WithLabelValues(networkName).Set(v6SubnetCount)
 }
 
+// RecordNetworkIPUsage records the number of allocated and free pod IPs of the
+// given IP family for a network
+func RecordNetworkIPUsage(allocated, free float64, ipFamily, networkName string) {
+	metricNetworkAllocatedIPCount.WithLabelValues(networkName, ipFamily).Set(allocated)
+	metricNetworkFreeIPCount.WithLabelValues(networkName, ipFamily).Set(free)
+}
+
+// RecordNetworkPersistentIPClaims records the number of IPAMClaims holding
+// persistent IPs for a network
+func RecordNetworkPersistentIPClaims(count float64, networkName string) {
+	metricNetworkPersistentIPClaimCount.WithLabelValues(networkName).Set(count)
+}
+
+// DeleteNetworkUsage deletes the usage metrics recorded for a network
+func DeleteNetworkUsage(networkName string) {
+	metricV4HostSubnetCount.DeleteLabelValues(networkName)
+	metricV6HostSubnetCount.DeleteLabelValues(networkName)
+	metricV4AllocatedHostSubnetCount.DeleteLabelValues(networkName)
+	metricV6AllocatedHostSubnetCount.DeleteLabelValues(networkName)
+	metricNetworkAllocatedIPCount.DeletePartialMatch(prometheus.Labels{"network_name": networkName})
+	metricNetworkFreeIPCount.DeletePartialMatch(prometheus.Labels{"network_name": networkName})
+	metricNetworkPersistentIPClaimCount.DeleteLabelValues(networkName)
+}
+
 // RecordEgressIPReachableNode records how many times EgressIP detected an unuseable node.
 func RecordEgressIPUnreachableNode() {
 	metricEgressIPNodeUnreacheableCount.Inc()
@@ -247,6 +247,62 @@ var metricEgressFirewallRuleCount = prometheus.NewGauge(prometheus.GaugeOpts{
 	Help:      "The number of egress firewall rules defined"},
 )
 
+var metricEgressFirewallRuleHits = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Namespace: types.MetricOvnkubeNamespace,
+	Subsystem: types.MetricOvnkubeSubsystemController,
+	Name:      "egress_firewall_rule_hits_total",
+	Help:      "The number of sampled packets matching an egress firewall or cluster egress firewall rule"},
+	[]string{
+		"network",
+		"kind",
+		"namespace",
+		"name",
+		"rule_index",
+	},
+)
+
+var metricRouteImportRoutes = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Namespace: types.MetricOvnkubeNamespace,
+	Subsystem: types.MetricOvnkubeSubsystemController,
+	Name:      "route_import_routes",
+	Help:      "The number of BGP routes imported into the gateway router of a network"},
+	[]string{
+		"network",
+	},
+)
+
+var metricRouteImportDroppedRoutes = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Namespace: types.MetricOvnkubeNamespace,
+	Subsystem: types.MetricOvnkubeSubsystemController,
+	Name:      "route_import_dropped_routes",
+	Help:      "The number of BGP routes of a network not imported because the route import limit was reached"},
+	[]string{
+		"network",
+	},
+)
+
+var metricNetworkAllocatedPodIPs = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Namespace: types.MetricOvnkubeNamespace,
+	Subsystem: types.MetricOvnkubeSubsystemController,
+	Name:      "network_allocated_pod_ips",
+	Help:      "The number of pod IPs allocated in the host subnets of the zone per network and IP family"},
+	[]string{
+		"network_name",
+		"ip_family",
+	},
+)
+
+var metricNetworkFreePodIPs = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Namespace: types.MetricOvnkubeNamespace,
+	Subsystem: types.MetricOvnkubeSubsystemController,
+	Name:      "network_free_pod_ips",
+	Help:      "The number of pod IPs still available in the host subnets of the zone per network and IP family"},
+	[]string{
+		"network_name",
+		"ip_family",
+	},
+)
+
 var metricIPsecEnabled = prometheus.NewGauge(prometheus.GaugeOpts{
 	Namespace: types.MetricOvnkubeNamespace,
 	Subsystem: types.MetricOvnkubeSubsystemController,
@@ -411,6 +467,11 @@ func RegisterOVNKubeControllerFunctional(stopChan <-chan struct{}) {
 	}
 	prometheus.MustRegister(metricEgressFirewallRuleCount)
 	prometheus.MustRegister(metricEgressFirewallCount)
+	prometheus.MustRegister(metricEgressFirewallRuleHits)
+	prometheus.MustRegister(metricRouteImportRoutes)
+	prometheus.MustRegister(metricRouteImportDroppedRoutes)
+	prometheus.MustRegister(metricNetworkAllocatedPodIPs)
+	prometheus.MustRegister(metricNetworkFreePodIPs)
 	prometheus.MustRegister(metricEgressRoutingViaHost)
 	prometheus.MustRegister(metricANPCount)
 	prometheus.MustRegister(metricBANPCount)
@@ -629,6 +690,50 @@ func DecrementEgressFirewallCount() {
 	metricEgressFirewallCount.Dec()
 }
 
+// AddEgressFirewallRuleHits adds hits to the number of sampled packets matching the rule with the given index
+// of the object of the given kind (EgressFirewall or ClusterEgressFirewall) rendered on network. namespace is
+// empty for cluster scoped objects.
+func AddEgressFirewallRuleHits(network, kind, namespace, name string, ruleIndex int, hits uint64) {
+	metricEgressFirewallRuleHits.WithLabelValues(network, kind, namespace, name, strconv.Itoa(ruleIndex)).Add(float64(hits))
+}
+
+// DeleteEgressFirewallRuleHits deletes the hit counters of the rules of the object of the given kind rendered
+// on network.
+func DeleteEgressFirewallRuleHits(network, kind, namespace, name string) {
+	metricEgressFirewallRuleHits.DeletePartialMatch(prometheus.Labels{
+		"network":   network,
+		"kind":      kind,
+		"namespace": namespace,
+		"name":      name,
+	})
+}
+
+// SetRouteImportRoutes records the number of BGP routes imported and dropped
+// for network.
+func SetRouteImportRoutes(network string, imported, dropped int) {
+	metricRouteImportRoutes.WithLabelValues(network).Set(float64(imported))
+	metricRouteImportDroppedRoutes.WithLabelValues(network).Set(float64(dropped))
+}
+
+// DeleteRouteImportRoutes deletes the route import metrics of network.
+func DeleteRouteImportRoutes(network string) {
+	metricRouteImportRoutes.DeleteLabelValues(network)
+	metricRouteImportDroppedRoutes.DeleteLabelValues(network)
+}
+
+// SetNetworkPodIPUsage records the number of allocated and free pod IPs of
+// the given IP family for network.
+func SetNetworkPodIPUsage(network, ipFamily string, allocated, free uint64) {
+	metricNetworkAllocatedPodIPs.WithLabelValues(network, ipFamily).Set(float64(allocated))
+	metricNetworkFreePodIPs.WithLabelValues(network, ipFamily).Set(float64(free))
+}
+
+// DeleteNetworkPodIPUsage deletes the pod IP usage metrics of network.
+func DeleteNetworkPodIPUsage(network string) {
+	metricNetworkAllocatedPodIPs.DeletePartialMatch(prometheus.Labels{"network_name": network})
+	metricNetworkFreePodIPs.DeletePartialMatch(prometheus.Labels{"network_name": network})
+}
+
 // IncrementANPCount increments the number of Admin Network Policies
 func IncrementANPCount() {
 	metricANPCount.Inc()
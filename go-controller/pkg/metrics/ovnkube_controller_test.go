@@ -0,0 +1,43 @@
+package metrics
+
+import (
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+)
+
+var _ = ginkgo.Describe("OVN Kube Controller metrics", func() {
+	ginkgo.AfterEach(func() {
+		metricEgressFirewallRuleHits.Reset()
+	})
+
+	getEgressFirewallRuleHits := func(network, kind, namespace, name, ruleIndex string) float64 {
+		metric := &dto.Metric{}
+		err := metricEgressFirewallRuleHits.WithLabelValues(network, kind, namespace, name, ruleIndex).(prometheus.Metric).Write(metric)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		return metric.GetCounter().GetValue()
+	}
+
+	countEgressFirewallRuleHits := func() int {
+		ch := make(chan prometheus.Metric, 10)
+		defer close(ch)
+		metricEgressFirewallRuleHits.Collect(ch)
+		return len(ch)
+	}
+
+	ginkgo.It("counts the egress firewall rule hits per network and object", func() {
+		AddEgressFirewallRuleHits("default", "EgressFirewall", "namespace1", "default", 0, 3)
+		AddEgressFirewallRuleHits("default", "EgressFirewall", "namespace1", "default", 0, 2)
+		AddEgressFirewallRuleHits("network1", "EgressFirewall", "namespace1", "default", 0, 4)
+		AddEgressFirewallRuleHits("default", "ClusterEgressFirewall", "", "cef1", 0, 1)
+		gomega.Expect(getEgressFirewallRuleHits("default", "EgressFirewall", "namespace1", "default", "0")).To(gomega.Equal(5.0))
+		gomega.Expect(getEgressFirewallRuleHits("network1", "EgressFirewall", "namespace1", "default", "0")).To(gomega.Equal(4.0))
+		gomega.Expect(getEgressFirewallRuleHits("default", "ClusterEgressFirewall", "", "cef1", "0")).To(gomega.Equal(1.0))
+
+		DeleteEgressFirewallRuleHits("default", "EgressFirewall", "namespace1", "default")
+		gomega.Expect(countEgressFirewallRuleHits()).To(gomega.Equal(2))
+		DeleteEgressFirewallRuleHits("default", "ClusterEgressFirewall", "", "cef1")
+		gomega.Expect(countEgressFirewallRuleHits()).To(gomega.Equal(1))
+	})
+})
@@ -317,14 +317,21 @@ func (c *nadController) syncNAD(key string, nad *nettypes.NetworkAttachmentDefin
 		ensureNetwork = util.NewMutableNetInfo(nadNetwork)
 	case util.AreNetworksCompatible(currentNetwork, nadNetwork):
 		// the NAD refers to an existing compatible network, ensure that
-		// existing network holds a reference to this NAD
+		// existing network holds a reference to this NAD and any subnets
+		// that might have been appended to the network
 		ensureNetwork = currentNetwork
+		ensureNetwork.AddSubnets(nadNetwork.Subnets()...)
 	case sets.New(key).HasAll(currentNetwork.GetNADs()...):
 		// the NAD is the only NAD referring to an existing incompatible
 		// network, remove the reference from the old network and ensure that
 		// existing network holds a reference to this NAD
 		oldNetwork = currentNetwork
 		ensureNetwork = util.NewMutableNetInfo(nadNetwork)
+	case util.AreNetworksCompatible(nadNetwork, currentNetwork):
+		// the NAD refers to an existing network whose subnets have been
+		// appended to through other NADs that this NAD has not caught up with
+		// yet, ensure that existing network holds a reference to this NAD
+		ensureNetwork = currentNetwork
 	// the NAD refers to an existing incompatible network referred by other
 	// NADs, return error
 	case oldNetwork == nil:
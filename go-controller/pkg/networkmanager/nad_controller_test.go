@@ -161,6 +161,16 @@ func TestNADController(t *testing.T) {
 		Role:    types.NetworkRoleSecondary,
 		MTU:     1400,
 	}
+	networkASecondaryGrown := &ovncnitypes.NetConf{
+		Topology: types.Layer2Topology,
+		NetConf: cnitypes.NetConf{
+			Name: "networkAPrimary",
+			Type: "ovn-k8s-cni-overlay",
+		},
+		Subnets: "10.1.130.0/24,10.1.131.0/24",
+		Role:    types.NetworkRoleSecondary,
+		MTU:     1400,
+	}
 
 	networkBSecondary := &ovncnitypes.NetConf{
 		Topology: types.LocalnetTopology,
@@ -469,6 +479,29 @@ func TestNADController(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "two NADs added then one updated with appended subnets",
+			args: []args{
+				{
+					nad:     "test/nad_1",
+					network: networkASecondary,
+				},
+				{
+					nad:     "test/nad_2",
+					network: networkASecondary,
+				},
+				{
+					nad:     "test/nad_1",
+					network: networkASecondaryGrown,
+				},
+			},
+			expected: []expected{
+				{
+					network: networkASecondaryGrown,
+					nads:    []string{"test/nad_1", "test/nad_2"},
+				},
+			},
+		},
 		{
 			name: "non ovn-k NAD added",
 			args: []args{
@@ -326,6 +326,21 @@ func (b *BridgeConfiguration) AddNetworkConfig(nInfo util.NetInfo, nodeSubnets,
 	return nil
 }
 
+// UpdateNetworkConfigSubnets updates the subnets, node subnets and management
+// IPs of the network configuration after subnets were appended to the network
+func (b *BridgeConfiguration) UpdateNetworkConfigSubnets(nInfo util.NetInfo, nodeSubnets, mgmtIPs []*net.IPNet) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	netConfig, found := b.netConfig[nInfo.GetNetworkName()]
+	if !found {
+		return
+	}
+	netConfig.Subnets = nInfo.Subnets()
+	netConfig.NodeSubnets = nodeSubnets
+	netConfig.ManagementIPs = mgmtIPs
+}
+
 // DelNetworkConfig deletes the provided netInfo from the bridge configuration cache
 func (b *BridgeConfiguration) DelNetworkConfig(nInfo util.NetInfo) {
 	b.mutex.Lock()
@@ -93,6 +93,10 @@ type UserDefinedNetworkGateway struct {
 	// save BGP state at the start of reconciliation loop run to handle it consistently throughout the run
 	isNetworkAdvertisedToDefaultVRF bool
 	isNetworkAdvertised             bool
+
+	// subnets of the network configured on the gateway, to find out the
+	// subnets appended to the network on reconciliation
+	subnets []config.CIDRNetworkEntry
 }
 
 func NewUserDefinedNetworkGateway(netInfo util.NetInfo, node *corev1.Node, nodeLister listers.NodeLister,
@@ -215,6 +219,7 @@ func (udng *UserDefinedNetworkGateway) AddNetwork() error {
 		return fmt.Errorf("could not create management port netdevice for network %s: %w", udng.GetNetworkName(), err)
 	}
 	vrfDeviceName := util.GetNetworkVRFName(udng.NetInfo)
+	udng.subnets = udng.Subnets()
 	routes, err := udng.computeRoutesForUDN(mplink)
 	if err != nil {
 		return fmt.Errorf("failed to compute routes for network %s, err: %v", udng.GetNetworkName(), err)
@@ -250,11 +255,7 @@ func (udng *UserDefinedNetworkGateway) AddNetwork() error {
 		return fmt.Errorf("could not set loose mode for reverse path filtering on management port %s: %v", mgmtPortName, err)
 	}
 
-	nodeSubnets, err := udng.getLocalSubnets()
-	var mgmtIPs []*net.IPNet
-	for _, subnet := range nodeSubnets {
-		mgmtIPs = append(mgmtIPs, udng.GetNodeManagementIP(subnet))
-	}
+	nodeSubnets, mgmtIPs, err := udng.getLocalSubnetsAndManagementIPs()
 	if err != nil {
 		return fmt.Errorf("failed to get node subnets for network %s: %w", udng.GetNetworkName(), err)
 	}
@@ -419,6 +420,20 @@ func (udng *UserDefinedNetworkGateway) getLocalSubnets() ([]*net.IPNet, error) {
 	return networkLocalSubnets, nil
 }
 
+// getLocalSubnetsAndManagementIPs returns pod subnets used by the current node
+// along with the management port IP on each of them
+func (udng *UserDefinedNetworkGateway) getLocalSubnetsAndManagementIPs() ([]*net.IPNet, []*net.IPNet, error) {
+	nodeSubnets, err := udng.getLocalSubnets()
+	if err != nil {
+		return nil, nil, err
+	}
+	mgmtIPs := make([]*net.IPNet, 0, len(nodeSubnets))
+	for _, subnet := range nodeSubnets {
+		mgmtIPs = append(mgmtIPs, udng.GetNodeManagementIP(subnet))
+	}
+	return nodeSubnets, mgmtIPs, nil
+}
+
 func (udng *UserDefinedNetworkGateway) addUDNManagementPortIPs(mpLink netlink.Link) error {
 	networkLocalSubnets, err := udng.getLocalSubnets()
 	if err != nil {
@@ -540,27 +555,18 @@ func (udng *UserDefinedNetworkGateway) computeRoutesForUDN(mpLink netlink.Link)
 		if utilnet.IsIPv6CIDR(localSubnet) {
 			etpLocalMasqueradeIP = config.Gateway.MasqueradeIPs.V6HostETPLocalMasqueradeIP
 		}
-		retVal = append(retVal, netlink.Route{
-			LinkIndex: mpLink.Attrs().Index,
-			Dst: &net.IPNet{
-				IP:   etpLocalMasqueradeIP,
-				Mask: util.GetIPFullMask(etpLocalMasqueradeIP),
-			},
-			Gw:    gwIP.IP,
-			Table: udng.vrfTableId,
-		})
-		if udng.NetInfo.TopologyType() == types.Layer3Topology {
-			for _, clusterSubnet := range udng.Subnets() {
-				if clusterSubnet.CIDR.Contains(gwIP.IP) {
-					retVal = append(retVal, netlink.Route{
-						LinkIndex: mpLink.Attrs().Index,
-						Dst:       clusterSubnet.CIDR,
-						Gw:        gwIP.IP,
-						Table:     udng.vrfTableId,
-					})
-				}
-			}
+		if !slices.ContainsFunc(retVal, func(route netlink.Route) bool { return route.Dst.IP.Equal(etpLocalMasqueradeIP) }) {
+			retVal = append(retVal, netlink.Route{
+				LinkIndex: mpLink.Attrs().Index,
+				Dst: &net.IPNet{
+					IP:   etpLocalMasqueradeIP,
+					Mask: util.GetIPFullMask(etpLocalMasqueradeIP),
+				},
+				Gw:    gwIP.IP,
+				Table: udng.vrfTableId,
+			})
 		}
+		retVal = append(retVal, udng.computeClusterSubnetRoutesForUDN(mpLink, gwIP.IP, udng.Subnets())...)
 	}
 	// Add unreachable route to enure that kernel always finds a match to the VRF table rather than
 	// referring to default VRF table and send traffic via unwanted interfaces and to unwanted gateway.
@@ -590,6 +596,63 @@ func (udng *UserDefinedNetworkGateway) computeRoutesForUDN(mpLink netlink.Link)
 	return retVal, nil
 }
 
+// computeClusterSubnetRoutesForUDN returns the routes towards the provided
+// cluster subnets of a Layer3 network through the gateway IP of the node
+// subnet of the same IP family:
+//
+//	100.100.0.0/16 via 100.100.1.1 dev ovn-k8s-mp1
+func (udng *UserDefinedNetworkGateway) computeClusterSubnetRoutesForUDN(mpLink netlink.Link, gwIP net.IP, clusterSubnets []config.CIDRNetworkEntry) []netlink.Route {
+	if udng.NetInfo.TopologyType() != types.Layer3Topology {
+		return nil
+	}
+	var retVal []netlink.Route
+	for _, clusterSubnet := range clusterSubnets {
+		if utilnet.IsIPv6CIDR(clusterSubnet.CIDR) == utilnet.IsIPv6(gwIP) {
+			retVal = append(retVal, netlink.Route{
+				LinkIndex: mpLink.Attrs().Index,
+				Dst:       clusterSubnet.CIDR,
+				Gw:        gwIP,
+				Table:     udng.vrfTableId,
+			})
+		}
+	}
+	return retVal
+}
+
+// addSubnets configures the subnets appended to the network: the cluster
+// subnet routes for Layer3 networks, the management port IPs for Layer2
+// networks and the bridge configuration used to render the flows.
+func (udng *UserDefinedNetworkGateway) addSubnets(subnets []config.CIDRNetworkEntry) error {
+	mpLink, err := util.GetNetLinkOps().LinkByName(util.GetNetworkScopedK8sMgmtHostIntfName(uint(udng.GetNetworkID())))
+	if err != nil {
+		return fmt.Errorf("failed to get management port of network %s: %w", udng.GetNetworkName(), err)
+	}
+	if err = udng.addUDNManagementPortIPs(mpLink); err != nil {
+		return fmt.Errorf("unable to add management port IP(s) for network %s: %w", udng.GetNetworkName(), err)
+	}
+	networkLocalSubnets, err := udng.getLocalSubnets()
+	if err != nil {
+		return fmt.Errorf("failed to get node subnets for network %s: %w", udng.GetNetworkName(), err)
+	}
+	var routes []netlink.Route
+	for _, localSubnet := range networkLocalSubnets {
+		routes = append(routes, udng.computeClusterSubnetRoutesForUDN(mpLink, udng.GetNodeGatewayIP(localSubnet).IP, subnets)...)
+	}
+	if len(routes) > 0 {
+		vrfDeviceName := util.GetNetworkVRFName(udng.NetInfo)
+		if err = udng.vrfManager.AddVRFRoutes(vrfDeviceName, routes); err != nil {
+			return fmt.Errorf("could not add VRF %s routes for network %s: %w", vrfDeviceName, udng.GetNetworkName(), err)
+		}
+	}
+	nodeSubnets, mgmtIPs, err := udng.getLocalSubnetsAndManagementIPs()
+	if err != nil {
+		return fmt.Errorf("failed to get node subnets for network %s: %w", udng.GetNetworkName(), err)
+	}
+	udng.openflowManager.updateNetworkSubnets(udng.NetInfo, nodeSubnets, mgmtIPs)
+	udng.subnets = udng.Subnets()
+	return nil
+}
+
 func (udng *UserDefinedNetworkGateway) getDefaultRoute() ([]netlink.Route, error) {
 	networkMTU := udng.NetInfo.MTU()
 	if networkMTU == 0 {
@@ -809,6 +872,12 @@ func (udng *UserDefinedNetworkGateway) doReconcile() error {
 	}
 	netConfig.Advertised.Store(udng.isNetworkAdvertised)
 
+	if addedSubnets := util.GetAddedSubnets(udng.subnets, udng.Subnets()); len(addedSubnets) > 0 {
+		if err := udng.addSubnets(addedSubnets); err != nil {
+			return fmt.Errorf("error while adding subnets %v for UDN %s: %w", addedSubnets, udng.GetNetworkName(), err)
+		}
+	}
+
 	if err := udng.updateUDNVRFIPRules(); err != nil {
 		return fmt.Errorf("error while updating ip rule for UDN %s: %s", udng.GetNetworkName(), err)
 	}
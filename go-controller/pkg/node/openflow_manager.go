@@ -53,6 +53,13 @@ func (c *openflowManager) addNetwork(nInfo util.NetInfo, nodeSubnets, mgmtIPs []
 	return nil
 }
 
+func (c *openflowManager) updateNetworkSubnets(nInfo util.NetInfo, nodeSubnets, mgmtIPs []*net.IPNet) {
+	c.defaultBridge.UpdateNetworkConfigSubnets(nInfo, nodeSubnets, mgmtIPs)
+	if c.externalGatewayBridge != nil {
+		c.externalGatewayBridge.UpdateNetworkConfigSubnets(nInfo, nodeSubnets, mgmtIPs)
+	}
+}
+
 func (c *openflowManager) delNetwork(nInfo util.NetInfo) {
 	c.defaultBridge.DelNetworkConfig(nInfo)
 	if c.externalGatewayBridge != nil {
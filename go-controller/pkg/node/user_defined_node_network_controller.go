@@ -2,18 +2,28 @@ package node
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sync"
+	"time"
 
+	libovsdbcache "github.com/ovn-kubernetes/libovsdb/cache"
+	libovsdbclient "github.com/ovn-kubernetes/libovsdb/client"
+	"github.com/ovn-kubernetes/libovsdb/model"
+
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
 
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/factory"
+	ovsops "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/ops/ovs"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/networkmanager"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/node/iprulemanager"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/node/vrfmanager"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/vswitchd"
 )
 
 // UserDefinedNodeNetworkController structure is the object which holds the controls for starting
@@ -24,6 +34,11 @@ type UserDefinedNodeNetworkController struct {
 	podHandler *factory.Handler
 	// responsible for programing gateway elements for this network
 	gateway *UserDefinedNetworkGateway
+	// ovs client used to configure the localnet patch port in VLAN trunk mode
+	ovsClient libovsdbclient.Client
+	// vlanTrunkSyncTrigger requests the configuration of the localnet patch
+	// port in VLAN trunk mode
+	vlanTrunkSyncTrigger chan struct{}
 }
 
 // NewUserDefinedNodeNetworkController creates a new OVN controller for creating logical network
@@ -36,6 +51,7 @@ func NewUserDefinedNodeNetworkController(
 	vrfManager *vrfmanager.Controller,
 	ruleManager *iprulemanager.Controller,
 	defaultNetworkGateway Gateway,
+	ovsClient libovsdbclient.Client,
 ) (*UserDefinedNodeNetworkController, error) {
 
 	snnc := &UserDefinedNodeNetworkController{
@@ -46,6 +62,8 @@ func NewUserDefinedNodeNetworkController(
 			wg:                              &sync.WaitGroup{},
 			networkManager:                  networkManager,
 		},
+		ovsClient:            ovsClient,
+		vlanTrunkSyncTrigger: make(chan struct{}, 1),
 	}
 	if util.IsNetworkSegmentationSupportEnabled() && snnc.IsPrimaryNetwork() {
 		node, err := snnc.watchFactory.GetNode(snnc.name)
@@ -81,6 +99,104 @@ func (nc *UserDefinedNodeNetworkController) Start(_ context.Context) error {
 				nc.GetNetworkName(), nc.name, err)
 		}
 	}
+	// OVS is not running on dpu-host nodes
+	if nc.TopologyType() == types.LocalnetTopology && len(nc.AllowedVLANs()) > 0 && config.OvnKubeNode.Mode != types.NodeModeDPUHost {
+		nc.startLocalnetVLANTrunkSync()
+	}
+	return nil
+}
+
+// localnetVLANTrunkSyncRetryInterval is how long to wait before retrying to
+// configure the localnet patch port of a network in VLAN trunk mode
+const localnetVLANTrunkSyncRetryInterval = 5 * time.Second
+
+// startLocalnetVLANTrunkSync configures the localnet patch port of the network
+// as a VLAN trunk every time it is created or updated. ovn-controller only
+// creates the patch port once a pod of the network is scheduled on the node.
+// The VLANs that are not allowed are dropped by OVN in the meantime, the
+// configuration of the patch port sets up the native VLAN.
+func (nc *UserDefinedNodeNetworkController) startLocalnetVLANTrunkSync() {
+	portName := nc.localnetPatchPortName()
+	isPatchPort := func(m model.Model) bool {
+		port, ok := m.(*vswitchd.Port)
+		return ok && port.Name == portName
+	}
+	nc.ovsClient.Cache().AddEventHandler(&libovsdbcache.EventHandlerFuncs{
+		AddFunc: func(_ string, m model.Model) {
+			if isPatchPort(m) {
+				nc.triggerLocalnetVLANTrunkSync()
+			}
+		},
+		UpdateFunc: func(_ string, _, new model.Model) {
+			if isPatchPort(new) {
+				nc.triggerLocalnetVLANTrunkSync()
+			}
+		},
+	})
+
+	nc.wg.Add(1)
+	go func() {
+		defer nc.wg.Done()
+		for {
+			select {
+			case <-nc.vlanTrunkSyncTrigger:
+				if err := nc.syncLocalnetVLANTrunk(); err != nil {
+					klog.Errorf("Failed to configure VLAN trunk for network %s: %v", nc.GetNetworkName(), err)
+					time.AfterFunc(localnetVLANTrunkSyncRetryInterval, nc.triggerLocalnetVLANTrunkSync)
+				}
+			case <-nc.stopChan:
+				return
+			}
+		}
+	}()
+	nc.triggerLocalnetVLANTrunkSync()
+}
+
+func (nc *UserDefinedNodeNetworkController) triggerLocalnetVLANTrunkSync() {
+	select {
+	case nc.vlanTrunkSyncTrigger <- struct{}{}:
+	default:
+		// a sync is already pending
+	}
+}
+
+func (nc *UserDefinedNodeNetworkController) localnetPatchPortName() string {
+	return types.PatchPortPrefix + nc.GetNetworkScopedName(types.OVNLocalnetPort) + types.PatchPortSuffix
+}
+
+// syncLocalnetVLANTrunk configures the patch port connecting the localnet port
+// of the network to the provider bridge as a trunk of the allowed VLANs, with
+// the untagged traffic of the pods mapped to the native VLAN, if any. It is a
+// no-op if the patch port does not exist yet.
+func (nc *UserDefinedNodeNetworkController) syncLocalnetVLANTrunk() error {
+	portName := nc.localnetPatchPortName()
+	port, err := ovsops.GetPort(nc.ovsClient, portName)
+	if errors.Is(err, libovsdbclient.ErrNotFound) {
+		// not created yet
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get port %s: %w", portName, err)
+	}
+
+	vlanMode, tag := vswitchd.PortVLANModeTrunk, (*int)(nil)
+	if nc.NativeVLAN() != 0 {
+		vlanMode, tag = vswitchd.PortVLANModeNativeUntagged, ptr.To(int(nc.NativeVLAN()))
+	}
+	trunks := make([]int, 0, len(nc.AllowedVLANs()))
+	for _, vlan := range nc.AllowedVLANs() {
+		trunks = append(trunks, int(vlan))
+	}
+	if ptr.Deref(port.VLANMode, "") == vlanMode && ptr.Equal(port.Tag, tag) && sets.New(port.Trunks...).Equal(sets.New(trunks...)) {
+		return nil
+	}
+
+	port.VLANMode, port.Tag, port.Trunks = &vlanMode, tag, trunks
+	if err := ovsops.UpdatePortVLANConfig(nc.ovsClient, port); err != nil {
+		return fmt.Errorf("failed to set VLAN configuration of port %s: %w", portName, err)
+	}
+	klog.Infof("Configured port %s as a trunk of VLANs %s for network %s", portName,
+		util.FormatVLANIDRanges(nc.AllowedVLANs()), nc.GetNetworkName())
 	return nil
 }
 
@@ -106,13 +222,15 @@ func (nc *UserDefinedNodeNetworkController) Cleanup() error {
 func (nc *UserDefinedNodeNetworkController) shouldReconcileNetworkChange(old, new util.NetInfo) bool {
 	wasUDNNetworkAdvertisedAtNode := util.IsPodNetworkAdvertisedAtNode(old, nc.name)
 	isUDNNetworkAdvertisedAtNode := util.IsPodNetworkAdvertisedAtNode(new, nc.name)
-	return wasUDNNetworkAdvertisedAtNode != isUDNNetworkAdvertisedAtNode
+	subnetsAdded := len(util.GetAddedSubnets(old.Subnets(), new.Subnets())) > 0
+	return wasUDNNetworkAdvertisedAtNode != isUDNNetworkAdvertisedAtNode || subnetsAdded
 }
 
 // Reconcile function reconciles three entities based on whether UDN network is advertised
-// and the gateway mode:
+// and the gateway mode, or on subnets appended to the network:
 // 1. IP rules
 // 2. OpenFlows on br-ex bridge to forward traffic to correct ofports
+// 3. Routes and management port IPs for the appended subnets
 func (nc *UserDefinedNodeNetworkController) Reconcile(netInfo util.NetInfo) error {
 	reconcilePodNetwork := nc.shouldReconcileNetworkChange(nc.ReconcilableNetInfo, netInfo)
 
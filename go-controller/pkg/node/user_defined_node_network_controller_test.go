@@ -9,9 +9,12 @@ import (
 	"sync"
 	"time"
 
+	cnitypes "github.com/containernetworking/cni/pkg/types"
 	"github.com/containernetworking/plugins/pkg/ns"
 	"github.com/containernetworking/plugins/pkg/testutils"
 	nadfake "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/client/clientset/versioned/fake"
+	libovsdbmodel "github.com/ovn-kubernetes/libovsdb/model"
+	"github.com/ovn-kubernetes/libovsdb/ovsdb"
 	"github.com/stretchr/testify/mock"
 	"github.com/vishvananda/netlink"
 
@@ -20,11 +23,14 @@ import (
 	"k8s.io/client-go/kubernetes/fake"
 	"k8s.io/utils/ptr"
 
+	ovncnitypes "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/cni/types"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
 	udnfakeclient "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/userdefinednetwork/v1/apis/clientset/versioned/fake"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/factory"
 	factoryMocks "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/factory/mocks"
 	kubemocks "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/kube/mocks"
+	libovsdbops "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
+	ovsops "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/ops/ovs"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/networkmanager"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/node/iprulemanager"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/node/managementport"
@@ -32,10 +38,12 @@ import (
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/node/routemanager"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/node/vrfmanager"
 	ovntest "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/testing"
+	libovsdbtest "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/testing/libovsdb"
 	coreinformermocks "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/testing/mocks/k8s.io/client-go/informers/core/v1"
 	v1mocks "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/testing/mocks/k8s.io/client-go/listers/core/v1"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/vswitchd"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -85,7 +93,7 @@ var _ = Describe("UserDefinedNodeNetworkController", func() {
 		factoryMock.On("GetNodes").Return(nodeList, nil)
 		NetInfo, err := util.ParseNADInfo(nad)
 		Expect(err).NotTo(HaveOccurred())
-		controller, err := NewUserDefinedNodeNetworkController(&cnnci, NetInfo, nil, nil, nil, &gateway{})
+		controller, err := NewUserDefinedNodeNetworkController(&cnnci, NetInfo, nil, nil, nil, &gateway{}, nil)
 		Expect(err).NotTo(HaveOccurred())
 		err = controller.Start(context.Background())
 		Expect(err).NotTo(HaveOccurred())
@@ -116,7 +124,7 @@ var _ = Describe("UserDefinedNodeNetworkController", func() {
 		Expect(err).NotTo(HaveOccurred())
 		getCreationFakeCommands(fexec, "ovn-k8s-mp3", mgtPortMAC, NetInfo.GetNetworkName(), "worker1", NetInfo.MTU())
 		ofm := getDummyOpenflowManager()
-		controller, err := NewUserDefinedNodeNetworkController(&cnnci, NetInfo, nil, nil, nil, &gateway{openflowManager: ofm})
+		controller, err := NewUserDefinedNodeNetworkController(&cnnci, NetInfo, nil, nil, nil, &gateway{openflowManager: ofm}, nil)
 		Expect(err).NotTo(HaveOccurred())
 		err = controller.Start(context.Background())
 		Expect(err).To(HaveOccurred()) // we don't have the gateway pieces setup so its expected to fail here
@@ -144,12 +152,73 @@ var _ = Describe("UserDefinedNodeNetworkController", func() {
 			types.Layer3Topology, "100.128.0.0/16", types.NetworkRoleSecondary)
 		NetInfo, err := util.ParseNADInfo(nad)
 		Expect(err).NotTo(HaveOccurred())
-		controller, err := NewUserDefinedNodeNetworkController(&cnnci, NetInfo, nil, nil, nil, &gateway{})
+		controller, err := NewUserDefinedNodeNetworkController(&cnnci, NetInfo, nil, nil, nil, &gateway{}, nil)
 		Expect(err).NotTo(HaveOccurred())
 		err = controller.Start(context.Background())
 		Expect(err).NotTo(HaveOccurred())
 		Expect(controller.gateway).To(BeNil())
 	})
+	It("configures the localnet patch port of networks in VLAN trunk mode when it is created", func() {
+		netInfo, err := util.NewNetInfo(&ovncnitypes.NetConf{
+			NetConf:      cnitypes.NetConf{Name: "bluenet"},
+			Topology:     types.LocalnetTopology,
+			AllowedVLANs: "10,20-22",
+			NativeVLAN:   5,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		bridge := &vswitchd.Bridge{UUID: "bridge-uuid", Name: "breth0"}
+		ovsClient, libovsdbCleanup, err := libovsdbtest.NewOVSTestHarness(libovsdbtest.TestSetup{
+			OVSData: []libovsdbtest.TestData{
+				&vswitchd.OpenvSwitch{UUID: "root-ovs", Bridges: []string{bridge.UUID}},
+				bridge,
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		defer libovsdbCleanup.Cleanup()
+		controller := &UserDefinedNodeNetworkController{
+			BaseNodeNetworkController: BaseNodeNetworkController{
+				ReconcilableNetInfo: util.NewReconcilableNetInfo(netInfo),
+				stopChan:            make(chan struct{}),
+				wg:                  &sync.WaitGroup{},
+			},
+			ovsClient:            ovsClient,
+			vlanTrunkSyncTrigger: make(chan struct{}, 1),
+		}
+		controller.startLocalnetVLANTrunkSync()
+		defer func() {
+			close(controller.stopChan)
+			controller.wg.Wait()
+		}()
+		portName := "patch-" + netInfo.GetNetworkScopedName(types.OVNLocalnetPort) + "-to-br-int"
+		expectTrunk := func(g Gomega) {
+			port, err := ovsops.GetPort(ovsClient, portName)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(port.VLANMode).To(HaveValue(Equal(vswitchd.PortVLANModeNativeUntagged)))
+			g.Expect(port.Tag).To(HaveValue(Equal(5)))
+			g.Expect(port.Trunks).To(ConsistOf(10, 20, 21, 22))
+		}
+
+		By("configuring the patch port as a trunk once ovn-controller creates it")
+		port := &vswitchd.Port{UUID: "patchport", Name: portName}
+		ops, err := ovsClient.Create(port)
+		Expect(err).NotTo(HaveOccurred())
+		mutateOps, err := ovsClient.Where(bridge).Mutate(bridge, libovsdbmodel.Mutation{
+			Field:   &bridge.Ports,
+			Mutator: ovsdb.MutateOperationInsert,
+			Value:   []string{port.UUID},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		_, err = libovsdbops.TransactAndCheck(ovsClient, append(ops, mutateOps...))
+		Expect(err).NotTo(HaveOccurred())
+		Eventually(expectTrunk).Should(Succeed())
+
+		By("configuring the patch port again when its VLAN configuration is reset")
+		port, err = ovsops.GetPort(ovsClient, portName)
+		Expect(err).NotTo(HaveOccurred())
+		port.VLANMode, port.Tag, port.Trunks = nil, nil, nil
+		Expect(ovsops.UpdatePortVLANConfig(ovsClient, port)).To(Succeed())
+		Eventually(expectTrunk).Should(Succeed())
+	})
 })
 
 var _ = Describe("UserDefinedNodeNetworkController: UserDefinedPrimaryNetwork Gateway functionality", func() {
@@ -420,7 +489,7 @@ var _ = Describe("UserDefinedNodeNetworkController: UserDefinedPrimaryNetwork Ga
 
 			By("creating a UDN controller for user-defined primary network")
 			cnnci := CommonNodeNetworkControllerInfo{name: nodeName, watchFactory: &factoryMock}
-			controller, err := NewUserDefinedNodeNetworkController(&cnnci, NetInfo, nil, vrf, ipRulesManager, localGw)
+			controller, err := NewUserDefinedNodeNetworkController(&cnnci, NetInfo, nil, vrf, ipRulesManager, localGw, nil)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(controller.gateway).To(Not(BeNil()))
 			Expect(controller.gateway.ruleManager).To(Not(BeNil()))
@@ -13,6 +13,7 @@ import (
 	libovsdbclient "github.com/ovn-kubernetes/libovsdb/client"
 	"github.com/ovn-kubernetes/libovsdb/ovsdb"
 
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
 	libovsdbops "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
 )
@@ -57,6 +58,8 @@ type Manager struct {
 	// Only maxCollectorID collectors are allowed, each should have unique ID.
 	// this set is tracking already assigned IDs.
 	takenCollectorIDs sets.Set[int]
+	// sampleCounter counts the samples received on the local node, nil if samples are not counted.
+	sampleCounter *SampleCounter
 }
 
 func NewManager(nbClient libovsdbclient.Client) *Manager {
@@ -74,6 +77,27 @@ func (m *Manager) SamplingConfig() *libovsdbops.SamplingConfig {
 	return m.sampConfig
 }
 
+// SampleCounter returns the counter of the samples received on the local node, or nil if samples are not counted.
+func (m *Manager) SampleCounter() *SampleCounter {
+	return m.sampleCounter
+}
+
+// StartSampleCounter starts counting the samples received on the local node until stopChan is closed.
+// It should only be called when the local node is the only node of the zone.
+func (m *Manager) StartSampleCounter(stopChan <-chan struct{}) error {
+	counter := NewSampleCounter()
+	if err := counter.Run(stopChan); err != nil {
+		return err
+	}
+	m.SetSampleCounter(counter)
+	return nil
+}
+
+// SetSampleCounter sets the counter of the samples received on the local node.
+func (m *Manager) SetSampleCounter(counter *SampleCounter) {
+	m.sampleCounter = counter
+}
+
 func (m *Manager) Init() error {
 	// this will be read from the kube-api in the future
 	currentConfig := &collectorConfig{
@@ -87,6 +111,10 @@ func (m *Manager) Init() error {
 		},
 	}
 
+	if config.OVNKubernetesFeature.EnableDNSSnooping {
+		currentConfig.featuresProbability[libovsdbops.DNSSnoopingSample] = 100
+	}
+
 	return m.initWithConfig(currentConfig)
 }
 
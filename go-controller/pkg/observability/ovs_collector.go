@@ -0,0 +1,76 @@
+package observability
+
+import (
+	"context"
+	"fmt"
+
+	libovsdbclient "github.com/ovn-kubernetes/libovsdb/client"
+	"github.com/ovn-kubernetes/libovsdb/ovsdb"
+
+	observovsdb "github.com/ovn-org/ovn-kubernetes/go-controller/observability-lib/ovsdb"
+	libovsdbops "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
+)
+
+const (
+	// ovsCollectorOwner is the owner of the OVS collector configured by ovnkube-controller.
+	ovsCollectorOwner = "ovnkube-controller"
+	// ovsCollectorGroupID is the psample group ID of the samples sent to the OVS collector.
+	ovsCollectorGroupID   = DefaultObservabilityCollectorSetID
+	ovsCollectorOwnerKey  = "owner"
+	integrationBridgeName = "br-int"
+)
+
+// EnsureOVSCollector configures OVS to send the samples of the observability collector set to the psample
+// netlink multicast group, so that they can be received on the local node.
+// It fails if the collector is already configured by someone else, e.g. by ovnkube-observ.
+func EnsureOVSCollector(ovsClient libovsdbclient.Client) error {
+	ctx, cancel := context.WithTimeout(context.Background(), types.OVSDBTimeout)
+	defer cancel()
+	collectors := []*observovsdb.FlowSampleCollectorSet{}
+	err := ovsClient.WhereCache(func(item *observovsdb.FlowSampleCollectorSet) bool {
+		return item.ID == DefaultObservabilityCollectorSetID
+	}).List(ctx, &collectors)
+	if err != nil {
+		return fmt.Errorf("failed to find OVS collector %d: %w", DefaultObservabilityCollectorSetID, err)
+	}
+	bridges := []*observovsdb.Bridge{}
+	err = ovsClient.WhereCache(func(item *observovsdb.Bridge) bool {
+		return item.Name == integrationBridgeName
+	}).List(ctx, &bridges)
+	if err != nil {
+		return fmt.Errorf("failed to find bridge %s: %w", integrationBridgeName, err)
+	}
+	if len(bridges) != 1 {
+		return fmt.Errorf("failed to find bridge %s", integrationBridgeName)
+	}
+
+	groupID := ovsCollectorGroupID
+	collector := &observovsdb.FlowSampleCollectorSet{
+		ID:           DefaultObservabilityCollectorSetID,
+		Bridge:       bridges[0].UUID,
+		LocalGroupID: &groupID,
+		ExternalIDs:  map[string]string{ovsCollectorOwnerKey: ovsCollectorOwner},
+	}
+	var ops []ovsdb.Operation
+	switch {
+	case len(collectors) == 0:
+		ops, err = ovsClient.Create(collector)
+	case collectors[0].ExternalIDs[ovsCollectorOwnerKey] != ovsCollectorOwner:
+		return fmt.Errorf("OVS collector %d is already configured by %q", DefaultObservabilityCollectorSetID,
+			collectors[0].ExternalIDs[ovsCollectorOwnerKey])
+	case collectors[0].Bridge == collector.Bridge && collectors[0].LocalGroupID != nil &&
+		*collectors[0].LocalGroupID == groupID:
+		return nil
+	default:
+		collector.UUID = collectors[0].UUID
+		ops, err = ovsClient.Where(collector).Update(collector, &collector.Bridge, &collector.LocalGroupID)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to configure OVS collector %d: %w", DefaultObservabilityCollectorSetID, err)
+	}
+	if _, err = libovsdbops.TransactAndCheck(ovsClient, ops); err != nil {
+		return fmt.Errorf("failed to configure OVS collector %d: %w", DefaultObservabilityCollectorSetID, err)
+	}
+	return nil
+}
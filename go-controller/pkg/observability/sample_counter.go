@@ -0,0 +1,46 @@
+package observability
+
+import (
+	"sync"
+)
+
+// SampleCounter counts the packets sampled by OVN observability per observation point ID.
+// For ACLs, the observation point ID is the ID returned by libovsdbops.GetACLSampleID.
+// Samples are received from the psample netlink multicast group, OVS sends them there when a
+// Flow_Sample_Collector_Set with a local_group_id is configured for the observability collector set, see
+// EnsureOVSCollector.
+type SampleCounter struct {
+	lock   sync.RWMutex
+	counts map[uint32]uint64
+}
+
+func NewSampleCounter() *SampleCounter {
+	return &SampleCounter{
+		counts: make(map[uint32]uint64),
+	}
+}
+
+// Run counts the samples received on the local node until stopChan is closed.
+func (c *SampleCounter) Run(stopChan <-chan struct{}) error {
+	return ReceiveSamples(stopChan, func(obsDomainID, obsPointID uint32, _ []byte) {
+		c.Count(obsDomainID, obsPointID)
+	})
+}
+
+// Count records a sample with the given observation domain and point IDs.
+// Only samples generated by ACLs are counted.
+func (c *SampleCounter) Count(obsDomainID, obsPointID uint32) {
+	if !IsACLSample(obsDomainID) {
+		return
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.counts[obsPointID]++
+}
+
+// Get returns the number of samples counted for the given observation point ID.
+func (c *SampleCounter) Get(obsPointID uint32) uint64 {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	return c.counts[obsPointID]
+}
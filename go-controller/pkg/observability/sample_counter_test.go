@@ -0,0 +1,20 @@
+package observability
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Sample Counter", func() {
+	It("counts only the samples generated by ACLs", func() {
+		counter := NewSampleCounter()
+		counter.Count(ACLNewTrafficSamplingID<<24|1, 10)
+		counter.Count(ACLEstTrafficSamplingID<<24|2, 10)
+		counter.Count(ACLNewTrafficSamplingID<<24, 20)
+		counter.Count(DropSamplingID<<24, 30)
+		Expect(counter.Get(10)).To(BeEquivalentTo(2))
+		Expect(counter.Get(20)).To(BeEquivalentTo(1))
+		Expect(counter.Get(30)).To(BeZero())
+		Expect(counter.Get(40)).To(BeZero())
+	})
+})
@@ -0,0 +1,15 @@
+package observability
+
+// SampleHandler is called for every sample received on the local node with the observation domain and point
+// IDs of the sample and the sampled packet.
+type SampleHandler func(obsDomainID, obsPointID uint32, data []byte)
+
+// IsACLSample returns true if a sample with the given observation domain ID was generated by an ACL.
+func IsACLSample(obsDomainID uint32) bool {
+	// the observation app ID is encoded in the 8 most significant bits of the observation domain ID
+	switch obsDomainID >> 24 {
+	case ACLNewTrafficSamplingID, ACLEstTrafficSamplingID:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,90 @@
+//go:build linux
+// +build linux
+
+package observability
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/vishvananda/netlink"
+	"github.com/vishvananda/netlink/nl"
+	"golang.org/x/sys/unix"
+	"k8s.io/klog/v2"
+)
+
+const (
+	psampleGenlName            = "psample"
+	psampleMcastGroupName      = "packets"
+	psampleAttrData            = 6
+	psampleAttrUserCookie      = 15
+	psampleCookieSize          = 8
+	psampleReceiveTimeoutInSec = 1
+)
+
+// ReceiveSamples subscribes to the psample netlink multicast group and calls handler for every
+// received sample until stopChan is closed.
+func ReceiveSamples(stopChan <-chan struct{}, handler SampleHandler) error {
+	family, err := netlink.GenlFamilyGet(psampleGenlName)
+	if err != nil {
+		return fmt.Errorf("failed to get netlink family %s: %w", psampleGenlName, err)
+	}
+	var groupID uint32
+	for _, group := range family.Groups {
+		if group.Name == psampleMcastGroupName {
+			groupID = group.ID
+		}
+	}
+	if groupID == 0 {
+		return fmt.Errorf("failed to find netlink multicast group %s of family %s", psampleMcastGroupName, psampleGenlName)
+	}
+	sock, err := nl.Subscribe(nl.GENL_ID_CTRL, uint(groupID))
+	if err != nil {
+		return fmt.Errorf("failed to subscribe to netlink multicast group %s: %w", psampleMcastGroupName, err)
+	}
+	// receive with a timeout, so that stopChan is checked periodically
+	if err = sock.SetReceiveTimeout(&unix.Timeval{Sec: psampleReceiveTimeoutInSec}); err != nil {
+		sock.Close()
+		return fmt.Errorf("failed to set netlink socket receive timeout: %w", err)
+	}
+
+	go func() {
+		defer sock.Close()
+		for {
+			select {
+			case <-stopChan:
+				return
+			default:
+			}
+			msgs, _, err := sock.Receive()
+			if err != nil {
+				// ENOBUFS means that samples were dropped because we didn't keep up, they are just missed
+				if !errors.Is(err, unix.EAGAIN) && !errors.Is(err, unix.EWOULDBLOCK) && !errors.Is(err, unix.ENOBUFS) {
+					klog.Errorf("Failed to receive psample messages: %v", err)
+				}
+				continue
+			}
+			for _, msg := range msgs {
+				if len(msg.Data) < nl.SizeofGenlmsg {
+					continue
+				}
+				var cookie, data []byte
+				for attr := range nl.ParseAttributes(msg.Data[nl.SizeofGenlmsg:]) {
+					switch attr.Type {
+					case psampleAttrUserCookie:
+						cookie = attr.Value
+					case psampleAttrData:
+						data = attr.Value
+					}
+				}
+				if len(cookie) != psampleCookieSize {
+					continue
+				}
+				// the cookie is encoded in network byte order
+				handler(binary.BigEndian.Uint32(cookie[:4]), binary.BigEndian.Uint32(cookie[4:]), data)
+			}
+		}
+	}()
+	return nil
+}
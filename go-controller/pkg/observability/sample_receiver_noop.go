@@ -0,0 +1,12 @@
+//go:build !linux
+// +build !linux
+
+package observability
+
+import (
+	"fmt"
+)
+
+func ReceiveSamples(_ <-chan struct{}, _ SampleHandler) error {
+	return fmt.Errorf("receiving samples is supported on linux platform only")
+}
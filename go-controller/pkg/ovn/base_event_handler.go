@@ -13,6 +13,7 @@ import (
 	"k8s.io/klog/v2"
 
 	egressfirewall "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressfirewall/v1"
+	egressipv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressip/v1"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/factory"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/metrics/recorders"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
@@ -87,8 +88,35 @@ func (h *baseNetworkControllerEventHandler) areResourcesEqual(objType reflect.Ty
 		}
 		return reflect.DeepEqual(oldEgressFirewall.Spec, newEgressFirewall.Spec), nil
 
-	case factory.EgressIPType,
-		factory.EgressIPNamespaceType,
+	case factory.ClusterEgressFirewallType:
+		oldClusterEgressFirewall, ok := obj1.(*egressfirewall.ClusterEgressFirewall)
+		if !ok {
+			return false, fmt.Errorf("could not cast obj1 of type %T to *egressfirewall.ClusterEgressFirewall", obj1)
+		}
+		newClusterEgressFirewall, ok := obj2.(*egressfirewall.ClusterEgressFirewall)
+		if !ok {
+			return false, fmt.Errorf("could not cast obj2 of type %T to *egressfirewall.ClusterEgressFirewall", obj2)
+		}
+		return reflect.DeepEqual(oldClusterEgressFirewall.Spec, newClusterEgressFirewall.Spec), nil
+
+	case factory.EgressIPType:
+		oldEgressIP, ok := obj1.(*egressipv1.EgressIP)
+		if !ok {
+			return false, fmt.Errorf("could not cast obj1 of type %T to *egressipv1.EgressIP", obj1)
+		}
+		newEgressIP, ok := obj2.(*egressipv1.EgressIP)
+		if !ok {
+			return false, fmt.Errorf("could not cast obj2 of type %T to *egressipv1.EgressIP", obj2)
+		}
+		// the conditions, the unassigned egress IPs and the preferred nodes are
+		// only used by the cluster manager, skip the update when nothing else
+		// changed so that reporting them doesn't override a failed update
+		// waiting to be retried
+		return reflect.DeepEqual(oldEgressIP.Spec, newEgressIP.Spec) &&
+			reflect.DeepEqual(oldEgressIP.Status.Items, newEgressIP.Status.Items) &&
+			!util.EgressIPMarkAnnotationChanged(oldEgressIP.Annotations, newEgressIP.Annotations), nil
+
+	case factory.EgressIPNamespaceType,
 		factory.EgressNodeType:
 		// force update path for EgressIP resource.
 		return false, nil
@@ -155,6 +183,9 @@ func (h *baseNetworkControllerEventHandler) getResourceFromInformerCache(objType
 	case factory.EgressFirewallType:
 		obj, err = watchFactory.GetEgressFirewall(namespace, name)
 
+	case factory.ClusterEgressFirewallType:
+		obj, err = watchFactory.GetClusterEgressFirewall(name)
+
 	case factory.EgressIPType:
 		obj, err = watchFactory.GetEgressIP(name)
 
@@ -14,6 +14,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	knet "k8s.io/api/networking/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/sets"
 	clientset "k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/scheme"
@@ -27,6 +28,8 @@ import (
 
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/allocator/pod"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/controller"
+	egressfirewall "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressfirewall/v1"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/factory"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/kube"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/kubevirt"
@@ -39,6 +42,7 @@ import (
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/observability"
 	addressset "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn/address_set"
 	nqoscontroller "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn/controller/network_qos"
+	dnsnameresolver "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn/dns_name_resolver"
 	lsm "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn/logical_switch_manager"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn/routeimport"
 	zoneic "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn/zone_interconnect"
@@ -102,6 +106,10 @@ type BaseNetworkController struct {
 	retryMultiNetworkPolicies *ovnretry.RetryFramework
 	// retry framework for IPAMClaims
 	retryIPAMClaims *ovnretry.RetryFramework
+	// retry framework for egress firewall
+	retryEgressFirewalls *ovnretry.RetryFramework
+	// retry framework for cluster egress firewall
+	retryClusterEgressFirewalls *ovnretry.RetryFramework
 
 	// pod events factory handler
 	podHandler *factory.Handler
@@ -111,6 +119,10 @@ type BaseNetworkController struct {
 	namespaceHandler *factory.Handler
 	// ipam claims events factory Handler
 	ipamClaimsHandler *factory.Handler
+	// egress firewall events factory Handler
+	egressFirewallHandler *factory.Handler
+	// cluster egress firewall events factory Handler
+	clusterEgressFirewallHandler *factory.Handler
 
 	// A cache of all logical switches seen by the watcher and their subnets
 	lsManager *lsm.LogicalSwitchManager
@@ -149,6 +161,17 @@ type BaseNetworkController struct {
 
 	podSelectorAddressSets *syncmap.SyncMap[*PodSelectorAddressSet]
 
+	// egressFirewalls is a map of namespaces and the egressFirewall attached to it
+	egressFirewalls sync.Map
+	// dnsNameResolver is used for resolving the IP addresses of DNS names
+	// used in egress firewall rules
+	dnsNameResolver  dnsnameresolver.DNSNameResolver
+	efNodeController controller.Controller
+	// clusterEgressFirewalls is a map of cluster egress firewall names and their rendered state
+	clusterEgressFirewalls sync.Map
+	// cefNamespaceController updates the namespaces selected by cluster egress firewalls
+	cefNamespaceController controller.Controller
+
 	// stopChan per controller
 	stopChan chan struct{}
 	// waitGroup per-Controller
@@ -192,11 +215,13 @@ type BaseNetworkController struct {
 func (oc *BaseNetworkController) reconcile(netInfo util.NetInfo, setNodeFailed func(string)) error {
 	// gather some information first
 	var reconcileNodes []string
+	// subnets appended to the network need to be configured on all nodes
+	subnetsAdded := len(util.GetAddedSubnets(oc.Subnets(), netInfo.Subnets())) > 0
 	oc.localZoneNodes.Range(func(key, _ any) bool {
 		nodeName := key.(string)
 		wasAdvertised := util.IsPodNetworkAdvertisedAtNode(oc, nodeName)
 		isAdvertised := util.IsPodNetworkAdvertisedAtNode(netInfo, nodeName)
-		if wasAdvertised == isAdvertised {
+		if wasAdvertised == isAdvertised && !subnetsAdded {
 			// noop
 			return true
 		}
@@ -204,7 +229,7 @@ func (oc *BaseNetworkController) reconcile(netInfo util.NetInfo, setNodeFailed f
 		return true
 	})
 	reconcileRoutes := oc.routeImportManager != nil && oc.routeImportManager.NeedsReconciliation(netInfo)
-	reconcilePendingPods := !oc.IsDefault() && !oc.ReconcilableNetInfo.EqualNADs(netInfo.GetNADs()...)
+	reconcilePendingPods := !oc.IsDefault() && (subnetsAdded || !oc.ReconcilableNetInfo.EqualNADs(netInfo.GetNADs()...))
 	reconcileNamespaces := sets.NewString()
 	if oc.IsPrimaryNetwork() {
 		// since CanServeNamespace filters out namespace events for namespaces unknown
@@ -281,6 +306,38 @@ func (oc *BaseNetworkController) doReconcile(reconcileRoutes, reconcilePendingPo
 	if namespaceAdded {
 		oc.retryNamespaces.RequestRetryObjs()
 	}
+
+	// egress firewalls of namespaces that were added to the network were filtered out until now,
+	// make sure they get rendered on this network.
+	if oc.retryEgressFirewalls == nil {
+		return
+	}
+	egressFirewallAdded := false
+	for _, ns := range reconcileNamespaces {
+		egressFirewalls, err := oc.watchFactory.EgressFirewallInformer().Lister().EgressFirewalls(ns).List(labels.Everything())
+		if err != nil {
+			klog.Infof("Failed to list egress firewalls in namespace %s for reconciling network %s: %v", ns, oc.GetNetworkName(), err)
+			continue
+		}
+		for _, egressFirewall := range egressFirewalls {
+			err = oc.retryEgressFirewalls.AddRetryObjWithAddNoBackoff(egressFirewall)
+			if err != nil {
+				klog.Infof("Failed to retry egress firewall %s for network %s: %v",
+					getEgressFirewallNamespacedName(egressFirewall), oc.GetNetworkName(), err)
+				continue
+			}
+			egressFirewallAdded = true
+		}
+	}
+	if egressFirewallAdded {
+		oc.retryEgressFirewalls.RequestRetryObjs()
+	}
+	// cluster egress firewalls may select the namespaces that were added to or removed from the network
+	if oc.cefNamespaceController != nil {
+		for _, ns := range reconcileNamespaces {
+			oc.cefNamespaceController.Reconcile(ns)
+		}
+	}
 }
 
 // BaseUserDefinedNetworkController structure holds per-network fields and network specific
@@ -292,6 +349,11 @@ type BaseUserDefinedNetworkController struct {
 	netPolicyHandler *factory.Handler
 	// multi-network policy events factory handler
 	multiNetPolicyHandler *factory.Handler
+
+	// networkPeeringController configures the NetworkPeerings of the network, and networkPeeringNamespaceController
+	// updates them when the namespaces they select change
+	networkPeeringController          controller.Controller
+	networkPeeringNamespaceController controller.Controller
 }
 
 func (oc *BaseUserDefinedNetworkController) FilterOutResource(objType reflect.Type, obj interface{}) bool {
@@ -310,6 +372,13 @@ func (oc *BaseUserDefinedNetworkController) FilterOutResource(objType reflect.Ty
 			return false
 		}
 		return !util.CanServeNamespace(oc.GetNetInfo(), pod.GetNamespace())
+	case factory.EgressFirewallType:
+		egressFirewall, ok := obj.(*egressfirewall.EgressFirewall)
+		if !ok {
+			klog.Errorf("Failed to cast the provided object to an egress firewall")
+			return false
+		}
+		return !util.CanServeNamespace(oc.GetNetInfo(), egressFirewall.Namespace)
 	default:
 		return false
 	}
@@ -574,7 +643,15 @@ func (bnc *BaseNetworkController) createNodeLogicalSwitch(nodeName string, hostS
 		return fmt.Errorf("failed finding migratable pod IPs belonging to %s: %v", nodeName, err)
 	}
 
-	return bnc.lsManager.AddOrUpdateSwitch(logicalSwitch.Name, hostSubnets, nil, migratableIPsByPod...)
+	excludeSubnets := migratableIPsByPod
+	// the network excluded subnets are applied on the node owning the host
+	// subnet they belong to
+	for _, excludeSubnet := range bnc.ExcludeSubnets() {
+		if util.IsContainedInAnyCIDR(excludeSubnet, hostSubnets...) {
+			excludeSubnets = append(excludeSubnets, excludeSubnet)
+		}
+	}
+	return bnc.lsManager.AddOrUpdateSwitch(logicalSwitch.Name, hostSubnets, nil, excludeSubnets...)
 }
 
 // deleteNodeLogicalNetwork removes the logical switch and logical router port associated with the node
@@ -1061,6 +1138,36 @@ func (bnc *BaseNetworkController) AddResourceCommon(objType reflect.Type, obj in
 				np.Namespace, np.Name, err)
 			return err
 		}
+	case factory.EgressFirewallType:
+		egressFirewall, ok := obj.(*egressfirewall.EgressFirewall)
+		if !ok {
+			return fmt.Errorf("could not cast %T object to *egressfirewall.EgressFirewall", obj)
+		}
+		egressFirewall = egressFirewall.DeepCopy()
+		served, err := bnc.isEgressFirewallNamespaceServed(egressFirewall.Namespace)
+		if err == nil && !served {
+			return nil
+		}
+		if err == nil {
+			err = bnc.addEgressFirewall(egressFirewall)
+		}
+		if statusErr := bnc.setEgressFirewallStatus(egressFirewall, err); statusErr != nil {
+			klog.Errorf("Failed to update egress firewall status %s, error: %v",
+				getEgressFirewallNamespacedName(egressFirewall), statusErr)
+		}
+		return err
+	case factory.ClusterEgressFirewallType:
+		clusterEgressFirewall, ok := obj.(*egressfirewall.ClusterEgressFirewall)
+		if !ok {
+			return fmt.Errorf("could not cast %T object to *egressfirewall.ClusterEgressFirewall", obj)
+		}
+		clusterEgressFirewall = clusterEgressFirewall.DeepCopy()
+		err := bnc.addClusterEgressFirewall(clusterEgressFirewall)
+		if statusErr := bnc.setClusterEgressFirewallStatus(clusterEgressFirewall, err); statusErr != nil {
+			klog.Errorf("Failed to update cluster egress firewall status %s, error: %v",
+				clusterEgressFirewall.Name, statusErr)
+		}
+		return err
 	default:
 		klog.Errorf("Can not process add resource event, object type %s is not supported", objType)
 	}
@@ -1084,6 +1191,27 @@ func (bnc *BaseNetworkController) DeleteResourceCommon(objType reflect.Type, obj
 			return nil
 		}
 		return bnc.deleteNetworkPolicy(knp)
+	case factory.EgressFirewallType:
+		egressFirewall, ok := obj.(*egressfirewall.EgressFirewall)
+		if !ok {
+			return fmt.Errorf("could not cast obj of type %T to *egressfirewall.EgressFirewall", obj)
+		}
+		// only egress firewalls that were rendered by this controller are in the cache
+		if _, loaded := bnc.egressFirewalls.Load(egressFirewall.Namespace); !loaded {
+			return nil
+		}
+		if err := bnc.deleteEgressFirewall(egressFirewall); err != nil {
+			return err
+		}
+		metrics.UpdateEgressFirewallRuleCount(float64(-len(egressFirewall.Spec.Egress)))
+		metrics.DecrementEgressFirewallCount()
+		return nil
+	case factory.ClusterEgressFirewallType:
+		clusterEgressFirewall, ok := obj.(*egressfirewall.ClusterEgressFirewall)
+		if !ok {
+			return fmt.Errorf("could not cast obj of type %T to *egressfirewall.ClusterEgressFirewall", obj)
+		}
+		return bnc.deleteClusterEgressFirewall(clusterEgressFirewall)
 	default:
 		klog.Errorf("Can not process delete resource event, object type %s is not supported", objType)
 	}
@@ -1202,3 +1330,11 @@ func (bnc *BaseNetworkController) GetSamplingConfig() *libovsdbops.SamplingConfi
 	}
 	return nil
 }
+
+// getSampleCounter returns the counter of the packets sampled in this zone, or nil if they are not counted.
+func (bnc *BaseNetworkController) getSampleCounter() *observability.SampleCounter {
+	if bnc.observManager != nil {
+		return bnc.observManager.SampleCounter()
+	}
+	return nil
+}
@@ -718,6 +718,15 @@ func (bsnc *BaseUserDefinedNetworkController) updateNamespaceForUserDefinedNetwo
 		if err := bsnc.updateNamespaceAclLogging(old.Name, aclAnnotation, nsInfo); err != nil {
 			errors = append(errors, err)
 		}
+		// Trigger an egress fw logging update - this will only happen if an egress firewall exists for the NS, otherwise
+		// this will not do anything.
+		updated, err := bsnc.updateACLLoggingForEgressFirewall(old.Name, nsInfo)
+		if err != nil {
+			errors = append(errors, err)
+		} else if updated {
+			klog.Infof("Namespace %s: EgressFirewall ACL logging setting updated to deny=%s allow=%s for network %s",
+				old.Name, nsInfo.aclLogging.Deny, nsInfo.aclLogging.Allow, bsnc.GetNetworkName())
+		}
 	}
 
 	if err := bsnc.multicastUpdateNamespace(newer, nsInfo); err != nil {
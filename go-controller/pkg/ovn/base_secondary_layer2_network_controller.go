@@ -49,6 +49,7 @@ func (oc *BaseLayer2UserDefinedNetworkController) stop() {
 	if oc.namespaceHandler != nil {
 		oc.watchFactory.RemoveNamespaceHandler(oc.namespaceHandler)
 	}
+	oc.stopEgressFirewall()
 	if oc.routeImportManager != nil && config.Gateway.Mode == config.GatewayModeShared {
 		oc.routeImportManager.ForgetNetwork(oc.GetNetworkName())
 	}
@@ -135,6 +136,11 @@ func (oc *BaseLayer2UserDefinedNetworkController) run() error {
 		if err := oc.WatchNetworkPolicy(); err != nil {
 			return err
 		}
+		if config.OVNKubernetesFeature.EnableEgressFirewall {
+			if err := oc.startEgressFirewall(); err != nil {
+				return err
+			}
+		}
 	}
 
 	// start NetworkQoS controller if feature is enabled
@@ -201,6 +207,24 @@ func (oc *BaseLayer2UserDefinedNetworkController) initializeLogicalSwitch(switch
 	return &logicalSwitch, nil
 }
 
+// addSwitchSubnets makes the subnets appended to the network available for
+// IP allocation on the network switch. Like the rest of the reconciliation
+// that happens after the network information is updated, errors are logged
+// instead of returned.
+func (oc *BaseLayer2UserDefinedNetworkController) addSwitchSubnets(switchName string, subnets []config.CIDRNetworkEntry) {
+	if len(subnets) == 0 {
+		return
+	}
+	hostSubnets := make([]*net.IPNet, 0, len(subnets))
+	for _, subnet := range subnets {
+		hostSubnets = append(hostSubnets, subnet.CIDR)
+	}
+	if err := oc.lsManager.AddSwitchSubnets(switchName, hostSubnets...); err != nil {
+		klog.Errorf("Failed to add subnets %v to switch %s of network %s: %v",
+			util.StringSlice(hostSubnets), switchName, oc.GetNetworkName(), err)
+	}
+}
+
 func (oc *BaseLayer2UserDefinedNetworkController) addUpdateNodeEvent(node *corev1.Node) error {
 	if oc.isLocalZoneNode(node) {
 		return oc.addUpdateLocalNodeEvent(node)
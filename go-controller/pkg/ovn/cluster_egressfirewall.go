@@ -0,0 +1,525 @@
+package ovn
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+	coreinformers "k8s.io/client-go/informers/core/v1"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+
+	"github.com/ovn-kubernetes/libovsdb/ovsdb"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/controller"
+	egressfirewallapi "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressfirewall/v1"
+	egressfirewallapply "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressfirewall/v1/apis/applyconfiguration/egressfirewall/v1"
+	libovsdbops "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
+	libovsdbutil "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/util"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/metrics"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
+	utilerrors "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util/errors"
+)
+
+const (
+	clusterEgressFirewallAppliedCorrectly = "ClusterEgressFirewall Rules applied"
+	// clusterEgressFirewallKind is the kind label of the hits metric of the cluster egress firewall rules
+	clusterEgressFirewallKind = "ClusterEgressFirewall"
+)
+
+// clusterEgressFirewall is the cached state of a ClusterEgressFirewall rendered by a network controller.
+// Its ACLs are attached to the cluster port group of the network, and match on a pod selector address set
+// holding the IPs of the pods of the selected namespaces.
+type clusterEgressFirewall struct {
+	sync.Mutex
+	name              string
+	tier              egressfirewallapi.ClusterEgressFirewallTier
+	priority          int32
+	namespaceSelector labels.Selector
+	egressRules       []*egressFirewallRule
+	// namespaces selected by the cluster egress firewall and served by the controller's network
+	namespaces sets.Set[string]
+	// addrSetKey is the key of the pod selector address set of the selected namespaces
+	addrSetKey string
+	// srcMatch matches the traffic of the pods of the selected namespaces
+	srcMatch string
+}
+
+func getClusterEgressFirewallKeyWithKind(name string) string {
+	return fmt.Sprintf("%v/%v", "ClusterEgressFirewall", name)
+}
+
+// getClusterEgressFirewallSourceMatch returns the match on the given pod selector address sets.
+func getClusterEgressFirewallSourceMatch(addrSetHashV4, addrSetHashV6 string) string {
+	var matches []string
+	if addrSetHashV4 != "" {
+		matches = append(matches, "ip4.src == $"+addrSetHashV4)
+	}
+	if addrSetHashV6 != "" {
+		matches = append(matches, "ip6.src == $"+addrSetHashV6)
+	}
+	if len(matches) == 1 {
+		return matches[0]
+	}
+	return "(" + strings.Join(matches, " || ") + ")"
+}
+
+// getClusterEgressFirewallACLPriority returns the ACL priority of the given rule of a ClusterEgressFirewall.
+// Cluster egress firewalls of the Before tier use priorities above the egress firewall priorities,
+// and cluster egress firewalls of the After tier use priorities below the egress firewall priorities.
+func getClusterEgressFirewallACLPriority(tier egressfirewallapi.ClusterEgressFirewallTier, priority int32, ruleIdx int) int {
+	startPriority := types.ClusterEgressFirewallBeforeStartPriority
+	if tier == egressfirewallapi.ClusterEgressFirewallTierAfter {
+		startPriority = types.ClusterEgressFirewallAfterStartPriority
+	}
+	return startPriority - int(priority)*types.ClusterEgressFirewallMaxRules - ruleIdx
+}
+
+func (oc *BaseNetworkController) getClusterEgressFirewallACLDbIDs(name string, ruleIdx int) *libovsdbops.DbObjectIDs {
+	return libovsdbops.NewDbObjectIDs(libovsdbops.ACLClusterEgressFirewall, oc.controllerName,
+		map[libovsdbops.ExternalIDKey]string{
+			libovsdbops.ObjectNameKey: name,
+			libovsdbops.RuleIndex:     strconv.Itoa(ruleIdx),
+		})
+}
+
+// WatchClusterEgressFirewall starts the watching of clusteregressfirewall resource and calls
+// back the appropriate handler logic
+func (oc *BaseNetworkController) WatchClusterEgressFirewall() error {
+	if oc.clusterEgressFirewallHandler != nil {
+		return nil
+	}
+	handler, err := oc.retryClusterEgressFirewalls.WatchResource()
+	if err != nil {
+		return err
+	}
+	oc.clusterEgressFirewallHandler = handler
+	return nil
+}
+
+// syncClusterEgressFirewall removes the ACLs of cluster egress firewalls that don't exist anymore.
+func (oc *BaseNetworkController) syncClusterEgressFirewall(clusterEgressFirewalls []interface{}) error {
+	existing := sets.New[string]()
+	for _, cefInterface := range clusterEgressFirewalls {
+		cef, ok := cefInterface.(*egressfirewallapi.ClusterEgressFirewall)
+		if !ok {
+			return fmt.Errorf("spurious object in syncClusterEgressFirewall: %v", cefInterface)
+		}
+		existing.Insert(cef.Name)
+	}
+
+	predicateIDs := libovsdbops.NewDbObjectIDs(libovsdbops.ACLClusterEgressFirewall, oc.controllerName, nil)
+	aclP := libovsdbops.GetPredicate[*nbdb.ACL](predicateIDs, func(acl *nbdb.ACL) bool {
+		return !existing.Has(acl.ExternalIDs[libovsdbops.ObjectNameKey.String()])
+	})
+	staleACLs, err := libovsdbops.FindACLsWithPredicate(oc.nbClient, aclP)
+	if err != nil {
+		return fmt.Errorf("cannot find stale cluster egress firewall ACLs: %w", err)
+	}
+	if len(staleACLs) == 0 {
+		return nil
+	}
+	return libovsdbops.DeleteACLsFromPortGroups(oc.nbClient,
+		[]string{oc.getClusterPortGroupName(types.ClusterPortGroupNameBase)}, staleACLs...)
+}
+
+// getClusterEgressFirewallNamespaces returns the namespaces matching the selector that are served by this
+// controller's network, and the namespaces that were skipped because their network can't be found yet.
+func (oc *BaseNetworkController) getClusterEgressFirewallNamespaces(selector labels.Selector) (sets.Set[string], []string, error) {
+	namespaces, err := oc.watchFactory.NamespaceInformer().Lister().List(selector)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list namespaces: %w", err)
+	}
+	selected := sets.New[string]()
+	var skipped []string
+	for _, namespace := range namespaces {
+		served, err := oc.isEgressFirewallNamespaceServed(namespace.Name)
+		if err != nil {
+			klog.Warningf("Skipping namespace %s for cluster egress firewalls of network %s, will retry: %v",
+				namespace.Name, oc.GetNetworkName(), err)
+			skipped = append(skipped, namespace.Name)
+			continue
+		}
+		if served {
+			selected.Insert(namespace.Name)
+		}
+	}
+	return selected, skipped, nil
+}
+
+func (oc *BaseNetworkController) addClusterEgressFirewall(cefObj *egressfirewallapi.ClusterEgressFirewall) error {
+	klog.Infof("Adding cluster egress firewall %s for network %s", cefObj.Name, oc.GetNetworkName())
+
+	// cluster egress firewall may already exist, if previous add failed, cleanup
+	if _, loaded := oc.clusterEgressFirewalls.Load(cefObj.Name); loaded {
+		klog.Infof("Cluster egress firewall %s already exists, cleanup", cefObj.Name)
+		if err := oc.deleteClusterEgressFirewall(cefObj); err != nil {
+			return fmt.Errorf("failed to cleanup existing cluster egress firewall %s on add: %v", cefObj.Name, err)
+		}
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(&cefObj.Spec.NamespaceSelector)
+	if err != nil {
+		return fmt.Errorf("invalid namespace selector: %w", err)
+	}
+	cef := &clusterEgressFirewall{
+		name:              cefObj.Name,
+		tier:              cefObj.Spec.Tier,
+		priority:          cefObj.Spec.Priority,
+		namespaceSelector: selector,
+	}
+	cef.Lock()
+	defer cef.Unlock()
+
+	var errorList []error
+	for i, egressFirewallRule := range cefObj.Spec.Egress {
+		if i >= types.ClusterEgressFirewallMaxRules {
+			errorList = append(errorList, fmt.Errorf("cluster egress firewall %s has too many rules, max allowed number is %v",
+				cefObj.Name, types.ClusterEgressFirewallMaxRules))
+			break
+		}
+		efr, err := oc.newEgressFirewallRule(egressFirewallRule, i)
+		if err != nil {
+			errorList = append(errorList, fmt.Errorf("cannot create ClusterEgressFirewall Rule to destination %s: %w",
+				egressFirewallRule.To.CIDRSelector, err))
+			continue
+		}
+		cef.egressRules = append(cef.egressRules, efr)
+	}
+	if len(errorList) > 0 {
+		return utilerrors.Join(errorList...)
+	}
+
+	var skippedNamespaces []string
+	cef.namespaces, skippedNamespaces, err = oc.getClusterEgressFirewallNamespaces(selector)
+	if err != nil {
+		return err
+	}
+	// store cluster egress firewall before creating the address set and adding the rules,
+	// oc.clusterEgressFirewalls will be used on retry to cleanup
+	oc.clusterEgressFirewalls.Store(cef.name, cef)
+	// the skipped namespaces are added by the namespace controller once their network is found. It is started
+	// after the initial cluster egress firewalls are added, and reconciles all the namespaces then.
+	if oc.cefNamespaceController != nil {
+		for _, namespace := range skippedNamespaces {
+			oc.cefNamespaceController.Reconcile(namespace)
+		}
+	}
+	addrSetKey, addrSetHashV4, addrSetHashV6, err := oc.EnsurePodSelectorAddressSet(&metav1.LabelSelector{},
+		&cefObj.Spec.NamespaceSelector, "", getClusterEgressFirewallKeyWithKind(cef.name))
+	// addrSetKey is set even on failure, and needed to cleanup
+	cef.addrSetKey = addrSetKey
+	if err != nil {
+		return fmt.Errorf("failed to ensure the address set of cluster egress firewall %s: %w", cef.name, err)
+	}
+	cef.srcMatch = getClusterEgressFirewallSourceMatch(addrSetHashV4, addrSetHashV6)
+	return oc.addClusterEgressFirewallRules(cef)
+}
+
+// addClusterEgressFirewallRules creates or updates the ACLs of the given rules of a cluster egress firewall, or all
+// of its rules if no rule ids are provided. The ACLs are removed if the cluster egress firewall doesn't select any
+// namespace. cef must be locked.
+func (oc *BaseNetworkController) addClusterEgressFirewallRules(cef *clusterEgressFirewall, ruleIDs ...int) error {
+	if cef.namespaces.Len() == 0 {
+		return oc.deleteClusterEgressFirewallRules(cef.name)
+	}
+	clusterPGName := oc.getClusterPortGroupName(types.ClusterPortGroupNameBase)
+
+	var ops []ovsdb.Operation
+	var err error
+	for _, rule := range cef.egressRules {
+		if len(ruleIDs) > 0 && !slices.Contains(ruleIDs, rule.id) {
+			continue
+		}
+		matchTargets, err := oc.getEgressFirewallMatchTargets(rule, util.GetClusterEgressFirewallDNSOwner(cef.name))
+		if err != nil {
+			return err
+		}
+		aclIDs := oc.getClusterEgressFirewallACLDbIDs(cef.name, rule.id)
+		if len(matchTargets) == 0 {
+			klog.Warningf("Cluster egress firewall %s rule: %#v has no destination...ignoring", cef.name, *rule)
+			// ensure the ACL is removed from OVN
+			ops, err = oc.deleteClusterEgressFirewallACLsOps(ops, libovsdbops.GetPredicate[*nbdb.ACL](aclIDs, nil))
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		acl := libovsdbutil.BuildACLWithDefaultTier(
+			aclIDs,
+			getClusterEgressFirewallACLPriority(cef.tier, cef.priority, rule.id),
+			generateMatchForSource(cef.srcMatch, matchTargets, rule.ports, oc.Subnets()),
+			getEgressFirewallACLAction(rule),
+			&libovsdbutil.ACLLoggingLevels{},
+			// since egressFirewall has direction to-lport, set type to ingress
+			libovsdbutil.LportIngress,
+		)
+		ops, err = libovsdbops.CreateOrUpdateACLsOps(oc.nbClient, ops, oc.GetSamplingConfig(), acl)
+		if err != nil {
+			return fmt.Errorf("failed to create cluster egress firewall ACL %v: %v", acl, err)
+		}
+		ops, err = libovsdbops.AddACLsToPortGroupOps(oc.nbClient, ops, clusterPGName, acl)
+		if err != nil {
+			return fmt.Errorf("failed to add cluster egress firewall ACL %v to port group %s: %v", acl, clusterPGName, err)
+		}
+	}
+	_, err = libovsdbops.TransactAndCheck(oc.nbClient, ops)
+	if err != nil {
+		return fmt.Errorf("failed to transact cluster egress firewall ACLs: %v", err)
+	}
+	return nil
+}
+
+func (oc *BaseNetworkController) deleteClusterEgressFirewallACLsOps(ops []ovsdb.Operation, p func(*nbdb.ACL) bool) ([]ovsdb.Operation, error) {
+	acls, err := libovsdbops.FindACLsWithPredicate(oc.nbClient, p)
+	if err != nil {
+		return ops, fmt.Errorf("unable to list cluster egress firewall ACLs: %w", err)
+	}
+	if len(acls) == 0 {
+		return ops, nil
+	}
+	return libovsdbops.DeleteACLsFromPortGroupOps(oc.nbClient, ops, oc.getClusterPortGroupName(types.ClusterPortGroupNameBase), acls...)
+}
+
+// deleteClusterEgressFirewallRules deletes all the ACLs of a cluster egress firewall
+func (oc *BaseNetworkController) deleteClusterEgressFirewallRules(name string) error {
+	predicateIDs := libovsdbops.NewDbObjectIDs(libovsdbops.ACLClusterEgressFirewall, oc.controllerName,
+		map[libovsdbops.ExternalIDKey]string{
+			libovsdbops.ObjectNameKey: name,
+		})
+	ops, err := oc.deleteClusterEgressFirewallACLsOps(nil, libovsdbops.GetPredicate[*nbdb.ACL](predicateIDs, nil))
+	if err != nil {
+		return err
+	}
+	_, err = libovsdbops.TransactAndCheck(oc.nbClient, ops)
+	if err != nil {
+		return fmt.Errorf("failed to delete cluster egress firewall %s ACLs: %w", name, err)
+	}
+	return nil
+}
+
+func (oc *BaseNetworkController) deleteClusterEgressFirewall(cefObj *egressfirewallapi.ClusterEgressFirewall) error {
+	klog.Infof("Deleting cluster egress firewall %s for network %s", cefObj.Name, oc.GetNetworkName())
+	obj, loaded := oc.clusterEgressFirewalls.Load(cefObj.Name)
+	if !loaded {
+		return nil
+	}
+	cef, ok := obj.(*clusterEgressFirewall)
+	if !ok {
+		return fmt.Errorf("deleteClusterEgressFirewall failed: type assertion to *clusterEgressFirewall"+
+			" failed for ClusterEgressFirewall %s of type %T", cefObj.Name, obj)
+	}
+
+	cef.Lock()
+	defer cef.Unlock()
+	// delete acls first, then the address sets that are referenced in these acls
+	if err := oc.deleteClusterEgressFirewallRules(cef.name); err != nil {
+		return err
+	}
+	if cef.addrSetKey != "" {
+		if err := oc.DeletePodSelectorAddressSet(cef.addrSetKey, getClusterEgressFirewallKeyWithKind(cef.name)); err != nil {
+			return err
+		}
+		cef.addrSetKey = ""
+	}
+	for _, rule := range cef.egressRules {
+		if len(rule.to.dnsName) > 0 {
+			if err := oc.dnsNameResolver.Delete(util.GetClusterEgressFirewallDNSOwner(cef.name)); err != nil {
+				return err
+			}
+			break
+		}
+	}
+	oc.clusterEgressFirewalls.Delete(cef.name)
+	metrics.DeleteEgressFirewallRuleHits(oc.GetNetworkName(), clusterEgressFirewallKind, "", cef.name)
+	return nil
+}
+
+// countClusterEgressFirewallRuleHits adds the packets sampled for the ACL of the cluster egress firewall rules since
+// they were last counted to the hits metric of the rules.
+func (oc *BaseNetworkController) countClusterEgressFirewallRuleHits(cef *clusterEgressFirewall) {
+	sampleCounter := oc.getSampleCounter()
+	if sampleCounter == nil {
+		return
+	}
+	cef.Lock()
+	defer cef.Unlock()
+	for _, rule := range cef.egressRules {
+		hits, err := oc.countEgressFirewallRuleHit(sampleCounter, oc.getClusterEgressFirewallACLDbIDs(cef.name, rule.id), rule)
+		if err != nil {
+			klog.Warningf("Failed to count hits of cluster egress firewall %s rule %d: %v", cef.name, rule.id, err)
+			continue
+		}
+		metrics.AddEgressFirewallRuleHits(oc.GetNetworkName(), clusterEgressFirewallKind, "", cef.name, rule.id, hits)
+	}
+}
+
+func (oc *BaseNetworkController) newCEFNamespaceController(namespaceInformer coreinformers.NamespaceInformer) controller.Controller {
+	controllerConfig := &controller.ControllerConfig[corev1.Namespace]{
+		RateLimiter:    workqueue.NewTypedItemFastSlowRateLimiter[string](time.Second, 5*time.Second, 5),
+		Informer:       namespaceInformer.Informer(),
+		Lister:         namespaceInformer.Lister().List,
+		ObjNeedsUpdate: cefNamespaceNeedsUpdate,
+		Reconcile:      oc.updateClusterEgressFirewallsForNamespace,
+		Threadiness:    1,
+	}
+	return controller.NewController[corev1.Namespace]("cef_namespace_controller", controllerConfig)
+}
+
+func cefNamespaceNeedsUpdate(oldNamespace, newNamespace *corev1.Namespace) bool {
+	if oldNamespace == nil || newNamespace == nil {
+		return true
+	}
+	return !reflect.DeepEqual(oldNamespace.Labels, newNamespace.Labels)
+}
+
+// updateClusterEgressFirewallsForNamespace updates the namespaces of the cluster egress firewalls that started or
+// stopped selecting the given namespace. The pod selector address sets follow the namespaces on their own, the ACLs
+// are only added or removed when a cluster egress firewall starts or stops selecting namespaces of the network.
+func (oc *BaseNetworkController) updateClusterEgressFirewallsForNamespace(namespaceName string) error {
+	namespace, err := oc.watchFactory.GetNamespace(namespaceName)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+
+	var errs []error
+	// cluster egress firewalls that started or stopped selecting namespaces of the network, and need their status
+	// reported again
+	var updated []string
+	oc.clusterEgressFirewalls.Range(func(_, v interface{}) bool {
+		cef := v.(*clusterEgressFirewall)
+		cef.Lock()
+		defer cef.Unlock()
+		selected := false
+		if namespace != nil && cef.namespaceSelector.Matches(labels.Set(namespace.Labels)) {
+			served, err := oc.isEgressFirewallNamespaceServed(namespaceName)
+			if err != nil {
+				errs = append(errs, err)
+				return true
+			}
+			selected = served
+		}
+		if selected == cef.namespaces.Has(namespaceName) {
+			return true
+		}
+		hadNamespaces := cef.namespaces.Len() > 0
+		if selected {
+			cef.namespaces.Insert(namespaceName)
+		} else {
+			cef.namespaces.Delete(namespaceName)
+		}
+		if hadNamespaces == (cef.namespaces.Len() > 0) {
+			return true
+		}
+		if err := oc.addClusterEgressFirewallRules(cef); err != nil {
+			errs = append(errs, fmt.Errorf("failed to update cluster egress firewall %s for namespace %s: %w",
+				cef.name, namespaceName, err))
+			return true
+		}
+		updated = append(updated, cef.name)
+		return true
+	})
+	for _, name := range updated {
+		cefObj, err := oc.watchFactory.GetClusterEgressFirewall(name)
+		if err != nil {
+			if !apierrors.IsNotFound(err) {
+				errs = append(errs, err)
+			}
+			continue
+		}
+		if err := oc.setClusterEgressFirewallStatus(cefObj, nil); err != nil {
+			errs = append(errs, fmt.Errorf("failed to update the status of cluster egress firewall %s: %w", name, err))
+		}
+	}
+	return utilerrors.Join(errs...)
+}
+
+// updateClusterEgressFirewallsForNode updates the rules of the cluster egress firewalls that select nodes.
+func (oc *BaseNetworkController) updateClusterEgressFirewallsForNode(nodeName string, node *corev1.Node, nodeIPs []string) error {
+	var cefErr error
+	oc.clusterEgressFirewalls.Range(func(_, v interface{}) bool {
+		cef := v.(*clusterEgressFirewall)
+		cef.Lock()
+		defer cef.Unlock()
+		modifiedRuleIDs := updateEgressFirewallRulesForNode(cef.egressRules, nodeName, node, nodeIPs)
+		if len(modifiedRuleIDs) == 0 {
+			return true
+		}
+		if err := oc.addClusterEgressFirewallRules(cef, modifiedRuleIDs...); err != nil {
+			cefErr = fmt.Errorf("failed to update cluster egress firewall %s for node %s: %w", cef.name, nodeName, err)
+			return false
+		}
+		return true
+	})
+	return cefErr
+}
+
+// shouldSetClusterEgressFirewallStatus returns true if this controller should report the status of the given
+// cluster egress firewall. User defined network controllers only report the status of cluster egress firewalls
+// that select namespaces served by their network, or that failed to be applied.
+func (oc *BaseNetworkController) shouldSetClusterEgressFirewallStatus(name string, handlerErr error) bool {
+	if oc.IsDefault() || handlerErr != nil {
+		return true
+	}
+	obj, loaded := oc.clusterEgressFirewalls.Load(name)
+	if !loaded {
+		return false
+	}
+	cef := obj.(*clusterEgressFirewall)
+	cef.Lock()
+	defer cef.Unlock()
+	return cef.namespaces.Len() > 0
+}
+
+// setClusterEgressFirewallStatus reports the status of a cluster egress firewall for the network of this controller.
+// Every network of a zone reports its own message with its own field manager, the status manager aggregates them.
+func (oc *BaseNetworkController) setClusterEgressFirewallStatus(cef *egressfirewallapi.ClusterEgressFirewall, handlerErr error) error {
+	var onNetwork string
+	if !oc.IsDefault() {
+		// report the user defined network the cluster egress firewall was rendered on
+		onNetwork = " on network " + oc.GetNetworkName()
+	}
+	appliedMsg := types.GetZoneStatus(oc.zone, clusterEgressFirewallAppliedCorrectly+onNetwork)
+	errorMsgPrefix := types.GetZoneStatus(oc.zone, types.EgressFirewallErrorMsg+onNetwork+": ")
+
+	applyStatus := egressfirewallapply.EgressFirewallStatus()
+	if oc.shouldSetClusterEgressFirewallStatus(cef.Name, handlerErr) {
+		newMsg := appliedMsg
+		if handlerErr != nil {
+			newMsg = errorMsgPrefix + handlerErr.Error()
+		}
+		if slices.Contains(cef.Status.Messages, newMsg) {
+			// found previous status
+			return nil
+		}
+		applyStatus.WithMessages(newMsg)
+	} else if !slices.ContainsFunc(cef.Status.Messages, func(msg string) bool {
+		return msg == appliedMsg || strings.HasPrefix(msg, errorMsgPrefix)
+	}) {
+		// the network doesn't serve any selected namespace and has no previous status to remove
+		return nil
+	}
+
+	applyOptions := metav1.ApplyOptions{
+		Force:        true,
+		FieldManager: types.GetZoneNetworkFieldManager(oc.zone, oc.GetNetworkName()),
+	}
+
+	applyObj := egressfirewallapply.ClusterEgressFirewall(cef.Name).
+		WithStatus(applyStatus)
+	_, err := oc.kube.EgressFirewallClient.K8sV1().ClusterEgressFirewalls().ApplyStatus(context.TODO(), applyObj, applyOptions)
+
+	return err
+}
@@ -0,0 +1,238 @@
+package ovn
+
+import (
+	"context"
+
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/controller"
+	egressfirewallapi "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressfirewall/v1"
+	libovsdbops "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/observability"
+	addressset "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn/address_set"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/testing/libovsdb"
+	t "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
+)
+
+func newClusterEgressFirewallObject(name string, tier egressfirewallapi.ClusterEgressFirewallTier, priority int32,
+	namespaceSelector metav1.LabelSelector, egressRules []egressfirewallapi.EgressFirewallRule) *egressfirewallapi.ClusterEgressFirewall {
+	return &egressfirewallapi.ClusterEgressFirewall{
+		ObjectMeta: newObjectMeta(name, ""),
+		Spec: egressfirewallapi.ClusterEgressFirewallSpec{
+			NamespaceSelector: namespaceSelector,
+			Tier:              tier,
+			Priority:          priority,
+			Egress:            egressRules,
+		},
+	}
+}
+
+var _ = ginkgo.Describe("OVN ClusterEgressFirewall Operations", func() {
+	var fakeOVN *FakeOVN
+
+	ginkgo.BeforeEach(func() {
+		gomega.Expect(config.PrepareTestConfig()).To(gomega.Succeed())
+		config.OVNKubernetesFeature.EnableEgressFirewall = true
+		config.IPv4Mode = true
+		fakeOVN = NewFakeOVN(false)
+	})
+
+	ginkgo.AfterEach(func() {
+		if fakeOVN.controller.cefNamespaceController != nil {
+			controller.Stop(fakeOVN.controller.cefNamespaceController)
+		}
+		fakeOVN.shutdown()
+	})
+
+	startOvn := func(namespaces []corev1.Namespace, pods []corev1.Pod, clusterEgressFirewalls []egressfirewallapi.ClusterEgressFirewall) {
+		fakeOVN.startWithDBSetup(libovsdb.TestSetup{NBData: []libovsdb.TestData{newClusterPortGroup()}},
+			&corev1.NamespaceList{Items: namespaces},
+			&corev1.PodList{Items: pods},
+			&egressfirewallapi.ClusterEgressFirewallList{Items: clusterEgressFirewalls},
+		)
+		gomega.Expect(fakeOVN.controller.WatchNamespaces()).To(gomega.Succeed())
+		gomega.Expect(fakeOVN.controller.WatchClusterEgressFirewall()).To(gomega.Succeed())
+		fakeOVN.controller.cefNamespaceController = fakeOVN.controller.newCEFNamespaceController(
+			fakeOVN.controller.watchFactory.NamespaceCoreInformer())
+		gomega.Expect(controller.Start(fakeOVN.controller.cefNamespaceController)).To(gomega.Succeed())
+	}
+
+	getACL := func(name string, ruleIdx int) func() *nbdb.ACL {
+		return func() *nbdb.ACL {
+			aclP := libovsdbops.GetPredicate[*nbdb.ACL](fakeOVN.controller.getClusterEgressFirewallACLDbIDs(name, ruleIdx), nil)
+			acls, err := libovsdbops.FindACLsWithPredicate(fakeOVN.nbClient, aclP)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			if len(acls) != 1 {
+				return nil
+			}
+			return acls[0]
+		}
+	}
+
+	getAddrSetDbIDs := func(namespaceSelector metav1.LabelSelector) *libovsdbops.DbObjectIDs {
+		return getPodSelectorAddrSetDbIDs(getPodSelectorKey(&metav1.LabelSelector{}, &namespaceSelector, ""),
+			fakeOVN.controller.controllerName)
+	}
+
+	getAddrSetAddresses := func(dbIDs *libovsdbops.DbObjectIDs) func() []string {
+		return func() []string {
+			asv4, _ := addressset.GetHashNamesForAS(dbIDs)
+			as, err := libovsdbops.GetAddressSet(fakeOVN.nbClient, &nbdb.AddressSet{Name: asv4})
+			if err != nil {
+				return nil
+			}
+			return as.Addresses
+		}
+	}
+
+	ginkgo.It("renders the rules for the selected namespaces and follows namespace label changes", func() {
+		namespace1 := *newNamespaceWithLabels("namespace1", map[string]string{"team": "a"})
+		namespace2 := *newNamespaceWithLabels("namespace2", map[string]string{"team": "b"})
+		pod1 := *newPod(namespace1.Name, "pod1", "node1", "10.128.1.3")
+		pod2 := *newPod(namespace2.Name, "pod2", "node1", "10.128.1.4")
+		namespaceSelector := metav1.LabelSelector{MatchLabels: map[string]string{"team": "a"}}
+		clusterEgressFirewall := newClusterEgressFirewallObject("cef1", egressfirewallapi.ClusterEgressFirewallTierAfter, 1,
+			namespaceSelector,
+			[]egressfirewallapi.EgressFirewallRule{
+				{
+					Type: "Deny",
+					Ports: []egressfirewallapi.EgressFirewallPort{
+						{
+							Protocol: "TCP",
+							Port:     443,
+						},
+					},
+					To: egressfirewallapi.EgressFirewallDestination{
+						CIDRSelector: "1.2.3.0/24",
+					},
+				},
+			})
+		startOvn([]corev1.Namespace{namespace1, namespace2}, []corev1.Pod{pod1, pod2},
+			[]egressfirewallapi.ClusterEgressFirewall{*clusterEgressFirewall})
+
+		addrSetDbIDs := getAddrSetDbIDs(namespaceSelector)
+		asv4, _ := addressset.GetHashNamesForAS(addrSetDbIDs)
+		match := "(ip4.dst == 1.2.3.0/24) && ip4.src == $" + asv4 + " && ((tcp && ( tcp.dst == 443 )))"
+		gomega.Eventually(getACL(clusterEgressFirewall.Name, 0)).ShouldNot(gomega.BeNil())
+		acl := getACL(clusterEgressFirewall.Name, 0)()
+		gomega.Expect(acl.Match).To(gomega.Equal(match))
+		gomega.Eventually(getAddrSetAddresses(addrSetDbIDs)).Should(gomega.ConsistOf(pod1.Status.PodIP))
+		gomega.Expect(acl.Action).To(gomega.Equal(nbdb.ACLActionDrop))
+		gomega.Expect(acl.Priority).To(gomega.Equal(t.ClusterEgressFirewallAfterStartPriority - t.ClusterEgressFirewallMaxRules))
+		gomega.Expect(acl.Tier).To(gomega.Equal(t.DefaultACLTier))
+		clusterPG, err := libovsdbops.GetPortGroup(fakeOVN.nbClient,
+			&nbdb.PortGroup{Name: fakeOVN.controller.getClusterPortGroupName(t.ClusterPortGroupNameBase)})
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(clusterPG.ACLs).To(gomega.ContainElement(acl.UUID))
+
+		gomega.Eventually(func() []string {
+			cef, err := fakeOVN.fakeClient.EgressFirewallClient.K8sV1().ClusterEgressFirewalls().
+				Get(context.TODO(), clusterEgressFirewall.Name, metav1.GetOptions{})
+			if err != nil {
+				return nil
+			}
+			return cef.Status.Messages
+		}).Should(gomega.ConsistOf(t.GetZoneStatus(fakeOVN.controller.zone, clusterEgressFirewallAppliedCorrectly)))
+
+		ginkgo.By("selecting a second namespace by label")
+		namespace2.Labels["team"] = "a"
+		_, err = fakeOVN.fakeClient.KubeClient.CoreV1().Namespaces().Update(context.TODO(), &namespace2, metav1.UpdateOptions{})
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Eventually(getAddrSetAddresses(addrSetDbIDs)).Should(gomega.ConsistOf(pod1.Status.PodIP, pod2.Status.PodIP))
+		gomega.Expect(getACL(clusterEgressFirewall.Name, 0)().Match).To(gomega.Equal(match))
+
+		ginkgo.By("unselecting both namespaces")
+		namespace1.Labels["team"] = "c"
+		_, err = fakeOVN.fakeClient.KubeClient.CoreV1().Namespaces().Update(context.TODO(), &namespace1, metav1.UpdateOptions{})
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		err = fakeOVN.fakeClient.KubeClient.CoreV1().Namespaces().Delete(context.TODO(), namespace2.Name, metav1.DeleteOptions{})
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Eventually(getACL(clusterEgressFirewall.Name, 0)).Should(gomega.BeNil())
+
+		ginkgo.By("selecting the first namespace again and deleting the cluster egress firewall")
+		namespace1.Labels["team"] = "a"
+		_, err = fakeOVN.fakeClient.KubeClient.CoreV1().Namespaces().Update(context.TODO(), &namespace1, metav1.UpdateOptions{})
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Eventually(getACL(clusterEgressFirewall.Name, 0)).ShouldNot(gomega.BeNil())
+		err = fakeOVN.fakeClient.EgressFirewallClient.K8sV1().ClusterEgressFirewalls().
+			Delete(context.TODO(), clusterEgressFirewall.Name, metav1.DeleteOptions{})
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Eventually(getACL(clusterEgressFirewall.Name, 0)).Should(gomega.BeNil())
+		gomega.Eventually(getAddrSetAddresses(addrSetDbIDs)).Should(gomega.BeNil())
+	})
+
+	ginkgo.It("counts the sampled packets of the rules", func() {
+		config.OVNKubernetesFeature.EnableObservability = true
+		namespace1 := *newNamespace("namespace1")
+		cefObj := newClusterEgressFirewallObject("cef1", egressfirewallapi.ClusterEgressFirewallTierBefore, 0,
+			metav1.LabelSelector{},
+			[]egressfirewallapi.EgressFirewallRule{
+				{
+					Type: "Deny",
+					To: egressfirewallapi.EgressFirewallDestination{
+						CIDRSelector: "1.2.3.0/24",
+					},
+				},
+			})
+		startOvn([]corev1.Namespace{namespace1}, nil, []egressfirewallapi.ClusterEgressFirewall{*cefObj})
+		gomega.Eventually(getACL(cefObj.Name, 0)).ShouldNot(gomega.BeNil())
+
+		fakeOVN.controller.observManager = observability.NewManager(fakeOVN.nbClient)
+		gomega.Expect(fakeOVN.controller.observManager.Init()).To(gomega.Succeed())
+		sampleCounter := observability.NewSampleCounter()
+		fakeOVN.controller.observManager.SetSampleCounter(sampleCounter)
+		obj, loaded := fakeOVN.controller.clusterEgressFirewalls.Load(cefObj.Name)
+		gomega.Expect(loaded).To(gomega.BeTrue())
+		cef := obj.(*clusterEgressFirewall)
+		cef.Lock()
+		gomega.Expect(fakeOVN.controller.addClusterEgressFirewallRules(cef)).To(gomega.Succeed())
+		cef.Unlock()
+		acl := getACL(cefObj.Name, 0)()
+		gomega.Expect(acl.SampleNew).NotTo(gomega.BeNil())
+
+		for i := 0; i < 3; i++ {
+			sampleCounter.Count(observability.ACLNewTrafficSamplingID<<24, libovsdbops.GetACLSampleID(acl))
+		}
+		fakeOVN.controller.syncEgressFirewallRuleStatuses()
+		gomega.Expect(cef.egressRules[0].reportedHits).To(gomega.BeNumerically("==", 3))
+	})
+
+	ginkgo.It("removes the ACLs of deleted cluster egress firewalls on startup", func() {
+		namespace1 := *newNamespace("namespace1")
+		clusterEgressFirewall := newClusterEgressFirewallObject("cef1", egressfirewallapi.ClusterEgressFirewallTierBefore, 0,
+			metav1.LabelSelector{},
+			[]egressfirewallapi.EgressFirewallRule{
+				{
+					Type: "Allow",
+					To: egressfirewallapi.EgressFirewallDestination{
+						CIDRSelector: "1.2.3.0/24",
+					},
+				},
+			})
+		startOvn([]corev1.Namespace{namespace1}, nil, []egressfirewallapi.ClusterEgressFirewall{*clusterEgressFirewall})
+		gomega.Eventually(getACL(clusterEgressFirewall.Name, 0)).ShouldNot(gomega.BeNil())
+		gomega.Expect(getACL(clusterEgressFirewall.Name, 0)().Priority).To(gomega.Equal(t.ClusterEgressFirewallBeforeStartPriority))
+
+		gomega.Expect(fakeOVN.controller.syncClusterEgressFirewall(nil)).To(gomega.Succeed())
+		gomega.Expect(getACL(clusterEgressFirewall.Name, 0)()).To(gomega.BeNil())
+	})
+})
+
+var _ = ginkgo.Describe("OVN ClusterEgressFirewall basic functions", func() {
+	ginkgo.It("computes ACL priorities around the egress firewall priorities", func() {
+		gomega.Expect(getClusterEgressFirewallACLPriority(egressfirewallapi.ClusterEgressFirewallTierBefore, 0, 0)).
+			To(gomega.Equal(10800))
+		gomega.Expect(getClusterEgressFirewallACLPriority(egressfirewallapi.ClusterEgressFirewallTierBefore, 9, 79)).
+			To(gomega.Equal(t.EgressFirewallStartPriority + 1))
+		gomega.Expect(getClusterEgressFirewallACLPriority(egressfirewallapi.ClusterEgressFirewallTierAfter, 0, 0)).
+			To(gomega.Equal(t.MinimumReservedEgressFirewallPriority - 1))
+		gomega.Expect(getClusterEgressFirewallACLPriority(egressfirewallapi.ClusterEgressFirewallTierAfter, 9, 79)).
+			To(gomega.Equal(1200))
+	})
+})
@@ -45,6 +45,9 @@ func insertSet(s1, s2 sets.Set[string]) {
 type podInfo struct {
 	StaticGateways  *gateway_info.GatewayInfoList
 	DynamicGateways *gateway_info.GatewayInfoList
+	// gatewaysDeleted is true when gateways were deleted from the pod since the gateways of its priority groups
+	// were last applied, the groups in use have to be re-evaluated then.
+	gatewaysDeleted bool
 }
 
 func newPodInfo() *podInfo {
@@ -191,6 +194,19 @@ type routePolicyConfig struct {
 	staticGateways *gateway_info.GatewayInfoList
 	// dynamicGateways contains the processed list of IPs and BFD information defined in the dynamicHop slice in the policy.
 	dynamicGateways *gateway_info.GatewayInfoList
+	// bfdChangedNodes are the nodes where the BFD status of a gateway of the policy changed since the last sync.
+	bfdChangedNodes sets.Set[string]
+}
+
+// allGateways returns a copy of the static and dynamic gateways of the policy in a single GatewayInfoList.
+func (rpc *routePolicyConfig) allGateways() *gateway_info.GatewayInfoList {
+	allGateways := gateway_info.NewGatewayInfoList()
+	for _, gws := range []*gateway_info.GatewayInfoList{rpc.staticGateways, rpc.dynamicGateways} {
+		for _, gw := range gws.Elems() {
+			allGateways.InsertOverwrite(gw.Clone())
+		}
+	}
+	return allGateways
 }
 
 type externalPolicyManager struct {
@@ -205,6 +221,12 @@ type externalPolicyManager struct {
 
 	// routePolicySyncCache is a cache of configures states for policies, key is policyName.
 	routePolicySyncCache *syncmap.SyncMap[*routePolicyState]
+
+	// bfdChangedNodesLock protects bfdChangedNodes
+	bfdChangedNodesLock sync.Mutex
+	// bfdChangedNodes are the nodes where the BFD status of a gateway of the policy changed since the policy was
+	// last synced, key is policyName. The pods of policies with priority groups are only re-applied on those nodes.
+	bfdChangedNodes map[string]sets.Set[string]
 	// networkClient is an interface that exposes add and delete GW IPs. There are 2 structs that implement this contract: one to interface with the north bound DB and another one for the conntrack.
 	// the north bound is used by the master controller to add and delete the logical static routes, whilst the conntrack is used by the node controller to ensure that the ECMP entries are removed
 	// when a gateway IP is no longer an egress access point.
@@ -230,8 +252,11 @@ type externalPolicyManager struct {
 
 type policyReferencedObjects struct {
 	targetNamespaces    sets.Set[string]
+	targetPods          sets.Set[ktypes.NamespacedName]
 	dynamicGWNamespaces sets.Set[string]
 	dynamicGWPods       sets.Set[ktypes.NamespacedName]
+	// bfdGatewayIPs are the IPs of the BFD enabled gateways of the policy
+	bfdGatewayIPs sets.Set[string]
 }
 
 func newExternalPolicyManager(
@@ -247,6 +272,7 @@ func newExternalPolicyManager(
 		policyReferencedObjectsLock: sync.RWMutex{},
 		policyReferencedObjects:     map[string]*policyReferencedObjects{},
 		routePolicySyncCache:        syncmap.NewSyncMap[*routePolicyState](),
+		bfdChangedNodes:             map[string]sets.Set[string]{},
 		netClient:                   netClient,
 
 		routeLister:   apbRouteInformer.Lister(),
@@ -646,11 +672,11 @@ func (m *externalPolicyManager) getPoliciesForNamespaceChange(namespace *corev1.
 	}
 
 	for _, informerPolicy := range informerPolicies {
-		targetNsSel, err := metav1.LabelSelectorAsSelector(&informerPolicy.Spec.From.NamespaceSelector)
+		targeted, err := namespaceTargetedBySource(namespace, &informerPolicy.Spec.From)
 		if err != nil {
 			return nil, err
 		}
-		if targetNsSel.Matches(labels.Set(namespace.Labels)) {
+		if targeted {
 			policyNames.Insert(informerPolicy.Name)
 			continue
 		}
@@ -701,11 +727,14 @@ func (m *externalPolicyManager) getPoliciesForPodChange(pod *corev1.Pod) (sets.S
 	}
 
 	for _, informerPolicy := range informerPolicies {
-		targetNsSel, err := metav1.LabelSelectorAsSelector(&informerPolicy.Spec.From.NamespaceSelector)
+		// every policy targeting the pod's namespace is queued, not only the ones whose pod selector matches:
+		// a pod label change may resolve or introduce a target pod conflict between policies, and the
+		// conflict check is only done when the policy is synced.
+		targeted, err := namespaceTargetedBySource(podNs, &informerPolicy.Spec.From)
 		if err != nil {
 			return nil, err
 		}
-		if targetNsSel.Matches(labels.Set(podNs.Labels)) {
+		if targeted {
 			policyNames.Insert(informerPolicy.Name)
 			continue
 		}
@@ -728,7 +757,6 @@ func (m *externalPolicyManager) getPoliciesForPodChange(pod *corev1.Pod) (sets.S
 	m.policyReferencedObjectsLock.RLock()
 	defer m.policyReferencedObjectsLock.RUnlock()
 	for policyName, policyRefs := range m.policyReferencedObjects {
-		// we don't store target pods, because all pods in the target namespace are affected, check namespace
 		if policyRefs.targetNamespaces.Has(podNs.Name) {
 			policyNames.Insert(policyName)
 			continue
@@ -740,6 +768,45 @@ func (m *externalPolicyManager) getPoliciesForPodChange(pod *corev1.Pod) (sets.S
 	return policyNames, nil
 }
 
+// queuePoliciesForBFDChange queues the policies with a BFD enabled gateway with the given IP, and records the node
+// of the BFD session whose status changed so that only the pods of that node are re-applied.
+func (m *externalPolicyManager) queuePoliciesForBFDChange(gwIP, nodeName string) {
+	m.policyReferencedObjectsLock.RLock()
+	defer m.policyReferencedObjectsLock.RUnlock()
+	for policyName, refObjs := range m.policyReferencedObjects {
+		if !refObjs.bfdGatewayIPs.Has(gwIP) {
+			continue
+		}
+		m.addBFDChangedNodes(policyName, sets.New(nodeName))
+		m.routeQueue.Add(policyName)
+	}
+}
+
+// addBFDChangedNodes records nodes where the BFD status of a gateway of the policy changed.
+func (m *externalPolicyManager) addBFDChangedNodes(policyName string, nodeNames sets.Set[string]) {
+	if nodeNames.Len() == 0 {
+		return
+	}
+	m.bfdChangedNodesLock.Lock()
+	defer m.bfdChangedNodesLock.Unlock()
+	if m.bfdChangedNodes[policyName] == nil {
+		m.bfdChangedNodes[policyName] = sets.New[string]()
+	}
+	m.bfdChangedNodes[policyName].Insert(nodeNames.UnsortedList()...)
+}
+
+// popBFDChangedNodes returns and forgets the nodes where the BFD status of a gateway of the policy changed.
+func (m *externalPolicyManager) popBFDChangedNodes(policyName string) sets.Set[string] {
+	m.bfdChangedNodesLock.Lock()
+	defer m.bfdChangedNodesLock.Unlock()
+	nodeNames := m.bfdChangedNodes[policyName]
+	delete(m.bfdChangedNodes, policyName)
+	if nodeNames == nil {
+		return sets.New[string]()
+	}
+	return nodeNames
+}
+
 func (m *externalPolicyManager) getAllRoutePolicies() ([]*adminpolicybasedrouteapi.AdminPolicyBasedExternalRoute, error) {
 	var (
 		routePolicies []*adminpolicybasedrouteapi.AdminPolicyBasedExternalRoute
@@ -769,7 +836,7 @@ func (m *externalPolicyManager) getDynamicGatewayIPsForTargetNamespace(namespace
 	}
 
 	for _, routePolicy := range routePolicies {
-		targetNamespaces, err := m.listNamespacesBySelector(&routePolicy.Spec.From.NamespaceSelector)
+		targetNamespaces, err := m.listTargetNamespaces(&routePolicy.Spec.From)
 		if err != nil {
 			return nil, fmt.Errorf("failed to get APB Policy %s dynamic gateway IPs: failed to list namespaces %v",
 				routePolicy.Name, err)
@@ -806,7 +873,7 @@ func (m *externalPolicyManager) getStaticGatewayIPsForTargetNamespace(namespaceN
 		return nil, err
 	}
 	for _, routePolicy := range routePolicies {
-		targetNamespaces, err := m.listNamespacesBySelector(&routePolicy.Spec.From.NamespaceSelector)
+		targetNamespaces, err := m.listTargetNamespaces(&routePolicy.Spec.From)
 		if err != nil {
 			klog.Errorf("Failed to process Admin Policy Based External Route %s: %v", routePolicy.Name, err)
 			return nil, err
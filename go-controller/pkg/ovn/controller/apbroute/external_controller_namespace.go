@@ -1,10 +1,17 @@
 package apbroute
 
 import (
+	"fmt"
+
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/util/workqueue"
 	"k8s.io/klog/v2"
+
+	adminpolicybasedrouteapi "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/adminpolicybasedroute/v1"
+	crdtypes "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/types"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
 )
 
 func (m *externalPolicyManager) syncNamespace(namespace *corev1.Namespace, routeQueue workqueue.TypedRateLimitingInterface[string]) error {
@@ -33,3 +40,62 @@ func (m *externalPolicyManager) listNamespacesBySelector(selector *metav1.LabelS
 	return ns, nil
 
 }
+
+// listTargetNamespaces returns the namespaces selected by both the namespace selector and the network selectors
+// of the given policy source.
+func (m *externalPolicyManager) listTargetNamespaces(from *adminpolicybasedrouteapi.ExternalNetworkSource) ([]*corev1.Namespace, error) {
+	namespaces, err := m.listNamespacesBySelector(&from.NamespaceSelector)
+	if err != nil {
+		return nil, err
+	}
+	targetNamespaces := make([]*corev1.Namespace, 0, len(namespaces))
+	for _, ns := range namespaces {
+		matches, err := namespaceMatchesNetworkSelectors(ns, from.NetworkSelectors)
+		if err != nil {
+			return nil, err
+		}
+		if matches {
+			targetNamespaces = append(targetNamespaces, ns)
+		}
+	}
+	return targetNamespaces, nil
+}
+
+// namespaceTargetedBySource returns true if the given namespace is selected by both the namespace selector and
+// the network selectors of the given policy source.
+func namespaceTargetedBySource(namespace *corev1.Namespace, from *adminpolicybasedrouteapi.ExternalNetworkSource) (bool, error) {
+	targetNsSel, err := metav1.LabelSelectorAsSelector(&from.NamespaceSelector)
+	if err != nil {
+		return false, err
+	}
+	if !targetNsSel.Matches(labels.Set(namespace.Labels)) {
+		return false, nil
+	}
+	return namespaceMatchesNetworkSelectors(namespace, from.NetworkSelectors)
+}
+
+// validateNetworkSelectors returns an error if networkSelectors select a network that the policies can't be applied
+// to. The routes of the policies are programmed on the gateway routers of the default network with the default
+// network IPs of the target pods, so only the default network can be selected.
+func validateNetworkSelectors(networkSelectors crdtypes.NetworkSelectors) error {
+	for _, networkSelector := range networkSelectors {
+		if networkSelector.NetworkSelectionType != crdtypes.DefaultNetwork {
+			return fmt.Errorf("unsupported network selection type %s", networkSelector.NetworkSelectionType)
+		}
+	}
+	return nil
+}
+
+// namespaceMatchesNetworkSelectors returns true if the given namespace is served by one of the networks selected by
+// networkSelectors. Namespaces labeled with types.RequiredUDNNamespaceLabel are served by a primary user defined
+// network, every other namespace is served by the default network. Empty networkSelectors match every namespace.
+func namespaceMatchesNetworkSelectors(namespace *corev1.Namespace, networkSelectors crdtypes.NetworkSelectors) (bool, error) {
+	if len(networkSelectors) == 0 {
+		return true, nil
+	}
+	if err := validateNetworkSelectors(networkSelectors); err != nil {
+		return false, err
+	}
+	_, servedByPrimaryUDN := namespace.Labels[types.RequiredUDNNamespaceLabel]
+	return !servedByPrimaryUDN, nil
+}
@@ -127,12 +127,14 @@ func expectedPolicyStateAndRefs(targetNamespaces []*namespaceWithPods, staticGWI
 	}
 
 	targetNamespaceNames := sets.Set[string]{}
+	targetPodNames := sets.Set[ktypes.NamespacedName]{}
 	for _, targetNS := range targetNamespaces {
 		nsState := map[ktypes.NamespacedName]*podInfo{}
 		for _, pod := range targetNS.pods {
+			targetPodNames.Insert(getPodNamespacedName(pod))
 			podInfo := &podInfo{
-				staticGWs,
-				dynamicGWs,
+				StaticGateways:  staticGWs,
+				DynamicGateways: dynamicGWs,
 			}
 			nsState[getPodNamespacedName(pod)] = podInfo
 		}
@@ -140,10 +142,21 @@ func expectedPolicyStateAndRefs(targetNamespaces []*namespaceWithPods, staticGWI
 		targetNamespaceNames.Insert(targetNS.nsName)
 	}
 
+	bfdGWIPs := sets.New[string]()
+	if bfdEnabled {
+		for _, gws := range []*gateway_info.GatewayInfoList{staticGWs, dynamicGWs} {
+			for _, gw := range gws.Elems() {
+				insertSet(bfdGWIPs, gw.Gateways)
+			}
+		}
+	}
+
 	return routeState, &policyReferencedObjects{
 		targetNamespaces:    targetNamespaceNames,
+		targetPods:          targetPodNames,
 		dynamicGWNamespaces: dynamicGWNamespaces,
 		dynamicGWPods:       dynamicGWPods,
+		bfdGatewayIPs:       bfdGWIPs,
 	}
 }
 
@@ -8,11 +8,15 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/kubernetes/fake"
 
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
+	adminpolicybasedrouteapi "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/adminpolicybasedroute/v1"
+	crdtypes "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/types"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
 	libovsdbtest "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/testing/libovsdb"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -449,6 +453,115 @@ var _ = Describe("OVN External Gateway pod", func() {
 
 	})
 
+	var _ = Context("When a policy selects target pods", func() {
+
+		newTargetPodPolicy := func(policyName, gwIP string, podLabels map[string]string) *adminpolicybasedrouteapi.AdminPolicyBasedExternalRoute {
+			p := newPolicy(
+				policyName,
+				&metav1.LabelSelector{MatchLabels: targetNamespace1Match},
+				sets.New(gwIP),
+				nil,
+				nil,
+				false,
+			)
+			p.Spec.From.PodSelector = metav1.LabelSelector{MatchLabels: podLabels}
+			return p
+		}
+
+		It("only applies the policy to the target pods that match the pod selector", func() {
+			dataPlanePod := newPod("pod_data_plane", namespaceTarget.Name, "192.169.10.3",
+				map[string]string{"role": "data-plane"})
+			staticPodPolicy := newTargetPodPolicy("static-pods", staticHopGWIP, map[string]string{"role": "data-plane"})
+			initController([]runtime.Object{namespaceTarget, targetPod1, dataPlanePod}, []runtime.Object{staticPodPolicy})
+
+			expectedPolicy, expectedRefs := expectedPolicyStateAndRefs(
+				[]*namespaceWithPods{newNamespaceWithPods(namespaceTarget.Name, dataPlanePod)},
+				[]string{staticHopGWIP},
+				nil, false)
+			eventuallyExpectNumberOfPolicies(1)
+			eventuallyExpectConfig(staticPodPolicy.Name, expectedPolicy, expectedRefs)
+
+			updatePodLabels(targetPod1, map[string]string{"role": "data-plane"}, fakeClient)
+			expectedPolicy, expectedRefs = expectedPolicyStateAndRefs(
+				[]*namespaceWithPods{newNamespaceWithPods(namespaceTarget.Name, targetPod1, dataPlanePod)},
+				[]string{staticHopGWIP},
+				nil, false)
+			eventuallyExpectConfig(staticPodPolicy.Name, expectedPolicy, expectedRefs)
+
+			updatePodLabels(dataPlanePod, map[string]string{}, fakeClient)
+			expectedPolicy, expectedRefs = expectedPolicyStateAndRefs(
+				[]*namespaceWithPods{newNamespaceWithPods(namespaceTarget.Name, targetPod1)},
+				[]string{staticHopGWIP},
+				nil, false)
+			eventuallyExpectConfig(staticPodPolicy.Name, expectedPolicy, expectedRefs)
+		})
+
+		It("applies two policies to the same namespace when they select different pods", func() {
+			dataPlanePod := newPod("pod_data_plane", namespaceTarget.Name, "192.169.10.3",
+				map[string]string{"role": "data-plane"})
+			dataPlanePolicy := newTargetPodPolicy("data-plane", staticHopGWIP, map[string]string{"role": "data-plane"})
+			controlPlanePolicy := newTargetPodPolicy("control-plane", "10.10.10.2", map[string]string{"plane": "control"})
+			// conflictingPod is selected by both policies, so only one of them can be applied
+			conflictingPod := targetPod1.DeepCopy()
+			conflictingPod.Labels = map[string]string{"role": "data-plane", "plane": "control"}
+			initController([]runtime.Object{namespaceTarget, conflictingPod, dataPlanePod},
+				[]runtime.Object{dataPlanePolicy})
+
+			expectedPolicy1, expectedRefs1 := expectedPolicyStateAndRefs(
+				[]*namespaceWithPods{newNamespaceWithPods(namespaceTarget.Name, conflictingPod, dataPlanePod)},
+				[]string{staticHopGWIP},
+				nil, false)
+			eventuallyExpectNumberOfPolicies(1)
+			eventuallyExpectConfig(dataPlanePolicy.Name, expectedPolicy1, expectedRefs1)
+
+			_, err = fakeRouteClient.K8sV1().AdminPolicyBasedExternalRoutes().Create(context.TODO(), controlPlanePolicy, metav1.CreateOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Consistently(func() *policyReferencedObjects { return listRefObjects(controlPlanePolicy.Name) }, 2).Should(BeNil())
+
+			// moving the pod to the control plane must re-sync both policies
+			updatePodLabels(conflictingPod, map[string]string{"plane": "control"}, fakeClient)
+			expectedPolicy1, expectedRefs1 = expectedPolicyStateAndRefs(
+				[]*namespaceWithPods{newNamespaceWithPods(namespaceTarget.Name, dataPlanePod)},
+				[]string{staticHopGWIP},
+				nil, false)
+			expectedPolicy2, expectedRefs2 := expectedPolicyStateAndRefs(
+				[]*namespaceWithPods{newNamespaceWithPods(namespaceTarget.Name, conflictingPod)},
+				[]string{"10.10.10.2"},
+				nil, false)
+			eventuallyExpectNumberOfPolicies(2)
+			eventuallyExpectConfig(dataPlanePolicy.Name, expectedPolicy1, expectedRefs1)
+			eventuallyExpectConfig(controlPlanePolicy.Name, expectedPolicy2, expectedRefs2)
+		})
+
+		It("only applies the policy to the namespaces served by the selected networks", func() {
+			udnNamespace := &corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{Name: targetNamespaceName2,
+					Labels: map[string]string{"name": targetNamespaceName2, "match": targetNamespaceLabel,
+						types.RequiredUDNNamespaceLabel: ""}},
+			}
+			staticNetworkPolicy := newPolicy(
+				"static-network",
+				&metav1.LabelSelector{MatchLabels: map[string]string{"match": targetNamespaceLabel}},
+				sets.New(staticHopGWIP),
+				nil,
+				nil,
+				false,
+			)
+			staticNetworkPolicy.Spec.From.NetworkSelectors = crdtypes.NetworkSelectors{
+				{NetworkSelectionType: crdtypes.DefaultNetwork},
+			}
+			initController([]runtime.Object{namespaceTarget, udnNamespace, targetPod1, targetPod2},
+				[]runtime.Object{staticNetworkPolicy})
+
+			expectedPolicy, expectedRefs := expectedPolicyStateAndRefs(
+				[]*namespaceWithPods{namespaceTargetWithPod},
+				[]string{staticHopGWIP},
+				nil, false)
+			eventuallyExpectNumberOfPolicies(1)
+			eventuallyExpectConfig(staticNetworkPolicy.Name, expectedPolicy, expectedRefs)
+		})
+	})
+
 	var _ = Context("When pod goes into terminating or not ready state", func() {
 
 		DescribeTable("reconciles a pod gateway in terminating or not ready state that matches two policies", func(
@@ -10,8 +10,10 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	ktypes "k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
 
 	adminpolicybasedrouteapi "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/adminpolicybasedroute/v1"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn/controller/apbroute/gateway_info"
@@ -53,6 +55,7 @@ func (m *externalPolicyManager) syncRoutePolicy(policyName string) (sets.Set[str
 			if err != nil {
 				return fmt.Errorf("failed to build updated policy: %w", err)
 			}
+			updatedPolicy.bfdChangedNodes = m.popBFDChangedNodes(policyName)
 		}
 
 		// get existing policy and update routePolicySyncCache
@@ -68,6 +71,10 @@ func (m *externalPolicyManager) syncRoutePolicy(policyName string) (sets.Set[str
 
 		err = m.updateRoutePolicy(existingPolicy, updatedPolicy)
 		if err != nil {
+			if updatedPolicy != nil {
+				// the pods of the nodes may not have been re-applied yet
+				m.addBFDChangedNodes(policyName, updatedPolicy.bfdChangedNodes)
+			}
 			return fmt.Errorf("failed to update policy from %s to %+v: %w", existingPolicy.String(), updatedPolicy, err)
 		}
 		if updatedPolicy == nil {
@@ -178,6 +185,7 @@ func (m *externalPolicyManager) updateRoutePolicy(existingPolicy *routePolicySta
 					// remove successfully deleted gateways from the existingPodConfig
 					existingPodConfig.StaticGateways.Delete(staticGWsToDelete.Elems()...)
 					existingPodConfig.DynamicGateways.Delete(dynamicGWsToDelete.Elems()...)
+					existingPodConfig.gatewaysDeleted = true
 				}
 
 				if updatedPolicy == nil || updatedPolicy.targetNamespacesWithPods[targetNamespace][podNamespacedName] == nil {
@@ -222,6 +230,10 @@ func (m *externalPolicyManager) updateRoutePolicy(existingPolicy *routePolicySta
 
 // applyPodConfig applies the gateway IPs derived from the processed policy to a pod and updates existingPodConfig.
 func (m *externalPolicyManager) applyPodConfig(pod *corev1.Pod, existingPodConfig *podInfo, updatedPolicy *routePolicyConfig) error {
+	allGateways := updatedPolicy.allGateways()
+	if allGateways.HasPriorityGroups() {
+		return m.applyPodConfigWithPriorityGroups(pod, existingPodConfig, updatedPolicy, allGateways)
+	}
 	// update static gw
 	gwsToAdd := gateway_info.NewGatewayInfoList()
 	for _, newGW := range updatedPolicy.staticGateways.Elems() {
@@ -262,6 +274,33 @@ func (m *externalPolicyManager) applyPodConfig(pod *corev1.Pod, existingPodConfi
 	return nil
 }
 
+// applyPodConfigWithPriorityGroups applies the gateway IPs of all the priority groups to a pod at once, since the
+// network client needs every group to decide which ones carry the pod traffic. Unlike applyPodConfig, the gateways
+// are also applied when they were already applied, if gateways were deleted from the pod or if the BFD status of a
+// gateway changed on the node of the pod, so that the groups in use are re-evaluated.
+func (m *externalPolicyManager) applyPodConfigWithPriorityGroups(pod *corev1.Pod, existingPodConfig *podInfo,
+	updatedPolicy *routePolicyConfig, allGateways *gateway_info.GatewayInfoList) error {
+	if existingPodConfig.StaticGateways.Equal(updatedPolicy.staticGateways) &&
+		existingPodConfig.DynamicGateways.Equal(updatedPolicy.dynamicGateways) &&
+		!existingPodConfig.gatewaysDeleted && !updatedPolicy.bfdChangedNodes.Has(pod.Spec.NodeName) {
+		return nil
+	}
+	applied, err := m.netClient.addGatewayIPs(pod, allGateways)
+	if !applied {
+		return nil
+	}
+	if err != nil {
+		existingPodConfig.StaticGateways.InsertOverwriteFailed(updatedPolicy.staticGateways.Elems()...)
+		existingPodConfig.DynamicGateways.InsertOverwriteFailed(updatedPolicy.dynamicGateways.Elems()...)
+		return err
+	}
+	existingPodConfig.StaticGateways.InsertOverwrite(updatedPolicy.staticGateways.Elems()...)
+	existingPodConfig.DynamicGateways.InsertOverwrite(updatedPolicy.dynamicGateways.Elems()...)
+	existingPodConfig.gatewaysDeleted = false
+	klog.V(4).Infof("Applying policy %s with priority groups to pod %s", updatedPolicy.policyName, getPodNamespacedName(pod))
+	return nil
+}
+
 // calculateAnnotatedNamespaceGatewayIPsForNamespace retrieves the list of IPs defined by the legacy annotation gateway logic for namespaces.
 // this function is used when deleting gateway IPs to ensure that IPs that overlap with the annotation logic are not deleted from the network resource
 // (north bound or conntrack) when the given IP is deleted when removing the policy that references them.
@@ -330,7 +369,9 @@ func (m *externalPolicyManager) processStaticHopsGatewayInformation(hops []*admi
 		if ip == nil {
 			return nil, fmt.Errorf("could not parse routing static gw annotation value '%s'", h.IP)
 		}
-		gwList.InsertOverwrite(gateway_info.NewGatewayInfo(sets.New(ip.String()), h.BFDEnabled))
+		gwInfo := gateway_info.NewGatewayInfoWithPriority(sets.New(ip.String()), h.BFDEnabled, h.Priority)
+		gwInfo.BFDTimers = getBFDTimers(h.BFDConfig)
+		gwList.InsertOverwrite(gwInfo)
 	}
 	return gwList, nil
 }
@@ -370,7 +411,9 @@ func (m *externalPolicyManager) processDynamicHopsGatewayInformation(hops []*adm
 					continue
 				}
 				key := ktypes.NamespacedName{Namespace: pod.Namespace, Name: pod.Name}
-				podsInfo.InsertOverwrite(gateway_info.NewGatewayInfo(foundGws, h.BFDEnabled))
+				gwInfo := gateway_info.NewGatewayInfoWithPriority(foundGws, h.BFDEnabled, h.Priority)
+				gwInfo.BFDTimers = getBFDTimers(h.BFDConfig)
+				podsInfo.InsertOverwrite(gwInfo)
 				selectedPods.Insert(key)
 			}
 			selectedNamespaces.Insert(gwNamespace.Name)
@@ -379,6 +422,18 @@ func (m *externalPolicyManager) processDynamicHopsGatewayInformation(hops []*adm
 	return podsInfo, selectedNamespaces, selectedPods, nil
 }
 
+// getBFDTimers returns the BFD session parameters defined by the given next hop BFD configuration.
+func getBFDTimers(bfdConfig *adminpolicybasedrouteapi.BFDConfig) gateway_info.BFDTimers {
+	if bfdConfig == nil {
+		return gateway_info.BFDTimers{}
+	}
+	return gateway_info.BFDTimers{
+		MinTx:      int(ptr.Deref(bfdConfig.MinTx, 0)),
+		MinRx:      int(ptr.Deref(bfdConfig.MinRx, 0)),
+		DetectMult: int(ptr.Deref(bfdConfig.DetectMult, 0)),
+	}
+}
+
 // getPolicyConfigAndUpdatePolicyRefs lists and updates all referenced objects for a given policy and returns
 // routePolicyConfig to perform an update.
 // This function should be the only one that lists referenced objects, and updates policyReferencedObjects atomically.
@@ -404,16 +459,24 @@ func (m *externalPolicyManager) getPolicyConfigAndUpdatePolicyRefs(policy *admin
 		klog.V(5).Infof("Found dynamic hops for policy %s: %+v", policy.Name, dynamicGWInfo)
 	}
 
-	targetNs, err := m.listNamespacesBySelector(&policy.Spec.From.NamespaceSelector)
+	if err = validateNetworkSelectors(policy.Spec.From.NetworkSelectors); err != nil {
+		return nil, fmt.Errorf("failed to process network selectors: %w", err)
+	}
+	targetNs, err := m.listTargetNamespaces(&policy.Spec.From)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list target namespaces: %w", err)
 	}
+	targetPodSel, err := metav1.LabelSelectorAsSelector(&policy.Spec.From.PodSelector)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert target pod selector: %w", err)
+	}
 
 	targetNsNames := sets.Set[string]{}
+	targetPodNames := sets.Set[ktypes.NamespacedName]{}
 	targetNamespaces := map[string]map[ktypes.NamespacedName]*corev1.Pod{}
 	for _, ns := range targetNs {
 		targetNsNames.Insert(ns.Name)
-		targetPods, err := m.podLister.Pods(ns.Name).List(labels.Everything())
+		targetPods, err := m.podLister.Pods(ns.Name).List(targetPodSel)
 		if err != nil {
 			return nil, fmt.Errorf("failed to get all ns %s pods: %v", ns.Name, err)
 		}
@@ -424,25 +487,40 @@ func (m *externalPolicyManager) getPolicyConfigAndUpdatePolicyRefs(policy *admin
 				continue
 			}
 			podsMap[getPodNamespacedName(pod)] = pod
+			targetPodNames.Insert(getPodNamespacedName(pod))
 		}
 		targetNamespaces[ns.Name] = podsMap
 	}
+	// policies may share a target namespace as long as their pod selectors don't select the same pods
 	for policyName, refObjs := range m.policyReferencedObjects {
 		if policyName == policy.Name {
 			continue
 		}
-		if refObjs.targetNamespaces.Intersection(targetNsNames).Len() > 0 {
-			return nil, fmt.Errorf("failed to update policy %s: namespaces %v are already affected by another policy: %s",
-				policy.Name, refObjs.targetNamespaces.Intersection(targetNsNames).UnsortedList(), policyName)
+		if refObjs.targetPods.Intersection(targetPodNames).Len() > 0 {
+			return nil, fmt.Errorf("failed to update policy %s: pods %v are already affected by another policy: %s",
+				policy.Name, refObjs.targetPods.Intersection(targetPodNames).UnsortedList(), policyName)
 
 		}
 	}
 
 	if updateRefs {
+		if existingRefObjs, found := m.policyReferencedObjects[policy.Name]; found {
+			m.queuePoliciesForReleasedTargetPods(policy.Name, existingRefObjs.targetPods.Difference(targetPodNames))
+		}
+		bfdGWIPs := sets.New[string]()
+		for _, gws := range []*gateway_info.GatewayInfoList{staticGWInfo, dynamicGWInfo} {
+			for _, gw := range gws.Elems() {
+				if gw.BFDEnabled {
+					insertSet(bfdGWIPs, gw.Gateways)
+				}
+			}
+		}
 		refObjs := &policyReferencedObjects{
 			targetNamespaces:    targetNsNames,
+			targetPods:          targetPodNames,
 			dynamicGWNamespaces: gwNamespaces,
 			dynamicGWPods:       gwPods,
+			bfdGatewayIPs:       bfdGWIPs,
 		}
 		m.policyReferencedObjects[policy.Name] = refObjs
 	}
@@ -458,9 +536,54 @@ func (m *externalPolicyManager) getPolicyConfigAndUpdatePolicyRefs(policy *admin
 func (m *externalPolicyManager) deletePolicyRefObjects(policyName string) {
 	m.policyReferencedObjectsLock.Lock()
 	defer m.policyReferencedObjectsLock.Unlock()
+	if existingRefObjs, found := m.policyReferencedObjects[policyName]; found {
+		m.queuePoliciesForReleasedTargetPods(policyName, existingRefObjs.targetPods)
+	}
 	delete(m.policyReferencedObjects, policyName)
 }
 
+// queuePoliciesForReleasedTargetPods queues the policies targeting the namespaces of the pods no longer selected by
+// the given policy. These policies may have failed to sync with a target pod conflict while the pods were selected.
+func (m *externalPolicyManager) queuePoliciesForReleasedTargetPods(policyName string, releasedPods sets.Set[ktypes.NamespacedName]) {
+	if releasedPods.Len() == 0 {
+		return
+	}
+	namespaceNames := sets.New[string]()
+	for pod := range releasedPods {
+		namespaceNames.Insert(pod.Namespace)
+	}
+	namespaces := []*corev1.Namespace{}
+	for namespaceName := range namespaceNames {
+		namespace, err := m.namespaceLister.Get(namespaceName)
+		if err != nil {
+			// the namespace is gone together with its pods
+			continue
+		}
+		namespaces = append(namespaces, namespace)
+	}
+	routePolicies, err := m.getAllRoutePolicies()
+	if err != nil {
+		utilruntime.HandleError(err)
+		return
+	}
+	for _, routePolicy := range routePolicies {
+		if routePolicy.Name == policyName {
+			continue
+		}
+		for _, namespace := range namespaces {
+			targeted, err := namespaceTargetedBySource(namespace, &routePolicy.Spec.From)
+			if err != nil {
+				utilruntime.HandleError(err)
+				break
+			}
+			if targeted {
+				m.routeQueue.Add(routePolicy.Name)
+				break
+			}
+		}
+	}
+}
+
 func getPodNamespacedName(pod *corev1.Pod) ktypes.NamespacedName {
 	return ktypes.NamespacedName{Namespace: pod.Namespace, Name: pod.Name}
 }
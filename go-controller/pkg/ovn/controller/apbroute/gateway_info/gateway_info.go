@@ -2,6 +2,7 @@ package gateway_info
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -124,6 +125,41 @@ func (g *GatewayInfoList) Len() int {
 	return len(g.elems)
 }
 
+// HasPriorityGroups returns true when the GatewayInfoList elements belong to more than one priority group.
+func (g *GatewayInfoList) HasPriorityGroups() bool {
+	for _, i := range g.elems {
+		if i.Priority != g.elems[0].Priority {
+			return true
+		}
+	}
+	return false
+}
+
+// PriorityGroups returns the GatewayInfoList elements grouped by priority, sorted from the preferred group,
+// with the lowest priority value, to the least preferred one.
+func (g *GatewayInfoList) PriorityGroups() [][]*GatewayInfo {
+	return GroupByPriority(g.elems)
+}
+
+// GroupByPriority groups the given gateways by priority, sorted from the preferred group, with the lowest
+// priority value, to the least preferred one.
+func GroupByPriority(gws []*GatewayInfo) [][]*GatewayInfo {
+	groups := map[int32][]*GatewayInfo{}
+	for _, gw := range gws {
+		groups[gw.Priority] = append(groups[gw.Priority], gw)
+	}
+	priorities := make([]int32, 0, len(groups))
+	for priority := range groups {
+		priorities = append(priorities, priority)
+	}
+	slices.Sort(priorities)
+	sortedGroups := make([][]*GatewayInfo, 0, len(priorities))
+	for _, priority := range priorities {
+		sortedGroups = append(sortedGroups, groups[priority])
+	}
+	return sortedGroups
+}
+
 // Equal compares GatewayInfoList elements to be exactly the same, including applied status,
 // but ignores elements order.
 func (g *GatewayInfoList) Equal(g2 *GatewayInfoList) bool {
@@ -148,31 +184,53 @@ func (g *GatewayInfoList) Equal(g2 *GatewayInfoList) bool {
 }
 
 type GatewayInfo struct {
-	Gateways      sets.Set[string]
-	BFDEnabled    bool
+	Gateways   sets.Set[string]
+	BFDEnabled bool
+	// BFDTimers are the parameters of the BFD sessions with the gateways, only used when BFDEnabled is true.
+	BFDTimers BFDTimers
+	// Priority is the priority group of the gateways, the lowest value is the preferred group.
+	Priority      int32
 	failedToApply bool
 }
 
+// BFDTimers defines the parameters of a BFD session, a zero value keeps the OVN default.
+type BFDTimers struct {
+	MinTx      int
+	MinRx      int
+	DetectMult int
+}
+
 func (g *GatewayInfo) String() string {
-	return fmt.Sprintf("BFDEnabled: %t, Gateways: %+v, failedToApply: %t", g.BFDEnabled, g.Gateways, g.failedToApply)
+	return fmt.Sprintf("BFDEnabled: %t, BFDTimers: %+v, Priority: %d, Gateways: %+v, failedToApply: %t", g.BFDEnabled,
+		g.BFDTimers, g.Priority, g.Gateways, g.failedToApply)
 }
 
 func NewGatewayInfo(items sets.Set[string], bfdEnabled bool) *GatewayInfo {
 	return &GatewayInfo{Gateways: items, BFDEnabled: bfdEnabled}
 }
 
+func NewGatewayInfoWithPriority(items sets.Set[string], bfdEnabled bool, priority int32) *GatewayInfo {
+	return &GatewayInfo{Gateways: items, BFDEnabled: bfdEnabled, Priority: priority}
+}
+
+// Clone returns a copy of the GatewayInfo spec fields, the copy is not marked as failed to apply.
+func (g *GatewayInfo) Clone() *GatewayInfo {
+	return &GatewayInfo{Gateways: g.Gateways.Clone(), BFDEnabled: g.BFDEnabled, BFDTimers: g.BFDTimers, Priority: g.Priority}
+}
+
 // SameSpec compares GatewayInfo fields, excluding applied
 func (g *GatewayInfo) SameSpec(g2 *GatewayInfo) bool {
-	return g.BFDEnabled == g2.BFDEnabled && g.Gateways.Equal(g2.Gateways)
+	return g.BFDEnabled == g2.BFDEnabled && g.BFDTimers == g2.BFDTimers && g.Priority == g2.Priority &&
+		g.Gateways.Equal(g2.Gateways)
 }
 
 func (g *GatewayInfo) RemoveIPs(g2 *GatewayInfo) {
 	g.Gateways = g.Gateways.Difference(g2.Gateways)
 }
 
-// Equal compares all GatewayInfo fields, including BFDEnabled and applied
+// Equal compares all GatewayInfo fields, including BFDEnabled, BFDTimers, Priority and applied
 func (g *GatewayInfo) Equal(g2 *GatewayInfo) bool {
-	return g.BFDEnabled == g2.BFDEnabled && g.Gateways.Equal(g2.Gateways) && g.failedToApply == g2.failedToApply
+	return g.SameSpec(g2) && g.failedToApply == g2.failedToApply
 }
 
 func (g *GatewayInfo) Has(ip string) bool {
@@ -63,6 +63,15 @@ var _ = Describe("GatewayInfoList", func() {
 				NewGatewayInfo(sets.New("1.1.1.1", "1.1.1.2"), false)))).To(BeTrue())
 		})
 
+		It("InsertOverwrite replaces an element with the same ips but different BFD timers", func() {
+			s1 := NewGatewayInfoList(NewGatewayInfo(sets.New("1.1.1.1"), true))
+			gw := NewGatewayInfo(sets.New("1.1.1.1"), true)
+			gw.BFDTimers = BFDTimers{MinTx: 100, MinRx: 100, DetectMult: 3}
+			s1.InsertOverwrite(gw)
+			Expect(s1.Equal(NewGatewayInfoList(gw))).To(BeTrue())
+			Expect(s1.Has(NewGatewayInfo(sets.New("1.1.1.1"), true))).To(BeFalse())
+		})
+
 		It("InsertOverwriteFailed updates status of the existing element", func() {
 			s1 := NewGatewayInfoList(NewGatewayInfo(sets.New("1.1.1.1"), false))
 			s1.InsertOverwriteFailed(NewGatewayInfo(sets.New("1.1.1.1"), false))
@@ -103,4 +112,29 @@ var _ = Describe("GatewayInfoList", func() {
 			Expect(s1.Equal(NewGatewayInfoList())).To(BeTrue())
 		})
 	})
+
+	var _ = Context("Priority groups", func() {
+
+		It("reports no priority groups when all the elements share the same priority", func() {
+			s1 := NewGatewayInfoList(
+				NewGatewayInfoWithPriority(sets.New("1.1.1.1"), false, 1),
+				NewGatewayInfoWithPriority(sets.New("1.1.1.2"), true, 1))
+			Expect(s1.HasPriorityGroups()).To(BeFalse())
+			Expect(s1.PriorityGroups()).To(HaveLen(1))
+		})
+
+		It("returns the elements grouped by priority from the preferred group", func() {
+			backup := NewGatewayInfoWithPriority(sets.New("1.1.1.3"), true, 10)
+			primary1 := NewGatewayInfoWithPriority(sets.New("1.1.1.1"), true, 0)
+			primary2 := NewGatewayInfoWithPriority(sets.New("1.1.1.2"), false, 0)
+			s1 := NewGatewayInfoList(backup, primary1, primary2)
+			Expect(s1.HasPriorityGroups()).To(BeTrue())
+			Expect(s1.PriorityGroups()).To(Equal([][]*GatewayInfo{{primary1, primary2}, {backup}}))
+		})
+
+		It("considers elements with the same ips but different priority as different", func() {
+			s1 := NewGatewayInfoList(NewGatewayInfoWithPriority(sets.New("1.1.1.1"), false, 0))
+			Expect(s1.Has(NewGatewayInfoWithPriority(sets.New("1.1.1.1"), false, 1))).To(BeFalse())
+		})
+	})
 })
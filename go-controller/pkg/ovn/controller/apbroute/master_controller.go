@@ -4,23 +4,29 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"slices"
 	"strings"
 	"sync"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
+	metaapplyv1 "k8s.io/client-go/applyconfigurations/meta/v1"
 	coreinformers "k8s.io/client-go/informers/core/v1"
 	corev1listers "k8s.io/client-go/listers/core/v1"
 	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
 
+	libovsdbcache "github.com/ovn-kubernetes/libovsdb/cache"
 	libovsdbclient "github.com/ovn-kubernetes/libovsdb/client"
+	"github.com/ovn-kubernetes/libovsdb/model"
 
 	adminpolicybasedrouteapi "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/adminpolicybasedroute/v1"
 	adminpolicybasedrouteapply "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/adminpolicybasedroute/v1/apis/applyconfiguration/adminpolicybasedroute/v1"
 	adminpolicybasedrouteclient "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/adminpolicybasedroute/v1/apis/clientset/versioned"
 	adminpolicybasedrouteinformer "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/adminpolicybasedroute/v1/apis/informers/externalversions/adminpolicybasedroute/v1"
 	libovsdbutil "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/util"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
 	addressset "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn/address_set"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
@@ -28,6 +34,10 @@ import (
 
 // Admin Policy Based Route services
 
+// nextHopBFDFieldManagerSuffix is appended to the zone name to get the field manager of the next hop BFD conditions
+// reported by the zone.
+const nextHopBFDFieldManagerSuffix = "-next-hop-bfd"
+
 type ExternalGatewayMasterController struct {
 	apbRoutePolicyClient adminpolicybasedrouteclient.Interface
 
@@ -87,6 +97,27 @@ func NewExternalMasterController(
 func (c *ExternalGatewayMasterController) Run(wg *sync.WaitGroup, threadiness int) error {
 	klog.V(4).Info("Starting Admin Policy Based Route Controller")
 
+	// the next hops of the backup priority groups are only used while the BFD sessions of the preferred next hops
+	// are down, and the status of the policies reports the state of the BFD sessions: re-sync the policies with
+	// the BFD enabled next hop every time ovn-northd updates the status of its session on a gateway router.
+	c.nbClient.nbClient.Cache().AddEventHandler(&libovsdbcache.EventHandlerFuncs{
+		UpdateFunc: func(_ string, old, new model.Model) {
+			oldBFD, ok := old.(*nbdb.BFD)
+			if !ok {
+				return
+			}
+			newBFD := new.(*nbdb.BFD)
+			if ptr.Deref(oldBFD.Status, "") == ptr.Deref(newBFD.Status, "") {
+				return
+			}
+			nodeName, ok := getGatewayRouterBFDNodeName(newBFD)
+			if !ok {
+				return
+			}
+			c.mgr.queuePoliciesForBFDChange(newBFD.DstIP, nodeName)
+		},
+	})
+
 	return c.mgr.Run(wg, threadiness)
 }
 
@@ -134,24 +165,134 @@ func (c *ExternalGatewayMasterController) updateStatusAPBExternalRoute(policyNam
 			break
 		}
 	}
+	if needsUpdate {
+		applyOptions := metav1.ApplyOptions{
+			Force:        true,
+			FieldManager: c.zoneID,
+		}
+		applyObj := adminpolicybasedrouteapply.AdminPolicyBasedExternalRoute(policyName).
+			WithStatus(adminpolicybasedrouteapply.AdminPolicyBasedRouteStatus().
+				WithMessages(newMsg).
+				WithLastTransitionTime(metav1.Now()))
+		_, err = c.apbRoutePolicyClient.K8sV1().AdminPolicyBasedExternalRoutes().ApplyStatus(context.TODO(), applyObj, applyOptions)
+		if err != nil {
+			return err
+		}
+	}
+
+	if syncError != nil {
+		// keep reporting the BFD sessions of the gateways applied by the last successful sync
+		return nil
+	}
+	return c.updateNextHopBFDConditions(routePolicy, gwIPs)
+}
+
+// updateNextHopBFDConditions reports the state of the BFD sessions of the zone gateway routers with the given
+// gateway IPs as the zone next hop BFD conditions of the policy. The conditions are applied with their own field
+// manager, so that the conditions of the next hops that are not used anymore are removed, and only when they change.
+func (c *ExternalGatewayMasterController) updateNextHopBFDConditions(routePolicy *adminpolicybasedrouteapi.AdminPolicyBasedExternalRoute,
+	gwIPs sets.Set[string]) error {
+	bfdsByGWIP, err := c.nbClient.getGatewayRouterBFDs(gwIPs)
+	if err != nil {
+		return err
+	}
+	zoneSuffix := adminpolicybasedrouteapi.NextHopBFDConditionZoneInfix + c.zoneID
+	existingConditions := map[string]metav1.Condition{}
+	for _, condition := range routePolicy.Status.Conditions {
+		if strings.HasPrefix(condition.Type, adminpolicybasedrouteapi.NextHopBFDConditionPrefix) &&
+			strings.HasSuffix(condition.Type, zoneSuffix) {
+			existingConditions[condition.Type] = condition
+		}
+	}
+
+	needsUpdate := len(existingConditions) != len(bfdsByGWIP)
+	conditions := make([]*metaapplyv1.ConditionApplyConfiguration, 0, len(bfdsByGWIP))
+	for _, gwIP := range sets.List(sets.KeySet(bfdsByGWIP)) {
+		condition := getNextHopBFDCondition(c.zoneID, gwIP, bfdsByGWIP[gwIP])
+		existingCondition, found := existingConditions[condition.Type]
+		if found && existingCondition.Status == condition.Status {
+			condition.LastTransitionTime = existingCondition.LastTransitionTime
+		}
+		if !found || existingCondition.Status != condition.Status || existingCondition.Reason != condition.Reason ||
+			existingCondition.Message != condition.Message {
+			needsUpdate = true
+		}
+		conditions = append(conditions, metaapplyv1.Condition().
+			WithType(condition.Type).
+			WithStatus(condition.Status).
+			WithReason(condition.Reason).
+			WithMessage(condition.Message).
+			WithLastTransitionTime(condition.LastTransitionTime))
+	}
 	if !needsUpdate {
 		return nil
 	}
 
 	applyOptions := metav1.ApplyOptions{
 		Force:        true,
-		FieldManager: c.zoneID,
+		FieldManager: c.zoneID + nextHopBFDFieldManagerSuffix,
 	}
-	applyObj := adminpolicybasedrouteapply.AdminPolicyBasedExternalRoute(policyName).
+	applyObj := adminpolicybasedrouteapply.AdminPolicyBasedExternalRoute(routePolicy.Name).
 		WithStatus(adminpolicybasedrouteapply.AdminPolicyBasedRouteStatus().
-			WithMessages(newMsg).
-			WithLastTransitionTime(metav1.Now()))
+			WithConditions(conditions...))
 	_, err = c.apbRoutePolicyClient.K8sV1().AdminPolicyBasedExternalRoutes().ApplyStatus(context.TODO(), applyObj, applyOptions)
+	return err
+}
 
-	if err != nil {
-		return err
+// nextHopBFDReasons are the reasons of the next hop BFD conditions, from the healthiest to the least healthy one.
+var nextHopBFDReasons = []string{
+	adminpolicybasedrouteapi.NextHopBFDReasonUp,
+	adminpolicybasedrouteapi.NextHopBFDReasonPending,
+	adminpolicybasedrouteapi.NextHopBFDReasonInit,
+	adminpolicybasedrouteapi.NextHopBFDReasonAdminDown,
+	adminpolicybasedrouteapi.NextHopBFDReasonDown,
+}
+
+// getNextHopBFDCondition returns the condition reporting the state of the given BFD sessions of a zone with a next
+// hop. The reason of the condition is the one of the least healthy session.
+func getNextHopBFDCondition(zone, gwIP string, bfds []*nbdb.BFD) metav1.Condition {
+	reason := adminpolicybasedrouteapi.NextHopBFDReasonUp
+	portsNotUp := []string{}
+	for _, bfd := range bfds {
+		bfdReason := getNextHopBFDReason(bfd)
+		if bfdReason == adminpolicybasedrouteapi.NextHopBFDReasonUp {
+			continue
+		}
+		portsNotUp = append(portsNotUp, bfd.LogicalPort)
+		if slices.Index(nextHopBFDReasons, bfdReason) > slices.Index(nextHopBFDReasons, reason) {
+			reason = bfdReason
+		}
+	}
+	condition := metav1.Condition{
+		Type: adminpolicybasedrouteapi.NextHopBFDConditionPrefix + strings.ReplaceAll(gwIP, ":", ".") +
+			adminpolicybasedrouteapi.NextHopBFDConditionZoneInfix + zone,
+		Status:             metav1.ConditionTrue,
+		Reason:             reason,
+		Message:            fmt.Sprintf("all the BFD sessions with next hop %s are up", gwIP),
+		LastTransitionTime: metav1.Now(),
+	}
+	if len(portsNotUp) > 0 {
+		slices.Sort(portsNotUp)
+		condition.Status = metav1.ConditionFalse
+		condition.Message = fmt.Sprintf("BFD sessions with next hop %s are not up on ports: %s", gwIP,
+			strings.Join(portsNotUp, ", "))
+	}
+	return condition
+}
+
+func getNextHopBFDReason(bfd *nbdb.BFD) string {
+	switch ptr.Deref(bfd.Status, "") {
+	case nbdb.BFDStatusUp:
+		return adminpolicybasedrouteapi.NextHopBFDReasonUp
+	case nbdb.BFDStatusDown:
+		return adminpolicybasedrouteapi.NextHopBFDReasonDown
+	case nbdb.BFDStatusAdminDown:
+		return adminpolicybasedrouteapi.NextHopBFDReasonAdminDown
+	case nbdb.BFDStatusInit:
+		return adminpolicybasedrouteapi.NextHopBFDReasonInit
+	default:
+		return adminpolicybasedrouteapi.NextHopBFDReasonPending
 	}
-	return nil
 }
 
 func (c *ExternalGatewayMasterController) GetDynamicGatewayIPsForTargetNamespace(namespaceName string) (sets.Set[string], error) {
@@ -194,3 +335,13 @@ func (c *ExternalGatewayMasterController) GetAPBRoutePolicyStatus(policyName str
 	}
 	return &pol.Status, nil
 }
+
+// getGatewayRouterBFDNodeName returns the node of the gateway router the BFD session is configured on, and false
+// if the BFD session is not configured on the external port of a gateway router.
+func getGatewayRouterBFDNodeName(bfd *nbdb.BFD) (string, bool) {
+	_, gr, found := strings.Cut(bfd.LogicalPort, types.GWRouterToExtSwitchPrefix)
+	if !found || !strings.HasPrefix(gr, types.GWRouterPrefix) {
+		return "", false
+	}
+	return util.GetWorkerFromGatewayRouter(gr), true
+}
@@ -1,6 +1,7 @@
 package apbroute
 
 import (
+	"errors"
 	"fmt"
 	"net"
 	"regexp"
@@ -252,6 +253,10 @@ func (nb *northBoundClient) addGWRoutesForPod(gateways []*gateway_info.GatewayIn
 	}
 
 	port := portPrefix + types.GWRouterToExtSwitchPrefix + gr
+	gateways, standbyGWIPs, err := nb.splitGatewaysByPriority(gateways, port)
+	if err != nil {
+		return err
+	}
 	return nb.externalGatewayRouteInfo.CreateOrLoad(podNsName, func(routeInfo *RouteInfo) error {
 		for _, podIPNet := range podIfAddrs {
 			for _, gateway := range gateways {
@@ -270,7 +275,7 @@ func (nb *northBoundClient) addGWRoutesForPod(gateways []*gateway_info.GatewayIn
 						continue
 					}
 					mask := util.GetIPFullMaskString(podIP)
-					if err := nb.createOrUpdateBFDStaticRoute(gateway.BFDEnabled, gw, podIP, gr, port, mask); err != nil {
+					if err := nb.createOrUpdateBFDStaticRoute(gateway.BFDEnabled, gateway.BFDTimers, gw, podIP, gr, port, mask); err != nil {
 						return err
 					}
 					if routeInfo.PodExternalRoutes[podIP] == nil {
@@ -291,10 +296,95 @@ func (nb *northBoundClient) addGWRoutesForPod(gateways []*gateway_info.GatewayIn
 			return fmt.Errorf("gateway specified for namespace %s with gateway addresses %v but no valid routes exist for pod: %s",
 				podNsName.Namespace, podIfAddrs, podNsName.Name)
 		}
+		// remove the routes of the backup gateways that are not needed anymore, now that the routes to the
+		// preferred gateways exist
+		for podIP, routes := range routeInfo.PodExternalRoutes {
+			for gw, routeGR := range routes {
+				if routeGR != gr || !standbyGWIPs.Has(gw) {
+					continue
+				}
+				if err := nb.deletePodGWRoute(routeInfo, podIP, gw, gr); err != nil {
+					return fmt.Errorf("APB delete pod standby GW route failed: %w", err)
+				}
+				delete(routes, gw)
+			}
+		}
 		return nil
 	})
 }
 
+// splitGatewaysByPriority returns the gateways that must have a route for the pod traffic leaving through the
+// given gateway router port, and the IPs of the standby gateways that must not.
+// The gateways of the preferred priority group always have a route, so that their BFD sessions keep running
+// and OVN removes them from the ECMP routes while they are down. The gateways of the next priority group are only
+// added when every gateway of the previous groups has BFD enabled and is reported down by BFD.
+func (nb *northBoundClient) splitGatewaysByPriority(gateways []*gateway_info.GatewayInfo, port string) ([]*gateway_info.GatewayInfo,
+	sets.Set[string], error) {
+	groups := gateway_info.GroupByPriority(gateways)
+	activeGWs := []*gateway_info.GatewayInfo{}
+	standbyGWIPs := sets.New[string]()
+	groupsDown := true
+	for _, group := range groups {
+		if !groupsDown {
+			for _, gw := range group {
+				insertSet(standbyGWIPs, gw.Gateways)
+			}
+			continue
+		}
+		activeGWs = append(activeGWs, group...)
+		for _, gw := range group {
+			down, err := nb.isGatewayDown(gw, port)
+			if err != nil {
+				return nil, nil, err
+			}
+			if !down {
+				groupsDown = false
+				break
+			}
+		}
+	}
+	return activeGWs, standbyGWIPs, nil
+}
+
+// getGatewayRouterBFDs returns the BFD sessions between the gateway routers of the zone and the given gateway IPs,
+// grouped by gateway IP.
+func (nb *northBoundClient) getGatewayRouterBFDs(gwIPs sets.Set[string]) (map[string][]*nbdb.BFD, error) {
+	bfds, err := libovsdbops.FindBFDsWithPredicate(nb.nbClient, func(item *nbdb.BFD) bool {
+		return gwIPs.Has(item.DstIP) && strings.Contains(item.LogicalPort, types.GWRouterToExtSwitchPrefix+types.GWRouterPrefix)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find BFDs for gateway IPs %v: %w", sets.List(gwIPs), err)
+	}
+	bfdsByGWIP := map[string][]*nbdb.BFD{}
+	for _, bfd := range bfds {
+		bfdsByGWIP[bfd.DstIP] = append(bfdsByGWIP[bfd.DstIP], bfd)
+	}
+	return bfdsByGWIP, nil
+}
+
+// isGatewayDown returns true when the gateway has BFD enabled and all its IPs have a BFD session on the given
+// gateway router port that is reported down. A gateway IP without a BFD session, or with a session that has not
+// reported its state yet, is not considered down, so the next priority group is not activated while the sessions
+// of the previous groups are being established.
+func (nb *northBoundClient) isGatewayDown(gw *gateway_info.GatewayInfo, port string) (bool, error) {
+	if !gw.BFDEnabled {
+		return false, nil
+	}
+	for gwIP := range gw.Gateways {
+		bfd, err := libovsdbops.LookupBFD(nb.nbClient, &nbdb.BFD{LogicalPort: port, DstIP: gwIP})
+		if errors.Is(err, libovsdbclient.ErrNotFound) {
+			return false, nil
+		}
+		if err != nil {
+			return false, fmt.Errorf("failed to lookup BFD for gateway IP %s on port %s: %w", gwIP, port, err)
+		}
+		if bfd.Status == nil || *bfd.Status != nbdb.BFDStatusDown {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
 // AddHybridRoutePolicyForPod handles adding a higher priority allow policy to allow traffic to be routed normally
 // by ecmp routes
 func (nb *northBoundClient) addHybridRoutePolicyForPod(podIP net.IP, node string) error {
@@ -369,7 +459,8 @@ func (nb *northBoundClient) addHybridRoutePolicyForPod(podIP net.IP, node string
 	return nil
 }
 
-func (nb *northBoundClient) createOrUpdateBFDStaticRoute(bfdEnabled bool, gw string, podIP, gr, port, mask string) error {
+func (nb *northBoundClient) createOrUpdateBFDStaticRoute(bfdEnabled bool, bfdTimers gateway_info.BFDTimers, gw string, podIP, gr,
+	port, mask string) error {
 	lrsr := nbdb.LogicalRouterStaticRoute{
 		Policy: &nbdb.LogicalRouterStaticRoutePolicySrcIP,
 		Options: map[string]string{
@@ -387,7 +478,16 @@ func (nb *northBoundClient) createOrUpdateBFDStaticRoute(bfdEnabled bool, gw str
 			DstIP:       gw,
 			LogicalPort: port,
 		}
-		ops, err = libovsdbops.CreateOrUpdateBFDOps(nb.nbClient, ops, &bfd)
+		if bfdTimers.MinTx > 0 {
+			bfd.MinTx = &bfdTimers.MinTx
+		}
+		if bfdTimers.MinRx > 0 {
+			bfd.MinRx = &bfdTimers.MinRx
+		}
+		if bfdTimers.DetectMult > 0 {
+			bfd.DetectMult = &bfdTimers.DetectMult
+		}
+		ops, err = libovsdbops.CreateOrUpdateBFDWithTimersOps(nb.nbClient, ops, &bfd)
 		if err != nil {
 			return fmt.Errorf("error creating or updating BFD %+v: %v", bfd, err)
 		}
@@ -415,7 +515,8 @@ func (nb *northBoundClient) createOrUpdateBFDStaticRoute(bfdEnabled bool, gw str
 	return nil
 }
 
-func (nb *northBoundClient) updateExternalGWInfoCacheForPodIPWithGatewayIP(podIP, gwIP, nodeName, gr string, bfdEnabled bool, namespacedName ktypes.NamespacedName) error {
+func (nb *northBoundClient) updateExternalGWInfoCacheForPodIPWithGatewayIP(podIP, gwIP, nodeName, gr string, bfdEnabled bool,
+	bfdTimers gateway_info.BFDTimers, namespacedName ktypes.NamespacedName) error {
 	return nb.externalGatewayRouteInfo.CreateOrLoad(namespacedName, func(routeInfo *RouteInfo) error {
 		// if route was already programmed, skip it
 		if foundGR, ok := routeInfo.PodExternalRoutes[podIP][gwIP]; ok && foundGR == gr {
@@ -431,7 +532,7 @@ func (nb *northBoundClient) updateExternalGWInfoCacheForPodIPWithGatewayIP(podIP
 		if bfdEnabled {
 			port := portPrefix + types.GWRouterToExtSwitchPrefix + gr
 			// update the BFD static route just in case it has changed
-			if err := nb.createOrUpdateBFDStaticRoute(bfdEnabled, gwIP, podIP, gr, port, mask); err != nil {
+			if err := nb.createOrUpdateBFDStaticRoute(bfdEnabled, bfdTimers, gwIP, podIP, gr, port, mask); err != nil {
 				return err
 			}
 		} else {
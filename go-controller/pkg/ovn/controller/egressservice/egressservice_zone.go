@@ -91,7 +91,7 @@ type Controller struct {
 }
 
 type svcState struct {
-	node string
+	nodes []string
 	// service endpoints that are hosted in the local zone (if IC is disabled, this holds all service endpoints)
 	v4LocalEndpoints sets.Set[string]
 	v6LocalEndpoints sets.Set[string]
@@ -335,19 +335,27 @@ func (c *Controller) repair() error {
 			continue
 		}
 
-		svcHost := es.Status.Host
-		if svcHost == "" || svcHost == ovntypes.EgressServiceNoSNATHost {
+		svcHosts := util.GetEgressServiceHosts(es)
+		if len(svcHosts) == 0 {
 			continue
 		}
 
-		node, found := allNodes[svcHost]
-		if !found {
-			klog.Errorf("Node %s not found: %v", svcHost, err)
-			continue
-		}
+		hostsValid := true
+		for _, svcHost := range svcHosts {
+			node, found := allNodes[svcHost]
+			if !found {
+				klog.Errorf("Node %s not found: %v", svcHost, err)
+				hostsValid = false
+				break
+			}
 
-		if !nodeIsReady(node) {
-			klog.Infof("Node %s is not ready, it can not be used for egress service %s", svcHost, key)
+			if !nodeIsReady(node) {
+				klog.Infof("Node %s is not ready, it can not be used for egress service %s", svcHost, key)
+				hostsValid = false
+				break
+			}
+		}
+		if !hostsValid {
 			continue
 		}
 
@@ -363,13 +371,20 @@ func (c *Controller) repair() error {
 			continue
 		}
 
-		nodeState, ok := c.nodes[svcHost]
-		if !ok {
-			nodeState, err = c.nodeStateFor(svcHost)
-			if err != nil {
-				klog.Errorf("Can't fetch egress service %s node %s state, err: %v", key, svcHost, err)
-				continue
+		nodeStates := map[string]*nodeState{}
+		for _, svcHost := range svcHosts {
+			nodeState, ok := c.nodes[svcHost]
+			if !ok {
+				nodeState, err = c.nodeStateFor(svcHost)
+				if err != nil {
+					klog.Errorf("Can't fetch egress service %s node %s state, err: %v", key, svcHost, err)
+					break
+				}
 			}
+			nodeStates[svcHost] = nodeState
+		}
+		if len(nodeStates) != len(svcHosts) {
+			continue
 		}
 		svcKeyToLocalV4Endpoints[key] = v4Local
 		svcKeyToLocalV6Endpoints[key] = v6Local
@@ -378,13 +393,15 @@ func (c *Controller) repair() error {
 		svcKeyToLocalConfiguredV4Endpoints[key] = []string{}
 		svcKeyToLocalConfiguredV6Endpoints[key] = []string{}
 		svcState := &svcState{
-			node:              svcHost,
+			nodes:             svcHosts,
 			v4LocalEndpoints:  sets.New[string](),
 			v6LocalEndpoints:  sets.New[string](),
 			v4RemoteEndpoints: sets.New[string](),
 			v6RemoteEndpoints: sets.New[string](),
 		}
-		c.nodes[svcHost] = nodeState
+		for svcHost, nodeState := range nodeStates {
+			c.nodes[svcHost] = nodeState
+		}
 		c.services[key] = svcState
 	}
 
@@ -425,28 +442,15 @@ func (c *Controller) repair() error {
 			return true
 		}
 
-		if len(item.Nexthops) != 1 {
-			klog.Infof("Egress service repair will delete lrp for service %s because it has more than one nexthop: %v", svcKey, item)
+		nextHopsV4, nextHopsV6, err := c.nextHopsFor(svc.nodes, false)
+		if err != nil {
+			klog.Errorf("Failed to get the nexthops of service %s, deleting lrp: %v", svcKey, err)
 			return true
 		}
 
-		node := c.nodes[svc.node]
-		nextHopV4 := node.v4MgmtIP.String()
-		nextHopV6 := node.v6MgmtIP.String()
-		if config.OVNKubernetesFeature.EnableInterconnect {
-			svcNodeInLocalZone, zoneKnown := c.nodesZoneState[node.name]
-			if !zoneKnown {
-				klog.Errorf("Failed to verify whether the svc node: %s is in the local zone, deleting lrp", node.name)
-				return true
-			}
-			if !svcNodeInLocalZone {
-				nextHopV4 = node.transitIPV4.String()
-				nextHopV6 = node.transitIPV6.String()
-			}
-		}
-
-		if item.Nexthops[0] != nextHopV4 && item.Nexthops[0] != nextHopV6 {
-			klog.Infof("Egress service repair will delete %s because it is uses a stale nexthop for service %s: %v", logicalIP, svcKey, item)
+		nextHops := sets.New(item.Nexthops...)
+		if !nextHops.Equal(sets.New(nextHopsV4...)) && !nextHops.Equal(sets.New(nextHopsV6...)) {
+			klog.Infof("Egress service repair will delete %s because it is uses stale nexthops for service %s: %v", logicalIP, svcKey, item)
 			return true
 		}
 
@@ -492,13 +496,12 @@ func (c *Controller) repair() error {
 				klog.Infof("Egress service repair continues with repairing service %s because it is valid: %v", svcKey, item)
 			}
 
-			node := c.nodes[svc.node]
-			svcNodeInLocalZone, zoneKnown := c.nodesZoneState[node.name]
-			if !zoneKnown {
-				klog.Errorf("Egress service repair failed to verify whether the svc node %s is in the local zone, deleting lrp", node.name)
+			localNextHopsV4, localNextHopsV6, err := c.nextHopsFor(svc.nodes, true)
+			if err != nil {
+				klog.Errorf("Egress service repair failed to verify whether the svc nodes %v are in the local zone, deleting lrp: %v", svc.nodes, err)
 				return true
 			}
-			if !svcNodeInLocalZone {
+			if len(localNextHopsV4)+len(localNextHopsV6) == 0 {
 				klog.Infof("Egress service repair will delete lrp for service %s because the service is no longer hosted in the local zone: %v", svcKey, item)
 				return true
 			}
@@ -515,13 +518,9 @@ func (c *Controller) repair() error {
 				return true
 			}
 
-			if len(item.Nexthops) != 1 {
-				klog.Infof("Egress service repair will delete lrp for service %s because it has more than one nexthop: %v", svcKey, item)
-				return true
-			}
-
-			if item.Nexthops[0] != node.v4MgmtIP.String() && item.Nexthops[0] != node.v6MgmtIP.String() {
-				klog.Infof("Egress service repair will delete %s lrp because it is uses a stale nexthop for service %s: %v", logicalIP, svcKey, item)
+			nextHops := sets.New(item.Nexthops...)
+			if !nextHops.Equal(sets.New(localNextHopsV4...)) && !nextHops.Equal(sets.New(localNextHopsV6...)) {
+				klog.Infof("Egress service repair will delete %s lrp because it is uses stale nexthops for service %s: %v", logicalIP, svcKey, item)
 				return true
 			}
 
@@ -733,36 +732,40 @@ func (c *Controller) syncEgressService(key string) error {
 		return c.clearServiceResourcesAndRequeue(key, state)
 	}
 
+	svcHosts := util.GetEgressServiceHosts(es)
 	if state == nil {
 		// The service has a valid EgressService and wasn't configured before.
-		nodeName := es.Status.Host
 		newState := &svcState{
-			node:              nodeName,
+			nodes:             svcHosts,
 			v4LocalEndpoints:  sets.New[string](),
 			v6LocalEndpoints:  sets.New[string](),
 			v4RemoteEndpoints: sets.New[string](),
 			v6RemoteEndpoints: sets.New[string](),
 		}
 		c.services[key] = newState
-		if _, exists := c.nodes[nodeName]; !exists {
-			nodeState, err := c.nodeStateFor(nodeName)
-			if err != nil {
-				return err
+		for _, nodeName := range svcHosts {
+			if _, exists := c.nodes[nodeName]; !exists {
+				nodeState, err := c.nodeStateFor(nodeName)
+				if err != nil {
+					return err
+				}
+				c.nodes[nodeName] = nodeState
 			}
-			c.nodes[nodeName] = nodeState
 		}
 		state = newState
 	}
 
-	if state.node != es.Status.Host {
-		klog.Errorf("EgressService %s/%s is configured for %s instead of %s, removing any existing configuration", namespace, name, state.node, es.Status.Host)
+	if !sets.New(state.nodes...).Equal(sets.New(svcHosts...)) {
+		klog.Infof("EgressService %s/%s is configured for %v instead of %v, removing any existing configuration", namespace, name, state.nodes, svcHosts)
 		return c.clearServiceResourcesAndRequeue(key, state)
 	}
 
-	node, ok := c.nodes[state.node]
-	if !ok || node.draining {
-		klog.Warningf("EgressService %s/%s is configured on non-existing or not ready node %s, removing", namespace, name, state.node)
-		return c.clearServiceResourcesAndRequeue(key, state)
+	for _, nodeName := range state.nodes {
+		node, ok := c.nodes[nodeName]
+		if !ok || node.draining {
+			klog.Warningf("EgressService %s/%s is configured on non-existing or not ready node %s, removing", namespace, name, nodeName)
+			return c.clearServiceResourcesAndRequeue(key, state)
+		}
 	}
 
 	// At this point the states are valid and we should create the proper logical router policies and static routes.
@@ -784,45 +787,43 @@ func (c *Controller) syncEgressService(key string) error {
 
 	// v[4|6]LocalEndpoints represents endpoints local to the current zone.
 	// v[4|6]RemoteEndpoints represents endpoints remote to the current zone.
-	// If a service is hosted in the local zone:
-	//  - create LRPs for local endpoints with mgmt IP as a nextHop
-	//  - create LRSRs for remote endpoints with mgmt IP as a nextHop
-	// If a service is hosted in a remote zone:
-	//  - create LRPs for local endpoints with node router transit IP as a next hop as a nextHop
-	//  - do nothing for remote endpoints
+	// For every node hosting the service:
+	// If the node is in the local zone:
+	//  - add its mgmt IP to the nextHops of the LRPs for local endpoints
+	//  - add its mgmt IP to the nextHops of the LRPs for remote endpoints
+	// If the node is in a remote zone:
+	//  - add its node router transit IP to the nextHops of the LRPs for local endpoints
+	// LRPs for remote endpoints are only created when the service is hosted in the local zone.
+	// When the service is hosted on multiple nodes, the traffic is spread across them using ECMP.
 	// When IC is disabled v[4|6]RemoteEndpoints are empty,
 	// service is considered to be local and LRSRs are not modified.
 
-	nextHopV4 := node.v4MgmtIP.String()
-	nextHopV6 := node.v6MgmtIP.String()
-	svcNodeInLocalZone := true
-	if config.OVNKubernetesFeature.EnableInterconnect {
-		var zoneKnown bool
-		svcNodeInLocalZone, zoneKnown = c.nodesZoneState[node.name]
-		if !zoneKnown {
-			return fmt.Errorf("failed to verify whether the svc node %s is in the local zone", node.name)
-		}
-		if !svcNodeInLocalZone {
-			nextHopV4 = node.transitIPV4.String()
-			nextHopV6 = node.transitIPV6.String()
-		}
+	nextHopsV4, nextHopsV6, err := c.nextHopsFor(state.nodes, false)
+	if err != nil {
+		return err
 	}
 
 	allOps := []ovsdb.Operation{}
-	createOps, err := c.createOrUpdateLogicalRouterPoliciesOps(key, nextHopV4, nextHopV6, v4LocalToAdd, v6LocalToAdd)
+	createOps, err := c.createOrUpdateLogicalRouterPoliciesOps(key, nextHopsV4, nextHopsV6, v4LocalToAdd, v6LocalToAdd)
 	if err != nil {
 		return err
 	}
 	allOps = append(allOps, createOps...)
 
-	if config.OVNKubernetesFeature.EnableInterconnect && svcNodeInLocalZone && (len(v4RemoteToAdd)+len(v6RemoteToAdd)) > 0 {
+	if config.OVNKubernetesFeature.EnableInterconnect && (len(v4RemoteToAdd)+len(v6RemoteToAdd)) > 0 {
 		// when IC is disabled v[4|6]RemoteToRemove are empty and no ops are created
 		// with IC enabled, when service is hosted in the local zone, create logical router policies for remote endpoints
-		createOps, err = c.createOrUpdateLogicalRouterPoliciesOps(key+interconnectSuffix, node.v4MgmtIP.String(), node.v6MgmtIP.String(), v4RemoteToAdd, v6RemoteToAdd)
+		localNextHopsV4, localNextHopsV6, err := c.nextHopsFor(state.nodes, true)
 		if err != nil {
 			return err
 		}
-		allOps = append(allOps, createOps...)
+		if len(localNextHopsV4)+len(localNextHopsV6) > 0 {
+			createOps, err = c.createOrUpdateLogicalRouterPoliciesOps(key+interconnectSuffix, localNextHopsV4, localNextHopsV6, v4RemoteToAdd, v6RemoteToAdd)
+			if err != nil {
+				return err
+			}
+			allOps = append(allOps, createOps...)
+		}
 	}
 
 	// update egresssvc-served-pods address set used to ensure egress service
@@ -873,6 +874,43 @@ func (c *Controller) syncEgressService(key string) error {
 	return nil
 }
 
+// Returns the nexthops of the logical router policies rerouting the traffic of an egress service
+// hosted on the given nodes: the mgmt IP of each node in the local zone and the node router transit IP
+// of each node in a remote zone. When localOnly is set only the nodes in the local zone are considered.
+// When IC is disabled all of the nodes are considered to be local.
+func (c *Controller) nextHopsFor(nodes []string, localOnly bool) ([]string, []string, error) {
+	var v4NextHops, v6NextHops []string
+	for _, nodeName := range nodes {
+		node, ok := c.nodes[nodeName]
+		if !ok {
+			return nil, nil, fmt.Errorf("svc node %s is not known", nodeName)
+		}
+
+		v4NextHop, v6NextHop := node.v4MgmtIP, node.v6MgmtIP
+		if config.OVNKubernetesFeature.EnableInterconnect {
+			svcNodeInLocalZone, zoneKnown := c.nodesZoneState[nodeName]
+			if !zoneKnown {
+				return nil, nil, fmt.Errorf("failed to verify whether the svc node %s is in the local zone", nodeName)
+			}
+			if !svcNodeInLocalZone {
+				if localOnly {
+					continue
+				}
+				v4NextHop, v6NextHop = node.transitIPV4, node.transitIPV6
+			}
+		}
+
+		if v4NextHop != nil {
+			v4NextHops = append(v4NextHops, v4NextHop.String())
+		}
+		if v6NextHop != nil {
+			v6NextHops = append(v6NextHops, v6NextHop.String())
+		}
+	}
+
+	return v4NextHops, v6NextHops, nil
+}
+
 // Removes all the logical router policies that belong to the egress service.
 // This also requeues the service after cleaning up to be sure we are not
 // missing an event after marking it as stale that should be handled.
@@ -3,6 +3,7 @@ package egressservice
 import (
 	"fmt"
 	"net"
+	"slices"
 	"sync"
 	"time"
 
@@ -141,7 +142,7 @@ func (c *Controller) syncNode(key string) error {
 			// Services can't be configured for a node while it is in draining status.
 			state.draining = true
 			for svcKey, svcState := range c.services {
-				if svcState.node == state.name {
+				if slices.Contains(svcState.nodes, state.name) {
 					if err := c.clearServiceResourcesAndRequeue(svcKey, svcState); err != nil {
 						return err
 					}
@@ -168,7 +169,7 @@ func (c *Controller) syncNode(key string) error {
 	// If the node is used by any service but is not in cache enqueue it
 	if state == nil {
 		for svcKey, svcState := range c.services {
-			if svcState.node == n.Name {
+			if slices.Contains(svcState.nodes, n.Name) {
 				c.egressServiceQueue.Add(svcKey)
 			}
 		}
@@ -180,7 +181,7 @@ func (c *Controller) syncNode(key string) error {
 		// We remove all the service configurations made for it,
 		// Services can't be configured for a node while it is in draining status.
 		for svcKey, svcState := range c.services {
-			if svcState.node == state.name {
+			if slices.Contains(svcState.nodes, state.name) {
 				if err := c.clearServiceResourcesAndRequeue(svcKey, svcState); err != nil {
 					return err
 				}
@@ -25,6 +25,7 @@ import (
 	crdtypes "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/types"
 	udnv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/userdefinednetwork/v1"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
 )
 
 func (c *Controller) processNextNQOSWorkItem(wg *sync.WaitGroup) bool {
@@ -109,14 +110,60 @@ func (c *Controller) ensureNetworkQos(nqos *networkqosapi.NetworkQoS) error {
 		}
 	}
 
-	// set EgressRules to desiredNQOSState
+	// set EgressRules and IngressRules to desiredNQOSState
+	egressRules, err := buildGressRules(nqos, ruleDirectionEgress)
+	if err != nil {
+		return err
+	}
+	ingressRules, err := buildGressRules(nqos, ruleDirectionIngress)
+	if err != nil {
+		return err
+	}
+	desiredNQOSState.EgressRules = egressRules
+	desiredNQOSState.IngressRules = ingressRules
+	if err := desiredNQOSState.initAddressSets(c.addressSetFactory, c.controllerName); err != nil {
+		return err
+	}
+	if desiredNQOSState.hasClusterScopedBandwidth() {
+		if desiredNQOSState.SourceNodeCount, err = c.getSourceNodeCount(desiredNQOSState); err != nil {
+			return fmt.Errorf("failed to count the nodes running selected pods: %w", err)
+		}
+	}
+	if err := c.resyncPods(desiredNQOSState); err != nil {
+		return fmt.Errorf("failed to resync pods: %w", err)
+	}
+	// delete stale rules left from previous NetworkQoS definition, along with the address sets
+	if err := c.cleanupStaleOvnObjects(desiredNQOSState); err != nil {
+		return fmt.Errorf("failed to delete stale QoSes: %w", err)
+	}
+	c.nqosCache.Store(joinMetaNamespaceAndName(nqos.Namespace, nqos.Name), desiredNQOSState)
+	if e := c.updateNQOSStatusToReady(nqos.Namespace, nqos.Name); e != nil {
+		return fmt.Errorf("successfully reconciled NetworkQoS %s/%s, but failed to patch status: %v", nqos.Namespace, nqos.Name, e)
+	}
+	return nil
+}
+
+// buildGressRules builds the rule states of the given direction from the NetworkQoS spec.
+// The peers of the egress rules are their destinations, and the peers of the ingress rules
+// are their sources.
+func buildGressRules(nqos *networkqosapi.NetworkQoS, direction ruleDirection) ([]*GressRule, error) {
+	ruleSpecs := nqos.Spec.Egress
+	peerDirection := trafficDirDest
+	if direction == ruleDirectionIngress {
+		ruleSpecs = nqos.Spec.Ingress
+		peerDirection = trafficDirSource
+	}
 	rules := []*GressRule{}
-	for index, ruleSpec := range nqos.Spec.Egress {
+	for index, ruleSpec := range ruleSpecs {
 		bwRate := int(ruleSpec.Bandwidth.Rate)
 		bwBurst := int(ruleSpec.Bandwidth.Burst)
 		ruleState := &GressRule{
-			Priority: getQoSRulePriority(nqos.Spec.Priority, index),
-			Dscp:     ruleSpec.DSCP,
+			Priority:       getQoSRulePriority(nqos.Spec.Priority, index),
+			Dscp:           ruleSpec.DSCP,
+			BandwidthScope: ruleSpec.Bandwidth.Scope,
+		}
+		if direction == ruleDirectionIngress {
+			ruleState.Priority = getQoSIngressRulePriority(nqos.Spec.Priority, index)
 		}
 		if bwRate > 0 {
 			ruleState.Rate = &bwRate
@@ -124,23 +171,32 @@ func (c *Controller) ensureNetworkQos(nqos *networkqosapi.NetworkQoS) error {
 		if bwBurst > 0 {
 			ruleState.Burst = &bwBurst
 		}
+		peerSpecs := ruleSpec.Classifier.To
+		if direction == ruleDirectionIngress {
+			if len(ruleSpec.Classifier.To) > 0 {
+				return nil, fmt.Errorf("classifier.to is not allowed in ingress rules")
+			}
+			peerSpecs = ruleSpec.Classifier.From
+		} else if len(ruleSpec.Classifier.From) > 0 {
+			return nil, fmt.Errorf("classifier.from is not allowed in egress rules")
+		}
 		destStates := []*Destination{}
-		for _, destSpec := range ruleSpec.Classifier.To {
+		for _, destSpec := range peerSpecs {
 			if destSpec.IPBlock != nil && (destSpec.PodSelector != nil || destSpec.NamespaceSelector != nil) {
-				return fmt.Errorf("specifying both ipBlock and podSelector/namespaceSelector is not allowed")
+				return nil, fmt.Errorf("specifying both ipBlock and podSelector/namespaceSelector is not allowed")
 			}
 			destState := &Destination{}
 			destState.IpBlock = destSpec.IPBlock.DeepCopy()
 			if destSpec.NamespaceSelector != nil && (len(destSpec.NamespaceSelector.MatchLabels) > 0 || len(destSpec.NamespaceSelector.MatchExpressions) > 0) {
 				if selector, err := metav1.LabelSelectorAsSelector(destSpec.NamespaceSelector); err != nil {
-					return fmt.Errorf("error parsing destination namespace selector: %v", err)
+					return nil, fmt.Errorf("error parsing %s peer namespace selector: %v", direction, err)
 				} else {
 					destState.NamespaceSelector = selector
 				}
 			}
 			if destSpec.PodSelector != nil && (len(destSpec.PodSelector.MatchLabels) > 0 || len(destSpec.PodSelector.MatchExpressions) > 0) {
 				if selector, err := metav1.LabelSelectorAsSelector(destSpec.PodSelector); err != nil {
-					return fmt.Errorf("error parsing destination pod selector: %v", err)
+					return nil, fmt.Errorf("error parsing %s peer pod selector: %v", direction, err)
 				} else {
 					destState.PodSelector = selector
 				}
@@ -148,27 +204,13 @@ func (c *Controller) ensureNetworkQos(nqos *networkqosapi.NetworkQoS) error {
 			destStates = append(destStates, destState)
 		}
 		ruleState.Classifier = &Classifier{
-			Destinations: destStates,
+			Destinations:  destStates,
+			PeerDirection: peerDirection,
 		}
 		ruleState.Classifier.Ports = ruleSpec.Classifier.Ports
 		rules = append(rules, ruleState)
 	}
-	desiredNQOSState.EgressRules = rules
-	if err := desiredNQOSState.initAddressSets(c.addressSetFactory, c.controllerName); err != nil {
-		return err
-	}
-	if err := c.resyncPods(desiredNQOSState); err != nil {
-		return fmt.Errorf("failed to resync pods: %w", err)
-	}
-	// delete stale rules left from previous NetworkQoS definition, along with the address sets
-	if err := c.cleanupStaleOvnObjects(desiredNQOSState); err != nil {
-		return fmt.Errorf("failed to delete stale QoSes: %w", err)
-	}
-	c.nqosCache.Store(joinMetaNamespaceAndName(nqos.Namespace, nqos.Name), desiredNQOSState)
-	if e := c.updateNQOSStatusToReady(nqos.Namespace, nqos.Name); e != nil {
-		return fmt.Errorf("successfully reconciled NetworkQoS %s/%s, but failed to patch status: %v", nqos.Namespace, nqos.Name, e)
-	}
-	return nil
+	return rules, nil
 }
 
 // clearNetworkQos will handle the logic for deleting all db objects related
@@ -267,6 +309,29 @@ func (c *Controller) updateNQOStatusCondition(newCondition metav1.Condition, nam
 	return err
 }
 
+// getSourceNodeCount returns the number of nodes running pods selected by the
+// NetworkQoS on this network, in all the zones.
+func (c *Controller) getSourceNodeCount(nqosState *networkQoSState) (int, error) {
+	pods, err := c.nqosPodLister.Pods(nqosState.namespace).List(labels.Everything())
+	if err != nil {
+		return 0, fmt.Errorf("failed to list pods in namespace %s: %w", nqosState.namespace, err)
+	}
+	nodes := sets.New[string]()
+	for _, pod := range pods {
+		if pod.Spec.HostNetwork || pod.DeletionTimestamp != nil || pod.Spec.NodeName == "" || util.PodCompleted(pod) {
+			continue
+		}
+		if !nqosState.matchSourceSelector(pod) {
+			continue
+		}
+		if addresses, err := getPodAddresses(pod, c.NetInfo); err != nil || len(addresses) == 0 {
+			continue
+		}
+		nodes.Insert(pod.Spec.NodeName)
+	}
+	return nodes.Len(), nil
+}
+
 func (c *Controller) resyncPods(nqosState *networkQoSState) error {
 	pods, err := c.nqosPodLister.List(labels.Everything())
 	if err != nil {
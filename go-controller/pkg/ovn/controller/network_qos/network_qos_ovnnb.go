@@ -35,29 +35,32 @@ func (c *Controller) addQoSToLogicalSwitch(qosState *networkQoSState, switchName
 	// construct qoses
 	qoses := []*nbdb.QoS{}
 	ipv4Enabled, ipv6Enabled := c.IPMode()
-	for index, rule := range qosState.EgressRules {
-		dbIDs := qosState.getDbObjectIDs(c.controllerName, index)
-		qos := &nbdb.QoS{
-			Action:      map[string]int{},
-			Bandwidth:   map[string]int{},
-			Direction:   nbdb.QoSDirectionToLport,
-			ExternalIDs: dbIDs.GetExternalIDs(),
-			Match:       generateNetworkQoSMatch(qosState, rule, ipv4Enabled, ipv6Enabled),
-			Priority:    rule.Priority,
-		}
-		if c.IsUserDefinedNetwork() {
-			qos.ExternalIDs[types.NetworkExternalID] = c.GetNetworkName()
-		}
-		if rule.Dscp >= 0 {
-			qos.Action[nbdb.QoSActionDSCP] = rule.Dscp
-		}
-		if rule.Rate != nil && *rule.Rate > 0 {
-			qos.Bandwidth[nbdb.QoSBandwidthRate] = *rule.Rate
-		}
-		if rule.Burst != nil && *rule.Burst > 0 {
-			qos.Bandwidth[nbdb.QoSBandwidthBurst] = *rule.Burst
+	for _, direction := range []ruleDirection{ruleDirectionEgress, ruleDirectionIngress} {
+		for index, rule := range qosState.getRules(direction) {
+			dbIDs := qosState.getDbObjectIDs(c.controllerName, direction, index)
+			qos := &nbdb.QoS{
+				Action:      map[string]int{},
+				Bandwidth:   map[string]int{},
+				Direction:   nbdb.QoSDirectionToLport,
+				ExternalIDs: dbIDs.GetExternalIDs(),
+				Match:       generateNetworkQoSMatch(qosState, rule, ipv4Enabled, ipv6Enabled),
+				Priority:    rule.Priority,
+			}
+			if c.IsUserDefinedNetwork() {
+				qos.ExternalIDs[types.NetworkExternalID] = c.GetNetworkName()
+			}
+			if rule.Dscp >= 0 {
+				qos.Action[nbdb.QoSActionDSCP] = rule.Dscp
+			}
+			rate, burst := rule.getBandwidth(qosState.SourceNodeCount)
+			if rate > 0 {
+				qos.Bandwidth[nbdb.QoSBandwidthRate] = rate
+			}
+			if burst > 0 {
+				qos.Bandwidth[nbdb.QoSBandwidthBurst] = burst
+			}
+			qoses = append(qoses, qos)
 		}
-		qoses = append(qoses, qos)
 	}
 	ops := []ovsdb.Operation{}
 	ops, err = libovsdbops.CreateOrUpdateQoSesOps(c.nbClient, ops, qoses...)
@@ -120,12 +123,14 @@ func (c *Controller) cleanupStaleOvnObjects(qosState *networkQoSState) error {
 		return fmt.Errorf("error looking up existing QoSes for %s/%s: %v", qosState.namespace, qosState.name, err)
 	}
 	staleSwitchQoSMap := map[string][]*nbdb.QoS{}
-	totalNumOfRules := len(qosState.EgressRules)
 	for _, qos := range existingQoSes {
 		index := qos.ExternalIDs[libovsdbops.RuleIndex.String()]
 		numIndex, convError := strconv.Atoi(index)
 		indexWithinRange := false
-		if index != "" && convError == nil && numIndex < totalNumOfRules {
+		// QoSes created before ingress rules were supported have no direction, and are stale
+		direction := ruleDirection(qos.ExternalIDs[libovsdbops.PolicyDirectionKey.String()])
+		if (direction == ruleDirectionEgress || direction == ruleDirectionIngress) &&
+			index != "" && convError == nil && numIndex < len(qosState.getRules(direction)) {
 			// rule index is valid
 			indexWithinRange = true
 		}
@@ -540,6 +540,160 @@ var _ = Describe("NetworkQoS Controller", func() {
 					eventuallyAddressSetHas(defaultAddrsetFactory, nqosNamespace, nqosName, "2", "0", defaultControllerName, "10.194.188.4")
 				}
 
+				By("adds to-lport QoS rules to ovn nb for the Ingress rules")
+				{
+					nqosUpdate, err := fakeNQoSClient.K8sV1alpha1().NetworkQoSes(nqosNamespace).Get(context.TODO(), nqosName, metav1.GetOptions{})
+					Expect(err).NotTo(HaveOccurred())
+					nqosUpdate.Spec.Ingress = []nqostype.Rule{
+						{
+							DSCP: 20,
+							Bandwidth: nqostype.Bandwidth{
+								Rate:  5000,
+								Burst: 50000,
+							},
+							Classifier: nqostype.Classifier{
+								From: []nqostype.Destination{
+									{
+										NamespaceSelector: &metav1.LabelSelector{
+											MatchLabels: map[string]string{
+												"app": "app1",
+											},
+										},
+									},
+									{
+										IPBlock: &networkingv1.IPBlock{
+											CIDR: "128.120.0.0/16",
+										},
+									},
+								},
+								Ports: []*nqostype.Port{
+									{
+										Protocol: "tcp",
+										Port:     &port9090,
+									},
+								},
+							},
+						},
+					}
+					nqosUpdate.ResourceVersion = time.Now().String()
+					_, err = fakeNQoSClient.K8sV1alpha1().NetworkQoSes(nqosNamespace).Update(context.TODO(), nqosUpdate, metav1.UpdateOptions{})
+					Expect(err).NotTo(HaveOccurred())
+
+					var ingressQoS *nbdb.QoS
+					Eventually(func() bool {
+						ingressQoS, _ = findGressQoS(defaultControllerName, nqosNamespace, nqosName, ruleDirectionIngress, 0)
+						return ingressQoS != nil
+					}).WithTimeout(10 * time.Second).WithPolling(1 * time.Second).Should(BeTrue())
+					eventuallySwitchHasQoS("node1", ingressQoS)
+					Eventually(func() bool {
+						addrset, _ := findGressAddressSet(defaultAddrsetFactory, nqosNamespace, nqosName, ruleDirectionIngress, "0", "0", defaultControllerName)
+						if addrset == nil {
+							return false
+						}
+						ip4, _ := addrset.GetAddresses()
+						return slices.Contains(ip4, "10.194.188.4")
+					}).WithTimeout(10 * time.Second).WithPolling(1 * time.Second).Should(BeTrue())
+					sourceAddrSet, err := findAddressSet(defaultAddrsetFactory, nqosNamespace, nqosName, "src", "0", defaultControllerName)
+					Expect(err).NotTo(HaveOccurred())
+					peerAddrSet, err := findGressAddressSet(defaultAddrsetFactory, nqosNamespace, nqosName, ruleDirectionIngress, "0", "0", defaultControllerName)
+					Expect(err).NotTo(HaveOccurred())
+					srcHashName4, _ := sourceAddrSet.GetASHashNames()
+					peerHashName4, _ := peerAddrSet.GetASHashNames()
+					Expect(ingressQoS.Match).Should(Equal(fmt.Sprintf("ip4.dst == {$%s} && (ip4.src == {$%s} || ip4.src == 128.120.0.0/16) && tcp && tcp.dst == 9090", srcHashName4, peerHashName4)))
+					Expect(ingressQoS.Direction).To(Equal(nbdb.QoSDirectionToLport))
+					Expect(ingressQoS.Priority).To(Equal(21000))
+					Expect(ingressQoS.Action).To(ContainElement(20))
+					Expect(ingressQoS.Bandwidth).To(ContainElements(5000, 50000))
+
+					By("keeps the egress QoS rules with their own priorities")
+					qos0, err := findQoS(defaultControllerName, nqosNamespace, nqosName, 0)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(qos0.Priority).To(Equal(11000))
+					eventuallySwitchHasQoS("node1", qos0)
+
+					By("deletes the QoS rules of removed Ingress rules")
+					nqosUpdate, err = fakeNQoSClient.K8sV1alpha1().NetworkQoSes(nqosNamespace).Get(context.TODO(), nqosName, metav1.GetOptions{})
+					Expect(err).NotTo(HaveOccurred())
+					nqosUpdate.Spec.Ingress = nil
+					nqosUpdate.ResourceVersion = time.Now().String()
+					_, err = fakeNQoSClient.K8sV1alpha1().NetworkQoSes(nqosNamespace).Update(context.TODO(), nqosUpdate, metav1.UpdateOptions{})
+					Expect(err).NotTo(HaveOccurred())
+					eventuallySwitchHasNoQoS("node1", ingressQoS)
+					eventuallySwitchHasQoS("node1", qos0)
+				}
+
+				By("polices all the selected pods of a node with the single QoS row of the rule")
+				{
+					secondClientPod := &corev1.Pod{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: nqosNamespace,
+							Name:      "second-client-pod",
+							Labels: map[string]string{
+								"app": "client",
+							},
+							Annotations: map[string]string{
+								"k8s.ovn.org/pod-networks": `{"default":{"ip_addresses":["10.192.177.5/26"],"mac_address":"0a:58:0a:c0:b1:05","gateway_ips":["10.192.177.1"],"routes":[{"dest":"10.192.0.0/16","nextHop":"10.192.177.1"}],"mtu":"1500","ip_address":"10.192.177.5/26","gateway_ip":"10.192.177.1"}}`,
+							},
+						},
+						Spec: corev1.PodSpec{
+							NodeName: "node1",
+						},
+					}
+					_, err := fakeKubeClient.CoreV1().Pods(nqosNamespace).Create(context.TODO(), secondClientPod, metav1.CreateOptions{})
+					Expect(err).NotTo(HaveOccurred())
+					eventuallyAddressSetHas(defaultAddrsetFactory, nqosNamespace, nqosName, "src", "0", defaultControllerName, "10.192.177.4")
+					eventuallyAddressSetHas(defaultAddrsetFactory, nqosNamespace, nqosName, "src", "0", defaultControllerName, "10.192.177.5")
+
+					// ovn-northd renders the QoS row as a single logical flow of the switch, and ovn-controller
+					// instantiates a single meter for it on the node, that the traffic of all the selected pods of
+					// the node matching the source address set goes through.
+					sourceAddrSet, err := findAddressSet(defaultAddrsetFactory, nqosNamespace, nqosName, "src", "0", defaultControllerName)
+					Expect(err).NotTo(HaveOccurred())
+					srcHashName4, _ := sourceAddrSet.GetASHashNames()
+					qos0 := eventuallyExpectQoS(defaultControllerName, nqosNamespace, nqosName, 0)
+					Expect(qos0.Match).To(HavePrefix(fmt.Sprintf("ip4.src == {$%s}", srcHashName4)))
+					eventuallyQoSHasBandwidth(defaultControllerName, nqosNamespace, nqosName, 0, 10000, 100000)
+					Expect(getSwitchNetworkQoSRules("node1", nqosNamespace, nqosName, ruleDirectionEgress, 0)).To(ConsistOf(qos0.UUID))
+
+					err = fakeKubeClient.CoreV1().Pods(nqosNamespace).Delete(context.TODO(), secondClientPod.Name, metav1.DeleteOptions{})
+					Expect(err).NotTo(HaveOccurred())
+					eventuallyAddressSetHasNo(defaultAddrsetFactory, nqosNamespace, nqosName, "src", "0", defaultControllerName, "10.192.177.5")
+				}
+
+				By("splits cluster scoped bandwidth limits across the nodes running selected pods")
+				{
+					nqosUpdate, err := fakeNQoSClient.K8sV1alpha1().NetworkQoSes(nqosNamespace).Get(context.TODO(), nqosName, metav1.GetOptions{})
+					Expect(err).NotTo(HaveOccurred())
+					nqosUpdate.Spec.Egress[0].Bandwidth.Scope = nqostype.BandwidthScopeCluster
+					nqosUpdate.ResourceVersion = time.Now().String()
+					_, err = fakeNQoSClient.K8sV1alpha1().NetworkQoSes(nqosNamespace).Update(context.TODO(), nqosUpdate, metav1.UpdateOptions{})
+					Expect(err).NotTo(HaveOccurred())
+					eventuallyQoSHasBandwidth(defaultControllerName, nqosNamespace, nqosName, 0, 10000, 100000)
+
+					remoteClientPod := &corev1.Pod{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: nqosNamespace,
+							Name:      "remote-client-pod",
+							Labels: map[string]string{
+								"app": "client",
+							},
+							Annotations: map[string]string{
+								"k8s.ovn.org/pod-networks": `{"default":{"ip_addresses":["10.192.178.4/26"],"mac_address":"0a:58:0a:c0:b2:04","gateway_ips":["10.192.178.1"],"routes":[{"dest":"10.192.0.0/16","nextHop":"10.192.178.1"}],"mtu":"1500","ip_address":"10.192.178.4/26","gateway_ip":"10.192.178.1"}}`,
+							},
+						},
+						Spec: corev1.PodSpec{
+							NodeName: "node2",
+						},
+					}
+					_, err = fakeKubeClient.CoreV1().Pods(nqosNamespace).Create(context.TODO(), remoteClientPod, metav1.CreateOptions{})
+					Expect(err).NotTo(HaveOccurred())
+					eventuallyQoSHasBandwidth(defaultControllerName, nqosNamespace, nqosName, 0, 5000, 50000)
+
+					err = fakeKubeClient.CoreV1().Pods(nqosNamespace).Delete(context.TODO(), remoteClientPod.Name, metav1.DeleteOptions{})
+					Expect(err).NotTo(HaveOccurred())
+					eventuallyQoSHasBandwidth(defaultControllerName, nqosNamespace, nqosName, 0, 10000, 100000)
+				}
+
 				nqos4StreamNet := &nqostype.NetworkQoS{
 					ObjectMeta: metav1.ObjectMeta{
 						Namespace: nqosNamespace,
@@ -1106,7 +1260,15 @@ func eventuallyAddressSetHasNo(addrsetFactory addressset.AddressSetFactory, nqos
 }
 
 func findAddressSet(addrsetFactory addressset.AddressSetFactory, nqosNamespace, nqosName, qosRuleIndex, ipBlockIndex, controllerName string) (addressset.AddressSet, error) {
-	dbID := GetNetworkQoSAddrSetDbIDs(nqosNamespace, nqosName, qosRuleIndex, ipBlockIndex, controllerName)
+	direction := ruleDirectionEgress
+	if qosRuleIndex == "src" {
+		direction = ""
+	}
+	return findGressAddressSet(addrsetFactory, nqosNamespace, nqosName, direction, qosRuleIndex, ipBlockIndex, controllerName)
+}
+
+func findGressAddressSet(addrsetFactory addressset.AddressSetFactory, nqosNamespace, nqosName string, direction ruleDirection, qosRuleIndex, ipBlockIndex, controllerName string) (addressset.AddressSet, error) {
+	dbID := GetNetworkQoSAddrSetDbIDs(nqosNamespace, nqosName, direction, qosRuleIndex, ipBlockIndex, controllerName)
 	return addrsetFactory.GetAddressSet(dbID)
 }
 
@@ -1128,14 +1290,20 @@ func eventuallyExpectNoQoS(controllerName, qosNamespace, qosName string, index i
 }
 
 func findQoS(controllerName, qosNamespace, qosName string, index int) (*nbdb.QoS, error) {
+	return findGressQoS(controllerName, qosNamespace, qosName, ruleDirectionEgress, index)
+}
+
+func findGressQoS(controllerName, qosNamespace, qosName string, direction ruleDirection, index int) (*nbdb.QoS, error) {
 	qosKey := joinMetaNamespaceAndName(qosNamespace, qosName, ":")
 	dbIDs := libovsdbops.NewDbObjectIDs(libovsdbops.NetworkQoS, controllerName, map[libovsdbops.ExternalIDKey]string{
-		libovsdbops.ObjectNameKey: qosKey,
-		libovsdbops.RuleIndex:     fmt.Sprintf("%d", index),
+		libovsdbops.ObjectNameKey:      qosKey,
+		libovsdbops.PolicyDirectionKey: string(direction),
+		libovsdbops.RuleIndex:          fmt.Sprintf("%d", index),
 	})
 	predicate := libovsdbops.GetPredicate(dbIDs, func(item *nbdb.QoS) bool {
 		return item.ExternalIDs[libovsdbops.OwnerControllerKey.String()] == controllerName &&
 			item.ExternalIDs[libovsdbops.ObjectNameKey.String()] == qosKey &&
+			item.ExternalIDs[libovsdbops.PolicyDirectionKey.String()] == string(direction) &&
 			item.ExternalIDs[libovsdbops.RuleIndex.String()] == strconv.Itoa(index)
 	})
 	qoses, err := libovsdbops.FindQoSesWithPredicate(nbClient, predicate)
@@ -1148,6 +1316,40 @@ func findQoS(controllerName, qosNamespace, qosName string, index int) (*nbdb.QoS
 	return nil, nil
 }
 
+func eventuallyQoSHasBandwidth(controllerName, qosNamespace, qosName string, index, rate, burst int) {
+	Eventually(func() map[string]int {
+		qos, _ := findQoS(controllerName, qosNamespace, qosName, index)
+		if qos == nil {
+			return nil
+		}
+		return qos.Bandwidth
+	}).WithTimeout(10 * time.Second).WithPolling(1 * time.Second).Should(Equal(map[string]int{
+		nbdb.QoSBandwidthRate:  rate,
+		nbdb.QoSBandwidthBurst: burst,
+	}))
+}
+
+// getSwitchNetworkQoSRules returns the UUIDs of the QoS rows of a NetworkQoS rule attached to a switch.
+func getSwitchNetworkQoSRules(switchName, qosNamespace, qosName string, direction ruleDirection, index int) []string {
+	ls, err := libovsdbops.GetLogicalSwitch(nbClient, &nbdb.LogicalSwitch{Name: switchName})
+	Expect(err).NotTo(HaveOccurred())
+	qosKey := joinMetaNamespaceAndName(qosNamespace, qosName, ":")
+	var qosRules []string
+	for _, uuid := range ls.QOSRules {
+		qos, err := libovsdbops.FindQoSesWithPredicate(nbClient, func(item *nbdb.QoS) bool {
+			return item.UUID == uuid &&
+				item.ExternalIDs[libovsdbops.ObjectNameKey.String()] == qosKey &&
+				item.ExternalIDs[libovsdbops.PolicyDirectionKey.String()] == string(direction) &&
+				item.ExternalIDs[libovsdbops.RuleIndex.String()] == strconv.Itoa(index)
+		})
+		Expect(err).NotTo(HaveOccurred())
+		if len(qos) > 0 {
+			qosRules = append(qosRules, uuid)
+		}
+	}
+	return qosRules
+}
+
 func eventuallySwitchHasQoS(switchName string, qos *nbdb.QoS) {
 	var ls *nbdb.LogicalSwitch
 	Eventually(func() bool {
@@ -37,16 +37,43 @@ type networkQoSState struct {
 
 	// egressRules stores the objects needed to track .Spec.Egress changes
 	EgressRules []*GressRule
+	// ingressRules stores the objects needed to track .Spec.Ingress changes
+	IngressRules []*GressRule
+
+	// SourceNodeCount is the number of nodes running the selected pods, used
+	// to split the cluster scoped bandwidth limits.
+	SourceNodeCount int
 }
 
 func (nqosState *networkQoSState) getObjectNameKey() string {
 	return joinMetaNamespaceAndName(nqosState.namespace, nqosState.name, ":")
 }
 
-func (nqosState *networkQoSState) getDbObjectIDs(controller string, ruleIndex int) *libovsdbops.DbObjectIDs {
+func (nqosState *networkQoSState) getDbObjectIDs(controller string, direction ruleDirection, ruleIndex int) *libovsdbops.DbObjectIDs {
 	return libovsdbops.NewDbObjectIDs(libovsdbops.NetworkQoS, controller, map[libovsdbops.ExternalIDKey]string{
-		libovsdbops.ObjectNameKey: nqosState.getObjectNameKey(),
-		libovsdbops.RuleIndex:     fmt.Sprintf("%d", ruleIndex),
+		libovsdbops.ObjectNameKey:      nqosState.getObjectNameKey(),
+		libovsdbops.PolicyDirectionKey: string(direction),
+		libovsdbops.RuleIndex:          fmt.Sprintf("%d", ruleIndex),
+	})
+}
+
+// getRules returns the rules of the given direction
+func (nqosState *networkQoSState) getRules(direction ruleDirection) []*GressRule {
+	if direction == ruleDirectionIngress {
+		return nqosState.IngressRules
+	}
+	return nqosState.EgressRules
+}
+
+// allRules returns the egress rules followed by the ingress rules
+func (nqosState *networkQoSState) allRules() []*GressRule {
+	return append(slices.Clone(nqosState.EgressRules), nqosState.IngressRules...)
+}
+
+// hasClusterScopedBandwidth returns true if any rule splits its bandwidth limits across nodes
+func (nqosState *networkQoSState) hasClusterScopedBandwidth() bool {
+	return slices.ContainsFunc(nqosState.allRules(), func(rule *GressRule) bool {
+		return rule.BandwidthScope == networkqosv1alpha1.BandwidthScopeCluster
 	})
 }
 
@@ -60,20 +87,22 @@ func (nqosState *networkQoSState) initAddressSets(addressSetFactory addressset.A
 	if nqosState.emptyPodSelector() {
 		nqosState.SrcAddrSet, err = getNamespaceAddressSet(addressSetFactory, controllerName, nqosState.namespace)
 	} else {
-		nqosState.SrcAddrSet, err = addressSetFactory.EnsureAddressSet(GetNetworkQoSAddrSetDbIDs(nqosState.namespace, nqosState.name, "src", "0", controllerName))
+		nqosState.SrcAddrSet, err = addressSetFactory.EnsureAddressSet(GetNetworkQoSAddrSetDbIDs(nqosState.namespace, nqosState.name, "", "src", "0", controllerName))
 	}
 	if err != nil {
 		return fmt.Errorf("failed to init source address set for %s/%s: %w", nqosState.namespace, nqosState.name, err)
 	}
-	// ensure destination address sets
-	for ruleIndex, rule := range nqosState.EgressRules {
-		for destIndex, dest := range rule.Classifier.Destinations {
-			if dest.NamespaceSelector == nil && dest.PodSelector == nil {
-				continue
-			}
-			dest.DestAddrSet, err = addressSetFactory.EnsureAddressSet(GetNetworkQoSAddrSetDbIDs(nqosState.namespace, nqosState.name, strconv.Itoa(ruleIndex), strconv.Itoa(destIndex), controllerName))
-			if err != nil {
-				return fmt.Errorf("failed to init destination address set for %s/%s: %w", nqosState.namespace, nqosState.name, err)
+	// ensure peer address sets
+	for _, direction := range []ruleDirection{ruleDirectionEgress, ruleDirectionIngress} {
+		for ruleIndex, rule := range nqosState.getRules(direction) {
+			for destIndex, dest := range rule.Classifier.Destinations {
+				if dest.NamespaceSelector == nil && dest.PodSelector == nil {
+					continue
+				}
+				dest.DestAddrSet, err = addressSetFactory.EnsureAddressSet(GetNetworkQoSAddrSetDbIDs(nqosState.namespace, nqosState.name, direction, strconv.Itoa(ruleIndex), strconv.Itoa(destIndex), controllerName))
+				if err != nil {
+					return fmt.Errorf("failed to init %s peer address set for %s/%s: %w", direction, nqosState.namespace, nqosState.name, err)
+				}
 			}
 		}
 	}
@@ -179,7 +208,7 @@ func (nqosState *networkQoSState) getAddressSetHashNames() []string {
 		v4Hash, v6Hash := nqosState.SrcAddrSet.GetASHashNames()
 		addrsetNames = append(addrsetNames, v4Hash, v6Hash)
 	}
-	for _, rule := range nqosState.EgressRules {
+	for _, rule := range nqosState.allRules() {
 		for _, dest := range rule.Classifier.Destinations {
 			if dest.DestAddrSet != nil {
 				v4Hash, v6Hash := dest.DestAddrSet.GetASHashNames()
@@ -206,8 +235,8 @@ func (nqosState *networkQoSState) cleanupStaleAddresses(addressSetMap map[string
 			}
 		}
 	}
-	for _, egress := range nqosState.EgressRules {
-		for _, dest := range egress.Classifier.Destinations {
+	for _, rule := range nqosState.allRules() {
+		for _, dest := range rule.Classifier.Destinations {
 			if dest.DestAddrSet == nil {
 				continue
 			}
@@ -235,8 +264,33 @@ type GressRule struct {
 	Classifier *Classifier
 
 	// bandwitdh
-	Rate  *int
-	Burst *int
+	Rate           *int
+	Burst          *int
+	BandwidthScope networkqosv1alpha1.BandwidthScope
+}
+
+// getBandwidth returns the rate and burst to apply on each node, 0 meaning no limit.
+// A rule is rendered as a single QoS row matching all the selected pods, that
+// ovn-controller polices with one meter per node, shared by all the selected
+// pods of the node. Cluster scoped limits are split evenly across the nodes
+// running selected pods.
+func (rule *GressRule) getBandwidth(sourceNodeCount int) (int, int) {
+	rate, burst := 0, 0
+	if rule.Rate != nil {
+		rate = *rule.Rate
+	}
+	if rule.Burst != nil {
+		burst = *rule.Burst
+	}
+	if rule.BandwidthScope == networkqosv1alpha1.BandwidthScopeCluster && sourceNodeCount > 1 {
+		if rate > 0 {
+			rate = max(rate/sourceNodeCount, 1)
+		}
+		if burst > 0 {
+			burst = max(burst/sourceNodeCount, 1)
+		}
+	}
+	return rate, burst
 }
 
 type trafficDirection string
@@ -246,34 +300,52 @@ const (
 	trafficDirDest   trafficDirection = "dst"
 )
 
+// ruleDirection is the direction of a NetworkQoS rule, relative to the selected pods
+type ruleDirection string
+
+const (
+	ruleDirectionEgress  ruleDirection = "egress"
+	ruleDirectionIngress ruleDirection = "ingress"
+)
+
 type Classifier struct {
+	// Destinations are the peers of the rule: the destinations of egress
+	// rules, or the sources of ingress rules.
 	Destinations []*Destination
 	Ports        []*networkqosv1alpha1.Port
+	// PeerDirection is the direction the peers are matched with, dst for
+	// egress rules and src for ingress rules.
+	PeerDirection trafficDirection
 }
 
 // ToQosMatchString generates dest and protocol/port part of QoS match string, based on
 // Classifier's destinations, protocol and port fields, example:
 // (ip4.dst == $addr_set_name || (ip4.dst == 128.116.0.0/17 && ip4.dst != {128.116.0.0,128.116.0.255})) && tcp && tcp.dst == 8080
-// Multiple destinations will be connected by "||".
+// Multiple destinations will be connected by "||". For ingress rules the peers are
+// matched on the source address instead, while the ports are still the destination ports.
 // See https://github.com/ovn-org/ovn/blob/2bdf1129c19d5bd2cd58a3ddcb6e2e7254b05054/ovn-nb.xml#L2942-L3025 for details
 func (c *Classifier) ToQosMatchString(ipv4Enabled, ipv6Enabled bool) string {
 	if c == nil {
 		return ""
 	}
+	peerDir := c.PeerDirection
+	if peerDir == "" {
+		peerDir = trafficDirDest
+	}
 	destMatchStrings := []string{}
 	for _, dest := range c.Destinations {
-		match := "ip4.dst == 0.0.0.0/0 || ip6.dst == ::/0"
+		match := fmt.Sprintf("ip4.%s == 0.0.0.0/0 || ip6.%s == ::/0", peerDir, peerDir)
 		if dest.DestAddrSet != nil {
-			match = addressSetToMatchString(dest.DestAddrSet, trafficDirDest, ipv4Enabled, ipv6Enabled)
+			match = addressSetToMatchString(dest.DestAddrSet, peerDir, ipv4Enabled, ipv6Enabled)
 		} else if dest.IpBlock != nil && dest.IpBlock.CIDR != "" {
 			ipVersion := "ip4"
 			if utilnet.IsIPv6CIDRString(dest.IpBlock.CIDR) {
 				ipVersion = "ip6"
 			}
 			if len(dest.IpBlock.Except) == 0 {
-				match = fmt.Sprintf("%s.%s == %s", ipVersion, trafficDirDest, dest.IpBlock.CIDR)
+				match = fmt.Sprintf("%s.%s == %s", ipVersion, peerDir, dest.IpBlock.CIDR)
 			} else {
-				match = fmt.Sprintf("%s.%s == %s && %s.%s != {%s}", ipVersion, trafficDirDest, dest.IpBlock.CIDR, ipVersion, trafficDirDest, strings.Join(dest.IpBlock.Except, ","))
+				match = fmt.Sprintf("%s.%s == %s && %s.%s != {%s}", ipVersion, peerDir, dest.IpBlock.CIDR, ipVersion, peerDir, strings.Join(dest.IpBlock.Except, ","))
 			}
 		}
 		destMatchStrings = append(destMatchStrings, match)
@@ -386,3 +458,11 @@ func (dest *Destination) removePod(fullPodName string, addresses []string) error
 func getQoSRulePriority(qosPriority, ruleIndex int) int {
 	return 10000 + qosPriority*10 + ruleIndex
 }
+
+// getQoSIngressRulePriority returns the priority of an ingress rule. Ingress rules
+// use their own range above the egress rules, so that when a packet matches
+// both an egress and an ingress rule on the same switch, the rule of the
+// receiving pod wins.
+func getQoSIngressRulePriority(qosPriority, ruleIndex int) int {
+	return 20000 + qosPriority*10 + ruleIndex
+}
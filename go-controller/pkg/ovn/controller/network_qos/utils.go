@@ -5,6 +5,7 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 
+	nqosv1alpha1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/networkqos/v1alpha1"
 	libovsdbops "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
 	addressset "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn/address_set"
 	ovnkutil "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
@@ -21,16 +22,31 @@ func joinMetaNamespaceAndName(namespace, name string, separator ...string) strin
 	return namespace + sep + name
 }
 
-func GetNetworkQoSAddrSetDbIDs(nqosNamespace, nqosName, ruleIndex, ipBlockIndex, controller string) *libovsdbops.DbObjectIDs {
+func GetNetworkQoSAddrSetDbIDs(nqosNamespace, nqosName string, direction ruleDirection, ruleIndex, ipBlockIndex, controller string) *libovsdbops.DbObjectIDs {
 	return libovsdbops.NewDbObjectIDs(libovsdbops.AddressSetNetworkQoS, controller,
 		map[libovsdbops.ExternalIDKey]string{
 			libovsdbops.ObjectNameKey: joinMetaNamespaceAndName(nqosNamespace, nqosName, ":"),
-			// rule index is the unique id for address set within given objectName
-			libovsdbops.RuleIndex:       ruleIndex,
-			libovsdbops.IpBlockIndexKey: ipBlockIndex,
+			// rule direction and index are the unique id for address set within given objectName,
+			// the source address set is shared by all the rules and has no direction
+			libovsdbops.PolicyDirectionKey: string(direction),
+			libovsdbops.RuleIndex:          ruleIndex,
+			libovsdbops.IpBlockIndexKey:    ipBlockIndex,
 		})
 }
 
+// getRulePeers returns the peers of all the rules of a NetworkQoS, that is the
+// destinations of the egress rules and the sources of the ingress rules.
+func getRulePeers(nqos *nqosv1alpha1.NetworkQoS) []nqosv1alpha1.Destination {
+	peers := []nqosv1alpha1.Destination{}
+	for _, egress := range nqos.Spec.Egress {
+		peers = append(peers, egress.Classifier.To...)
+	}
+	for _, ingress := range nqos.Spec.Ingress {
+		peers = append(peers, ingress.Classifier.From...)
+	}
+	return peers
+}
+
 func getPodAddresses(pod *corev1.Pod, networkInfo ovnkutil.NetInfo) ([]string, error) {
 	// check annotation "k8s.ovn.org/pod-networks" before calling GetPodIPsOfNetwork,
 	// as it's no easy to check if the error is caused by missing annotation, while
@@ -52,7 +68,12 @@ func getPodAddresses(pod *corev1.Pod, networkInfo ovnkutil.NetInfo) ([]string, e
 }
 
 func generateNetworkQoSMatch(qosState *networkQoSState, rule *GressRule, ipv4Enabled, ipv6Enabled bool) string {
-	match := addressSetToMatchString(qosState.SrcAddrSet, trafficDirSource, ipv4Enabled, ipv6Enabled)
+	// the selected pods are the source of egress rules and the destination of ingress rules
+	podDir := trafficDirSource
+	if rule.Classifier.PeerDirection == trafficDirSource {
+		podDir = trafficDirDest
+	}
+	match := addressSetToMatchString(qosState.SrcAddrSet, podDir, ipv4Enabled, ipv6Enabled)
 
 	classiferMatchString := rule.Classifier.ToQosMatchString(ipv4Enabled, ipv6Enabled)
 	if classiferMatchString != "" {
@@ -17,8 +17,6 @@ import (
 
 	hotypes "github.com/ovn-org/ovn-kubernetes/go-controller/hybrid-overlay/pkg/types"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
-	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/controller"
-	egressfirewall "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressfirewall/v1"
 	egressipv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressip/v1"
 	egressqoslisters "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressqos/v1/apis/listers/egressqos/v1"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/factory"
@@ -32,7 +30,6 @@ import (
 	egresssvc "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn/controller/egressservice"
 	svccontroller "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn/controller/services"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn/controller/unidling"
-	dnsnameresolver "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn/dns_name_resolver"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn/external_ids_syncer/logical_router_policy"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn/external_ids_syncer/nat"
 	lsm "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn/logical_switch_manager"
@@ -59,9 +56,6 @@ type DefaultNetworkController struct {
 
 	externalGatewayRouteInfo *apbroutecontroller.ExternalGatewayRouteInfoCache
 
-	// egressFirewalls is a map of namespaces and the egressFirewall attached to it
-	egressFirewalls sync.Map
-
 	// EgressQoS
 	egressQoSLister egressqoslisters.EgressQoSLister
 	egressQoSSynced cache.InformerSynced
@@ -105,14 +99,6 @@ type DefaultNetworkController struct {
 	// Controller used to handle the admin policy based external route resources
 	apbExternalRouteController *apbroutecontroller.ExternalGatewayMasterController
 
-	// dnsNameResolver is used for resolving the IP addresses of DNS names
-	// used in egress firewall rules
-	dnsNameResolver  dnsnameresolver.DNSNameResolver
-	efNodeController controller.Controller
-
-	// retry framework for egress firewall
-	retryEgressFirewalls *retry.RetryFramework
-
 	// retry framework for egress IP
 	retryEgressIPs *retry.RetryFramework
 	// retry framework for egress IP Namespaces
@@ -260,6 +246,7 @@ func (oc *DefaultNetworkController) initRetryFramework() {
 	oc.retryPods = oc.newRetryFramework(factory.PodType)
 	oc.retryNodes = oc.newRetryFramework(factory.NodeType)
 	oc.retryEgressFirewalls = oc.newRetryFramework(factory.EgressFirewallType)
+	oc.retryClusterEgressFirewalls = oc.newRetryFramework(factory.ClusterEgressFirewallType)
 	oc.retryEgressIPs = oc.newRetryFramework(factory.EgressIPType)
 	oc.retryEgressIPNamespaces = oc.newRetryFramework(factory.EgressIPNamespaceType)
 	oc.retryEgressIPPods = oc.newRetryFramework(factory.EgressIPPodType)
@@ -341,12 +328,7 @@ func (oc *DefaultNetworkController) Start(ctx context.Context) error {
 
 // Stop gracefully stops the controller
 func (oc *DefaultNetworkController) Stop() {
-	if oc.dnsNameResolver != nil {
-		oc.dnsNameResolver.Shutdown()
-	}
-	if oc.efNodeController != nil {
-		controller.Stop(oc.efNodeController)
-	}
+	oc.stopEgressFirewall()
 	if oc.routeImportManager != nil {
 		oc.routeImportManager.ForgetNetwork(oc.GetNetworkName())
 	}
@@ -476,6 +458,12 @@ func (oc *DefaultNetworkController) run(_ context.Context) error {
 		if err := WithSyncDurationMetric("egress ip pod", oc.WatchEgressIPPods); err != nil {
 			return err
 		}
+		// also removes the BFD sessions left over from a previous run with BFD
+		// based node reachability, once switched back to gRPC
+		if err := oc.eIPC.syncEgressIPBFDSessions(); err != nil {
+			klog.Warningf("Failed to sync EgressIP BFD sessions: %v", err)
+		}
+		oc.eIPC.startEgressIPBFDStatusReporter(oc.stopChan)
 		if err := WithSyncDurationMetric("egress node", oc.WatchEgressNodes); err != nil {
 			return err
 		}
@@ -485,30 +473,7 @@ func (oc *DefaultNetworkController) run(_ context.Context) error {
 	}
 
 	if config.OVNKubernetesFeature.EnableEgressFirewall {
-		var err error
-		// If DNSNameResolver is enabled, then initialize dnsNameResolver to ExternalEgressDNS
-		// for maintaining the address sets corresponding to the DNS names and start watching
-		// DNSNameResolver resources. Otherwise initialize dnsNameResolver to EgressDNS.
-		if config.OVNKubernetesFeature.EnableDNSNameResolver {
-			oc.dnsNameResolver, err = dnsnameresolver.NewExternalEgressDNS(oc.addressSetFactory, oc.controllerName, true,
-				oc.watchFactory.DNSNameResolverInformer().Informer(), oc.watchFactory.EgressFirewallInformer().Lister())
-		} else {
-			oc.dnsNameResolver, err = dnsnameresolver.NewEgressDNS(oc.addressSetFactory, oc.controllerName, oc.stopChan, egressFirewallDNSDefaultDuration)
-		}
-		if err != nil {
-			return err
-		}
-		err = oc.dnsNameResolver.Run()
-		if err != nil {
-			return err
-		}
-		err = WithSyncDurationMetric("egress firewall", oc.WatchEgressFirewall)
-		if err != nil {
-			return err
-		}
-		oc.efNodeController = oc.newEFNodeController(oc.watchFactory.NodeCoreInformer())
-		err = controller.Start(oc.efNodeController)
-		if err != nil {
+		if err := oc.startEgressFirewall(); err != nil {
 			return err
 		}
 	}
@@ -821,18 +786,13 @@ func (h *defaultNetworkControllerEventHandler) AddResource(obj interface{}, from
 		}
 		return utilerrors.Join(aggregatedErrors...)
 
-	case factory.EgressFirewallType:
-		egressFirewall := obj.(*egressfirewall.EgressFirewall).DeepCopy()
-		err := h.oc.addEgressFirewall(egressFirewall)
-		if statusErr := h.oc.setEgressFirewallStatus(egressFirewall, err); statusErr != nil {
-			klog.Errorf("Failed to update egress firewall status %s, error: %v",
-				getEgressFirewallNamespacedName(egressFirewall), statusErr)
-		}
-		return err
-
 	case factory.EgressIPType:
 		eIP := obj.(*egressipv1.EgressIP)
-		return h.oc.eIPC.reconcileEgressIP(nil, eIP)
+		err := h.oc.eIPC.reconcileEgressIP(nil, eIP)
+		if statusErr := h.oc.eIPC.setEgressIPZoneStatus(eIP, err); statusErr != nil {
+			klog.Errorf("Failed to set the status of EgressIP %s in zone %s: %v", eIP.Name, h.oc.zone, statusErr)
+		}
+		return err
 
 	case factory.EgressIPNamespaceType:
 		namespace := obj.(*corev1.Namespace)
@@ -884,6 +844,9 @@ func (h *defaultNetworkControllerEventHandler) AddResource(obj interface{}, from
 			}
 			h.oc.syncEIPNodeFailed.Delete(node.Name)
 		}
+		if isEgressIPBFDEnabled() && isEgressIPBFDNode(node) {
+			return h.oc.eIPC.syncEgressIPBFDSessions()
+		}
 		return nil
 
 	case factory.NamespaceType:
@@ -1044,7 +1007,11 @@ func (h *defaultNetworkControllerEventHandler) UpdateResource(oldObj, newObj int
 	case factory.EgressIPType:
 		oldEIP := oldObj.(*egressipv1.EgressIP)
 		newEIP := newObj.(*egressipv1.EgressIP)
-		return h.oc.eIPC.reconcileEgressIP(oldEIP, newEIP)
+		err := h.oc.eIPC.reconcileEgressIP(oldEIP, newEIP)
+		if statusErr := h.oc.eIPC.setEgressIPZoneStatus(newEIP, err); statusErr != nil {
+			klog.Errorf("Failed to set the status of EgressIP %s in zone %s: %v", newEIP.Name, h.oc.zone, statusErr)
+		}
+		return err
 
 	case factory.EgressIPNamespaceType:
 		oldNamespace := oldObj.(*corev1.Namespace)
@@ -1093,6 +1060,9 @@ func (h *defaultNetworkControllerEventHandler) UpdateResource(oldObj, newObj int
 			}
 			h.oc.syncEIPNodeFailed.Delete(newNode.Name)
 		}
+		if isEgressIPBFDEnabled() && egressIPBFDNodeChanged(oldNode, newNode) {
+			return h.oc.eIPC.syncEgressIPBFDSessions()
+		}
 		return nil
 
 	case factory.NamespaceType:
@@ -1123,15 +1093,6 @@ func (h *defaultNetworkControllerEventHandler) DeleteResource(obj, cachedObj int
 		}
 		return h.oc.deleteNodeEvent(node)
 
-	case factory.EgressFirewallType:
-		egressFirewall := obj.(*egressfirewall.EgressFirewall)
-		if err := h.oc.deleteEgressFirewall(egressFirewall); err != nil {
-			return err
-		}
-		metrics.UpdateEgressFirewallRuleCount(float64(-len(egressFirewall.Spec.Egress)))
-		metrics.DecrementEgressFirewallCount()
-		return nil
-
 	case factory.EgressIPType:
 		eIP := obj.(*egressipv1.EgressIP)
 		return h.oc.eIPC.reconcileEgressIP(eIP, nil)
@@ -1157,6 +1118,9 @@ func (h *defaultNetworkControllerEventHandler) DeleteResource(obj, cachedObj int
 		h.oc.eIPC.nodeZoneState.UnlockKey(node.Name)
 		h.oc.syncEIPNodeRerouteFailed.Delete(node.Name)
 		h.oc.syncEIPNodeFailed.Delete(node.Name)
+		if isEgressIPBFDEnabled() && isEgressIPBFDNode(node) {
+			return h.oc.eIPC.syncEgressIPBFDSessions()
+		}
 		return nil
 
 	case factory.NamespaceType:
@@ -1188,6 +1152,9 @@ func (h *defaultNetworkControllerEventHandler) SyncFunc(objs []interface{}) erro
 		case factory.EgressFirewallType:
 			syncFunc = h.oc.syncEgressFirewall
 
+		case factory.ClusterEgressFirewallType:
+			syncFunc = h.oc.syncClusterEgressFirewall
+
 		case factory.EgressIPNamespaceType:
 			syncFunc = h.oc.eIPC.syncEgressIPs
 
@@ -45,8 +45,9 @@ type dnsEntry struct {
 	// this map holds all the namespaces that a dnsName appears in
 	namespaces map[string]struct{}
 	// the current IP addresses the dnsName resolves to
-	// NOTE: used for testing
 	dnsResolves []net.IP
+	// resolved is true if the last lookup of the dnsName succeeded
+	resolved bool
 	// the addressSet that contains the current IPs
 	dnsAddressSet addressset.AddressSet
 }
@@ -141,11 +142,25 @@ func (e *EgressDNS) Delete(namespace string) error {
 	return nil
 }
 
+// GetResolvedAddresses returns the addresses the DNS name was last resolved to and whether its last
+// resolution succeeded.
+func (e *EgressDNS) GetResolvedAddresses(dnsName string) ([]string, bool) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	entry, ok := e.dnsEntries[dnsName]
+	if !ok {
+		return nil, false
+	}
+	return util.StringSlice(entry.dnsResolves), entry.resolved
+}
+
 func (e *EgressDNS) Update(dnsName string) (bool, error) {
 	return e.dns.Update(dnsName)
 }
 
-func (e *EgressDNS) updateEntryForName(dnsName string) error {
+// updateEntryForName updates the address set of the dnsName with the IPs it currently resolves to.
+// resolved tells whether the last lookup of the dnsName succeeded.
+func (e *EgressDNS) updateEntryForName(dnsName string, resolved bool) error {
 	e.lock.Lock()
 	defer e.lock.Unlock()
 	ips := e.dns.GetIPs(dnsName)
@@ -154,8 +169,17 @@ func (e *EgressDNS) updateEntryForName(dnsName string) error {
 			"Was the EgressFirewall deleted?", dnsName)
 	}
 	e.dnsEntries[dnsName].dnsResolves = ips
+	e.dnsEntries[dnsName].resolved = resolved
 
-	// ignore ips from clusterSubnet, since this subnet shouldn't be affected by egress firewall
+	if err := e.dnsEntries[dnsName].dnsAddressSet.SetAddresses(util.StringSlice(filterClusterSubnetIPs(ips))); err != nil {
+		return fmt.Errorf("cannot add IPs from EgressFirewall AddressSet %s: %v", dnsName, err)
+	}
+	return nil
+}
+
+// filterClusterSubnetIPs returns the ips that don't belong to the cluster subnets, since the cluster subnets
+// shouldn't be affected by egress firewall.
+func filterClusterSubnetIPs(ips []net.IP) []net.IP {
 	ipsNoClusterSubnet := []net.IP{}
 	for _, ip := range ips {
 		fromClusterSubnet := false
@@ -170,10 +194,7 @@ func (e *EgressDNS) updateEntryForName(dnsName string) error {
 			ipsNoClusterSubnet = append(ipsNoClusterSubnet, ip)
 		}
 	}
-	if err := e.dnsEntries[dnsName].dnsAddressSet.SetAddresses(util.StringSlice(ipsNoClusterSubnet)); err != nil {
-		return fmt.Errorf("cannot add IPs from EgressFirewall AddressSet %s: %v", dnsName, err)
-	}
-	return nil
+	return ipsNoClusterSubnet
 }
 
 // addToDNS takes the dnsName adds it to the underlying dns resolver and
@@ -181,10 +202,11 @@ func (e *EgressDNS) updateEntryForName(dnsName string) error {
 // thread performing periodic updates that a new DNS name has been added and
 // so that it can updates GetNextQueryTime() if needed
 func (e *EgressDNS) addToDNS(dnsName string) {
-	if err := e.dns.Add(dnsName); err != nil {
-		utilruntime.HandleError(err)
+	resolveErr := e.dns.Add(dnsName)
+	if resolveErr != nil {
+		utilruntime.HandleError(resolveErr)
 	}
-	if err := e.updateEntryForName(dnsName); err != nil {
+	if err := e.updateEntryForName(dnsName, resolveErr == nil); err != nil {
 		utilruntime.HandleError(err)
 	}
 	// No need to block waiting to signal the add.
@@ -228,10 +250,11 @@ func (e *EgressDNS) Run() error {
 				//on update need to check if the GetNextQueryTime has changed
 			case <-timer.C:
 				if len(domainNameExpiringNext) > 0 {
-					if _, err := e.Update(domainNameExpiringNext); err != nil {
-						utilruntime.HandleError(err)
+					_, resolveErr := e.Update(domainNameExpiringNext)
+					if resolveErr != nil {
+						utilruntime.HandleError(resolveErr)
 					}
-					if err := e.updateEntryForName(domainNameExpiringNext); err != nil {
+					if err := e.updateEntryForName(domainNameExpiringNext, resolveErr == nil); err != nil {
 						utilruntime.HandleError(err)
 					}
 				}
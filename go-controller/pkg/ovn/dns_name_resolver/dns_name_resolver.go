@@ -9,6 +9,9 @@ import (
 type DNSNameResolver interface {
 	Add(namespace, dnsName string) (addressset.AddressSet, error)
 	Delete(namespace string) error
+	// GetResolvedAddresses returns the addresses the DNS name was last resolved to and whether its last
+	// resolution succeeded.
+	GetResolvedAddresses(dnsName string) ([]string, bool)
 	Run() error
 	Shutdown()
 	DeleteStaleAddrSets(nbClient libovsdbclient.Client) error
@@ -0,0 +1,190 @@
+package dnsnameresolver
+
+import (
+	"net"
+	"net/netip"
+	"strings"
+	"time"
+
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	"github.com/miekg/dns"
+
+	"k8s.io/klog/v2"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
+)
+
+const (
+	dnsPort = 53
+	// dnsQueryTimeout is how long a response is expected for a snooped query.
+	dnsQueryTimeout = 10 * time.Second
+	// maxPendingDNSQueries limits the number of snooped queries waiting for a response.
+	maxPendingDNSQueries = 16384
+)
+
+// dnsQueryKey identifies a DNS query by the client address and port it was sent from and its ID.
+type dnsQueryKey struct {
+	client netip.Addr
+	port   uint16
+	id     uint16
+}
+
+type pendingDNSQuery struct {
+	// lower case fully qualified name of the query question
+	name    string
+	expires time.Time
+}
+
+// snoopedDNSResponse is a DNS response that matched a snooped query.
+type snoopedDNSResponse struct {
+	// lower case fully qualified name of the query question
+	name string
+	// failed is true if the response reported an error, like NXDOMAIN or SERVFAIL
+	failed bool
+	// addresses maps the addresses of the A and AAAA answers to their TTL
+	addresses map[string]time.Duration
+}
+
+// dnsSnooper extracts DNS responses from sampled packets. Only the queries sent to and the responses sent by
+// the resolvers are considered, so that a pod can't forge a response by running its own DNS server. Responses
+// are only accepted if they match a query previously snooped for the same client address, port, query ID and
+// question, so that a pod can't forge a response to another pod without guessing the query port and ID.
+// dnsSnooper is not safe for concurrent use.
+type dnsSnooper struct {
+	parser  *gopacket.DecodingLayerParser
+	decoded []gopacket.LayerType
+	eth     layers.Ethernet
+	dot1q   layers.Dot1Q
+	ip4     layers.IPv4
+	ip6     layers.IPv6
+	udp     layers.UDP
+	payload gopacket.Payload
+
+	queries map[dnsQueryKey]pendingDNSQuery
+	// isResolver returns true if the address is the address of a resolver the pods are expected to query
+	isResolver func(netip.Addr) bool
+}
+
+func newDNSSnooper(isResolver func(netip.Addr) bool) *dnsSnooper {
+	s := &dnsSnooper{
+		queries:    make(map[dnsQueryKey]pendingDNSQuery),
+		isResolver: isResolver,
+	}
+	s.parser = gopacket.NewDecodingLayerParser(layers.LayerTypeEthernet, &s.eth, &s.dot1q, &s.ip4, &s.ip6, &s.udp,
+		&s.payload)
+	s.parser.IgnoreUnsupported = true
+	return s
+}
+
+// snoop parses the sampled packet data. It records the DNS queries and returns the DNS responses that match a
+// recorded query, it returns nil for any other packet.
+func (s *dnsSnooper) snoop(data []byte, now time.Time) *snoopedDNSResponse {
+	if err := s.parser.DecodeLayers(data, &s.decoded); err != nil {
+		klog.V(5).Infof("Failed to decode sampled packet: %v", err)
+		return nil
+	}
+	var srcIP, dstIP net.IP
+	var isUDP bool
+	for _, layerType := range s.decoded {
+		switch layerType {
+		case layers.LayerTypeIPv4:
+			srcIP, dstIP = s.ip4.SrcIP, s.ip4.DstIP
+		case layers.LayerTypeIPv6:
+			srcIP, dstIP = s.ip6.SrcIP, s.ip6.DstIP
+		case layers.LayerTypeUDP:
+			isUDP = true
+		}
+	}
+	if !isUDP || srcIP == nil || (s.udp.SrcPort != dnsPort && s.udp.DstPort != dnsPort) {
+		return nil
+	}
+	msg := &dns.Msg{}
+	if err := msg.Unpack(s.udp.Payload); err != nil {
+		klog.V(5).Infof("Failed to unpack DNS message sent from %s to %s: %v", srcIP, dstIP, err)
+		return nil
+	}
+	if len(msg.Question) != 1 {
+		return nil
+	}
+	name := util.LowerCaseFQDN(msg.Question[0].Name)
+	if !msg.Response {
+		if s.udp.DstPort == dnsPort && s.isResolver(ipToAddr(dstIP)) {
+			s.addQuery(dnsQueryKey{client: ipToAddr(srcIP), port: uint16(s.udp.SrcPort), id: msg.Id}, name, now)
+		}
+		return nil
+	}
+	if s.udp.SrcPort != dnsPort {
+		return nil
+	}
+	if !s.isResolver(ipToAddr(srcIP)) {
+		klog.V(5).Infof("Ignoring DNS response for %s sent from %s to %s by a server that is not a resolver", name, srcIP, dstIP)
+		return nil
+	}
+	key := dnsQueryKey{client: ipToAddr(dstIP), port: uint16(s.udp.DstPort), id: msg.Id}
+	query, ok := s.queries[key]
+	if !ok || query.name != name || now.After(query.expires) {
+		klog.V(5).Infof("Ignoring unsolicited DNS response for %s sent from %s to %s", name, srcIP, dstIP)
+		return nil
+	}
+	delete(s.queries, key)
+
+	response := &snoopedDNSResponse{
+		name:      name,
+		failed:    msg.Rcode != dns.RcodeSuccess,
+		addresses: map[string]time.Duration{},
+	}
+	for _, answer := range msg.Answer {
+		var ip net.IP
+		switch rr := answer.(type) {
+		case *dns.A:
+			ip = rr.A
+		case *dns.AAAA:
+			ip = rr.AAAA
+		default:
+			// the CNAME records of the chain are followed by the addresses of the last name
+			continue
+		}
+		ttl := time.Duration(answer.Header().Ttl) * time.Second
+		if ttl > response.addresses[ip.String()] {
+			response.addresses[ip.String()] = ttl
+		}
+	}
+	return response
+}
+
+func (s *dnsSnooper) addQuery(key dnsQueryKey, name string, now time.Time) {
+	if len(s.queries) >= maxPendingDNSQueries {
+		for k, query := range s.queries {
+			if now.After(query.expires) {
+				delete(s.queries, k)
+			}
+		}
+		if len(s.queries) >= maxPendingDNSQueries {
+			klog.V(5).Infof("Too many pending DNS queries, ignoring query for %s from %s", name, key.client)
+			return
+		}
+	}
+	s.queries[key] = pendingDNSQuery{name: name, expires: now.Add(dnsQueryTimeout)}
+}
+
+func ipToAddr(ip net.IP) netip.Addr {
+	addr, _ := netip.AddrFromSlice(ip)
+	return addr.Unmap()
+}
+
+// getWildcardDNSNames returns the wildcard DNS names matching dnsName, i.e. dnsName with one or more of its
+// leading labels replaced by a single '*', from the most to the least specific. A wildcard DNS name matches
+// the names of any depth under its domain, e.g. "*.example.com." matches "a.b.example.com.".
+func getWildcardDNSNames(dnsName string) []string {
+	var wildcardDNSNames []string
+	for idx := strings.Index(dnsName, "."); idx >= 0 && idx < len(dnsName)-1; {
+		wildcardDNSNames = append(wildcardDNSNames, "*"+dnsName[idx:])
+		next := strings.Index(dnsName[idx+1:], ".")
+		if next < 0 {
+			break
+		}
+		idx += next + 1
+	}
+	return wildcardDNSNames
+}
@@ -153,13 +153,19 @@ func (extEgDNS *ExternalEgressDNS) reconcileDNSNameResolver(key string) error {
 
 	// Get the addresses corresponding to the DNS name and add them to
 	// the address set corresponding to the DNS name.
+	// The DNS name is considered resolved if none of the names matching it
+	// failed to be resolved the last time they were looked up.
 	addresses := []string{}
+	resolved := len(obj.Status.ResolvedNames) > 0
 	for _, resolvedName := range obj.Status.ResolvedNames {
 		for _, resolvedAddress := range resolvedName.ResolvedAddresses {
 			addresses = append(addresses, resolvedAddress.IP)
 		}
+		if resolvedName.ResolutionFailures > 0 {
+			resolved = false
+		}
 	}
-	err = extEgDNS.dnsTracker.addOrUpdateDNSName(dnsName, addresses)
+	err = extEgDNS.dnsTracker.addOrUpdateDNSName(dnsName, addresses, resolved)
 	return err
 }
 
@@ -177,6 +183,12 @@ func (extEgDNS *ExternalEgressDNS) Delete(namespace string) error {
 	return extEgDNS.dnsTracker.deleteNamespace(namespace)
 }
 
+// GetResolvedAddresses returns the addresses the DNS name was last resolved to and whether its last
+// resolution succeeded.
+func (extEgDNS *ExternalEgressDNS) GetResolvedAddresses(dnsName string) ([]string, bool) {
+	return extEgDNS.dnsTracker.getResolvedAddresses(dnsName)
+}
+
 // Run starts the DNSNameResolver controller.
 func (extEgDNS *ExternalEgressDNS) Run() error {
 	return controller.Start(extEgDNS.controller)
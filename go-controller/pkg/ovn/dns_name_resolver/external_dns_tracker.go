@@ -47,6 +47,11 @@ type dnsResolvedName struct {
 	// deleted indicates whether the DNSNameResolver object corresponding
 	// to the DNS name was deleted or not.
 	deleted bool
+	// addresses gives the IPs the DNS name was last resolved to.
+	addresses []string
+	// resolved indicates whether the last resolution of the DNS name
+	// succeeded or not.
+	resolved bool
 }
 
 func newDNSTracker(addressSetFactory addressset.AddressSetFactory, controllerName string, ignoreClusterSubnet bool) dnsTracker {
@@ -60,7 +65,8 @@ func newDNSTracker(addressSetFactory addressset.AddressSetFactory, controllerNam
 }
 
 // addOrUpdateDNSName is called whenever a DNS name is needed to be added or updated.
-func (dnsTracker *dnsTracker) addOrUpdateDNSName(dnsName string, addresses []string) error {
+// resolved indicates whether the last resolution of the DNS name succeeded or not.
+func (dnsTracker *dnsTracker) addOrUpdateDNSName(dnsName string, addresses []string, resolved bool) error {
 	dnsTracker.dnsLock.Lock()
 	defer dnsTracker.dnsLock.Unlock()
 
@@ -95,10 +101,25 @@ func (dnsTracker *dnsTracker) addOrUpdateDNSName(dnsName string, addresses []str
 	if err := resolvedName.dnsAddressSet.SetAddresses(addresses); err != nil {
 		return fmt.Errorf("cannot add IPs to AddressSet for DNS name %s: %v", dnsName, err)
 	}
+	resolvedName.addresses = addresses
+	resolvedName.resolved = resolved
 
 	return nil
 }
 
+// getResolvedAddresses returns the addresses the DNS name was last resolved to and
+// whether its last resolution succeeded.
+func (dnsTracker *dnsTracker) getResolvedAddresses(dnsName string) ([]string, bool) {
+	dnsTracker.dnsLock.Lock()
+	defer dnsTracker.dnsLock.Unlock()
+
+	resolvedName, exists := dnsTracker.dnsNames[dnsName]
+	if !exists {
+		return nil, false
+	}
+	return resolvedName.addresses, resolvedName.resolved
+}
+
 // deleteDNSName is called whenever a DNS name is needed to be deleted.
 func (dnsTracker *dnsTracker) deleteDNSName(dnsName string) error {
 	dnsTracker.dnsLock.Lock()
@@ -0,0 +1,320 @@
+package dnsnameresolver
+
+import (
+	"fmt"
+	"net"
+	"net/netip"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	discoverylisters "k8s.io/client-go/listers/discovery/v1"
+	"k8s.io/klog/v2"
+
+	libovsdbclient "github.com/ovn-kubernetes/libovsdb/client"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
+	libovsdbops "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
+	libovsdbutil "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/util"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/observability"
+	addressset "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn/address_set"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
+)
+
+const (
+	// snoopedAddressGracePeriod is how long a snooped address is kept after its TTL expired, to allow for
+	// clients that keep using an address slightly longer than its TTL.
+	snoopedAddressGracePeriod = time.Minute
+	// snoopedAddressExpiryInterval is how often expired snooped addresses are removed.
+	snoopedAddressExpiryInterval = 5 * time.Second
+)
+
+// SnoopingEgressDNS maintains the address sets of the DNS names used in EgressFirewall rules with the
+// addresses of the DNS responses received by the pods of the local node. The DNS traffic of the pods is
+// sampled by OVN ACLs and the samples are received from psample, therefore it can only be used when the
+// zone only contains the local node. Only the DNS traffic exchanged with the cluster DNS service, configured
+// with --dns-service-namespace and --dns-service-name, is snooped: the resolvers are the cluster IPs and the
+// endpoint addresses of the service.
+// DNS names, including wildcard DNS names, are expected as lower case fully qualified domain names. Unlike
+// with the DNSNameResolver resources, a wildcard DNS name matches the names of any depth under its domain.
+type SnoopingEgressDNS struct {
+	// Protects dnsEntries
+	lock sync.Mutex
+	// this map holds dnsNames to the snoopedDNSEntries
+	dnsEntries map[string]*snoopedDNSEntry
+	snooper    *dnsSnooper
+	// allows for the creation of addresssets
+	addressSetFactory addressset.AddressSetFactory
+	controllerName    string
+	// receiveSamples calls the handler for every sample received on the local node
+	receiveSamples func(stopChan <-chan struct{}, handler observability.SampleHandler) error
+	// resolvers holds the addresses of the cluster DNS service, it is refreshed periodically from the listers
+	resolvers           atomic.Pointer[sets.Set[netip.Addr]]
+	serviceLister       corev1listers.ServiceLister
+	endpointSliceLister discoverylisters.EndpointSliceLister
+
+	stopChan       chan struct{}
+	controllerStop <-chan struct{}
+}
+
+var _ DNSNameResolver = &SnoopingEgressDNS{}
+
+type snoopedDNSEntry struct {
+	// this map holds all the namespaces that a dnsName appears in
+	namespaces map[string]struct{}
+	// addresses maps the IP addresses snooped for the dnsName to the time they expire at
+	addresses map[string]time.Time
+	// resolved is false if the last snooped response for the dnsName reported an error
+	resolved bool
+	// the addressSet that contains the current IPs
+	dnsAddressSet addressset.AddressSet
+}
+
+// NewSnoopingEgressDNS initializes and returns a new SnoopingEgressDNS instance.
+func NewSnoopingEgressDNS(addressSetFactory addressset.AddressSetFactory, controllerName string,
+	serviceLister corev1listers.ServiceLister, endpointSliceLister discoverylisters.EndpointSliceLister,
+	controllerStop <-chan struct{}) *SnoopingEgressDNS {
+	e := &SnoopingEgressDNS{
+		dnsEntries:          make(map[string]*snoopedDNSEntry),
+		addressSetFactory:   addressSetFactory,
+		controllerName:      controllerName,
+		receiveSamples:      observability.ReceiveSamples,
+		serviceLister:       serviceLister,
+		endpointSliceLister: endpointSliceLister,
+		stopChan:            make(chan struct{}),
+		controllerStop:      controllerStop,
+	}
+	e.snooper = newDNSSnooper(e.isResolver)
+	e.resolvers.Store(&sets.Set[netip.Addr]{})
+	return e
+}
+
+func (e *SnoopingEgressDNS) Add(namespace, dnsName string) (addressset.AddressSet, error) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	if _, exists := e.dnsEntries[dnsName]; !exists {
+		if e.addressSetFactory == nil {
+			return nil, fmt.Errorf("error adding EgressFirewall DNS rule for host %s, in namespace %s: addressSetFactory is nil", dnsName, namespace)
+		}
+		asIndex := GetEgressFirewallDNSAddrSetDbIDs(dnsName, e.controllerName)
+		dnsAddressSet, err := e.addressSetFactory.NewAddressSet(asIndex, nil)
+		if err != nil {
+			return nil, fmt.Errorf("cannot create addressSet for %s: %v", dnsName, err)
+		}
+		e.dnsEntries[dnsName] = &snoopedDNSEntry{
+			namespaces:    make(map[string]struct{}),
+			addresses:     make(map[string]time.Time),
+			resolved:      true,
+			dnsAddressSet: dnsAddressSet,
+		}
+	}
+	e.dnsEntries[dnsName].namespaces[namespace] = struct{}{}
+	return e.dnsEntries[dnsName].dnsAddressSet, nil
+}
+
+func (e *SnoopingEgressDNS) Delete(namespace string) error {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	for dnsName, dnsEntry := range e.dnsEntries {
+		delete(dnsEntry.namespaces, namespace)
+		if len(dnsEntry.namespaces) == 0 {
+			// the dnsEntry appears in no other namespace, so delete the address_set
+			if err := dnsEntry.dnsAddressSet.Destroy(); err != nil {
+				return fmt.Errorf("error deleting EgressFirewall AddressSet for dnsName: %s %v", dnsName, err)
+			}
+			delete(e.dnsEntries, dnsName)
+		}
+	}
+	return nil
+}
+
+// GetResolvedAddresses returns the addresses currently snooped for the DNS name and whether the last
+// snooped response for the DNS name succeeded.
+func (e *SnoopingEgressDNS) GetResolvedAddresses(dnsName string) ([]string, bool) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	entry, ok := e.dnsEntries[dnsName]
+	if !ok {
+		return nil, false
+	}
+	addresses := make([]string, 0, len(entry.addresses))
+	for address := range entry.addresses {
+		addresses = append(addresses, address)
+	}
+	sort.Strings(addresses)
+	return addresses, entry.resolved
+}
+
+// Run starts receiving the samples of the local node and a goroutine removing the snooped addresses
+// once they expire and refreshing the addresses of the resolvers.
+func (e *SnoopingEgressDNS) Run() error {
+	e.updateResolvers()
+	stopChan := make(chan struct{})
+	go func() {
+		select {
+		case <-e.stopChan:
+		case <-e.controllerStop:
+		}
+		close(stopChan)
+	}()
+	if err := e.receiveSamples(stopChan, e.handleSample); err != nil {
+		return fmt.Errorf("failed to receive DNS samples: %w", err)
+	}
+	go func() {
+		ticker := time.NewTicker(snoopedAddressExpiryInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case now := <-ticker.C:
+				e.removeExpiredAddresses(now)
+				e.updateResolvers()
+			case <-stopChan:
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+func (e *SnoopingEgressDNS) Shutdown() {
+	close(e.stopChan)
+}
+
+// DeleteStaleAddrSets deletes all the address sets related to EgressFirewall DNS rules which are not
+// referenced by any acl.
+func (e *SnoopingEgressDNS) DeleteStaleAddrSets(nbClient libovsdbclient.Client) error {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	predicateIDs := libovsdbops.NewDbObjectIDs(libovsdbops.AddressSetEgressFirewallDNS, e.controllerName, nil)
+	return libovsdbutil.DeleteAddrSetsWithoutACLRef(predicateIDs, nbClient)
+}
+
+// handleSample is called for every sample received on the local node. Only the samples generated by ACLs are
+// considered, as all the ACLs allowing the DNS traffic of the pods are expected to sample it.
+func (e *SnoopingEgressDNS) handleSample(obsDomainID, _ uint32, data []byte) {
+	if !observability.IsACLSample(obsDomainID) {
+		return
+	}
+	now := time.Now()
+	response := e.snooper.snoop(data, now)
+	if response == nil {
+		return
+	}
+	e.addResponse(response, now)
+}
+
+// addResponse adds the addresses of the snooped response to the address sets of the DNS names matching the
+// name of the response, directly or through a wildcard DNS name.
+func (e *SnoopingEgressDNS) addResponse(response *snoopedDNSResponse, now time.Time) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	for _, dnsName := range append([]string{response.name}, getWildcardDNSNames(response.name)...) {
+		entry, ok := e.dnsEntries[dnsName]
+		if !ok {
+			continue
+		}
+		// on failure, keep the addresses snooped previously until they expire
+		entry.resolved = !response.failed
+		changed := false
+		for address, ttl := range response.addresses {
+			expires := now.Add(ttl + snoopedAddressGracePeriod)
+			if current, ok := entry.addresses[address]; !ok || current.Before(expires) {
+				changed = changed || !ok
+				entry.addresses[address] = expires
+			}
+		}
+		if changed {
+			klog.V(5).Infof("Snooped addresses %v for DNS name %s", response.addresses, dnsName)
+			if err := e.updateAddressSet(dnsName, entry); err != nil {
+				utilruntime.HandleError(err)
+			}
+		}
+	}
+}
+
+// isResolver returns true if addr is a cluster IP or an endpoint address of the cluster DNS service.
+func (e *SnoopingEgressDNS) isResolver(addr netip.Addr) bool {
+	return e.resolvers.Load().Has(addr)
+}
+
+// updateResolvers refreshes the addresses of the cluster DNS service. The queries are sampled after load
+// balancing, while the responses may be sampled before or after their source is translated back to the
+// service address, so both the cluster IPs and the endpoint addresses are resolvers.
+func (e *SnoopingEgressDNS) updateResolvers() {
+	resolvers := sets.New[netip.Addr]()
+	service, err := e.serviceLister.Services(config.Kubernetes.DNSServiceNamespace).Get(config.Kubernetes.DNSServiceName)
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			klog.Errorf("Failed to get DNS service %s/%s: %v", config.Kubernetes.DNSServiceNamespace,
+				config.Kubernetes.DNSServiceName, err)
+			return
+		}
+		klog.V(5).Infof("DNS service %s/%s not found, no DNS response is snooped", config.Kubernetes.DNSServiceNamespace,
+			config.Kubernetes.DNSServiceName)
+	} else {
+		for _, clusterIP := range util.GetClusterIPs(service) {
+			if addr, err := netip.ParseAddr(clusterIP); err == nil {
+				resolvers.Insert(addr.Unmap())
+			}
+		}
+	}
+	endpointSlices, err := util.GetServiceEndpointSlices(config.Kubernetes.DNSServiceNamespace,
+		config.Kubernetes.DNSServiceName, types.DefaultNetworkName, e.endpointSliceLister)
+	if err != nil {
+		klog.Errorf("Failed to get the endpoint slices of DNS service %s/%s: %v", config.Kubernetes.DNSServiceNamespace,
+			config.Kubernetes.DNSServiceName, err)
+		return
+	}
+	for _, endpointSlice := range endpointSlices {
+		for _, endpoint := range endpointSlice.Endpoints {
+			for _, address := range endpoint.Addresses {
+				if addr, err := netip.ParseAddr(address); err == nil {
+					resolvers.Insert(addr.Unmap())
+				}
+			}
+		}
+	}
+	e.resolvers.Store(&resolvers)
+}
+
+func (e *SnoopingEgressDNS) removeExpiredAddresses(now time.Time) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	for dnsName, entry := range e.dnsEntries {
+		changed := false
+		for address, expires := range entry.addresses {
+			if now.After(expires) {
+				delete(entry.addresses, address)
+				changed = true
+			}
+		}
+		if changed {
+			if err := e.updateAddressSet(dnsName, entry); err != nil {
+				utilruntime.HandleError(err)
+			}
+		}
+	}
+}
+
+// updateAddressSet sets the addresses of the entry to its address set, e.lock must be held.
+func (e *SnoopingEgressDNS) updateAddressSet(dnsName string, entry *snoopedDNSEntry) error {
+	ips := make([]net.IP, 0, len(entry.addresses))
+	for address := range entry.addresses {
+		ips = append(ips, net.ParseIP(address))
+	}
+	if err := entry.dnsAddressSet.SetAddresses(util.StringSlice(filterClusterSubnetIPs(ips))); err != nil {
+		return fmt.Errorf("cannot set snooped IPs to EgressFirewall AddressSet %s: %v", dnsName, err)
+	}
+	return nil
+}
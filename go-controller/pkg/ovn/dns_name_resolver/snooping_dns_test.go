@@ -0,0 +1,222 @@
+package dnsnameresolver
+
+import (
+	"net"
+	"time"
+
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	"github.com/miekg/dns"
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	discoverylisters "k8s.io/client-go/listers/discovery/v1"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/observability"
+	addressset "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn/address_set"
+)
+
+const (
+	podIP        = "10.128.0.5"
+	dnsServerIP  = "10.128.1.10"
+	dnsServiceIP = "10.96.0.10"
+	podPort      = 40000
+)
+
+var aclSampleDomainID uint32 = observability.ACLEstTrafficSamplingID << 24
+
+func newDNSPacket(srcIP, dstIP string, srcPort, dstPort uint16, msg *dns.Msg) []byte {
+	payload, err := msg.Pack()
+	gomega.Expect(err).NotTo(gomega.HaveOccurred())
+	eth := &layers.Ethernet{
+		SrcMAC:       net.HardwareAddr{0x0a, 0x58, 0x0a, 0x80, 0x00, 0x05},
+		DstMAC:       net.HardwareAddr{0x0a, 0x58, 0x0a, 0x80, 0x00, 0x01},
+		EthernetType: layers.EthernetTypeIPv4,
+	}
+	ip := &layers.IPv4{
+		Version:  4,
+		TTL:      64,
+		Protocol: layers.IPProtocolUDP,
+		SrcIP:    net.ParseIP(srcIP).To4(),
+		DstIP:    net.ParseIP(dstIP).To4(),
+	}
+	udp := &layers.UDP{
+		SrcPort: layers.UDPPort(srcPort),
+		DstPort: layers.UDPPort(dstPort),
+	}
+	gomega.Expect(udp.SetNetworkLayerForChecksum(ip)).To(gomega.Succeed())
+	buf := gopacket.NewSerializeBuffer()
+	err = gopacket.SerializeLayers(buf, gopacket.SerializeOptions{FixLengths: true, ComputeChecksums: true},
+		eth, ip, udp, gopacket.Payload(payload))
+	gomega.Expect(err).NotTo(gomega.HaveOccurred())
+	return buf.Bytes()
+}
+
+func newDNSQuery(id uint16, name string) *dns.Msg {
+	msg := &dns.Msg{}
+	msg.SetQuestion(name, dns.TypeA)
+	msg.Id = id
+	return msg
+}
+
+func newDNSResponse(query *dns.Msg, ttl uint32, addresses ...string) *dns.Msg {
+	msg := &dns.Msg{}
+	msg.SetReply(query)
+	for _, address := range addresses {
+		msg.Answer = append(msg.Answer, &dns.A{
+			Hdr: dns.RR_Header{Name: query.Question[0].Name, Rrtype: dns.TypeA, Class: dns.ClassINET, Ttl: ttl},
+			A:   net.ParseIP(address),
+		})
+	}
+	return msg
+}
+
+var _ = ginkgo.Describe("Snooping Egress DNS", func() {
+	var (
+		snoopingDNS *SnoopingEgressDNS
+		handler     observability.SampleHandler
+	)
+
+	ginkgo.BeforeEach(func() {
+		gomega.Expect(config.PrepareTestConfig()).To(gomega.Succeed())
+		_, clusterSubnet, _ := net.ParseCIDR("10.128.0.0/14")
+		config.Default.ClusterSubnets = []config.CIDRNetworkEntry{{CIDR: clusterSubnet}}
+		serviceIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+		gomega.Expect(serviceIndexer.Add(&corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "kube-system", Name: "kube-dns"},
+			Spec:       corev1.ServiceSpec{ClusterIP: dnsServiceIP, ClusterIPs: []string{dnsServiceIP}},
+		})).To(gomega.Succeed())
+		endpointSliceIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+		gomega.Expect(endpointSliceIndexer.Add(&discoveryv1.EndpointSlice{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "kube-system",
+				Name:      "kube-dns-abcde",
+				Labels:    map[string]string{discoveryv1.LabelServiceName: "kube-dns"},
+			},
+			AddressType: discoveryv1.AddressTypeIPv4,
+			Endpoints:   []discoveryv1.Endpoint{{Addresses: []string{dnsServerIP}}},
+		})).To(gomega.Succeed())
+		snoopingDNS = NewSnoopingEgressDNS(addressset.NewFakeAddressSetFactory(DefaultNetworkControllerName),
+			DefaultNetworkControllerName, corev1listers.NewServiceLister(serviceIndexer),
+			discoverylisters.NewEndpointSliceLister(endpointSliceIndexer), nil)
+		snoopingDNS.receiveSamples = func(_ <-chan struct{}, h observability.SampleHandler) error {
+			handler = h
+			return nil
+		}
+		gomega.Expect(snoopingDNS.Run()).To(gomega.Succeed())
+	})
+
+	ginkgo.AfterEach(func() {
+		snoopingDNS.Shutdown()
+	})
+
+	// exchange sends the samples of a DNS query from the pod and of its response
+	exchange := func(query, response *dns.Msg) {
+		handler(aclSampleDomainID, 1, newDNSPacket(podIP, dnsServerIP, podPort, dnsPort, query))
+		handler(aclSampleDomainID, 1, newDNSPacket(dnsServerIP, podIP, dnsPort, podPort, response))
+	}
+
+	getAddresses := func(as addressset.AddressSet) []string {
+		v4, v6 := as.GetAddresses()
+		return append(v4, v6...)
+	}
+
+	ginkgo.It("adds the addresses of the responses to queries of the local pods", func() {
+		as, err := snoopingDNS.Add("namespace1", "www.example.com.")
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+		query := newDNSQuery(1, "WWW.example.com.")
+		exchange(query, newDNSResponse(query, 30, "1.1.1.1", "2.2.2.2", "10.128.2.2"))
+		gomega.Expect(getAddresses(as)).To(gomega.ConsistOf("1.1.1.1", "2.2.2.2"))
+		addresses, resolved := snoopingDNS.GetResolvedAddresses("www.example.com.")
+		gomega.Expect(addresses).To(gomega.Equal([]string{"1.1.1.1", "10.128.2.2", "2.2.2.2"}))
+		gomega.Expect(resolved).To(gomega.BeTrue())
+
+		ginkgo.By("keeping the addresses when the name fails to be resolved")
+		query = newDNSQuery(2, "www.example.com.")
+		response := newDNSResponse(query, 0)
+		response.Rcode = dns.RcodeServerFailure
+		exchange(query, response)
+		addresses, resolved = snoopingDNS.GetResolvedAddresses("www.example.com.")
+		gomega.Expect(addresses).To(gomega.HaveLen(3))
+		gomega.Expect(resolved).To(gomega.BeFalse())
+
+		ginkgo.By("removing the addresses once they expire")
+		query = newDNSQuery(3, "www.example.com.")
+		exchange(query, newDNSResponse(query, 3600, "2.2.2.2"))
+		snoopingDNS.removeExpiredAddresses(time.Now().Add(30*time.Second + snoopedAddressGracePeriod + time.Second))
+		gomega.Expect(getAddresses(as)).To(gomega.ConsistOf("2.2.2.2"))
+	})
+
+	ginkgo.It("ignores responses that don't match a query", func() {
+		as, err := snoopingDNS.Add("namespace1", "www.example.com.")
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+		query := newDNSQuery(1, "www.example.com.")
+		response := newDNSResponse(query, 30, "1.1.1.1")
+		ginkgo.By("sending a response without a query")
+		handler(aclSampleDomainID, 1, newDNSPacket(dnsServerIP, podIP, dnsPort, podPort, response))
+		ginkgo.By("sending a response with a different ID")
+		handler(aclSampleDomainID, 1, newDNSPacket(podIP, dnsServerIP, podPort, dnsPort, newDNSQuery(2, "www.example.com.")))
+		handler(aclSampleDomainID, 1, newDNSPacket(dnsServerIP, podIP, dnsPort, podPort, response))
+		ginkgo.By("sending samples that were not generated by an ACL")
+		handler(observability.DropSamplingID<<24, 1, newDNSPacket(podIP, dnsServerIP, podPort, dnsPort, query))
+		handler(observability.DropSamplingID<<24, 1, newDNSPacket(dnsServerIP, podIP, dnsPort, podPort, response))
+		gomega.Expect(getAddresses(as)).To(gomega.BeEmpty())
+
+		ginkgo.By("sending a response to a different question")
+		query = newDNSQuery(3, "www.example.com.")
+		exchange(query, newDNSResponse(newDNSQuery(3, "evil.example.com."), 30, "1.1.1.1"))
+		gomega.Expect(getAddresses(as)).To(gomega.BeEmpty())
+	})
+
+	ginkgo.It("only considers the DNS traffic exchanged with the cluster DNS service", func() {
+		as, err := snoopingDNS.Add("namespace1", "www.example.com.")
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+		ginkgo.By("sending a query to and a response from a pod that is not a resolver")
+		const evilServerIP = "10.128.1.66"
+		query := newDNSQuery(1, "www.example.com.")
+		handler(aclSampleDomainID, 1, newDNSPacket(podIP, evilServerIP, podPort, dnsPort, query))
+		handler(aclSampleDomainID, 1, newDNSPacket(evilServerIP, podIP, dnsPort, podPort, newDNSResponse(query, 30, "6.6.6.6")))
+		gomega.Expect(getAddresses(as)).To(gomega.BeEmpty())
+
+		ginkgo.By("sending a response from a pod that is not a resolver to a query sent to a resolver")
+		query = newDNSQuery(2, "www.example.com.")
+		handler(aclSampleDomainID, 1, newDNSPacket(podIP, dnsServerIP, podPort, dnsPort, query))
+		handler(aclSampleDomainID, 1, newDNSPacket(evilServerIP, podIP, dnsPort, podPort, newDNSResponse(query, 30, "6.6.6.6")))
+		gomega.Expect(getAddresses(as)).To(gomega.BeEmpty())
+
+		ginkgo.By("sending a response from the service address to a query sent to an endpoint")
+		handler(aclSampleDomainID, 1, newDNSPacket(dnsServiceIP, podIP, dnsPort, podPort, newDNSResponse(query, 30, "1.1.1.1")))
+		gomega.Expect(getAddresses(as)).To(gomega.ConsistOf("1.1.1.1"))
+	})
+
+	ginkgo.It("adds the addresses of the names matching a wildcard DNS name at any depth", func() {
+		as, err := snoopingDNS.Add("namespace1", "*.example.com.")
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+		query := newDNSQuery(1, "www.example.com.")
+		exchange(query, newDNSResponse(query, 30, "1.1.1.1"))
+		query = newDNSQuery(2, "api.example.com.")
+		exchange(query, newDNSResponse(query, 30, "2.2.2.2"))
+		query = newDNSQuery(3, "www.sub.example.com.")
+		exchange(query, newDNSResponse(query, 30, "3.3.3.3"))
+		query = newDNSQuery(4, "example.com.")
+		exchange(query, newDNSResponse(query, 30, "4.4.4.4"))
+		query = newDNSQuery(5, "www.example.org.")
+		exchange(query, newDNSResponse(query, 30, "5.5.5.5"))
+		gomega.Expect(getAddresses(as)).To(gomega.ConsistOf("1.1.1.1", "2.2.2.2", "3.3.3.3"))
+
+		ginkgo.By("deleting the address set when no namespace uses the DNS name")
+		gomega.Expect(snoopingDNS.Delete("namespace1")).To(gomega.Succeed())
+		addresses, _ := snoopingDNS.GetResolvedAddresses("*.example.com.")
+		gomega.Expect(addresses).To(gomega.BeNil())
+	})
+})
@@ -0,0 +1,75 @@
+package ovn
+
+import (
+	"fmt"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
+	libovsdbops "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
+	libovsdbutil "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/util"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
+)
+
+const (
+	dnsSnoopingACLName  = "dns-queries"
+	dnsSnoopingACLMatch = "udp.dst == 53"
+)
+
+// useDNSSnooping returns true if the DNS names used in egress firewall rules are resolved by snooping the DNS
+// traffic of the local pods. Snooping relies on the ACL samples of the zone, so it is only available for the
+// network controller that has observability enabled, when the samples of the zone are received locally. The
+// other network controllers, e.g. the ones of the user defined networks, poll the DNS names instead.
+func (oc *BaseNetworkController) useDNSSnooping() bool {
+	return config.OVNKubernetesFeature.EnableDNSSnooping && oc.getSampleCounter() != nil
+}
+
+// isWildcardDNSNameSupported returns true if the DNS name resolver of the network controller resolves wildcard
+// DNS names, i.e. it uses the DNSNameResolver resources or DNS snooping.
+func (oc *BaseNetworkController) isWildcardDNSNameSupported() bool {
+	return config.OVNKubernetesFeature.EnableDNSNameResolver || oc.useDNSSnooping()
+}
+
+func (oc *BaseNetworkController) getDNSSnoopingACLDbIDs() *libovsdbops.DbObjectIDs {
+	return libovsdbops.NewDbObjectIDs(libovsdbops.ACLDNSSnooping, oc.controllerName,
+		map[libovsdbops.ExternalIDKey]string{
+			libovsdbops.ObjectNameKey: dnsSnoopingACLName,
+		})
+}
+
+// syncDNSSnoopingACL creates the DNS snooping ACL if DNS snooping is used, and deletes it otherwise.
+// DNS traffic allowed by other ACLs is sampled by those ACLs. The DNS snooping ACL allows and samples the DNS
+// queries that are not allowed or denied by any other ACL: it has the lowest priority of the last tier,
+// so it doesn't change which traffic is allowed.
+func (oc *BaseNetworkController) syncDNSSnoopingACL() error {
+	clusterPortGroupName := oc.getClusterPortGroupName(types.ClusterPortGroupNameBase)
+	if !oc.useDNSSnooping() {
+		predicateIDs := libovsdbops.NewDbObjectIDs(libovsdbops.ACLDNSSnooping, oc.controllerName, nil)
+		aclPredicate := libovsdbops.GetPredicate[*nbdb.ACL](predicateIDs, nil)
+		acls, err := libovsdbops.FindACLsWithPredicate(oc.nbClient, aclPredicate)
+		if err != nil {
+			return fmt.Errorf("failed to find DNS snooping ACLs: %w", err)
+		}
+		if len(acls) == 0 {
+			return nil
+		}
+		if err = libovsdbops.DeleteACLsFromPortGroups(oc.nbClient, []string{clusterPortGroupName}, acls...); err != nil {
+			return fmt.Errorf("failed to delete DNS snooping ACLs: %w", err)
+		}
+		return nil
+	}
+
+	acl := libovsdbutil.BuildACL(oc.getDNSSnoopingACLDbIDs(), types.DNSSnoopingAllowPriority, dnsSnoopingACLMatch,
+		nbdb.ACLActionAllowRelated, nil, libovsdbutil.LportEgressAfterLB, types.DefaultBANPACLTier)
+	ops, err := libovsdbops.CreateOrUpdateACLsOps(oc.nbClient, nil, oc.GetSamplingConfig(), acl)
+	if err != nil {
+		return fmt.Errorf("failed to create DNS snooping ACL: %w", err)
+	}
+	ops, err = libovsdbops.AddACLsToPortGroupOps(oc.nbClient, ops, clusterPortGroupName, acl)
+	if err != nil {
+		return fmt.Errorf("failed to add DNS snooping ACL to port group %s: %w", clusterPortGroupName, err)
+	}
+	if _, err = libovsdbops.TransactAndCheck(oc.nbClient, ops); err != nil {
+		return fmt.Errorf("failed to create DNS snooping ACL: %w", err)
+	}
+	return nil
+}
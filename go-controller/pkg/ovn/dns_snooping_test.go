@@ -0,0 +1,140 @@
+package ovn
+
+import (
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
+	egressfirewallapi "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressfirewall/v1"
+	libovsdbops "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/observability"
+	dnsnameresolver "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn/dns_name_resolver"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/testing/libovsdb"
+	t "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
+)
+
+// resolvedDNSNameResolver is a DNS name resolver that resolves every DNS name to the same addresses.
+type resolvedDNSNameResolver struct {
+	dnsnameresolver.DNSNameResolver
+	addresses []string
+}
+
+func (r *resolvedDNSNameResolver) GetResolvedAddresses(string) ([]string, bool) {
+	return r.addresses, true
+}
+
+var _ = ginkgo.Describe("OVN DNS snooping", func() {
+	var fakeOVN *FakeOVN
+
+	ginkgo.BeforeEach(func() {
+		gomega.Expect(config.PrepareTestConfig()).To(gomega.Succeed())
+		config.OVNKubernetesFeature.EnableEgressFirewall = true
+		config.OVNKubernetesFeature.EnableObservability = true
+		config.OVNKubernetesFeature.EnableDNSSnooping = true
+		fakeOVN = NewFakeOVN(false)
+	})
+
+	ginkgo.AfterEach(func() {
+		fakeOVN.shutdown()
+	})
+
+	getDNSSnoopingACLs := func() []*nbdb.ACL {
+		aclPredicate := libovsdbops.GetPredicate[*nbdb.ACL](fakeOVN.controller.getDNSSnoopingACLDbIDs(), nil)
+		acls, err := libovsdbops.FindACLsWithPredicate(fakeOVN.nbClient, aclPredicate)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		return acls
+	}
+
+	ginkgo.It("creates a sampled ACL for the DNS queries and deletes it when DNS snooping is disabled", func() {
+		fakeOVN.startWithDBSetup(libovsdb.TestSetup{NBData: []libovsdb.TestData{newClusterPortGroup()}})
+		fakeOVN.controller.observManager = observability.NewManager(fakeOVN.nbClient)
+		gomega.Expect(fakeOVN.controller.observManager.Init()).To(gomega.Succeed())
+		fakeOVN.controller.observManager.SetSampleCounter(observability.NewSampleCounter())
+
+		gomega.Expect(fakeOVN.controller.syncDNSSnoopingACL()).To(gomega.Succeed())
+		acls := getDNSSnoopingACLs()
+		gomega.Expect(acls).To(gomega.HaveLen(1))
+		acl := acls[0]
+		gomega.Expect(acl.Match).To(gomega.Equal("udp.dst == 53"))
+		gomega.Expect(acl.Action).To(gomega.Equal(nbdb.ACLActionAllowRelated))
+		gomega.Expect(acl.Direction).To(gomega.Equal(nbdb.ACLDirectionFromLport))
+		gomega.Expect(acl.Options).To(gomega.HaveKeyWithValue("apply-after-lb", "true"))
+		gomega.Expect(acl.Tier).To(gomega.Equal(t.DefaultBANPACLTier))
+		gomega.Expect(acl.Priority).To(gomega.Equal(t.DNSSnoopingAllowPriority))
+		gomega.Expect(acl.SampleNew).NotTo(gomega.BeNil())
+		gomega.Expect(acl.SampleEst).NotTo(gomega.BeNil())
+		clusterPG, err := libovsdbops.GetPortGroup(fakeOVN.nbClient,
+			&nbdb.PortGroup{Name: fakeOVN.controller.getClusterPortGroupName(t.ClusterPortGroupNameBase)})
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(clusterPG.ACLs).To(gomega.ContainElement(acl.UUID))
+
+		config.OVNKubernetesFeature.EnableDNSSnooping = false
+		gomega.Expect(fakeOVN.controller.syncDNSSnoopingACL()).To(gomega.Succeed())
+		gomega.Expect(getDNSSnoopingACLs()).To(gomega.BeEmpty())
+	})
+
+	ginkgo.It("polls the DNS names and rejects wildcard DNS names when the samples of the zone are not received", func() {
+		fakeOVN.startWithDBSetup(libovsdb.TestSetup{NBData: []libovsdb.TestData{newClusterPortGroup()}})
+		// user defined network controllers have no observability manager
+		gomega.Expect(fakeOVN.controller.useDNSSnooping()).To(gomega.BeFalse())
+		fakeOVN.controller.observManager = observability.NewManager(fakeOVN.nbClient)
+		gomega.Expect(fakeOVN.controller.observManager.Init()).To(gomega.Succeed())
+		// the samples are not received until the sample counter is started
+		gomega.Expect(fakeOVN.controller.useDNSSnooping()).To(gomega.BeFalse())
+
+		_, err := fakeOVN.controller.newEgressFirewallRule(egressfirewallapi.EgressFirewallRule{
+			Type: egressfirewallapi.EgressFirewallRuleAllow,
+			To:   egressfirewallapi.EgressFirewallDestination{DNSName: "*.example.com"},
+		}, 0)
+		gomega.Expect(err).To(gomega.MatchError(gomega.ContainSubstring("wildcard dns name is not supported")))
+		_, err = fakeOVN.controller.newEgressFirewallRule(egressfirewallapi.EgressFirewallRule{
+			Type: egressfirewallapi.EgressFirewallRuleAllow,
+			To:   egressfirewallapi.EgressFirewallDestination{DNSName: "www.example.com"},
+		}, 0)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+		fakeOVN.controller.observManager.SetSampleCounter(observability.NewSampleCounter())
+		gomega.Expect(fakeOVN.controller.useDNSSnooping()).To(gomega.BeTrue())
+		_, err = fakeOVN.controller.newEgressFirewallRule(egressfirewallapi.EgressFirewallRule{
+			Type: egressfirewallapi.EgressFirewallRuleAllow,
+			To:   egressfirewallapi.EgressFirewallDestination{DNSName: "*.example.com"},
+		}, 0)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+	})
+
+	ginkgo.It("reports the DNS names that are polled in the status of the rules", func() {
+		const dnsName = "www.example.com"
+		fakeOVN.startWithDBSetup(libovsdb.TestSetup{NBData: []libovsdb.TestData{newClusterPortGroup()}})
+		fakeOVN.controller.dnsNameResolver = &resolvedDNSNameResolver{addresses: []string{"1.1.1.1"}}
+		rule, err := fakeOVN.controller.newEgressFirewallRule(egressfirewallapi.EgressFirewallRule{
+			Type: egressfirewallapi.EgressFirewallRuleAllow,
+			To:   egressfirewallapi.EgressFirewallDestination{DNSName: dnsName},
+		}, 0)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		efObj := newEgressFirewallObject("default", "namespace1", nil)
+		fakeOVN.controller.egressFirewalls.Store(efObj.Namespace, &egressFirewall{
+			name:        efObj.Name,
+			namespace:   efObj.Namespace,
+			egressRules: []*egressFirewallRule{rule},
+		})
+		pollingMsg := "DNS name " + dnsName + " is resolved by polling, DNS snooping is not supported on network " +
+			fakeOVN.controller.GetNetworkName()
+
+		ruleStatuses := fakeOVN.controller.getEgressFirewallRuleStatuses(efObj, nil)
+		gomega.Expect(ruleStatuses).To(gomega.Equal([]egressfirewallapi.EgressFirewallRuleStatus{{
+			Zone:              fakeOVN.controller.zone,
+			Index:             0,
+			State:             egressfirewallapi.EgressFirewallRuleApplied,
+			Message:           pollingMsg,
+			ResolvedAddresses: []string{"1.1.1.1"},
+		}}))
+
+		fakeOVN.controller.observManager = observability.NewManager(fakeOVN.nbClient)
+		gomega.Expect(fakeOVN.controller.observManager.Init()).To(gomega.Succeed())
+		fakeOVN.controller.observManager.SetSampleCounter(observability.NewSampleCounter())
+		ruleStatuses = fakeOVN.controller.getEgressFirewallRuleStatuses(efObj, nil)
+		gomega.Expect(ruleStatuses).To(gomega.HaveLen(1))
+		gomega.Expect(ruleStatuses[0].Message).To(gomega.BeEmpty())
+	})
+})
@@ -3,6 +3,7 @@ package ovn
 import (
 	"context"
 	"fmt"
+	"net"
 	"reflect"
 	"slices"
 	"strconv"
@@ -15,10 +16,12 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
 	coreinformers "k8s.io/client-go/informers/core/v1"
 	"k8s.io/client-go/util/workqueue"
 	"k8s.io/klog/v2"
 	utilnet "k8s.io/utils/net"
+	"k8s.io/utils/ptr"
 
 	"github.com/ovn-kubernetes/libovsdb/ovsdb"
 
@@ -30,6 +33,8 @@ import (
 	libovsdbutil "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/util"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/metrics"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/observability"
+	dnsnameresolver "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn/dns_name_resolver"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util/batching"
@@ -39,6 +44,11 @@ import (
 const (
 	egressFirewallAppliedCorrectly = "EgressFirewall Rules applied"
 	aclDeleteBatchSize             = 1000
+	// egressFirewallRuleStatusSyncInterval is the interval the state of the egress firewall rules is refreshed
+	// at, to report DNS resolution changes and hits.
+	egressFirewallRuleStatusSyncInterval = time.Minute
+	// egressFirewallKind is the kind label of the hits metric of the egress firewall rules
+	egressFirewallKind = "EgressFirewall"
 	// transaction time to delete 80K ACLs from 2 port groups is ~4.5 sec.
 	// transaction time to delete 80K acls from one port group and add them to another
 	// is ~3 sec
@@ -58,6 +68,23 @@ type egressFirewallRule struct {
 	access egressfirewallapi.EgressFirewallRuleType
 	ports  []egressfirewallapi.EgressFirewallPort
 	to     destination
+	// reportedHits is the number of sampled packets matching the rule last reported in the hits metric and in
+	// the status of the rule.
+	reportedHits uint64
+}
+
+// egressFirewallRuleError is the error of an egress firewall rule that can't be applied.
+type egressFirewallRuleError struct {
+	ruleIdx int
+	err     error
+}
+
+func (e *egressFirewallRuleError) Error() string {
+	return e.err.Error()
+}
+
+func (e *egressFirewallRuleError) Unwrap() error {
+	return e.err
 }
 
 type destination struct {
@@ -86,7 +113,7 @@ func cloneEgressFirewall(originalEgressfirewall *egressfirewallapi.EgressFirewal
 
 // newEgressFirewallRule creates a new egressFirewallRule. For the logging level, it will pick either of
 // aclLoggingAllow or aclLoggingDeny depending if this is an allow or deny rule.
-func (oc *DefaultNetworkController) newEgressFirewallRule(rawEgressFirewallRule egressfirewallapi.EgressFirewallRule, id int) (*egressFirewallRule, error) {
+func (oc *BaseNetworkController) newEgressFirewallRule(rawEgressFirewallRule egressfirewallapi.EgressFirewallRule, id int) (*egressFirewallRule, error) {
 	efr := &egressFirewallRule{
 		id:     id,
 		access: rawEgressFirewallRule.Type,
@@ -96,10 +123,16 @@ func (oc *DefaultNetworkController) newEgressFirewallRule(rawEgressFirewallRule
 	// fields of efr.
 	var err error
 	efr.to.cidrSelector, efr.to.dnsName, efr.to.clusterSubnetIntersection, efr.to.nodeSelector, err =
-		util.ValidateAndGetEgressFirewallDestination(rawEgressFirewallRule.To)
+		util.ValidateAndGetEgressFirewallDestination(rawEgressFirewallRule.To, oc.Subnets())
 	if err != nil {
 		return efr, err
 	}
+	// DNS snooping may be enabled while this network controller polls the DNS names, which doesn't support
+	// wildcard DNS names.
+	if util.IsWildcard(efr.to.dnsName) && !oc.isWildcardDNSNameSupported() {
+		return efr, fmt.Errorf("wildcard dns name is not supported as rule destination on network %s, %s",
+			oc.GetNetworkName(), efr.to.dnsName)
+	}
 	// If nodeSelector is set then fetch the node addresses.
 	if efr.to.nodeSelector != nil {
 		efr.to.nodeAddrs = map[string][]string{}
@@ -115,6 +148,9 @@ func (oc *DefaultNetworkController) newEgressFirewallRule(rawEgressFirewallRule
 			efr.to.nodeAddrs[node.Name] = hostAddresses
 		}
 	}
+	if err := validateEgressFirewallPorts(rawEgressFirewallRule.Ports); err != nil {
+		return efr, err
+	}
 	efr.ports = rawEgressFirewallRule.Ports
 
 	return efr, nil
@@ -124,10 +160,15 @@ func (oc *DefaultNetworkController) newEgressFirewallRule(rawEgressFirewallRule
 // stale db entries for Egress Firewalls that don't exist anymore.
 // Egress firewall implementation had many versions, the latest one makes no difference for gateway modes, and creates
 // ACLs on namespaced port groups.
-func (oc *DefaultNetworkController) syncEgressFirewall(egressFirewalls []interface{}) error {
-	err := oc.deleteStaleACLs()
-	if err != nil {
-		return err
+// Egress firewalls of namespaces that are not served by this controller's network are considered stale.
+func (oc *BaseNetworkController) syncEgressFirewall(egressFirewalls []interface{}) error {
+	var err error
+	if oc.IsDefault() {
+		// previous implementations only existed for the default network
+		err = oc.deleteStaleACLs()
+		if err != nil {
+			return err
+		}
 	}
 
 	existingEFNamespaces := map[string]bool{}
@@ -136,7 +177,16 @@ func (oc *DefaultNetworkController) syncEgressFirewall(egressFirewalls []interfa
 		if !ok {
 			return fmt.Errorf("spurious object in syncEgressFirewall: %v", efInterface)
 		}
-		existingEFNamespaces[ef.Namespace] = true
+		served, err := oc.isEgressFirewallNamespaceServed(ef.Namespace)
+		if err != nil {
+			// keep the ACLs around, the add handler will retry and figure out the right network
+			klog.Warningf("Unable to determine the network of egress firewall %s: %v",
+				getEgressFirewallNamespacedName(ef), err)
+			served = true
+		}
+		if served {
+			existingEFNamespaces[ef.Namespace] = true
+		}
 	}
 
 	// find all existing egress firewall ACLs
@@ -147,10 +197,12 @@ func (oc *DefaultNetworkController) syncEgressFirewall(egressFirewalls []interfa
 		return fmt.Errorf("cannot find Egress Firewall ACLs: %v", err)
 	}
 
-	// another sync to move ACLs to the right port group
-	err = oc.moveACLsToNamespacedPortGroups(existingEFNamespaces, efACLs)
-	if err != nil {
-		return err
+	if oc.IsDefault() {
+		// another sync to move ACLs to the right port group
+		err = oc.moveACLsToNamespacedPortGroups(existingEFNamespaces, efACLs)
+		if err != nil {
+			return err
+		}
 	}
 
 	var deletedNSACLs = map[string][]*nbdb.ACL{}
@@ -193,7 +245,7 @@ func (oc *DefaultNetworkController) syncEgressFirewall(egressFirewalls []interfa
 //     For this it just deletes all LRP setup done for egress firewall
 //   - Cleanup the old implementation (using ACLs on the join and node switches)
 //     For this it deletes all the ACLs on the join and node switches, they will be created from scratch later.
-func (oc *DefaultNetworkController) deleteStaleACLs() error {
+func (oc *BaseNetworkController) deleteStaleACLs() error {
 	// In any gateway mode, make sure to delete all LRPs on ovn_cluster_router.
 	p := func(item *nbdb.LogicalRouterPolicy) bool {
 		return item.Priority <= types.EgressFirewallStartPriority && item.Priority >= types.MinimumReservedEgressFirewallPriority
@@ -233,7 +285,7 @@ func (oc *DefaultNetworkController) deleteStaleACLs() error {
 
 // moveACLsToNamespacedPortGroups syncs db from the previous version where all ACLs were attached to the ClusterPortGroup
 // to the new version where ACLs are attached to the namespace port groups.
-func (oc *DefaultNetworkController) moveACLsToNamespacedPortGroups(existingEFNamespaces map[string]bool, efACLs []*nbdb.ACL) error {
+func (oc *BaseNetworkController) moveACLsToNamespacedPortGroups(existingEFNamespaces map[string]bool, efACLs []*nbdb.ACL) error {
 	// find stale ACLs attached to a cluster port group, and move them to namespaced port groups
 	clusterPG, err := libovsdbops.GetPortGroup(oc.nbClient, &nbdb.PortGroup{
 		Name: oc.getClusterPortGroupName(types.ClusterPortGroupNameBase),
@@ -287,8 +339,109 @@ func (oc *DefaultNetworkController) moveACLsToNamespacedPortGroups(existingEFNam
 	return err
 }
 
-func (oc *DefaultNetworkController) addEgressFirewall(egressFirewall *egressfirewallapi.EgressFirewall) error {
-	klog.Infof("Adding egressFirewall %s in namespace %s", egressFirewall.Name, egressFirewall.Namespace)
+// startEgressFirewall initializes the DNS name resolver used by egress firewall DNS rules, starts watching
+// EgressFirewall and ClusterEgressFirewall resources and the controllers updating rules that select nodes
+// and the namespaces selected by cluster egress firewalls.
+func (oc *BaseNetworkController) startEgressFirewall() error {
+	var err error
+	// If DNSNameResolver is enabled, then initialize dnsNameResolver to ExternalEgressDNS
+	// for maintaining the address sets corresponding to the DNS names and start watching
+	// DNSNameResolver resources. If DNS snooping is used, initialize dnsNameResolver to
+	// SnoopingEgressDNS. Otherwise initialize dnsNameResolver to EgressDNS.
+	if config.OVNKubernetesFeature.EnableDNSNameResolver {
+		oc.dnsNameResolver, err = dnsnameresolver.NewExternalEgressDNS(oc.addressSetFactory, oc.controllerName, true,
+			oc.watchFactory.DNSNameResolverInformer().Informer(), oc.watchFactory.EgressFirewallInformer().Lister())
+	} else if oc.useDNSSnooping() {
+		oc.dnsNameResolver = dnsnameresolver.NewSnoopingEgressDNS(oc.addressSetFactory, oc.controllerName,
+			oc.watchFactory.ServiceCoreInformer().Lister(), oc.watchFactory.EndpointSliceCoreInformer().Lister(), oc.stopChan)
+	} else {
+		oc.dnsNameResolver, err = dnsnameresolver.NewEgressDNS(oc.addressSetFactory, oc.controllerName, oc.stopChan, egressFirewallDNSDefaultDuration)
+	}
+	if err != nil {
+		return err
+	}
+	err = oc.dnsNameResolver.Run()
+	if err != nil {
+		return err
+	}
+	if oc.IsDefault() {
+		if err = oc.syncDNSSnoopingACL(); err != nil {
+			return err
+		}
+	}
+	resourceName := "egress firewall"
+	if !oc.IsDefault() {
+		resourceName = "egress firewall_" + oc.GetNetworkName()
+	}
+	err = WithSyncDurationMetric(resourceName, oc.WatchEgressFirewall)
+	if err != nil {
+		return err
+	}
+	resourceName = "cluster egress firewall"
+	if !oc.IsDefault() {
+		resourceName = "cluster egress firewall_" + oc.GetNetworkName()
+	}
+	err = WithSyncDurationMetric(resourceName, oc.WatchClusterEgressFirewall)
+	if err != nil {
+		return err
+	}
+	oc.efNodeController = oc.newEFNodeController(oc.watchFactory.NodeCoreInformer())
+	oc.cefNamespaceController = oc.newCEFNamespaceController(oc.watchFactory.NamespaceCoreInformer())
+	if err = controller.Start(oc.efNodeController, oc.cefNamespaceController); err != nil {
+		return err
+	}
+	go wait.Until(oc.syncEgressFirewallRuleStatuses, egressFirewallRuleStatusSyncInterval, oc.stopChan)
+	return nil
+}
+
+// stopEgressFirewall stops the egress firewall handlers started by startEgressFirewall.
+func (oc *BaseNetworkController) stopEgressFirewall() {
+	if oc.egressFirewallHandler != nil {
+		oc.watchFactory.RemoveEgressFirewallHandler(oc.egressFirewallHandler)
+		oc.egressFirewallHandler = nil
+	}
+	if oc.clusterEgressFirewallHandler != nil {
+		oc.watchFactory.RemoveClusterEgressFirewallHandler(oc.clusterEgressFirewallHandler)
+		oc.clusterEgressFirewallHandler = nil
+	}
+	if oc.dnsNameResolver != nil {
+		oc.dnsNameResolver.Shutdown()
+	}
+	if oc.efNodeController != nil {
+		controller.Stop(oc.efNodeController)
+	}
+	if oc.cefNamespaceController != nil {
+		controller.Stop(oc.cefNamespaceController)
+	}
+}
+
+// WatchEgressFirewall starts the watching of egressfirewall resource and calls
+// back the appropriate handler logic
+func (oc *BaseNetworkController) WatchEgressFirewall() error {
+	if oc.egressFirewallHandler != nil {
+		return nil
+	}
+	handler, err := oc.retryEgressFirewalls.WatchResource()
+	if err != nil {
+		return err
+	}
+	oc.egressFirewallHandler = handler
+	return nil
+}
+
+// isEgressFirewallNamespaceServed returns true if the primary network of the given namespace is the network
+// of this controller, and therefore egress firewalls in that namespace have to be rendered on its topology.
+func (oc *BaseNetworkController) isEgressFirewallNamespaceServed(namespace string) (bool, error) {
+	netInfo, err := oc.networkManager.GetActiveNetworkForNamespace(namespace)
+	if err != nil {
+		return false, fmt.Errorf("could not get active network for namespace %s: %w", namespace, err)
+	}
+	return oc.GetNetworkName() == netInfo.GetNetworkName(), nil
+}
+
+func (oc *BaseNetworkController) addEgressFirewall(egressFirewall *egressfirewallapi.EgressFirewall) error {
+	klog.Infof("Adding egressFirewall %s in namespace %s for network %s", egressFirewall.Name,
+		egressFirewall.Namespace, oc.GetNetworkName())
 
 	ef := cloneEgressFirewall(egressFirewall)
 	ef.Lock()
@@ -306,14 +459,16 @@ func (oc *DefaultNetworkController) addEgressFirewall(egressFirewall *egressfire
 	for i, egressFirewallRule := range egressFirewall.Spec.Egress {
 		// process Rules into egressFirewallRules for egressFirewall struct
 		if i > types.EgressFirewallStartPriority-types.MinimumReservedEgressFirewallPriority {
-			errorList = append(errorList, fmt.Errorf("egressFirewall for namespace %s has too many rules, max allowed number is %v",
-				egressFirewall.Namespace, types.EgressFirewallStartPriority-types.MinimumReservedEgressFirewallPriority))
+			errorList = append(errorList, &egressFirewallRuleError{ruleIdx: i,
+				err: fmt.Errorf("egressFirewall for namespace %s has too many rules, max allowed number is %v",
+					egressFirewall.Namespace, types.EgressFirewallStartPriority-types.MinimumReservedEgressFirewallPriority)})
 			break
 		}
 		efr, err := oc.newEgressFirewallRule(egressFirewallRule, i)
 		if err != nil {
-			errorList = append(errorList, fmt.Errorf("cannot create EgressFirewall Rule to destination %s for namespace %s: %w",
-				egressFirewallRule.To.CIDRSelector, egressFirewall.Namespace, err))
+			errorList = append(errorList, &egressFirewallRuleError{ruleIdx: i,
+				err: fmt.Errorf("cannot create EgressFirewall Rule to destination %s for namespace %s: %w",
+					egressFirewallRule.To.CIDRSelector, egressFirewall.Namespace, err)})
 			continue
 
 		}
@@ -334,8 +489,9 @@ func (oc *DefaultNetworkController) addEgressFirewall(egressFirewall *egressfire
 	return nil
 }
 
-func (oc *DefaultNetworkController) deleteEgressFirewall(egressFirewallObj *egressfirewallapi.EgressFirewall) error {
-	klog.Infof("Deleting egress Firewall %s in namespace %s", egressFirewallObj.Name, egressFirewallObj.Namespace)
+func (oc *BaseNetworkController) deleteEgressFirewall(egressFirewallObj *egressfirewallapi.EgressFirewall) error {
+	klog.Infof("Deleting egress Firewall %s in namespace %s for network %s", egressFirewallObj.Name,
+		egressFirewallObj.Namespace, oc.GetNetworkName())
 	deleteDNS := false
 	obj, loaded := oc.egressFirewalls.Load(egressFirewallObj.Namespace)
 	if !loaded {
@@ -367,10 +523,12 @@ func (oc *DefaultNetworkController) deleteEgressFirewall(egressFirewallObj *egre
 		}
 	}
 	oc.egressFirewalls.Delete(egressFirewallObj.Namespace)
+	metrics.DeleteEgressFirewallRuleHits(oc.GetNetworkName(), egressFirewallKind, egressFirewallObj.Namespace,
+		egressFirewallObj.Name)
 	return nil
 }
 
-func (oc *DefaultNetworkController) addEgressFirewallRules(ef *egressFirewall, pgName string,
+func (oc *BaseNetworkController) addEgressFirewallRules(ef *egressFirewall, pgName string,
 	aclLogging *libovsdbutil.ACLLoggingLevels, ruleIDs ...int) error {
 	var ops []ovsdb.Operation
 	var err error
@@ -388,56 +546,10 @@ func (oc *DefaultNetworkController) addEgressFirewallRules(ef *egressFirewall, p
 				continue
 			}
 		}
-		var action string
-		var matchTargets []matchTarget
-		if rule.access == egressfirewallapi.EgressFirewallRuleAllow {
-			action = nbdb.ACLActionAllow
-		} else {
-			action = nbdb.ACLActionDrop
-		}
-		if len(rule.to.nodeAddrs) > 0 {
-			// sort node ips to ensure the same order when no changes are present
-			// this ensure ACL recalculation won't happen just because of the order changes
-			allIPs := []string{}
-			for _, nodeIPs := range rule.to.nodeAddrs {
-				allIPs = append(allIPs, nodeIPs...)
-			}
-			slices.Sort(allIPs)
-
-			for _, addr := range allIPs {
-				if utilnet.IsIPv6String(addr) {
-					matchTargets = append(matchTargets, matchTarget{matchKindV6CIDR, addr, false})
-				} else {
-					matchTargets = append(matchTargets, matchTarget{matchKindV4CIDR, addr, false})
-				}
-			}
-		} else if rule.to.cidrSelector != "" {
-			if utilnet.IsIPv6CIDRString(rule.to.cidrSelector) {
-				matchTargets = []matchTarget{{matchKindV6CIDR, rule.to.cidrSelector, rule.to.clusterSubnetIntersection}}
-			} else {
-				matchTargets = []matchTarget{{matchKindV4CIDR, rule.to.cidrSelector, rule.to.clusterSubnetIntersection}}
-			}
-		} else if len(rule.to.dnsName) > 0 {
-			// rule based on DNS NAME
-			dnsName := rule.to.dnsName
-			// If DNSNameResolver is enabled, then use the egressFirewallExternalDNS to get the address
-			// set corresponding to the DNS name, otherwise use the egressFirewallDNS
-			// to get the address set.
-			if config.OVNKubernetesFeature.EnableDNSNameResolver {
-				// Convert the DNS name to lower case fully qualified domain name.
-				dnsName = util.LowerCaseFQDN(rule.to.dnsName)
-			}
-			dnsNameAddressSets, err := oc.dnsNameResolver.Add(ef.namespace, dnsName)
-			if err != nil {
-				return fmt.Errorf("error with DNSNameResolver - %v", err)
-			}
-			dnsNameIPv4ASHashName, dnsNameIPv6ASHashName := dnsNameAddressSets.GetASHashNames()
-			if dnsNameIPv4ASHashName != "" {
-				matchTargets = append(matchTargets, matchTarget{matchKindV4AddressSet, dnsNameIPv4ASHashName, rule.to.clusterSubnetIntersection})
-			}
-			if dnsNameIPv6ASHashName != "" {
-				matchTargets = append(matchTargets, matchTarget{matchKindV6AddressSet, dnsNameIPv6ASHashName, rule.to.clusterSubnetIntersection})
-			}
+		action := getEgressFirewallACLAction(rule)
+		matchTargets, err := oc.getEgressFirewallMatchTargets(rule, ef.namespace)
+		if err != nil {
+			return err
 		}
 
 		if len(matchTargets) == 0 {
@@ -449,7 +561,7 @@ func (oc *DefaultNetworkController) addEgressFirewallRules(ef *egressFirewall, p
 			continue
 		}
 
-		match := generateMatch(pgName, matchTargets, rule.ports)
+		match := generateMatch(pgName, matchTargets, rule.ports, oc.Subnets())
 		ops, err = oc.createEgressFirewallACLOps(ops, rule.id, match, action, ef.namespace, pgName, aclLogging)
 		if err != nil {
 			return err
@@ -462,9 +574,71 @@ func (oc *DefaultNetworkController) addEgressFirewallRules(ef *egressFirewall, p
 	return nil
 }
 
+// getEgressFirewallACLAction returns the ACL action of an egress firewall rule.
+func getEgressFirewallACLAction(rule *egressFirewallRule) string {
+	if rule.access == egressfirewallapi.EgressFirewallRuleAllow {
+		return nbdb.ACLActionAllow
+	}
+	return nbdb.ACLActionDrop
+}
+
+// getEgressFirewallMatchTargets returns the match targets for the destination of an egress firewall rule.
+// dnsOwner is the key the DNS name of the rule is referenced with in the DNS name resolver.
+func (oc *BaseNetworkController) getEgressFirewallMatchTargets(rule *egressFirewallRule, dnsOwner string) ([]matchTarget, error) {
+	var matchTargets []matchTarget
+	if len(rule.to.nodeAddrs) > 0 {
+		// sort node ips to ensure the same order when no changes are present
+		// this ensure ACL recalculation won't happen just because of the order changes
+		allIPs := []string{}
+		for _, nodeIPs := range rule.to.nodeAddrs {
+			allIPs = append(allIPs, nodeIPs...)
+		}
+		slices.Sort(allIPs)
+
+		for _, addr := range allIPs {
+			if utilnet.IsIPv6String(addr) {
+				matchTargets = append(matchTargets, matchTarget{matchKindV6CIDR, addr, false})
+			} else {
+				matchTargets = append(matchTargets, matchTarget{matchKindV4CIDR, addr, false})
+			}
+		}
+	} else if rule.to.cidrSelector != "" {
+		if utilnet.IsIPv6CIDRString(rule.to.cidrSelector) {
+			matchTargets = []matchTarget{{matchKindV6CIDR, rule.to.cidrSelector, rule.to.clusterSubnetIntersection}}
+		} else {
+			matchTargets = []matchTarget{{matchKindV4CIDR, rule.to.cidrSelector, rule.to.clusterSubnetIntersection}}
+		}
+	} else if len(rule.to.dnsName) > 0 {
+		// rule based on DNS NAME
+		dnsNameAddressSets, err := oc.dnsNameResolver.Add(dnsOwner, getEgressFirewallRuleDNSName(rule))
+		if err != nil {
+			return nil, fmt.Errorf("error with DNSNameResolver - %v", err)
+		}
+		dnsNameIPv4ASHashName, dnsNameIPv6ASHashName := dnsNameAddressSets.GetASHashNames()
+		if dnsNameIPv4ASHashName != "" {
+			matchTargets = append(matchTargets, matchTarget{matchKindV4AddressSet, dnsNameIPv4ASHashName, rule.to.clusterSubnetIntersection})
+		}
+		if dnsNameIPv6ASHashName != "" {
+			matchTargets = append(matchTargets, matchTarget{matchKindV6AddressSet, dnsNameIPv6ASHashName, rule.to.clusterSubnetIntersection})
+		}
+	}
+	return matchTargets, nil
+}
+
+// getEgressFirewallRuleDNSName returns the DNS name of an egress firewall rule as it is referenced in the DNS name
+// resolver.
+func getEgressFirewallRuleDNSName(rule *egressFirewallRule) string {
+	// If DNSNameResolver or DNS snooping is enabled, the DNS name resolver references the DNS name
+	// as a lower case fully qualified domain name.
+	if config.OVNKubernetesFeature.EnableDNSNameResolver || config.OVNKubernetesFeature.EnableDNSSnooping {
+		return util.LowerCaseFQDN(rule.to.dnsName)
+	}
+	return rule.to.dnsName
+}
+
 // createEgressFirewallACLOps uses the previously generated elements and creates the
 // acls for all node switches
-func (oc *DefaultNetworkController) createEgressFirewallACLOps(ops []ovsdb.Operation, ruleIdx int, match, action, namespace, pgName string, aclLogging *libovsdbutil.ACLLoggingLevels) ([]ovsdb.Operation, error) {
+func (oc *BaseNetworkController) createEgressFirewallACLOps(ops []ovsdb.Operation, ruleIdx int, match, action, namespace, pgName string, aclLogging *libovsdbutil.ACLLoggingLevels) ([]ovsdb.Operation, error) {
 	aclIDs := oc.getEgressFirewallACLDbIDs(namespace, ruleIdx)
 	priority := types.EgressFirewallStartPriority - ruleIdx
 	egressFirewallACL := libovsdbutil.BuildACLWithDefaultTier(
@@ -491,7 +665,7 @@ func (oc *DefaultNetworkController) createEgressFirewallACLOps(ops []ovsdb.Opera
 	return ops, nil
 }
 
-func (oc *DefaultNetworkController) deleteEgressFirewallRule(namespace, pgName string, ruleIdx int) error {
+func (oc *BaseNetworkController) deleteEgressFirewallRule(namespace, pgName string, ruleIdx int) error {
 	// Find ACLs for a given egressFirewall
 	aclIDs := oc.getEgressFirewallACLDbIDs(namespace, ruleIdx)
 	pACL := libovsdbops.GetPredicate[*nbdb.ACL](aclIDs, nil)
@@ -513,7 +687,7 @@ func (oc *DefaultNetworkController) deleteEgressFirewallRule(namespace, pgName s
 }
 
 // deleteEgressFirewallRules delete egress firewall Acls
-func (oc *DefaultNetworkController) deleteEgressFirewallRules(namespace string) error {
+func (oc *BaseNetworkController) deleteEgressFirewallRules(namespace string) error {
 	// Find ACLs for a given egressFirewall
 	predicateIDs := libovsdbops.NewDbObjectIDs(libovsdbops.ACLEgressFirewall, oc.controllerName,
 		map[libovsdbops.ExternalIDKey]string{
@@ -557,18 +731,18 @@ const (
 	matchKindV6AddressSet
 )
 
-func (m *matchTarget) toExpr() (string, error) {
+func (m *matchTarget) toExpr(clusterSubnets []config.CIDRNetworkEntry) (string, error) {
 	var match string
 	switch m.kind {
 	case matchKindV4CIDR:
 		match = fmt.Sprintf("ip4.dst == %s", m.value)
 		if m.clusterSubnetIntersection {
-			match = fmt.Sprintf("%s && %s", match, getV4ClusterSubnetsExclusion())
+			match = fmt.Sprintf("%s && %s", match, getV4ClusterSubnetsExclusion(clusterSubnets))
 		}
 	case matchKindV6CIDR:
 		match = fmt.Sprintf("ip6.dst == %s", m.value)
 		if m.clusterSubnetIntersection {
-			match = fmt.Sprintf("%s && %s", match, getV6ClusterSubnetsExclusion())
+			match = fmt.Sprintf("%s && %s", match, getV6ClusterSubnetsExclusion(clusterSubnets))
 		}
 	case matchKindV4AddressSet:
 		if m.value != "" {
@@ -585,18 +759,25 @@ func (m *matchTarget) toExpr() (string, error) {
 }
 
 // generateMatch generates the "match" section of ACL generation for egressFirewallRules.
-// It is referentially transparent as all the elements have been validated before this function is called
+// It is referentially transparent as all the elements have been validated before this function is called.
+// clusterSubnets are the subnets of the network the egress firewall is applied to.
 // sample output:
 // match=\"(ip4.dst == 1.2.3.4/32) && ip4.src == $testv4 && ip4.dst != 10.128.0.0/14\
-func generateMatch(pgName string, destinations []matchTarget, dstPorts []egressfirewallapi.EgressFirewallPort) string {
+func generateMatch(pgName string, destinations []matchTarget, dstPorts []egressfirewallapi.EgressFirewallPort,
+	clusterSubnets []config.CIDRNetworkEntry) string {
+	return generateMatchForSource("inport == @"+pgName, destinations, dstPorts, clusterSubnets)
+}
+
+// generateMatchForSource is the same as generateMatch, with the given source match instead of a single port group.
+func generateMatchForSource(src string, destinations []matchTarget, dstPorts []egressfirewallapi.EgressFirewallPort,
+	clusterSubnets []config.CIDRNetworkEntry) string {
 	var dst string
-	src := "inport == @" + pgName
 
 	for _, entry := range destinations {
 		if entry.value == "" {
 			continue
 		}
-		ipDst, err := entry.toExpr()
+		ipDst, err := entry.toExpr(clusterSubnets)
 		if err != nil {
 			klog.Error(err)
 			continue
@@ -627,19 +808,19 @@ func egressGetL4Match(ports []egressfirewallapi.EgressFirewallPort) string {
 			if port.Port == 0 {
 				udpString = "udp"
 			} else {
-				udpString = fmt.Sprintf("%s udp.dst == %d ||", udpString, port.Port)
+				udpString = fmt.Sprintf("%s %s ||", udpString, egressGetPortMatch("udp", port))
 			}
 		} else if corev1.Protocol(port.Protocol) == corev1.ProtocolTCP && tcpString != "tcp" {
 			if port.Port == 0 {
 				tcpString = "tcp"
 			} else {
-				tcpString = fmt.Sprintf("%s tcp.dst == %d ||", tcpString, port.Port)
+				tcpString = fmt.Sprintf("%s %s ||", tcpString, egressGetPortMatch("tcp", port))
 			}
 		} else if corev1.Protocol(port.Protocol) == corev1.ProtocolSCTP && sctpString != "sctp" {
 			if port.Port == 0 {
 				sctpString = "sctp"
 			} else {
-				sctpString = fmt.Sprintf("%s sctp.dst == %d ||", sctpString, port.Port)
+				sctpString = fmt.Sprintf("%s %s ||", sctpString, egressGetPortMatch("sctp", port))
 			}
 		}
 	}
@@ -681,9 +862,98 @@ func egressGetL4Match(ports []egressfirewallapi.EgressFirewallPort) string {
 	return fmt.Sprintf("(%s)", l4Match)
 }
 
-func getV4ClusterSubnetsExclusion() string {
+// egressGetPortMatch returns the match for a single port or a port range of the given protocol.
+func egressGetPortMatch(protocol string, port egressfirewallapi.EgressFirewallPort) string {
+	if port.EndPort > port.Port {
+		return fmt.Sprintf("%d<=%s.dst<=%d", port.Port, protocol, port.EndPort)
+	}
+	return fmt.Sprintf("%s.dst == %d", protocol, port.Port)
+}
+
+// validateEgressFirewallPorts checks that the port ranges of an egress firewall rule are valid. Port entries of the
+// same rule may overlap, they are matched as alternatives.
+func validateEgressFirewallPorts(ports []egressfirewallapi.EgressFirewallPort) error {
+	for _, port := range ports {
+		if port.EndPort != 0 && (port.Port == 0 || port.EndPort < port.Port) {
+			return fmt.Errorf("invalid port range %d-%d for protocol %s", port.Port, port.EndPort, port.Protocol)
+		}
+	}
+	return nil
+}
+
+// getEgressFirewallPortRange returns the first and last ports matched by a port entry. A port entry without port
+// matches every port of its protocol.
+func getEgressFirewallPortRange(port egressfirewallapi.EgressFirewallPort) (int32, int32) {
+	if port.Port == 0 {
+		return 1, 65535
+	}
+	if port.EndPort > port.Port {
+		return port.Port, port.EndPort
+	}
+	return port.Port, port.Port
+}
+
+// getShadowingEgressFirewallRule returns the first rule preceding rules[idx] with the opposite action that matches
+// all the traffic of rules[idx], so that rules[idx] never applies, or nil if there is none. Only the rules with the
+// same DNS name or node selector, or with a CIDR selector containing the one of rules[idx], are considered.
+func getShadowingEgressFirewallRule(rules []*egressFirewallRule, idx int) *egressFirewallRule {
+	rule := rules[idx]
+	for _, earlier := range rules[:idx] {
+		if earlier.access != rule.access && egressFirewallDestinationContains(earlier.to, rule.to) &&
+			egressFirewallPortsContain(earlier.ports, rule.ports) {
+			return earlier
+		}
+	}
+	return nil
+}
+
+// egressFirewallDestinationContains returns true if every destination of to is known to be a destination of from.
+func egressFirewallDestinationContains(from, to destination) bool {
+	switch {
+	case from.cidrSelector != "" && to.cidrSelector != "":
+		_, fromNet, err := net.ParseCIDR(from.cidrSelector)
+		if err != nil {
+			return false
+		}
+		_, toNet, err := net.ParseCIDR(to.cidrSelector)
+		if err != nil {
+			return false
+		}
+		fromOnes, fromBits := fromNet.Mask.Size()
+		toOnes, toBits := toNet.Mask.Size()
+		return fromBits == toBits && fromOnes <= toOnes && fromNet.Contains(toNet.IP)
+	case from.dnsName != "" && to.dnsName != "":
+		return util.LowerCaseFQDN(from.dnsName) == util.LowerCaseFQDN(to.dnsName)
+	case from.nodeSelector != nil && to.nodeSelector != nil:
+		return reflect.DeepEqual(from.nodeSelector, to.nodeSelector)
+	}
+	return false
+}
+
+// egressFirewallPortsContain returns true if every port entry of to is contained in a port entry of from. Empty
+// port entries match every port of every protocol.
+func egressFirewallPortsContain(from, to []egressfirewallapi.EgressFirewallPort) bool {
+	if len(from) == 0 {
+		return true
+	}
+	if len(to) == 0 {
+		return false
+	}
+	for _, toPort := range to {
+		toStart, toEnd := getEgressFirewallPortRange(toPort)
+		if !slices.ContainsFunc(from, func(fromPort egressfirewallapi.EgressFirewallPort) bool {
+			fromStart, fromEnd := getEgressFirewallPortRange(fromPort)
+			return strings.EqualFold(fromPort.Protocol, toPort.Protocol) && fromStart <= toStart && toEnd <= fromEnd
+		}) {
+			return false
+		}
+	}
+	return true
+}
+
+func getV4ClusterSubnetsExclusion(clusterSubnets []config.CIDRNetworkEntry) string {
 	var exclusions []string
-	for _, clusterSubnet := range config.Default.ClusterSubnets {
+	for _, clusterSubnet := range clusterSubnets {
 		if utilnet.IsIPv4CIDR(clusterSubnet.CIDR) {
 			exclusions = append(exclusions, fmt.Sprintf("%s.dst != %s", "ip4", clusterSubnet.CIDR))
 		}
@@ -691,9 +961,9 @@ func getV4ClusterSubnetsExclusion() string {
 	return strings.Join(exclusions, "&&")
 }
 
-func getV6ClusterSubnetsExclusion() string {
+func getV6ClusterSubnetsExclusion(clusterSubnets []config.CIDRNetworkEntry) string {
 	var exclusions []string
-	for _, clusterSubnet := range config.Default.ClusterSubnets {
+	for _, clusterSubnet := range clusterSubnets {
 		if utilnet.IsIPv6CIDR(clusterSubnet.CIDR) {
 			exclusions = append(exclusions, fmt.Sprintf("%s.dst != %s", "ip6", clusterSubnet.CIDR))
 		}
@@ -710,7 +980,7 @@ func getEgressFirewallNamespacedName(egressFirewall *egressfirewallapi.EgressFir
 // namespace annotations change.
 // Return values are: bool - if the egressFirewall's ACL was updated or not, error in case of errors. If a namespace
 // does not contain an egress firewall ACL, then this returns false, nil instead of a NotFound error.
-func (oc *DefaultNetworkController) updateACLLoggingForEgressFirewall(egressFirewallNamespace string, nsInfo *namespaceInfo) (bool, error) {
+func (oc *BaseNetworkController) updateACLLoggingForEgressFirewall(egressFirewallNamespace string, nsInfo *namespaceInfo) (bool, error) {
 	// Retrieve the egress firewall object from cache and lock it.
 	obj, loaded := oc.egressFirewalls.Load(egressFirewallNamespace)
 	if !loaded {
@@ -737,7 +1007,7 @@ func (oc *DefaultNetworkController) updateACLLoggingForEgressFirewall(egressFire
 	return true, nil
 }
 
-func (oc *DefaultNetworkController) getEgressFirewallACLDbIDs(namespace string, ruleIdx int) *libovsdbops.DbObjectIDs {
+func (oc *BaseNetworkController) getEgressFirewallACLDbIDs(namespace string, ruleIdx int) *libovsdbops.DbObjectIDs {
 	return libovsdbops.NewDbObjectIDs(libovsdbops.ACLEgressFirewall, oc.controllerName,
 		map[libovsdbops.ExternalIDKey]string{
 			libovsdbops.ObjectNameKey: namespace,
@@ -745,7 +1015,7 @@ func (oc *DefaultNetworkController) getEgressFirewallACLDbIDs(namespace string,
 		})
 }
 
-func (oc *DefaultNetworkController) newEFNodeController(nodeInformer coreinformers.NodeInformer) controller.Controller {
+func (oc *BaseNetworkController) newEFNodeController(nodeInformer coreinformers.NodeInformer) controller.Controller {
 	controllerConfig := &controller.ControllerConfig[corev1.Node]{
 		RateLimiter:    workqueue.NewTypedItemFastSlowRateLimiter[string](time.Second, 5*time.Second, 5),
 		Informer:       nodeInformer.Informer(),
@@ -757,7 +1027,7 @@ func (oc *DefaultNetworkController) newEFNodeController(nodeInformer coreinforme
 	return controller.NewController[corev1.Node]("ef_node_controller", controllerConfig)
 }
 
-func (oc *DefaultNetworkController) efNodeNeedsUpdate(oldNode, newNode *corev1.Node) bool {
+func (oc *BaseNetworkController) efNodeNeedsUpdate(oldNode, newNode *corev1.Node) bool {
 	if oldNode == nil || newNode == nil {
 		return true
 	}
@@ -765,7 +1035,7 @@ func (oc *DefaultNetworkController) efNodeNeedsUpdate(oldNode, newNode *corev1.N
 		util.NodeHostCIDRsAnnotationChanged(oldNode, newNode)
 }
 
-func (oc *DefaultNetworkController) updateEgressFirewallForNode(nodeName string) error {
+func (oc *BaseNetworkController) updateEgressFirewallForNode(nodeName string) error {
 	node, err := oc.watchFactory.GetNode(nodeName)
 	// It´s unlikely that we have an error different that "Not Found Object"
 	// because we are getting the object from the informer´s cache
@@ -788,27 +1058,7 @@ func (oc *DefaultNetworkController) updateEgressFirewallForNode(nodeName string)
 		namespace := k.(string)
 		ef.Lock()
 		defer ef.Unlock()
-		var modifiedRuleIDs []int
-		for _, rule := range ef.egressRules {
-			// nodeSelector will always have a value, but it is mutually exclusive from cidrSelector and dnsName
-			if len(rule.to.cidrSelector) != 0 || len(rule.to.dnsName) != 0 {
-				continue
-			}
-			selector, err := metav1.LabelSelectorAsSelector(rule.to.nodeSelector)
-			if err != nil {
-				klog.Errorf("Error while parsing label selector %#v for egress firewall in namespace %s",
-					rule.to.nodeSelector, namespace)
-				continue
-			}
-			// no need to check selector on old node here, ips are unique and regardless of if selector
-			// matches or not we shouldn't have those addresses anymore
-			delete(rule.to.nodeAddrs, nodeName)
-			// check if selector matches
-			if node != nil && selector.Matches(labels.Set(node.Labels)) {
-				rule.to.nodeAddrs[nodeName] = nodeIPs
-			}
-			modifiedRuleIDs = append(modifiedRuleIDs, rule.id)
-		}
+		modifiedRuleIDs := updateEgressFirewallRulesForNode(ef.egressRules, nodeName, node, nodeIPs)
 		if len(modifiedRuleIDs) == 0 {
 			return true
 		}
@@ -822,30 +1072,75 @@ func (oc *DefaultNetworkController) updateEgressFirewallForNode(nodeName string)
 		}
 		return true
 	})
+	if efErr != nil {
+		return efErr
+	}
 
-	return efErr
+	return oc.updateClusterEgressFirewallsForNode(nodeName, node, nodeIPs)
 }
 
-func (oc *DefaultNetworkController) setEgressFirewallStatus(egressFirewall *egressfirewallapi.EgressFirewall, handlerErr error) error {
-	var newMsg string
-	if handlerErr != nil {
-		newMsg = types.EgressFirewallErrorMsg + ": " + handlerErr.Error()
-	} else {
-		newMsg = egressFirewallAppliedCorrectly
+// updateEgressFirewallRulesForNode updates the node addresses of the rules selecting nodes, and returns the ids
+// of the updated rules.
+func updateEgressFirewallRulesForNode(rules []*egressFirewallRule, nodeName string, node *corev1.Node, nodeIPs []string) []int {
+	var modifiedRuleIDs []int
+	for _, rule := range rules {
+		// nodeSelector will always have a value, but it is mutually exclusive from cidrSelector and dnsName
+		if len(rule.to.cidrSelector) != 0 || len(rule.to.dnsName) != 0 {
+			continue
+		}
+		selector, err := metav1.LabelSelectorAsSelector(rule.to.nodeSelector)
+		if err != nil {
+			klog.Errorf("Error while parsing label selector %#v for egress firewall rule %d",
+				rule.to.nodeSelector, rule.id)
+			continue
+		}
+		// no need to check selector on old node here, ips are unique and regardless of if selector
+		// matches or not we shouldn't have those addresses anymore
+		delete(rule.to.nodeAddrs, nodeName)
+		// check if selector matches
+		if node != nil && selector.Matches(labels.Set(node.Labels)) {
+			rule.to.nodeAddrs[nodeName] = nodeIPs
+		}
+		modifiedRuleIDs = append(modifiedRuleIDs, rule.id)
+	}
+	return modifiedRuleIDs
+}
+
+func (oc *BaseNetworkController) setEgressFirewallStatus(egressFirewall *egressfirewallapi.EgressFirewall, handlerErr error) error {
+	newMsg := oc.getEgressFirewallStatusMessage(handlerErr)
+	if handlerErr == nil {
 		metrics.UpdateEgressFirewallRuleCount(float64(len(egressFirewall.Spec.Egress)))
 		metrics.IncrementEgressFirewallCount()
 	}
+	return oc.applyEgressFirewallStatus(egressFirewall, newMsg, oc.getEgressFirewallRuleStatuses(egressFirewall, handlerErr))
+}
 
+// getEgressFirewallStatusMessage returns the status message this zone reports for an egress firewall that was
+// handled with handlerErr.
+func (oc *BaseNetworkController) getEgressFirewallStatusMessage(handlerErr error) string {
+	var onNetwork string
+	if !oc.IsDefault() {
+		// report the user defined network the egress firewall was rendered on
+		onNetwork = " on network " + oc.GetNetworkName()
+	}
+	if handlerErr != nil {
+		return types.EgressFirewallErrorMsg + onNetwork + ": " + handlerErr.Error()
+	}
+	return egressFirewallAppliedCorrectly + onNetwork
+}
+
+// applyEgressFirewallStatus sets the status message and the rule states reported by this zone, if they changed.
+func (oc *BaseNetworkController) applyEgressFirewallStatus(egressFirewall *egressfirewallapi.EgressFirewall, newMsg string,
+	ruleStatuses []egressfirewallapi.EgressFirewallRuleStatus) error {
 	newMsg = types.GetZoneStatus(oc.zone, newMsg)
-	needsUpdate := true
-	for _, message := range egressFirewall.Status.Messages {
-		if message == newMsg {
-			// found previous status
-			needsUpdate = false
-			break
+	var currentRuleStatuses []egressfirewallapi.EgressFirewallRuleStatus
+	for _, ruleStatus := range egressFirewall.Status.Rules {
+		if ruleStatus.Zone == oc.zone {
+			currentRuleStatuses = append(currentRuleStatuses, ruleStatus)
 		}
 	}
-	if !needsUpdate {
+	if slices.Contains(egressFirewall.Status.Messages, newMsg) && reflect.DeepEqual(currentRuleStatuses, ruleStatuses) {
+		// found previous status
 		return nil
 	}
 
@@ -854,10 +1149,191 @@ func (oc *DefaultNetworkController) setEgressFirewallStatus(egressFirewall *egre
 		FieldManager: oc.zone,
 	}
 
+	applyStatus := egressfirewallapply.EgressFirewallStatus().
+		WithMessages(newMsg)
+	for _, ruleStatus := range ruleStatuses {
+		applyRuleStatus := egressfirewallapply.EgressFirewallRuleStatus().
+			WithZone(ruleStatus.Zone).
+			WithIndex(ruleStatus.Index).
+			WithState(ruleStatus.State).
+			WithResolvedAddresses(ruleStatus.ResolvedAddresses...)
+		if ruleStatus.Message != "" {
+			applyRuleStatus.WithMessage(ruleStatus.Message)
+		}
+		if ruleStatus.Hits != nil {
+			applyRuleStatus.WithHits(*ruleStatus.Hits)
+		}
+		applyStatus.WithRules(applyRuleStatus)
+	}
 	applyObj := egressfirewallapply.EgressFirewall(egressFirewall.Name, egressFirewall.Namespace).
-		WithStatus(egressfirewallapply.EgressFirewallStatus().
-			WithMessages(newMsg))
+		WithStatus(applyStatus)
 	_, err := oc.kube.EgressFirewallClient.K8sV1().EgressFirewalls(egressFirewall.Namespace).ApplyStatus(context.TODO(), applyObj, applyOptions)
 
 	return err
 }
+
+// getEgressFirewallRuleStatuses returns the state of the rules of an egress firewall in this zone. If the egress
+// firewall failed to be applied with handlerErr, only the rules that caused the failure are returned.
+func (oc *BaseNetworkController) getEgressFirewallRuleStatuses(egressFirewallObj *egressfirewallapi.EgressFirewall,
+	handlerErr error) []egressfirewallapi.EgressFirewallRuleStatus {
+	var ruleStatuses []egressfirewallapi.EgressFirewallRuleStatus
+	if handlerErr != nil {
+		for _, ruleErr := range getEgressFirewallRuleErrors(handlerErr) {
+			ruleStatuses = append(ruleStatuses, egressfirewallapi.EgressFirewallRuleStatus{
+				Zone:    oc.zone,
+				Index:   int32(ruleErr.ruleIdx),
+				State:   egressfirewallapi.EgressFirewallRuleInvalid,
+				Message: ruleErr.Error(),
+			})
+		}
+		return ruleStatuses
+	}
+
+	obj, loaded := oc.egressFirewalls.Load(egressFirewallObj.Namespace)
+	if !loaded {
+		return nil
+	}
+	ef := obj.(*egressFirewall)
+	ef.Lock()
+	defer ef.Unlock()
+	countHits := oc.getSampleCounter() != nil
+	// report the DNS names that are polled even though DNS snooping is enabled, e.g. on user defined networks
+	pollDNSNames := config.OVNKubernetesFeature.EnableDNSSnooping && !oc.useDNSSnooping()
+	for i, rule := range ef.egressRules {
+		ruleStatus := egressfirewallapi.EgressFirewallRuleStatus{
+			Zone:  oc.zone,
+			Index: int32(rule.id),
+			State: egressfirewallapi.EgressFirewallRuleApplied,
+		}
+		var messages []string
+		// the rules shadowed by an earlier rule are still applied, they are only reported as a warning
+		if shadowingRule := getShadowingEgressFirewallRule(ef.egressRules, i); shadowingRule != nil {
+			messages = append(messages, fmt.Sprintf("rule is shadowed by rule %d with action %s, which matches all its traffic",
+				shadowingRule.id, shadowingRule.access))
+		}
+		if len(rule.to.dnsName) > 0 {
+			addresses, resolved := oc.dnsNameResolver.GetResolvedAddresses(getEgressFirewallRuleDNSName(rule))
+			if !resolved {
+				ruleStatus.State = egressfirewallapi.EgressFirewallRuleDNSUnresolved
+				messages = append(messages, fmt.Sprintf("DNS name %s could not be resolved", rule.to.dnsName))
+			}
+			if pollDNSNames {
+				messages = append(messages, fmt.Sprintf("DNS name %s is resolved by polling, DNS snooping is not supported on network %s",
+					rule.to.dnsName, oc.GetNetworkName()))
+			}
+			if len(addresses) > 0 {
+				ruleStatus.ResolvedAddresses = slices.Sorted(slices.Values(addresses))
+			}
+		}
+		ruleStatus.Message = strings.Join(messages, "; ")
+		if countHits {
+			ruleStatus.Hits = ptr.To(getEgressFirewallRuleStatusHits(rule.reportedHits))
+		}
+		ruleStatuses = append(ruleStatuses, ruleStatus)
+	}
+	return ruleStatuses
+}
+
+// getEgressFirewallRuleErrors returns the rule errors wrapped in err.
+func getEgressFirewallRuleErrors(err error) []*egressFirewallRuleError {
+	switch e := err.(type) {
+	case *egressFirewallRuleError:
+		return []*egressFirewallRuleError{e}
+	case interface{ Unwrap() []error }:
+		var ruleErrs []*egressFirewallRuleError
+		for _, wrappedErr := range e.Unwrap() {
+			ruleErrs = append(ruleErrs, getEgressFirewallRuleErrors(wrappedErr)...)
+		}
+		return ruleErrs
+	case interface{ Unwrap() error }:
+		return getEgressFirewallRuleErrors(e.Unwrap())
+	}
+	return nil
+}
+
+// getEgressFirewallRuleStatusHits returns the hits reported in the status of a rule: the hits rounded down to their
+// most significant digit, so that the status is updated a few times per order of magnitude of the hits instead of
+// every time they are counted.
+func getEgressFirewallRuleStatusHits(hits uint64) int64 {
+	bucket := uint64(1)
+	for hits/bucket >= 10 {
+		bucket *= 10
+	}
+	return int64(hits / bucket * bucket)
+}
+
+// countEgressFirewallRuleHits adds the packets sampled for the ACL of the egress firewall rules since they were
+// last counted to the hits metric of the rules. Every zone counts the samples of its own node, the metric of all
+// the zones has to be aggregated to get the hits in the cluster.
+func (oc *BaseNetworkController) countEgressFirewallRuleHits(ef *egressFirewall) {
+	sampleCounter := oc.getSampleCounter()
+	if sampleCounter == nil {
+		return
+	}
+	ef.Lock()
+	defer ef.Unlock()
+	for _, rule := range ef.egressRules {
+		hits, err := oc.countEgressFirewallRuleHit(sampleCounter, oc.getEgressFirewallACLDbIDs(ef.namespace, rule.id), rule)
+		if err != nil {
+			klog.Warningf("Failed to count hits of egress firewall rule %d in namespace %s: %v", rule.id, ef.namespace, err)
+			continue
+		}
+		metrics.AddEgressFirewallRuleHits(oc.GetNetworkName(), egressFirewallKind, ef.namespace, ef.name, rule.id, hits)
+	}
+}
+
+// countEgressFirewallRuleHit returns the packets sampled for the ACLs with the given IDs since the hits of the rule
+// were last counted. Samples are counted per ACL match, so the samples restart from zero when the ACL of the rule
+// is updated.
+func (oc *BaseNetworkController) countEgressFirewallRuleHit(sampleCounter *observability.SampleCounter,
+	aclIDs *libovsdbops.DbObjectIDs, rule *egressFirewallRule) (uint64, error) {
+	pACL := libovsdbops.GetPredicate[*nbdb.ACL](aclIDs, nil)
+	acls, err := libovsdbops.FindACLsWithPredicate(oc.nbClient, pACL)
+	if err != nil {
+		return 0, fmt.Errorf("unable to find egress firewall ACLs: %w", err)
+	}
+	var hits uint64
+	for _, acl := range acls {
+		if acl.SampleNew != nil || acl.SampleEst != nil {
+			hits += sampleCounter.Get(libovsdbops.GetACLSampleID(acl))
+		}
+	}
+	newHits := hits
+	if hits >= rule.reportedHits {
+		newHits = hits - rule.reportedHits
+	}
+	rule.reportedHits = hits
+	return newHits, nil
+}
+
+// syncEgressFirewallRuleStatuses counts the hits of the rules of the egress firewalls and cluster egress firewalls
+// applied by this controller and refreshes the state of the rules of the egress firewalls successfully applied, to
+// report DNS resolution changes and hits. The status is only updated when the state or the rounded hits of a rule changed. Egress
+// firewalls that failed to be applied get their status updated when they are retried.
+func (oc *BaseNetworkController) syncEgressFirewallRuleStatuses() {
+	appliedMsg := oc.getEgressFirewallStatusMessage(nil)
+	oc.egressFirewalls.Range(func(_, v interface{}) bool {
+		ef := v.(*egressFirewall)
+		oc.countEgressFirewallRuleHits(ef)
+		egressFirewall, err := oc.watchFactory.GetEgressFirewall(ef.namespace, ef.name)
+		if err != nil {
+			if !apierrors.IsNotFound(err) {
+				klog.Errorf("Failed to get egress firewall %s/%s: %v", ef.namespace, ef.name, err)
+			}
+			return true
+		}
+		if !slices.Contains(egressFirewall.Status.Messages, types.GetZoneStatus(oc.zone, appliedMsg)) {
+			return true
+		}
+		if err := oc.applyEgressFirewallStatus(egressFirewall, appliedMsg,
+			oc.getEgressFirewallRuleStatuses(egressFirewall, nil)); err != nil {
+			klog.Errorf("Failed to update egress firewall status %s, error: %v",
+				getEgressFirewallNamespacedName(egressFirewall), err)
+		}
+		return true
+	})
+	oc.clusterEgressFirewalls.Range(func(_, v interface{}) bool {
+		oc.countClusterEgressFirewallRuleHits(v.(*clusterEgressFirewall))
+		return true
+	})
+}
@@ -8,6 +8,7 @@ import (
 	"strings"
 	"time"
 
+	nadv1 "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
 	"github.com/miekg/dns"
 	"github.com/onsi/ginkgo/v2"
 	"github.com/onsi/gomega"
@@ -1520,12 +1521,181 @@ var _ = ginkgo.Describe("OVN test basic functions", func() {
 				},
 				expectedMatch: "((udp && ( udp.dst == 400 )) || (tcp && ( tcp.dst == 100 || tcp.dst == 102 )) || (sctp && ( sctp.dst == 13 )))",
 			},
+			{
+				ports: []egressfirewallapi.EgressFirewallPort{
+					{
+						Protocol: "TCP",
+						Port:     30000,
+						EndPort:  32767,
+					},
+					{
+						Protocol: "TCP",
+						Port:     80,
+						EndPort:  80,
+					},
+					{
+						Protocol: "SCTP",
+					},
+				},
+				expectedMatch: "((tcp && ( 30000<=tcp.dst<=32767 || tcp.dst == 80 )) || (sctp))",
+			},
 		}
 		for _, test := range testcases {
 			l4Match := egressGetL4Match(test.ports)
 			gomega.Expect(test.expectedMatch).To(gomega.Equal(l4Match))
 		}
 	})
+	ginkgo.It("rounds the hits reported in the rule status down to their most significant digit", func() {
+		for hits, expected := range map[uint64]int64{
+			0:    0,
+			7:    7,
+			10:   10,
+			19:   10,
+			345:  300,
+			4567: 4000,
+			9999: 9000,
+		} {
+			gomega.Expect(getEgressFirewallRuleStatusHits(hits)).To(gomega.Equal(expected), "hits %d", hits)
+		}
+	})
+	ginkgo.It("validates egress firewall ports", func() {
+		type testcase struct {
+			ports       []egressfirewallapi.EgressFirewallPort
+			expectedErr bool
+		}
+		testcases := []testcase{
+			{
+				ports: []egressfirewallapi.EgressFirewallPort{
+					{Protocol: "TCP", Port: 100, EndPort: 200},
+					{Protocol: "TCP", Port: 201},
+					{Protocol: "UDP", Port: 150},
+					{Protocol: "TCP", Port: 100, EndPort: 200},
+				},
+			},
+			{
+				// overlapping port entries of the same rule are matched as alternatives
+				ports: []egressfirewallapi.EgressFirewallPort{
+					{Protocol: "TCP", Port: 100, EndPort: 200},
+					{Protocol: "TCP", Port: 150},
+				},
+			},
+			{
+				ports: []egressfirewallapi.EgressFirewallPort{
+					{Protocol: "UDP"},
+					{Protocol: "UDP", Port: 53},
+				},
+			},
+			{
+				ports: []egressfirewallapi.EgressFirewallPort{
+					{Protocol: "SCTP", Port: 200, EndPort: 100},
+				},
+				expectedErr: true,
+			},
+			{
+				ports: []egressfirewallapi.EgressFirewallPort{
+					{Protocol: "SCTP", EndPort: 100},
+				},
+				expectedErr: true,
+			},
+		}
+		for _, test := range testcases {
+			err := validateEgressFirewallPorts(test.ports)
+			if test.expectedErr {
+				gomega.Expect(err).To(gomega.HaveOccurred())
+			} else {
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			}
+		}
+	})
+	ginkgo.It("finds the earlier rule with the opposite action shadowing a rule", func() {
+		newRule := func(id int, access egressfirewallapi.EgressFirewallRuleType, to destination,
+			ports ...egressfirewallapi.EgressFirewallPort) *egressFirewallRule {
+			return &egressFirewallRule{id: id, access: access, to: to, ports: ports}
+		}
+		nodeSelector := &metav1.LabelSelector{MatchLabels: map[string]string{"role": "infra"}}
+		type testcase struct {
+			rules             []*egressFirewallRule
+			expectedShadowing int
+		}
+		testcases := []testcase{
+			{
+				rules: []*egressFirewallRule{
+					newRule(0, egressfirewallapi.EgressFirewallRuleDeny, destination{cidrSelector: "1.2.3.0/24"}),
+					newRule(1, egressfirewallapi.EgressFirewallRuleAllow, destination{cidrSelector: "1.2.3.4/32"},
+						egressfirewallapi.EgressFirewallPort{Protocol: "TCP", Port: 80}),
+				},
+				expectedShadowing: 0,
+			},
+			{
+				// the same action doesn't shadow
+				rules: []*egressFirewallRule{
+					newRule(0, egressfirewallapi.EgressFirewallRuleAllow, destination{cidrSelector: "1.2.3.0/24"}),
+					newRule(1, egressfirewallapi.EgressFirewallRuleAllow, destination{cidrSelector: "1.2.3.4/32"}),
+				},
+				expectedShadowing: -1,
+			},
+			{
+				// the later rule is only partly matched by the earlier rule
+				rules: []*egressFirewallRule{
+					newRule(0, egressfirewallapi.EgressFirewallRuleAllow, destination{cidrSelector: "1.2.3.4/32"}),
+					newRule(1, egressfirewallapi.EgressFirewallRuleDeny, destination{cidrSelector: "1.2.3.0/24"}),
+				},
+				expectedShadowing: -1,
+			},
+			{
+				rules: []*egressFirewallRule{
+					newRule(0, egressfirewallapi.EgressFirewallRuleAllow, destination{cidrSelector: "0.0.0.0/0"},
+						egressfirewallapi.EgressFirewallPort{Protocol: "TCP", Port: 30000, EndPort: 32767}),
+					newRule(1, egressfirewallapi.EgressFirewallRuleDeny, destination{cidrSelector: "10.0.0.0/8"},
+						egressfirewallapi.EgressFirewallPort{Protocol: "TCP", Port: 31000, EndPort: 31100},
+						egressfirewallapi.EgressFirewallPort{Protocol: "TCP", Port: 32000}),
+				},
+				expectedShadowing: 0,
+			},
+			{
+				rules: []*egressFirewallRule{
+					newRule(0, egressfirewallapi.EgressFirewallRuleAllow, destination{cidrSelector: "0.0.0.0/0"},
+						egressfirewallapi.EgressFirewallPort{Protocol: "TCP", Port: 30000, EndPort: 32767}),
+					newRule(1, egressfirewallapi.EgressFirewallRuleDeny, destination{cidrSelector: "10.0.0.0/8"},
+						egressfirewallapi.EgressFirewallPort{Protocol: "UDP", Port: 31000}),
+				},
+				expectedShadowing: -1,
+			},
+			{
+				rules: []*egressFirewallRule{
+					newRule(0, egressfirewallapi.EgressFirewallRuleAllow, destination{cidrSelector: "0.0.0.0/0"},
+						egressfirewallapi.EgressFirewallPort{Protocol: "TCP", Port: 80}),
+					newRule(1, egressfirewallapi.EgressFirewallRuleDeny, destination{cidrSelector: "::/0"},
+						egressfirewallapi.EgressFirewallPort{Protocol: "TCP", Port: 80}),
+				},
+				expectedShadowing: -1,
+			},
+			{
+				rules: []*egressFirewallRule{
+					newRule(0, egressfirewallapi.EgressFirewallRuleAllow, destination{dnsName: "www.example.com"}),
+					newRule(1, egressfirewallapi.EgressFirewallRuleAllow, destination{dnsName: "www.example.com"}),
+					newRule(2, egressfirewallapi.EgressFirewallRuleDeny, destination{dnsName: "WWW.example.com."},
+						egressfirewallapi.EgressFirewallPort{Protocol: "SCTP"}),
+				},
+				expectedShadowing: 0,
+			},
+			{
+				rules: []*egressFirewallRule{
+					newRule(0, egressfirewallapi.EgressFirewallRuleDeny, destination{nodeSelector: nodeSelector}),
+					newRule(1, egressfirewallapi.EgressFirewallRuleAllow, destination{nodeSelector: nodeSelector}),
+				},
+				expectedShadowing: 0,
+			},
+		}
+		for i, test := range testcases {
+			shadowingRule := getShadowingEgressFirewallRule(test.rules, len(test.rules)-1)
+			if test.expectedShadowing < 0 {
+				gomega.Expect(shadowingRule).To(gomega.BeNil(), "testcase %d", i)
+			} else {
+				gomega.Expect(shadowingRule).To(gomega.Equal(test.rules[test.expectedShadowing]), "testcase %d", i)
+			}
+		}
+	})
 	ginkgo.It("computes correct match function", func() {
 		type testcase struct {
 			clusterSubnets []string
@@ -1632,7 +1802,7 @@ var _ = ginkgo.Describe("OVN test basic functions", func() {
 			config.Default.ClusterSubnets = subnets
 
 			config.Gateway.Mode = config.GatewayModeShared
-			matchExpression := generateMatch(tc.pgName, tc.destinations, tc.ports)
+			matchExpression := generateMatch(tc.pgName, tc.destinations, tc.ports, config.Default.ClusterSubnets)
 			gomega.Expect(matchExpression).To(gomega.Equal(tc.output))
 		}
 	})
@@ -1848,3 +2018,311 @@ var _ = ginkgo.Describe("OVN test basic functions", func() {
 		}
 	})
 })
+
+var _ = ginkgo.Describe("OVN EgressFirewall on primary user defined networks", func() {
+	const (
+		namespaceName = "namespace1"
+		networkName   = "bluenet"
+		nadName       = "rednad"
+	)
+	var fakeOVN *FakeOVN
+
+	ginkgo.BeforeEach(func() {
+		gomega.Expect(config.PrepareTestConfig()).To(gomega.Succeed())
+		config.OVNKubernetesFeature.EnableEgressFirewall = true
+		config.OVNKubernetesFeature.EnableDNSNameResolver = true
+		config.OVNKubernetesFeature.EnableMultiNetwork = true
+		config.OVNKubernetesFeature.EnableNetworkSegmentation = true
+		config.IPv4Mode = true
+		fakeOVN = NewFakeOVN(false)
+	})
+
+	ginkgo.AfterEach(func() {
+		fakeOVN.shutdown()
+	})
+
+	ginkgo.DescribeTable("renders the rules on the network topology", func(topology, subnets string) {
+		nad := ovntest.GenerateNAD(networkName, nadName, namespaceName, topology, subnets, t.NetworkRolePrimary)
+		nad.Annotations = map[string]string{t.OvnNetworkIDAnnotation: "50"}
+		egressFirewall := newEgressFirewallObject("default", namespaceName, []egressfirewallapi.EgressFirewallRule{
+			{
+				Type: "Deny",
+				To: egressfirewallapi.EgressFirewallDestination{
+					CIDRSelector: "1.2.3.0/24",
+				},
+			},
+			{
+				Type: "Allow",
+				To: egressfirewallapi.EgressFirewallDestination{
+					// intersects with the network subnet
+					CIDRSelector: "100.0.0.0/8",
+				},
+			},
+		})
+		fakeOVN.startWithDBSetup(libovsdb.TestSetup{},
+			&corev1.NamespaceList{Items: []corev1.Namespace{*newUDNNamespace(namespaceName)}},
+			&nadv1.NetworkAttachmentDefinitionList{Items: []nadv1.NetworkAttachmentDefinition{*nad}},
+		)
+		gomega.Expect(fakeOVN.networkManager.Start()).To(gomega.Succeed())
+		defer fakeOVN.networkManager.Stop()
+		gomega.Expect(fakeOVN.NewUserDefinedNetworkController(nad)).To(gomega.Succeed())
+		bnc := &fakeOVN.userDefinedNetworkControllers[networkName].bnc.BaseNetworkController
+		// the namespace handler creates the namespaced port group for the network
+		gomega.Expect(bnc.WatchNamespaces()).To(gomega.Succeed())
+		var err error
+		bnc.dnsNameResolver, err = dnsnameresolver.NewExternalEgressDNS(bnc.addressSetFactory, bnc.controllerName, true,
+			fakeOVN.watcher.DNSNameResolverInformer().Informer(), fakeOVN.watcher.EgressFirewallInformer().Lister())
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(bnc.dnsNameResolver.Run()).To(gomega.Succeed())
+		gomega.Expect(bnc.WatchEgressFirewall()).To(gomega.Succeed())
+
+		_, err = fakeOVN.fakeClient.EgressFirewallClient.K8sV1().EgressFirewalls(namespaceName).
+			Create(context.TODO(), egressFirewall, metav1.CreateOptions{})
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+		pgName := bnc.getNamespacePortGroupName(namespaceName)
+		gomega.Expect(pgName).NotTo(gomega.Equal(fakeOVN.controller.getNamespacePortGroupName(namespaceName)))
+		expectedMatches := []string{
+			"(ip4.dst == 1.2.3.0/24) && inport == @" + pgName,
+			"(ip4.dst == 100.0.0.0/8 && ip4.dst != " + strings.Split(subnets, "/")[0] + "/16) && inport == @" + pgName,
+		}
+		expectedActions := []string{nbdb.ACLActionDrop, nbdb.ACLActionAllow}
+		getACLs := func(ruleIdx int) func() []*nbdb.ACL {
+			return func() []*nbdb.ACL {
+				aclP := libovsdbops.GetPredicate[*nbdb.ACL](bnc.getEgressFirewallACLDbIDs(namespaceName, ruleIdx), nil)
+				acls, err := libovsdbops.FindACLsWithPredicate(fakeOVN.nbClient, aclP)
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				return acls
+			}
+		}
+		for ruleIdx := range expectedMatches {
+			gomega.Eventually(getACLs(ruleIdx)).Should(gomega.HaveLen(1))
+		}
+		pg, err := libovsdbops.GetPortGroup(fakeOVN.nbClient, &nbdb.PortGroup{Name: pgName})
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		for ruleIdx := range expectedMatches {
+			acls := getACLs(ruleIdx)()
+			gomega.Expect(acls[0].Match).To(gomega.Equal(expectedMatches[ruleIdx]))
+			gomega.Expect(acls[0].Action).To(gomega.Equal(expectedActions[ruleIdx]))
+			gomega.Expect(acls[0].ExternalIDs[libovsdbops.OwnerControllerKey.String()]).To(gomega.Equal(bnc.controllerName))
+			gomega.Expect(pg.ACLs).To(gomega.ContainElement(acls[0].UUID))
+		}
+
+		// status reports the network the egress firewall was applied to
+		gomega.Eventually(func() []string {
+			ef, err := fakeOVN.fakeClient.EgressFirewallClient.K8sV1().EgressFirewalls(namespaceName).
+				Get(context.TODO(), egressFirewall.Name, metav1.GetOptions{})
+			if err != nil {
+				return nil
+			}
+			return ef.Status.Messages
+		}).Should(gomega.ConsistOf(t.GetZoneStatus(bnc.zone, egressFirewallAppliedCorrectly+" on network "+networkName)))
+
+		// deleting the egress firewall removes the ACLs from the network port group
+		err = fakeOVN.fakeClient.EgressFirewallClient.K8sV1().EgressFirewalls(namespaceName).
+			Delete(context.TODO(), egressFirewall.Name, metav1.DeleteOptions{})
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Eventually(func() []string {
+			pg, err := libovsdbops.GetPortGroup(fakeOVN.nbClient, &nbdb.PortGroup{Name: pgName})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			return pg.ACLs
+		}).Should(gomega.BeEmpty())
+	},
+		ginkgo.Entry("for a layer3 network", t.Layer3Topology, "100.128.0.0/16/24"),
+		ginkgo.Entry("for a layer2 network", t.Layer2Topology, "100.128.0.0/16"),
+	)
+})
+
+var _ = ginkgo.Describe("OVN EgressFirewall rule status", func() {
+	const namespaceName = "namespace1"
+	var fakeOVN *FakeOVN
+
+	ginkgo.BeforeEach(func() {
+		gomega.Expect(config.PrepareTestConfig()).To(gomega.Succeed())
+		config.OVNKubernetesFeature.EnableEgressFirewall = true
+		config.OVNKubernetesFeature.EnableDNSNameResolver = true
+		config.IPv4Mode = true
+		fakeOVN = NewFakeOVN(false)
+	})
+
+	ginkgo.AfterEach(func() {
+		fakeOVN.shutdown()
+	})
+
+	startOvn := func(egressFirewall *egressfirewallapi.EgressFirewall) {
+		fakeOVN.startWithDBSetup(libovsdb.TestSetup{NBData: []libovsdb.TestData{newClusterPortGroup()}},
+			&corev1.NamespaceList{Items: []corev1.Namespace{*newNamespace(namespaceName)}},
+			&egressfirewallapi.EgressFirewallList{Items: []egressfirewallapi.EgressFirewall{*egressFirewall}},
+		)
+		gomega.Expect(fakeOVN.controller.WatchNamespaces()).To(gomega.Succeed())
+		var err error
+		fakeOVN.controller.dnsNameResolver, err = dnsnameresolver.NewExternalEgressDNS(fakeOVN.controller.addressSetFactory,
+			fakeOVN.controller.controllerName, true, fakeOVN.watcher.DNSNameResolverInformer().Informer(),
+			fakeOVN.watcher.EgressFirewallInformer().Lister())
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(fakeOVN.controller.dnsNameResolver.Run()).To(gomega.Succeed())
+	}
+
+	getRuleStatuses := func(name string) func() []egressfirewallapi.EgressFirewallRuleStatus {
+		return func() []egressfirewallapi.EgressFirewallRuleStatus {
+			ef, err := fakeOVN.fakeClient.EgressFirewallClient.K8sV1().EgressFirewalls(namespaceName).
+				Get(context.TODO(), name, metav1.GetOptions{})
+			if err != nil {
+				return nil
+			}
+			return ef.Status.Rules
+		}
+	}
+
+	ginkgo.It("reports applied rules and the resolution of their DNS names", func() {
+		const dnsName = "www.example.com"
+		dnsNameLowerCaseFQDN := util.LowerCaseFQDN(dnsName)
+		egressFirewall := newEgressFirewallObject("default", namespaceName, []egressfirewallapi.EgressFirewallRule{
+			{
+				Type: "Deny",
+				To: egressfirewallapi.EgressFirewallDestination{
+					CIDRSelector: "1.2.3.0/24",
+				},
+			},
+			{
+				Type: "Allow",
+				To: egressfirewallapi.EgressFirewallDestination{
+					DNSName: dnsName,
+				},
+			},
+		})
+		startOvn(egressFirewall)
+		dnsNameResolver := newDNSNameResolverObject("dns-default", config.Kubernetes.OVNConfigNamespace, dnsNameLowerCaseFQDN, "2.2.2.2")
+		_, err := fakeOVN.fakeClient.OCPNetworkClient.NetworkV1alpha1().DNSNameResolvers(dnsNameResolver.Namespace).
+			Create(context.TODO(), dnsNameResolver, metav1.CreateOptions{})
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Eventually(func() []string {
+			addresses, _ := fakeOVN.controller.dnsNameResolver.GetResolvedAddresses(dnsNameLowerCaseFQDN)
+			return addresses
+		}).Should(gomega.ConsistOf("2.2.2.2"))
+
+		gomega.Expect(fakeOVN.controller.addEgressFirewall(egressFirewall)).To(gomega.Succeed())
+		gomega.Expect(fakeOVN.controller.setEgressFirewallStatus(egressFirewall, nil)).To(gomega.Succeed())
+		zone := fakeOVN.controller.zone
+		gomega.Eventually(getRuleStatuses(egressFirewall.Name)).Should(gomega.Equal([]egressfirewallapi.EgressFirewallRuleStatus{
+			{
+				Zone:  zone,
+				Index: 0,
+				State: egressfirewallapi.EgressFirewallRuleApplied,
+			},
+			{
+				Zone:              zone,
+				Index:             1,
+				State:             egressfirewallapi.EgressFirewallRuleApplied,
+				ResolvedAddresses: []string{"2.2.2.2"},
+			},
+		}))
+
+		ginkgo.By("failing to resolve the DNS name")
+		dnsNameResolver.Status.ResolvedNames[0].ResolutionFailures = 1
+		_, err = fakeOVN.fakeClient.OCPNetworkClient.NetworkV1alpha1().DNSNameResolvers(dnsNameResolver.Namespace).
+			Update(context.TODO(), dnsNameResolver, metav1.UpdateOptions{})
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Eventually(func() bool {
+			_, resolved := fakeOVN.controller.dnsNameResolver.GetResolvedAddresses(dnsNameLowerCaseFQDN)
+			return resolved
+		}).Should(gomega.BeFalse())
+		gomega.Eventually(func() []egressfirewallapi.EgressFirewallRuleStatus {
+			fakeOVN.controller.syncEgressFirewallRuleStatuses()
+			return getRuleStatuses(egressFirewall.Name)()
+		}).Should(gomega.ContainElement(egressfirewallapi.EgressFirewallRuleStatus{
+			Zone:              zone,
+			Index:             1,
+			State:             egressfirewallapi.EgressFirewallRuleDNSUnresolved,
+			Message:           "DNS name " + dnsName + " could not be resolved",
+			ResolvedAddresses: []string{"2.2.2.2"},
+		}))
+	})
+
+	ginkgo.It("keeps applying the rules shadowed by an earlier rule and reports them", func() {
+		egressFirewall := newEgressFirewallObject("default", namespaceName, []egressfirewallapi.EgressFirewallRule{
+			{
+				Type: "Allow",
+				To: egressfirewallapi.EgressFirewallDestination{
+					CIDRSelector: "1.2.3.0/24",
+				},
+				Ports: []egressfirewallapi.EgressFirewallPort{
+					{Protocol: "TCP", Port: 80},
+					{Protocol: "TCP", Port: 80},
+				},
+			},
+			{
+				Type: "Deny",
+				To: egressfirewallapi.EgressFirewallDestination{
+					CIDRSelector: "1.2.3.4/32",
+				},
+				Ports: []egressfirewallapi.EgressFirewallPort{
+					{Protocol: "TCP", Port: 80},
+				},
+			},
+			{
+				Type: "Deny",
+				To: egressfirewallapi.EgressFirewallDestination{
+					CIDRSelector: "0.0.0.0/0",
+				},
+			},
+		})
+		startOvn(egressFirewall)
+
+		gomega.Expect(fakeOVN.controller.addEgressFirewall(egressFirewall)).To(gomega.Succeed())
+		gomega.Expect(fakeOVN.controller.setEgressFirewallStatus(egressFirewall, nil)).To(gomega.Succeed())
+		zone := fakeOVN.controller.zone
+		gomega.Eventually(getRuleStatuses(egressFirewall.Name)).Should(gomega.Equal([]egressfirewallapi.EgressFirewallRuleStatus{
+			{
+				Zone:  zone,
+				Index: 0,
+				State: egressfirewallapi.EgressFirewallRuleApplied,
+			},
+			{
+				Zone:    zone,
+				Index:   1,
+				State:   egressfirewallapi.EgressFirewallRuleApplied,
+				Message: "rule is shadowed by rule 0 with action Allow, which matches all its traffic",
+			},
+			{
+				Zone:  zone,
+				Index: 2,
+				State: egressfirewallapi.EgressFirewallRuleApplied,
+			},
+		}))
+		acls, err := libovsdbops.FindACLsWithPredicate(fakeOVN.nbClient, libovsdbops.GetPredicate[*nbdb.ACL](
+			libovsdbops.NewDbObjectIDs(libovsdbops.ACLEgressFirewall, fakeOVN.controller.controllerName, nil), nil))
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(acls).To(gomega.HaveLen(3))
+	})
+
+	ginkgo.It("reports invalid rules", func() {
+		egressFirewall := newEgressFirewallObject("default", namespaceName, []egressfirewallapi.EgressFirewallRule{
+			{
+				Type: "Allow",
+				To: egressfirewallapi.EgressFirewallDestination{
+					CIDRSelector: "1.2.3.0/24",
+				},
+			},
+			{
+				Type: "Deny",
+				To: egressfirewallapi.EgressFirewallDestination{
+					CIDRSelector: "1.2.3.0/33",
+				},
+			},
+		})
+		startOvn(egressFirewall)
+
+		err := fakeOVN.controller.addEgressFirewall(egressFirewall)
+		gomega.Expect(err).To(gomega.HaveOccurred())
+		gomega.Expect(fakeOVN.controller.setEgressFirewallStatus(egressFirewall, err)).To(gomega.Succeed())
+		gomega.Eventually(getRuleStatuses(egressFirewall.Name)).Should(gomega.Equal([]egressfirewallapi.EgressFirewallRuleStatus{
+			{
+				Zone:    fakeOVN.controller.zone,
+				Index:   1,
+				State:   egressfirewallapi.EgressFirewallRuleInvalid,
+				Message: err.Error(),
+			},
+		}))
+	})
+})
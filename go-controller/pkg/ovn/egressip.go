@@ -16,15 +16,18 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/wait"
+	metaapplyv1 "k8s.io/client-go/applyconfigurations/meta/v1"
 	listers "k8s.io/client-go/listers/core/v1"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/retry"
 	"k8s.io/klog/v2"
 	utilnet "k8s.io/utils/net"
+	"k8s.io/utils/ptr"
 
 	libovsdbclient "github.com/ovn-kubernetes/libovsdb/client"
 	"github.com/ovn-kubernetes/libovsdb/ovsdb"
@@ -32,6 +35,7 @@ import (
 	ovncnitypes "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/cni/types"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
 	egressipv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressip/v1"
+	egressipapply "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressip/v1/apis/applyconfiguration/egressip/v1"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/factory"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/generator/udn"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/kube"
@@ -70,6 +74,12 @@ const (
 	dbIDEIPNamePodDivider                               = "_"
 )
 
+const (
+	egressIPProgrammedReason        = "Programmed"
+	egressIPProgrammingFailedReason = "ProgrammingFailed"
+	egressIPProgrammedCorrectly     = "EgressIP programmed correctly"
+)
+
 func getEgressIPAddrSetDbIDs(name egressIPAddrSetName, network, controller string) *libovsdbops.DbObjectIDs {
 	return libovsdbops.NewDbObjectIDs(libovsdbops.AddressSetEgressIP, controller, map[libovsdbops.ExternalIDKey]string{
 		// egress ip creates cluster-wide address sets with egressIpAddrSetName
@@ -199,6 +209,12 @@ type EgressIPController struct {
 	v6   bool
 	// controllerName is the name of the controller. For backward compatibility reasons, this is the default network controller name.
 	controllerName string
+	// bfdMutex serializes the sync of the BFD sessions run by the local egress
+	// nodes when the EgressIP node reachability is checked with BFD
+	bfdMutex *sync.Mutex
+	// bfdStatusTrigger requests a report of the status of the BFD sessions run
+	// by the local egress nodes
+	bfdStatusTrigger chan struct{}
 }
 
 func NewEIPController(
@@ -230,10 +246,76 @@ func NewEIPController(
 		zone:              zone,
 		v4:                v4,
 		v6:                v6,
+		bfdMutex:          &sync.Mutex{},
+		bfdStatusTrigger:  make(chan struct{}, 1),
 	}
 	return e
 }
 
+// setEgressIPZoneStatus reports in the status of the EgressIP whether it has
+// been programmed in the local zone. Each zone's ovnkube-controller will call
+// this, hence let's update status using server side apply.
+func (e *EgressIPController) setEgressIPZoneStatus(eIP *egressipv1.EgressIP, handlerErr error) error {
+	if eIP == nil {
+		return nil
+	}
+	eIP, err := e.watchFactory.GetEgressIP(eIP.Name)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	newCondition := metav1.Condition{
+		Type:    egressipv1.EgressIPProgrammedInZoneConditionPrefix + e.zone,
+		Status:  metav1.ConditionTrue,
+		Reason:  egressIPProgrammedReason,
+		Message: egressIPProgrammedCorrectly,
+	}
+	if handlerErr != nil {
+		newCondition.Status = metav1.ConditionFalse
+		newCondition.Reason = egressIPProgrammingFailedReason
+		newCondition.Message = types.EgressIPErrorMsg + ": " + handlerErr.Error()
+	}
+	existingCondition := meta.FindStatusCondition(eIP.Status.Conditions, newCondition.Type)
+	if existingCondition != nil && existingCondition.Status == newCondition.Status &&
+		existingCondition.Reason == newCondition.Reason && existingCondition.Message == newCondition.Message {
+		return nil
+	}
+
+	newConditionApply := &metaapplyv1.ConditionApplyConfiguration{
+		Type:    &newCondition.Type,
+		Status:  &newCondition.Status,
+		Reason:  &newCondition.Reason,
+		Message: &newCondition.Message,
+	}
+	if existingCondition == nil || existingCondition.Status != newCondition.Status {
+		newConditionApply.LastTransitionTime = ptr.To(metav1.NewTime(time.Now()))
+	} else {
+		newConditionApply.LastTransitionTime = &existingCondition.LastTransitionTime
+	}
+	applyObj := egressipapply.EgressIP(eIP.Name).
+		WithStatus(egressipapply.EgressIPStatus().WithConditions(newConditionApply))
+	if _, err = e.kube.EIPClient.K8sV1().EgressIPs().Apply(context.TODO(), applyObj,
+		metav1.ApplyOptions{FieldManager: e.zone, Force: true}); err != nil {
+		return err
+	}
+	// a zone successfully programming a new EgressIP is not worth an event
+	if existingCondition == nil && newCondition.Status == metav1.ConditionFalse ||
+		existingCondition != nil && existingCondition.Status != newCondition.Status {
+		eventType := corev1.EventTypeNormal
+		if newCondition.Status == metav1.ConditionFalse {
+			eventType = corev1.EventTypeWarning
+		}
+		eIPRef := corev1.ObjectReference{
+			Kind: "EgressIP",
+			Name: eIP.Name,
+		}
+		e.recorder.Eventf(&eIPRef, eventType, newCondition.Reason, "EgressIP %s in zone %s: %s", eIP.Name, e.zone, newCondition.Message)
+	}
+	return nil
+}
+
 // main reconcile functions begin here
 
 // reconcileEgressIP reconciles the database configuration
@@ -281,7 +363,7 @@ func (e *EgressIPController) reconcileEgressIP(old, new *egressipv1.EgressIP) (e
 	if old == nil && new != nil {
 		addStatus := new.Status.Items
 		if len(addStatus) > 0 {
-			if err := e.addEgressIPAssignments(new.Name, addStatus, mark, new.Spec.NamespaceSelector, new.Spec.PodSelector); err != nil {
+			if err := e.addEgressIPAssignments(new.Name, addStatus, mark, new.Spec.DestinationSelector, new.Spec.NamespaceSelector, new.Spec.PodSelector); err != nil {
 				return err
 			}
 		}
@@ -290,6 +372,22 @@ func (e *EgressIPController) reconcileEgressIP(old, new *egressipv1.EgressIP) (e
 	if old != nil && new != nil {
 		oldEIP := old
 		newEIP := new
+		// CASE 3.0: the destinations changed, which changes the match of all
+		// the logical router policies and NATs: tear down the setup of the
+		// old object and set it up again for the new one.
+		if !reflect.DeepEqual(oldEIP.Spec.DestinationSelector, newEIP.Spec.DestinationSelector) {
+			if len(oldEIP.Status.Items) > 0 {
+				if err := e.deleteEgressIPAssignments(old.Name, oldEIP.Status.Items); err != nil {
+					return err
+				}
+			}
+			if len(newEIP.Status.Items) > 0 {
+				if err := e.addEgressIPAssignments(new.Name, newEIP.Status.Items, mark, new.Spec.DestinationSelector, new.Spec.NamespaceSelector, new.Spec.PodSelector); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
 		// CASE 3.1: we need to see which statuses
 		//        1) need teardown
 		//        2) need setup
@@ -325,7 +423,7 @@ func (e *EgressIPController) reconcileEgressIP(old, new *egressipv1.EgressIP) (e
 				statusToAdd = append(statusToAdd, newStatus)
 			}
 			if len(statusToAdd) > 0 {
-				if err := e.addEgressIPAssignments(new.Name, statusToAdd, mark, new.Spec.NamespaceSelector, new.Spec.PodSelector); err != nil {
+				if err := e.addEgressIPAssignments(new.Name, statusToAdd, mark, new.Spec.DestinationSelector, new.Spec.NamespaceSelector, new.Spec.PodSelector); err != nil {
 					return err
 				}
 			}
@@ -372,7 +470,7 @@ func (e *EgressIPController) reconcileEgressIP(old, new *egressipv1.EgressIP) (e
 					if err != nil {
 						return fmt.Errorf("failed to get active network for namespace %s: %v", namespace.Name, err)
 					}
-					if err := e.addNamespaceEgressIPAssignments(ni, newEIP.Name, newEIP.Status.Items, mark, namespace, newEIP.Spec.PodSelector); err != nil {
+					if err := e.addNamespaceEgressIPAssignments(ni, newEIP.Name, newEIP.Status.Items, mark, newEIP.Spec.DestinationSelector, namespace, newEIP.Spec.PodSelector); err != nil {
 						return fmt.Errorf("network %s: failed to add namespace %s egress IP config: %v", ni.GetNetworkName(), namespace.Name, err)
 					}
 				}
@@ -410,7 +508,7 @@ func (e *EgressIPController) reconcileEgressIP(old, new *egressipv1.EgressIP) (e
 						if err != nil {
 							return fmt.Errorf("failed to get active network for namespace %s: %v", namespace.Name, err)
 						}
-						if err := e.addPodEgressIPAssignmentsWithLock(ni, newEIP.Name, newEIP.Status.Items, mark, pod); err != nil {
+						if err := e.addPodEgressIPAssignmentsWithLock(ni, newEIP.Name, newEIP.Status.Items, mark, newEIP.Spec.DestinationSelector, pod); err != nil {
 							return fmt.Errorf("network %s: failed to add pod %s/%s egress IP config: %v", ni.GetNetworkName(), pod.Namespace, pod.Name, err)
 						}
 					}
@@ -450,7 +548,7 @@ func (e *EgressIPController) reconcileEgressIP(old, new *egressipv1.EgressIP) (e
 					for _, pod := range pods {
 						podLabels := labels.Set(pod.Labels)
 						if newPodSelector.Matches(podLabels) {
-							if err := e.addPodEgressIPAssignmentsWithLock(ni, newEIP.Name, newEIP.Status.Items, mark, pod); err != nil {
+							if err := e.addPodEgressIPAssignmentsWithLock(ni, newEIP.Name, newEIP.Status.Items, mark, newEIP.Spec.DestinationSelector, pod); err != nil {
 								return fmt.Errorf("network %s: failed to add pod %s/%s egress IP config: %v", ni.GetNetworkName(), pod.Namespace, pod.Name, err)
 							}
 						}
@@ -474,7 +572,7 @@ func (e *EgressIPController) reconcileEgressIP(old, new *egressipv1.EgressIP) (e
 							continue
 						}
 						if newPodSelector.Matches(podLabels) && !oldPodSelector.Matches(podLabels) {
-							if err := e.addPodEgressIPAssignmentsWithLock(ni, newEIP.Name, newEIP.Status.Items, mark, pod); err != nil {
+							if err := e.addPodEgressIPAssignmentsWithLock(ni, newEIP.Name, newEIP.Status.Items, mark, newEIP.Spec.DestinationSelector, pod); err != nil {
 								return fmt.Errorf("network %s: failed to add pod %s/%s egress IP config: %v", ni.GetNetworkName(), pod.Namespace, pod.Name, err)
 							}
 						}
@@ -553,7 +651,7 @@ func (e *EgressIPController) reconcileEgressIPNamespace(old, new *corev1.Namespa
 				if err != nil {
 					return fmt.Errorf("failed to get active network for namespace %s: %v", namespaceName, err)
 				}
-				if err := e.addNamespaceEgressIPAssignments(ni, eIP.Name, eIP.Status.Items, mark, newNamespace, eIP.Spec.PodSelector); err != nil {
+				if err := e.addNamespaceEgressIPAssignments(ni, eIP.Name, eIP.Status.Items, mark, eIP.Spec.DestinationSelector, newNamespace, eIP.Spec.PodSelector); err != nil {
 					return fmt.Errorf("network %s: failed to add namespace %q for egress IP %q: %w",
 						ni.GetNetworkName(), namespaceName, eIP.Name, err)
 				}
@@ -686,7 +784,7 @@ func (e *EgressIPController) reconcileEgressIPPod(old, new *corev1.Pod) (err err
 					// IPs assigned at that point and we need to continue trying the
 					// pod setup for every pod update as to make sure we process the
 					// pod IP assignment.
-					if err := e.addPodEgressIPAssignmentsWithLock(ni, eIP.Name, eIP.Status.Items, mark, newPod); err != nil {
+					if err := e.addPodEgressIPAssignmentsWithLock(ni, eIP.Name, eIP.Status.Items, mark, eIP.Spec.DestinationSelector, newPod); err != nil {
 						return fmt.Errorf("network %s: failed to add pod %s/%s for egress IP %q: %w",
 							ni.GetNetworkName(), newPod.Namespace, newPod.Name, eIP.Name, err)
 					}
@@ -703,7 +801,7 @@ func (e *EgressIPController) reconcileEgressIPPod(old, new *corev1.Pod) (err err
 					return nil
 				}
 				// For all else, perform a setup for the pod
-				if err := e.addPodEgressIPAssignmentsWithLock(ni, eIP.Name, eIP.Status.Items, mark, newPod); err != nil {
+				if err := e.addPodEgressIPAssignmentsWithLock(ni, eIP.Name, eIP.Status.Items, mark, eIP.Spec.DestinationSelector, newPod); err != nil {
 					return fmt.Errorf("network %s: failed to add pod %s/%s for egress IP %q: %w",
 						ni.GetNetworkName(), newPod.Namespace, newPod.Name, eIP.Name, err)
 				}
@@ -719,7 +817,8 @@ func (e *EgressIPController) reconcileEgressIPPod(old, new *corev1.Pod) (err err
 
 // main reconcile functions end here and local zone controller functions begin
 
-func (e *EgressIPController) addEgressIPAssignments(name string, statusAssignments []egressipv1.EgressIPStatusItem, mark util.EgressIPMark, namespaceSelector, podSelector metav1.LabelSelector) error {
+func (e *EgressIPController) addEgressIPAssignments(name string, statusAssignments []egressipv1.EgressIPStatusItem, mark util.EgressIPMark,
+	destinations *egressipv1.EgressIPDestinationSelector, namespaceSelector, podSelector metav1.LabelSelector) error {
 	namespaces, err := e.watchFactory.GetNamespacesBySelector(namespaceSelector)
 	if err != nil {
 		return err
@@ -729,7 +828,7 @@ func (e *EgressIPController) addEgressIPAssignments(name string, statusAssignmen
 		if err != nil {
 			return fmt.Errorf("failed to get active network for namespace %s: %v", namespace.Name, err)
 		}
-		if err := e.addNamespaceEgressIPAssignments(ni, name, statusAssignments, mark, namespace, podSelector); err != nil {
+		if err := e.addNamespaceEgressIPAssignments(ni, name, statusAssignments, mark, destinations, namespace, podSelector); err != nil {
 			return err
 		}
 	}
@@ -737,7 +836,7 @@ func (e *EgressIPController) addEgressIPAssignments(name string, statusAssignmen
 }
 
 func (e *EgressIPController) addNamespaceEgressIPAssignments(ni util.NetInfo, name string, statusAssignments []egressipv1.EgressIPStatusItem, mark util.EgressIPMark,
-	namespace *corev1.Namespace, podSelector metav1.LabelSelector) error {
+	destinations *egressipv1.EgressIPDestinationSelector, namespace *corev1.Namespace, podSelector metav1.LabelSelector) error {
 	var pods []*corev1.Pod
 	var err error
 	selector, err := metav1.LabelSelectorAsSelector(&podSelector)
@@ -756,18 +855,19 @@ func (e *EgressIPController) addNamespaceEgressIPAssignments(ni util.NetInfo, na
 		}
 	}
 	for _, pod := range pods {
-		if err := e.addPodEgressIPAssignmentsWithLock(ni, name, statusAssignments, mark, pod); err != nil {
+		if err := e.addPodEgressIPAssignmentsWithLock(ni, name, statusAssignments, mark, destinations, pod); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
-func (e *EgressIPController) addPodEgressIPAssignmentsWithLock(ni util.NetInfo, name string, statusAssignments []egressipv1.EgressIPStatusItem, mark util.EgressIPMark, pod *corev1.Pod) error {
+func (e *EgressIPController) addPodEgressIPAssignmentsWithLock(ni util.NetInfo, name string, statusAssignments []egressipv1.EgressIPStatusItem, mark util.EgressIPMark,
+	destinations *egressipv1.EgressIPDestinationSelector, pod *corev1.Pod) error {
 	e.podAssignment.LockKey(getPodKey(pod))
 	defer e.podAssignment.UnlockKey(getPodKey(pod))
 	e.deletePreviousNetworkPodEgressIPAssignments(ni, name, statusAssignments, pod)
-	return e.addPodEgressIPAssignments(ni, name, statusAssignments, mark, pod)
+	return e.addPodEgressIPAssignments(ni, name, statusAssignments, mark, destinations, pod)
 }
 
 // addPodEgressIPAssignments tracks the setup made for each egress IP matching
@@ -775,7 +875,8 @@ func (e *EgressIPController) addPodEgressIPAssignmentsWithLock(ni util.NetInfo,
 // work on ovnkube-master restarts when all egress IP handlers will most likely
 // match and perform the setup for the same pod and status multiple times over.
 // requires holding the podAssignmentMutex lock
-func (e *EgressIPController) addPodEgressIPAssignments(ni util.NetInfo, name string, statusAssignments []egressipv1.EgressIPStatusItem, mark util.EgressIPMark, pod *corev1.Pod) error {
+func (e *EgressIPController) addPodEgressIPAssignments(ni util.NetInfo, name string, statusAssignments []egressipv1.EgressIPStatusItem, mark util.EgressIPMark,
+	destinations *egressipv1.EgressIPDestinationSelector, pod *corev1.Pod) error {
 	podKey := getPodKey(pod)
 	// If pod is already in succeeded or failed state, return it without proceeding further.
 	if util.PodCompleted(pod) {
@@ -871,7 +972,7 @@ func (e *EgressIPController) addPodEgressIPAssignments(ni util.NetInfo, name str
 		err = e.nodeZoneState.DoWithLock(status.Node, func(_ string) error {
 			if status.Node == pod.Spec.NodeName {
 				// we are safe, no need to grab lock again
-				if err := e.addPodEgressIPAssignment(ni, name, status, mark, pod, podIPNets); err != nil {
+				if err := e.addPodEgressIPAssignment(ni, name, status, mark, destinations, pod, podIPNets); err != nil {
 					return fmt.Errorf("unable to create egressip configuration for pod %s/%s/%v, err: %w", pod.Namespace, pod.Name, podIPNets, err)
 				}
 				podState.egressStatuses.statusMap[status] = ""
@@ -879,7 +980,7 @@ func (e *EgressIPController) addPodEgressIPAssignments(ni util.NetInfo, name str
 			}
 			return e.nodeZoneState.DoWithLock(pod.Spec.NodeName, func(_ string) error {
 				// we need to grab lock again for pod's node
-				if err := e.addPodEgressIPAssignment(ni, name, status, mark, pod, podIPNets); err != nil {
+				if err := e.addPodEgressIPAssignment(ni, name, status, mark, destinations, pod, podIPNets); err != nil {
 					return fmt.Errorf("unable to create egressip configuration for pod %s/%s/%v, err: %w", pod.Namespace, pod.Name, podIPNets, err)
 				}
 				podState.egressStatuses.statusMap[status] = ""
@@ -2334,7 +2435,7 @@ func (e *EgressIPController) addStandByEgressIPAssignment(ni util.NetInfo, podKe
 	}
 	e.podAssignment.Store(podKey, podState)
 	// NOTE: We let addPodEgressIPAssignments take care of setting egressIPName and egressStatuses and removing it from standBy
-	err = e.addPodEgressIPAssignments(ni, eipToAssign, eip.Status.Items, mark, pod)
+	err = e.addPodEgressIPAssignments(ni, eipToAssign, eip.Status.Items, mark, eip.Spec.DestinationSelector, pod)
 	if err != nil {
 		return fmt.Errorf("failed to add standby pod %s/%s for network %s: %v", pod.Namespace, pod.Name, ni.GetNetworkName(), err)
 	}
@@ -2346,7 +2447,7 @@ func (e *EgressIPController) addStandByEgressIPAssignment(ni util.NetInfo, podKe
 // (SNAT-ing to the egress IP).
 // This function should be called with lock on nodeZoneState cache key status.Node and pod.Spec.NodeName
 func (e *EgressIPController) addPodEgressIPAssignment(ni util.NetInfo, egressIPName string, status egressipv1.EgressIPStatusItem, mark util.EgressIPMark,
-	pod *corev1.Pod, podIPs []*net.IPNet) (err error) {
+	destinations *egressipv1.EgressIPDestinationSelector, pod *corev1.Pod, podIPs []*net.IPNet) (err error) {
 	if config.Metrics.EnableScaleMetrics {
 		start := time.Now()
 		defer func() {
@@ -2370,6 +2471,15 @@ func (e *EgressIPController) addPodEgressIPAssignment(ni util.NetInfo, egressIPN
 		return fmt.Errorf("failed to get node %s egress IP config: %w", eNode.Name, err)
 	}
 	isOVNNetwork := util.IsOVNNetwork(parsedNodeEIPConfig, eIPIP)
+	destinationMatch, hasDestinations, err := buildEgressIPDestinationMatch(destinations, utilnet.IsIPv6(eIPIP))
+	if err != nil {
+		return fmt.Errorf("failed to get the destinations of egress IP %s: %v", egressIPName, err)
+	}
+	if !hasDestinations {
+		klog.V(5).Infof("Egress IP %s of EgressIP %s doesn't apply to any destination of its IP family, skipping pod %s/%s",
+			status.EgressIP, egressIPName, pod.Namespace, pod.Name)
+		return nil
+	}
 	nextHopIP, err := e.getNextHop(ni, status.Node, status.EgressIP, egressIPName, isLocalZoneEgressNode, isOVNNetwork)
 	if err != nil {
 		return fmt.Errorf("failed to determine next hop for pod %s/%s when configuring egress IP %s"+
@@ -2386,14 +2496,14 @@ func (e *EgressIPController) addPodEgressIPAssignment(ni util.NetInfo, egressIPN
 		// L2 UDNs require LRPs with reroute action with a pkt_mark option attached to GW router.
 		if isOVNNetwork {
 			if ni.IsDefault() {
-				ops, err = e.createNATRuleOps(ni, nil, podIPs, status, egressIPName, pod.Namespace, pod.Name)
+				ops, err = e.createNATRuleOps(ni, nil, podIPs, status, egressIPName, destinationMatch, pod.Namespace, pod.Name)
 				if err != nil {
 					return fmt.Errorf("unable to create NAT rule ops for status: %v, err: %v", status, err)
 				}
 
 			} else if ni.IsUserDefinedNetwork() && ni.TopologyType() == types.Layer3Topology {
 				// not required for L2 because we always have LRPs using reroute action to pkt mark
-				ops, err = e.createGWMarkPolicyOps(ni, ops, podIPs, status, mark, pod.Namespace, pod.Name, egressIPName)
+				ops, err = e.createGWMarkPolicyOps(ni, ops, podIPs, status, mark, pod.Namespace, pod.Name, egressIPName, destinationMatch)
 				if err != nil {
 					return fmt.Errorf("unable to create GW router LRP ops to packet mark pod %s/%s: %v", pod.Namespace, pod.Name, err)
 				}
@@ -2406,7 +2516,7 @@ func (e *EgressIPController) addPodEgressIPAssignment(ni util.NetInfo, egressIPN
 			if err != nil {
 				return err
 			}
-			ops, err = e.createReroutePolicyOps(ni, ops, podIPs, status, mark, egressIPName, nextHopIP, routerName, pod.Namespace, pod.Name)
+			ops, err = e.createReroutePolicyOps(ni, ops, podIPs, status, mark, egressIPName, nextHopIP, routerName, destinationMatch, pod.Namespace, pod.Name)
 			if err != nil {
 				return fmt.Errorf("unable to create logical router policy ops %v, err: %v", status, err)
 			}
@@ -2427,12 +2537,14 @@ func (e *EgressIPController) addPodEgressIPAssignment(ni util.NetInfo, egressIPN
 	// don't add a reroute policy if the egress node towards which we are adding this doesn't exist
 	if loadedEgressNode && loadedPodNode {
 		if isLocalZonePod || (isLocalZoneEgressNode && ni.IsUserDefinedNetwork() && ni.TopologyType() == types.Layer2Topology) {
-			ops, err = e.createReroutePolicyOps(ni, ops, podIPs, status, mark, egressIPName, nextHopIP, routerName, pod.Namespace, pod.Name)
+			ops, err = e.createReroutePolicyOps(ni, ops, podIPs, status, mark, egressIPName, nextHopIP, routerName, destinationMatch, pod.Namespace, pod.Name)
 			if err != nil {
 				return fmt.Errorf("unable to create logical router policy ops, err: %v", err)
 			}
 		}
-		if isLocalZonePod {
+		// the traffic toward other destinations than the ones of the egress IP
+		// keeps being SNATed by the node hosting the pod
+		if isLocalZonePod && destinationMatch == "" {
 			ops, err = e.deleteExternalGWPodSNATOps(ni, ops, pod, podIPs, status, isOVNNetwork)
 			if err != nil {
 				return err
@@ -2821,8 +2933,10 @@ func (e *EgressIPController) getNextHop(ni util.NetInfo, egressNodeName, egressI
 // to redirect the pods to the appropriate management port or if interconnect is
 // enabled, the appropriate transit switch port.
 // This function should be called with lock on nodeZoneState cache key status.Node
+// The policy only matches the traffic toward the destinations of the egress IP
+// if destinationMatch is set.
 func (e *EgressIPController) createReroutePolicyOps(ni util.NetInfo, ops []ovsdb.Operation, podIPNets []*net.IPNet, status egressipv1.EgressIPStatusItem,
-	mark util.EgressIPMark, egressIPName, nextHopIP, routerName, podNamespace, podName string) ([]ovsdb.Operation, error) {
+	mark util.EgressIPMark, egressIPName, nextHopIP, routerName, destinationMatch, podNamespace, podName string) ([]ovsdb.Operation, error) {
 	isEgressIPv6 := utilnet.IsIPv6String(status.EgressIP)
 	ipFamily := getEIPIPFamily(isEgressIPv6)
 	options := make(map[string]string)
@@ -2839,7 +2953,7 @@ func (e *EgressIPController) createReroutePolicyOps(ni util.NetInfo, ops []ovsdb
 	for _, podIPNet := range util.MatchAllIPNetFamily(isEgressIPv6, podIPNets) {
 
 		lrp := nbdb.LogicalRouterPolicy{
-			Match:       fmt.Sprintf("%s.src == %s", ipFamilyName(isEgressIPv6), podIPNet.IP.String()),
+			Match:       withEgressIPDestinationMatch(destinationMatch, fmt.Sprintf("%s.src == %s", ipFamilyName(isEgressIPv6), podIPNet.IP.String())),
 			Priority:    types.EgressIPReroutePriority,
 			Nexthops:    []string{nextHopIP},
 			Action:      nbdb.LogicalRouterPolicyActionReroute,
@@ -2892,7 +3006,7 @@ func (e *EgressIPController) deleteReroutePolicyOps(ni util.NetInfo, ops []ovsdb
 }
 
 func (e *EgressIPController) createGWMarkPolicyOps(ni util.NetInfo, ops []ovsdb.Operation, podIPNets []*net.IPNet, status egressipv1.EgressIPStatusItem,
-	mark util.EgressIPMark, podNamespace, podName, egressIPName string) ([]ovsdb.Operation, error) {
+	mark util.EgressIPMark, podNamespace, podName, egressIPName, destinationMatch string) ([]ovsdb.Operation, error) {
 	isEgressIPv6 := utilnet.IsIPv6String(status.EgressIP)
 	routerName := ni.GetNetworkScopedGWRouterName(status.Node)
 	options := make(map[string]string)
@@ -2907,7 +3021,7 @@ func (e *EgressIPController) createGWMarkPolicyOps(ni util.NetInfo, ops []ovsdb.
 	// Handle all pod IPs that match the egress IP address family
 	for _, podIPNet := range util.MatchAllIPNetFamily(isEgressIPv6, podIPNets) {
 		lrp := nbdb.LogicalRouterPolicy{
-			Match:       fmt.Sprintf("%s.src == %s && pkt.mark == 0", ovnIPFamilyName, podIPNet.IP.String()), // only add pkt mark if one already doesn't exist
+			Match:       withEgressIPDestinationMatch(destinationMatch, fmt.Sprintf("%s.src == %s && pkt.mark == 0", ovnIPFamilyName, podIPNet.IP.String())), // only add pkt mark if one already doesn't exist
 			Priority:    types.EgressIPSNATMarkPriority,
 			Action:      nbdb.LogicalRouterPolicyActionAllow,
 			ExternalIDs: dbIDs.GetExternalIDs(),
@@ -3622,7 +3736,7 @@ func createEgressIPLogicalRouterPolicy(nbClient libovsdbclient.Client, clusterRo
 	return nil
 }
 
-func (e *EgressIPController) buildSNATFromEgressIPStatus(ni util.NetInfo, podIP net.IP, status egressipv1.EgressIPStatusItem, egressIPName, podNamespace, podName string) (*nbdb.NAT, error) {
+func (e *EgressIPController) buildSNATFromEgressIPStatus(ni util.NetInfo, podIP net.IP, status egressipv1.EgressIPStatusItem, egressIPName, destinationMatch, podNamespace, podName string) (*nbdb.NAT, error) {
 	logicalIP := &net.IPNet{
 		IP:   podIP,
 		Mask: util.GetIPFullMask(podIP),
@@ -3634,18 +3748,23 @@ func (e *EgressIPController) buildSNATFromEgressIPStatus(ni util.NetInfo, podIP
 	externalIP := net.ParseIP(status.EgressIP)
 	logicalPort := ni.GetNetworkScopedK8sMgmtIntfName(status.Node)
 	externalIds := getEgressIPNATDbIDs(egressIPName, podNamespace, podName, ipFamily, e.controllerName).GetExternalIDs()
-	nat := libovsdbops.BuildSNAT(&externalIP, logicalIP, logicalPort, externalIds)
+	nat := libovsdbops.BuildSNATWithMatch(&externalIP, logicalIP, logicalPort, externalIds, destinationMatch)
+	if destinationMatch != "" {
+		// take precedence over the SNAT of the pod to the node IP, which is
+		// kept for the traffic toward the other destinations
+		nat.Priority = 1
+	}
 	return nat, nil
 }
 
 func (e *EgressIPController) createNATRuleOps(ni util.NetInfo, ops []ovsdb.Operation, podIPs []*net.IPNet, status egressipv1.EgressIPStatusItem,
-	egressIPName, podNamespace, podName string) ([]ovsdb.Operation, error) {
+	egressIPName, destinationMatch, podNamespace, podName string) ([]ovsdb.Operation, error) {
 	nats := make([]*nbdb.NAT, 0, len(podIPs))
 	var nat *nbdb.NAT
 	var err error
 	for _, podIP := range podIPs {
 		if (utilnet.IsIPv6String(status.EgressIP) && utilnet.IsIPv6(podIP.IP)) || (!utilnet.IsIPv6String(status.EgressIP) && !utilnet.IsIPv6(podIP.IP)) {
-			nat, err = e.buildSNATFromEgressIPStatus(ni, podIP.IP, status, egressIPName, podNamespace, podName)
+			nat, err = e.buildSNATFromEgressIPStatus(ni, podIP.IP, status, egressIPName, destinationMatch, podNamespace, podName)
 			if err != nil {
 				return nil, err
 			}
@@ -3788,6 +3907,39 @@ func getEgressIPPktMark(eipName string, annotations map[string]string) util.Egre
 	return mark
 }
 
+// buildEgressIPDestinationMatch returns the match restricting the traffic of the
+// given IP family to the destinations of an EgressIP, empty if the EgressIP
+// applies to all destinations. It returns false if the EgressIP doesn't apply
+// to any destination of the IP family.
+func buildEgressIPDestinationMatch(destinations *egressipv1.EgressIPDestinationSelector, isIPv6 bool) (string, bool, error) {
+	if destinations == nil {
+		return "", true, nil
+	}
+	var cidrs []string
+	for _, cidr := range destinations.CIDRs {
+		_, ipNet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return "", false, fmt.Errorf("invalid destination CIDR %q: %v", cidr, err)
+		}
+		if utilnet.IsIPv6CIDR(ipNet) == isIPv6 {
+			cidrs = append(cidrs, ipNet.String())
+		}
+	}
+	if len(cidrs) == 0 {
+		return "", false, nil
+	}
+	return fmt.Sprintf("%s.dst == {%s}", ipFamilyName(isIPv6), strings.Join(cidrs, ", ")), true, nil
+}
+
+// withEgressIPDestinationMatch prefixes the match with the destination match,
+// if any, keeping the pod IP last in the match.
+func withEgressIPDestinationMatch(destinationMatch, match string) string {
+	if destinationMatch == "" {
+		return match
+	}
+	return destinationMatch + " && " + match
+}
+
 func getPodIPFromEIPSNATMarkMatch(match string) string {
 	//format ${IP family}.src == ${pod IP}
 	if match == "" {
@@ -0,0 +1,346 @@
+package ovn
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"net"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+
+	libovsdbcache "github.com/ovn-kubernetes/libovsdb/cache"
+	libovsdbclient "github.com/ovn-kubernetes/libovsdb/client"
+	"github.com/ovn-kubernetes/libovsdb/model"
+	"github.com/ovn-kubernetes/libovsdb/ovsdb"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
+	libovsdbops "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
+	utilerrors "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util/errors"
+)
+
+// egressIPBFDStatusRetryInterval is the interval after which a failed report of
+// the EgressIP BFD status is retried
+const egressIPBFDStatusRetryInterval = 5 * time.Second
+
+// egressIPBFDSession is a BFD session run by the gateway router of a local
+// egress node with another egress node, when the EgressIP node reachability
+// is checked with BFD.
+type egressIPBFDSession struct {
+	node     string
+	peerNode string
+	peerIP   net.IP
+	ipFamily egressIPFamilyValue
+}
+
+func getEgressIPBFDDbIDs(node, peerNode string, ipFamily egressIPFamilyValue, controller string) *libovsdbops.DbObjectIDs {
+	return libovsdbops.NewDbObjectIDs(libovsdbops.BFDEgressIP, controller, map[libovsdbops.ExternalIDKey]string{
+		libovsdbops.ObjectNameKey: node,
+		libovsdbops.PeerNodeKey:   peerNode,
+		libovsdbops.IPFamilyKey:   string(ipFamily),
+	})
+}
+
+func getEgressIPBFDRouteDbIDs(node, peerNode string, ipFamily egressIPFamilyValue, controller string) *libovsdbops.DbObjectIDs {
+	return libovsdbops.NewDbObjectIDs(libovsdbops.LogicalRouterStaticRouteEgressIP, controller, map[libovsdbops.ExternalIDKey]string{
+		libovsdbops.ObjectNameKey: node,
+		libovsdbops.PeerNodeKey:   peerNode,
+		libovsdbops.IPFamilyKey:   string(ipFamily),
+	})
+}
+
+func isEgressIPBFDEnabled() bool {
+	return config.OVNKubernetesFeature.EgressIPReachabilityMode == config.EgressIPReachabilityModeBFD
+}
+
+func isEgressIPBFDNode(node *corev1.Node) bool {
+	_, hasEgressLabel := node.Labels[util.GetNodeEgressLabel()]
+	return hasEgressLabel && !util.NoHostSubnet(node)
+}
+
+// egressIPBFDNodeChanged returns true if the BFD sessions run with or by the
+// node need to be synced following the node update.
+func egressIPBFDNodeChanged(oldNode, newNode *corev1.Node) bool {
+	wasBFDNode := isEgressIPBFDNode(oldNode)
+	isBFDNode := isEgressIPBFDNode(newNode)
+	if !wasBFDNode && !isBFDNode {
+		return false
+	}
+	return wasBFDNode != isBFDNode ||
+		oldNode.Annotations[util.OvnNodeIfAddr] != newNode.Annotations[util.OvnNodeIfAddr] ||
+		util.NodeZoneAnnotationChanged(oldNode, newNode) ||
+		util.NodeMigratedZoneAnnotationChanged(oldNode, newNode)
+}
+
+// getEgressIPBFDSessions returns the BFD sessions the gateway routers of the
+// local egress nodes are expected to run. A session is run with every other
+// egress node whose primary IP belongs to the subnet of the local egress node
+// primary IP, so that the BFD packets are exchanged directly between the
+// gateway routers.
+func (e *EgressIPController) getEgressIPBFDSessions() ([]egressIPBFDSession, error) {
+	nodes, err := e.watchFactory.GetNodes()
+	if err != nil {
+		return nil, fmt.Errorf("unable to list nodes: %w", err)
+	}
+	egressNodeConfigs := map[string]*util.ParsedNodeEgressIPConfiguration{}
+	localEgressNodes := []string{}
+	for _, node := range nodes {
+		if !isEgressIPBFDNode(node) {
+			continue
+		}
+		nodeConfig, err := util.ParseNodePrimaryIfAddr(node)
+		if err != nil {
+			// the node will be synced again once annotated
+			klog.V(5).Infof("Unable to get the primary IP of egress node %s for BFD: %v", node.Name, err)
+			continue
+		}
+		egressNodeConfigs[node.Name] = nodeConfig
+		if e.isLocalZoneNode(node) {
+			localEgressNodes = append(localEgressNodes, node.Name)
+		}
+	}
+
+	sessions := []egressIPBFDSession{}
+	for _, node := range localEgressNodes {
+		nodeConfig := egressNodeConfigs[node]
+		for peerNode, peerConfig := range egressNodeConfigs {
+			if peerNode == node {
+				continue
+			}
+			if nodeConfig.V4.Net != nil && peerConfig.V4.IP != nil && nodeConfig.V4.Net.Contains(peerConfig.V4.IP) {
+				sessions = append(sessions, egressIPBFDSession{node, peerNode, peerConfig.V4.IP, IPFamilyValueV4})
+			}
+			if nodeConfig.V6.Net != nil && peerConfig.V6.IP != nil && nodeConfig.V6.Net.Contains(peerConfig.V6.IP) {
+				sessions = append(sessions, egressIPBFDSession{node, peerNode, peerConfig.V6.IP, IPFamilyValueV6})
+			}
+		}
+	}
+	return sessions, nil
+}
+
+// syncEgressIPBFDSessions ensures the gateway router of every local egress node
+// runs a BFD session with the other egress nodes, and removes the sessions
+// which are not needed anymore, all of them when the EgressIP node reachability
+// is not checked with BFD. OVN only runs the BFD sessions referenced by a
+// static route, hence each session comes with a static route toward the other
+// egress node, which is equivalent to the route of the directly connected
+// subnet the other egress node belongs to.
+func (e *EgressIPController) syncEgressIPBFDSessions() error {
+	e.bfdMutex.Lock()
+	defer e.bfdMutex.Unlock()
+
+	var err error
+	sessions := []egressIPBFDSession{}
+	if isEgressIPBFDEnabled() {
+		sessions, err = e.getEgressIPBFDSessions()
+		if err != nil {
+			return err
+		}
+	}
+
+	var errs []error
+	var ops []ovsdb.Operation
+	expectedBFDs := sets.New[string]()
+	expectedRoutes := sets.New[string]()
+	for _, session := range sessions {
+		gwRouterName := types.GWRouterPrefix + session.node
+		if _, err := libovsdbops.GetLogicalRouter(e.nbClient, &nbdb.LogicalRouter{Name: gwRouterName}); err != nil {
+			if errors.Is(err, libovsdbclient.ErrNotFound) {
+				// the sessions will be created on retry, once the gateway router exists
+				errs = append(errs, fmt.Errorf("gateway router %s of egress node %s not found", gwRouterName, session.node))
+				continue
+			}
+			return fmt.Errorf("unable to get gateway router %s: %w", gwRouterName, err)
+		}
+		outputPort := types.GWRouterToExtSwitchPrefix + gwRouterName
+		bfd := &nbdb.BFD{
+			LogicalPort: outputPort,
+			DstIP:       session.peerIP.String(),
+			MinTx:       ptr.To(config.OVNKubernetesFeature.EgressIPBFDInterval),
+			MinRx:       ptr.To(config.OVNKubernetesFeature.EgressIPBFDInterval),
+			DetectMult:  ptr.To(config.OVNKubernetesFeature.EgressIPBFDMultiplier),
+			ExternalIDs: getEgressIPBFDDbIDs(session.node, session.peerNode, session.ipFamily, e.controllerName).GetExternalIDs(),
+		}
+		ops, err = libovsdbops.CreateOrUpdateBFDOps(e.nbClient, ops, bfd)
+		if err != nil {
+			return fmt.Errorf("unable to create or update BFD %+v: %w", bfd, err)
+		}
+		expectedBFDs.Insert(bfd.UUID)
+
+		mask := util.GetIPFullMaskString(session.peerIP.String())
+		routeDbIDs := getEgressIPBFDRouteDbIDs(session.node, session.peerNode, session.ipFamily, e.controllerName)
+		route := &nbdb.LogicalRouterStaticRoute{
+			IPPrefix:    session.peerIP.String() + mask,
+			Nexthop:     session.peerIP.String(),
+			OutputPort:  &outputPort,
+			BFD:         &bfd.UUID,
+			ExternalIDs: routeDbIDs.GetExternalIDs(),
+		}
+		ops, err = libovsdbops.CreateOrReplaceLogicalRouterStaticRouteWithPredicateOps(e.nbClient, ops, gwRouterName, route,
+			libovsdbops.GetPredicate[*nbdb.LogicalRouterStaticRoute](routeDbIDs, nil))
+		if err != nil {
+			return fmt.Errorf("unable to create or update static route %+v on router %s: %w", route, gwRouterName, err)
+		}
+		expectedRoutes.Insert(route.UUID)
+	}
+
+	staleRoutes, err := libovsdbops.FindLogicalRouterStaticRoutesWithPredicate(e.nbClient,
+		libovsdbops.GetPredicate[*nbdb.LogicalRouterStaticRoute](
+			libovsdbops.NewDbObjectIDs(libovsdbops.LogicalRouterStaticRouteEgressIP, e.controllerName, nil),
+			func(item *nbdb.LogicalRouterStaticRoute) bool { return !expectedRoutes.Has(item.UUID) }))
+	if err != nil {
+		return fmt.Errorf("unable to find stale EgressIP BFD static routes: %w", err)
+	}
+	for _, route := range staleRoutes {
+		gwRouterName := types.GWRouterPrefix + route.ExternalIDs[libovsdbops.ObjectNameKey.String()]
+		ops, err = libovsdbops.DeleteLogicalRouterStaticRoutesOps(e.nbClient, ops, gwRouterName, route)
+		if err != nil {
+			return fmt.Errorf("unable to delete stale static route %+v from router %s: %w", route, gwRouterName, err)
+		}
+	}
+	staleBFDs, err := libovsdbops.FindBFDsWithPredicate(e.nbClient, libovsdbops.GetPredicate[*nbdb.BFD](
+		libovsdbops.NewDbObjectIDs(libovsdbops.BFDEgressIP, e.controllerName, nil),
+		func(item *nbdb.BFD) bool { return !expectedBFDs.Has(item.UUID) }))
+	if err != nil {
+		return fmt.Errorf("unable to find stale EgressIP BFDs: %w", err)
+	}
+	ops, err = libovsdbops.DeleteBFDsOps(e.nbClient, ops, staleBFDs...)
+	if err != nil {
+		return fmt.Errorf("unable to delete stale EgressIP BFDs: %w", err)
+	}
+
+	if _, err = libovsdbops.TransactAndCheck(e.nbClient, ops); err != nil {
+		return fmt.Errorf("unable to sync EgressIP BFD sessions: %w", err)
+	}
+	return utilerrors.Join(errs...)
+}
+
+// startEgressIPBFDStatusReporter reports the status of the BFD sessions run by
+// the local egress nodes in their k8s.ovn.org/egressip-bfd-status annotation,
+// every time ovn-northd updates the status of one of the sessions. The cluster
+// manager relies on the reports to detect the unreachable egress nodes.
+func (e *EgressIPController) startEgressIPBFDStatusReporter(stopChan <-chan struct{}) {
+	if !isEgressIPBFDEnabled() {
+		return
+	}
+	bfdDbIDs := libovsdbops.NewDbObjectIDs(libovsdbops.BFDEgressIP, e.controllerName, nil)
+	isEgressIPBFD := libovsdbops.GetPredicate[*nbdb.BFD](bfdDbIDs, nil)
+	e.nbClient.Cache().AddEventHandler(&libovsdbcache.EventHandlerFuncs{
+		AddFunc: func(_ string, m model.Model) {
+			if bfd, ok := m.(*nbdb.BFD); ok && isEgressIPBFD(bfd) {
+				e.triggerEgressIPBFDStatusReport()
+			}
+		},
+		UpdateFunc: func(_ string, old, new model.Model) {
+			oldBFD, ok := old.(*nbdb.BFD)
+			if !ok {
+				return
+			}
+			newBFD := new.(*nbdb.BFD)
+			if isEgressIPBFD(newBFD) && ptr.Deref(oldBFD.Status, "") != ptr.Deref(newBFD.Status, "") {
+				e.triggerEgressIPBFDStatusReport()
+			}
+		},
+		DeleteFunc: func(_ string, m model.Model) {
+			if bfd, ok := m.(*nbdb.BFD); ok && isEgressIPBFD(bfd) {
+				e.triggerEgressIPBFDStatusReport()
+			}
+		},
+	})
+
+	go func() {
+		for {
+			select {
+			case <-e.bfdStatusTrigger:
+				if err := e.reportEgressIPBFDStatus(); err != nil {
+					klog.Errorf("Failed to report the status of the EgressIP BFD sessions: %v", err)
+					// don't wait for the next status update to retry, not
+					// to lose the reports of the sessions going down
+					time.AfterFunc(egressIPBFDStatusRetryInterval, e.triggerEgressIPBFDStatusReport)
+				}
+			case <-stopChan:
+				klog.V(5).Infof("Stop channel got triggered: will stop reporting the EgressIP BFD status")
+				return
+			}
+		}
+	}()
+	e.triggerEgressIPBFDStatusReport()
+}
+
+func (e *EgressIPController) triggerEgressIPBFDStatusReport() {
+	select {
+	case e.bfdStatusTrigger <- struct{}{}:
+	default:
+		// a report is already pending
+	}
+}
+
+// reportEgressIPBFDStatus annotates the local egress nodes with the status of
+// the BFD sessions they run. A session is reported once it has been up, and
+// then keeps being reported until it is removed, so that the sessions which
+// can't be established, e.g. because BFD packets are filtered, don't make the
+// other egress nodes unreachable: the cluster manager falls back to its
+// regular reachability check for the egress nodes nobody reports about.
+func (e *EgressIPController) reportEgressIPBFDStatus() error {
+	bfds, err := libovsdbops.FindBFDsWithPredicate(e.nbClient, libovsdbops.GetPredicate[*nbdb.BFD](
+		libovsdbops.NewDbObjectIDs(libovsdbops.BFDEgressIP, e.controllerName, nil), nil))
+	if err != nil {
+		return fmt.Errorf("unable to find EgressIP BFDs: %w", err)
+	}
+	// a dual stack peer is up as long as one of its sessions is up
+	sessionsUp := map[string]map[string]bool{}
+	for _, bfd := range bfds {
+		node := bfd.ExternalIDs[libovsdbops.ObjectNameKey.String()]
+		peerNode := bfd.ExternalIDs[libovsdbops.PeerNodeKey.String()]
+		if sessionsUp[node] == nil {
+			sessionsUp[node] = map[string]bool{}
+		}
+		sessionsUp[node][peerNode] = sessionsUp[node][peerNode] || ptr.Deref(bfd.Status, "") == nbdb.BFDStatusUp
+	}
+
+	nodes, err := e.watchFactory.GetNodes()
+	if err != nil {
+		return fmt.Errorf("unable to list nodes: %w", err)
+	}
+	var errs []error
+	for _, node := range nodes {
+		if !e.isLocalZoneNode(node) {
+			continue
+		}
+		reportedStatus, err := util.ParseNodeEgressIPBFDStatus(node)
+		if err != nil {
+			klog.Warningf("Overwriting invalid EgressIP BFD status of node %s: %v", node.Name, err)
+			reportedStatus = map[string]string{}
+		}
+		status := map[string]string{}
+		for peerNode, isUp := range sessionsUp[node.Name] {
+			if isUp {
+				status[peerNode] = util.EgressIPBFDStatusUp
+			} else if _, reported := reportedStatus[peerNode]; reported {
+				status[peerNode] = util.EgressIPBFDStatusDown
+			}
+		}
+		if maps.Equal(status, reportedStatus) {
+			continue
+		}
+		var annotation interface{}
+		if len(status) > 0 {
+			bytes, err := json.Marshal(status)
+			if err != nil {
+				return fmt.Errorf("unable to marshal EgressIP BFD status %v: %w", status, err)
+			}
+			annotation = string(bytes)
+		}
+		if err := e.kube.SetAnnotationsOnNode(node.Name, map[string]interface{}{util.OVNNodeEgressIPBFDStatus: annotation}); err != nil {
+			errs = append(errs, fmt.Errorf("unable to report EgressIP BFD status of node %s: %w", node.Name, err))
+		}
+	}
+	return utilerrors.Join(errs...)
+}
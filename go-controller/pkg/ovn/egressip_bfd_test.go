@@ -0,0 +1,152 @@
+package ovn
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
+	libovsdbops "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/testing/libovsdb"
+	t "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
+)
+
+var _ = ginkgo.Describe("OVN EgressIP BFD node reachability", func() {
+	var fakeOVN *FakeOVN
+
+	ginkgo.BeforeEach(func() {
+		gomega.Expect(config.PrepareTestConfig()).To(gomega.Succeed())
+		config.OVNKubernetesFeature.EnableEgressIP = true
+		config.OVNKubernetesFeature.EgressIPReachabilityMode = config.EgressIPReachabilityModeBFD
+		fakeOVN = NewFakeOVN(false)
+	})
+
+	ginkgo.AfterEach(func() {
+		fakeOVN.shutdown()
+	})
+
+	newEgressNode := func(name, ip string) corev1.Node {
+		return corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   name,
+				Labels: map[string]string{util.GetNodeEgressLabel(): ""},
+				Annotations: map[string]string{
+					util.OvnNodeIfAddr: fmt.Sprintf("{\"ipv4\": \"%s\"}", ip),
+				},
+			},
+		}
+	}
+
+	newGatewayRouter := func(node string) *nbdb.LogicalRouter {
+		return &nbdb.LogicalRouter{
+			UUID: t.GWRouterPrefix + node + "-UUID",
+			Name: t.GWRouterPrefix + node,
+		}
+	}
+
+	getBFDs := func() []*nbdb.BFD {
+		bfds, err := libovsdbops.FindBFDsWithPredicate(fakeOVN.nbClient, libovsdbops.GetPredicate[*nbdb.BFD](
+			libovsdbops.NewDbObjectIDs(libovsdbops.BFDEgressIP, fakeOVN.controller.eIPC.controllerName, nil), nil))
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		return bfds
+	}
+
+	getRoutes := func() []*nbdb.LogicalRouterStaticRoute {
+		routes, err := libovsdbops.FindLogicalRouterStaticRoutesWithPredicate(fakeOVN.nbClient,
+			libovsdbops.GetPredicate[*nbdb.LogicalRouterStaticRoute](
+				libovsdbops.NewDbObjectIDs(libovsdbops.LogicalRouterStaticRouteEgressIP, fakeOVN.controller.eIPC.controllerName, nil), nil))
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		return routes
+	}
+
+	setBFDStatus := func(bfd *nbdb.BFD, status string) {
+		bfd.Status = ptr.To(status)
+		ops, err := libovsdbops.CreateOrUpdateBFDOps(fakeOVN.nbClient, nil, bfd)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		_, err = libovsdbops.TransactAndCheck(fakeOVN.nbClient, ops)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+	}
+
+	getReportedStatus := func(nodeName string) string {
+		node, err := fakeOVN.fakeClient.KubeClient.CoreV1().Nodes().Get(context.TODO(), nodeName, metav1.GetOptions{})
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		return node.Annotations[util.OVNNodeEgressIPBFDStatus]
+	}
+
+	ginkgo.It("runs BFD sessions between the egress nodes of the same subnet and reports their status", func() {
+		node1 := newEgressNode("node1", "192.168.126.11/24")
+		node2 := newEgressNode("node2", "192.168.126.12/24")
+		node3 := newEgressNode("node3", "10.0.0.13/24")
+		fakeOVN.startWithDBSetup(
+			libovsdb.TestSetup{NBData: []libovsdb.TestData{
+				newGatewayRouter(node1.Name),
+				newGatewayRouter(node2.Name),
+				newGatewayRouter(node3.Name),
+			}},
+			&corev1.NodeList{Items: []corev1.Node{node1, node2, node3}},
+		)
+
+		ginkgo.By("creating a session with a static route on each side")
+		gomega.Expect(fakeOVN.controller.eIPC.syncEgressIPBFDSessions()).To(gomega.Succeed())
+		bfds := getBFDs()
+		gomega.Expect(bfds).To(gomega.HaveLen(2))
+		var bfd1to2 *nbdb.BFD
+		for _, bfd := range bfds {
+			gomega.Expect(bfd.MinTx).To(gomega.Equal(ptr.To(config.OVNKubernetesFeature.EgressIPBFDInterval)))
+			gomega.Expect(bfd.DetectMult).To(gomega.Equal(ptr.To(config.OVNKubernetesFeature.EgressIPBFDMultiplier)))
+			if bfd.LogicalPort == t.GWRouterToExtSwitchPrefix+t.GWRouterPrefix+node1.Name {
+				gomega.Expect(bfd.DstIP).To(gomega.Equal("192.168.126.12"))
+				gomega.Expect(bfd.ExternalIDs).To(gomega.HaveKeyWithValue(libovsdbops.PeerNodeKey.String(), node2.Name))
+				bfd1to2 = bfd
+			} else {
+				gomega.Expect(bfd.LogicalPort).To(gomega.Equal(t.GWRouterToExtSwitchPrefix + t.GWRouterPrefix + node2.Name))
+				gomega.Expect(bfd.DstIP).To(gomega.Equal("192.168.126.11"))
+			}
+		}
+		gomega.Expect(bfd1to2).NotTo(gomega.BeNil())
+		routes := getRoutes()
+		gomega.Expect(routes).To(gomega.HaveLen(2))
+		for _, route := range routes {
+			gomega.Expect(route.BFD).NotTo(gomega.BeNil())
+			gomega.Expect(route.IPPrefix).To(gomega.Equal(route.Nexthop + "/32"))
+		}
+		gr1, err := libovsdbops.GetLogicalRouter(fakeOVN.nbClient, newGatewayRouter(node1.Name))
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(gr1.StaticRoutes).To(gomega.HaveLen(1))
+
+		ginkgo.By("reporting the sessions once up")
+		gomega.Expect(fakeOVN.controller.eIPC.reportEgressIPBFDStatus()).To(gomega.Succeed())
+		gomega.Expect(getReportedStatus(node1.Name)).To(gomega.BeEmpty())
+		setBFDStatus(bfd1to2, nbdb.BFDStatusUp)
+		gomega.Expect(fakeOVN.controller.eIPC.reportEgressIPBFDStatus()).To(gomega.Succeed())
+		gomega.Expect(getReportedStatus(node1.Name)).To(gomega.MatchJSON(`{"node2":"up"}`))
+
+		ginkgo.By("reporting the sessions going down")
+		setBFDStatus(bfd1to2, nbdb.BFDStatusDown)
+		gomega.Eventually(func() string {
+			// the reported status is read from the informer cache
+			gomega.Expect(fakeOVN.controller.eIPC.reportEgressIPBFDStatus()).To(gomega.Succeed())
+			return getReportedStatus(node1.Name)
+		}).Should(gomega.MatchJSON(`{"node2":"down"}`))
+
+		ginkgo.By("removing the sessions with the nodes not used for egress anymore")
+		node2.Labels = map[string]string{}
+		_, err = fakeOVN.fakeClient.KubeClient.CoreV1().Nodes().Update(context.TODO(), &node2, metav1.UpdateOptions{})
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Eventually(func() []*nbdb.BFD {
+			gomega.Expect(fakeOVN.controller.eIPC.syncEgressIPBFDSessions()).To(gomega.Succeed())
+			return getBFDs()
+		}).Should(gomega.BeEmpty())
+		gomega.Expect(getRoutes()).To(gomega.BeEmpty())
+		gomega.Expect(fakeOVN.controller.eIPC.reportEgressIPBFDStatus()).To(gomega.Succeed())
+		gomega.Expect(getReportedStatus(node1.Name)).To(gomega.BeEmpty())
+	})
+})
@@ -12,6 +12,7 @@ import (
 	"github.com/urfave/cli/v2"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8stypes "k8s.io/apimachinery/pkg/types"
 	utilnet "k8s.io/utils/net"
@@ -115,6 +116,18 @@ var _ = ginkgo.Describe("OVN master EgressIP Operations cluster default network"
 		}
 	}
 
+	getEgressIPZoneConditionStatus := func(egressIPName, zone string) func() metav1.ConditionStatus {
+		return func() metav1.ConditionStatus {
+			tmp, err := fakeOvn.fakeClient.EgressIPClient.K8sV1().EgressIPs().Get(context.TODO(), egressIPName, metav1.GetOptions{})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			condition := meta.FindStatusCondition(tmp.Status.Conditions, egressipv1.EgressIPProgrammedInZoneConditionPrefix+zone)
+			if condition == nil {
+				return metav1.ConditionUnknown
+			}
+			return condition.Status
+		}
+	}
+
 	getEgressIPStatus := func(egressIPName string) ([]string, []string) {
 		tmp, err := fakeOvn.fakeClient.EgressIPClient.K8sV1().EgressIPs().Get(context.TODO(), egressIPName, metav1.GetOptions{})
 		gomega.Expect(err).NotTo(gomega.HaveOccurred())
@@ -3590,6 +3603,169 @@ var _ = ginkgo.Describe("OVN master EgressIP Operations cluster default network"
 		})
 	})
 
+	ginkgo.Context("on EgressIP with destinationSelector", func() {
+		ginkgo.It("should scope OVN pod egress setup to the destinations and unscope it when the selector is removed", func() {
+			config.OVNKubernetesFeature.EnableInterconnect = true
+			app.Action = func(*cli.Context) error {
+				egressPod := *newPodWithLabels(eipNamespace, podName, node1Name, podV4IP, egressPodLabel)
+				egressNamespace := newNamespace(eipNamespace)
+				nodeIPv4 := "192.168.126.210/24"
+				egressIP := net.ParseIP("192.168.126.211")
+				_, nodeSubnetV4, _ := net.ParseCIDR(v4Node1Subnet)
+				_, nodeSubnetV6, _ := net.ParseCIDR(v6Node1Subnet)
+
+				annotations := map[string]string{
+					"k8s.ovn.org/node-primary-ifaddr":             fmt.Sprintf("{\"ipv4\": \"%s\", \"ipv6\": \"%s\"}", nodeIPv4, ""),
+					"k8s.ovn.org/node-subnets":                    fmt.Sprintf("{\"default\":\"%s\",\"%s\"}", v4Node1Subnet, v6Node1Subnet),
+					"k8s.ovn.org/node-transit-switch-port-ifaddr": "{\"ipv4\":\"100.88.0.2/16\", \"ipv6\": \"fd97::2/64\"}",
+					util.OVNNodeHostCIDRs:                         fmt.Sprintf("[\"%s\"]", nodeIPv4),
+					"k8s.ovn.org/zone-name":                       node1Name,
+				}
+				node := getNodeObj(node1Name, annotations, map[string]string{})
+				fakeOvn.startWithDBSetup(
+					libovsdbtest.TestSetup{
+						NBData: []libovsdbtest.TestData{
+							&nbdb.LogicalRouterPort{
+								UUID:     types.GWRouterToJoinSwitchPrefix + types.GWRouterPrefix + node1Name + "-UUID",
+								Name:     types.GWRouterToJoinSwitchPrefix + types.GWRouterPrefix + node1Name,
+								Networks: []string{nodeLogicalRouterIfAddrV6, nodeLogicalRouterIfAddrV4},
+							},
+							&nbdb.LogicalRouter{
+								Name: types.OVNClusterRouter,
+								UUID: types.OVNClusterRouter + "-UUID",
+							},
+							&nbdb.LogicalRouter{
+								Name:    types.GWRouterPrefix + node1Name,
+								UUID:    types.GWRouterPrefix + node1Name + "-UUID",
+								Ports:   []string{types.GWRouterToJoinSwitchPrefix + types.GWRouterPrefix + node1Name + "-UUID"},
+								Options: map[string]string{"dynamic_neigh_routers": "false"},
+							},
+							&nbdb.LogicalSwitchPort{
+								UUID: "k8s-" + node.Name + "-UUID",
+								Name: "k8s-" + node.Name,
+								Addresses: []string{"fe:1a:b2:3f:0e:fb " + util.GetNodeManagementIfAddr(nodeSubnetV4).IP.String(),
+									"fe:1a:b2:3f:0e:fb " + util.GetNodeManagementIfAddr(nodeSubnetV6).IP.String()},
+							},
+							&nbdb.LogicalSwitch{
+								UUID:  node.Name + "-UUID",
+								Name:  node.Name,
+								Ports: []string{"k8s-" + node.Name + "-UUID"},
+							},
+						},
+					},
+					&corev1.NamespaceList{
+						Items: []corev1.Namespace{*egressNamespace},
+					},
+					&corev1.PodList{
+						Items: []corev1.Pod{egressPod},
+					},
+					&corev1.NodeList{
+						Items: []corev1.Node{node},
+					},
+				)
+
+				eIP := egressipv1.EgressIP{
+					ObjectMeta: newEgressIPMeta(egressIPName),
+					Spec: egressipv1.EgressIPSpec{
+						EgressIPs: []string{
+							egressIP.String(),
+						},
+						NamespaceSelector: metav1.LabelSelector{
+							MatchLabels: map[string]string{
+								"name": egressNamespace.Name,
+							},
+						},
+						PodSelector: metav1.LabelSelector{
+							MatchLabels: egressPodLabel,
+						},
+						DestinationSelector: &egressipv1.EgressIPDestinationSelector{
+							CIDRs: []string{"203.0.113.0/24", "198.51.100.0/24", "2001:db8::/64"},
+						},
+					},
+				}
+				i, n, _ := net.ParseCIDR(podV4IP + "/23")
+				n.IP = i
+				fakeOvn.controller.logicalPortCache.add(&egressPod, "", types.DefaultNetworkName, "", nil, []*net.IPNet{n})
+				err := fakeOvn.controller.WatchEgressIPNamespaces()
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				err = fakeOvn.controller.WatchEgressIPPods()
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				err = fakeOvn.controller.WatchEgressIP()
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				fakeOvn.controller.eIPC.nodeZoneState.Store(nodeName, true)
+				_, err = fakeOvn.fakeClient.EgressIPClient.K8sV1().EgressIPs().Create(context.TODO(), &eIP, metav1.CreateOptions{})
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				fakeOvn.patchEgressIPObj(node1Name, egressIPName, egressIP.String())
+				gomega.Eventually(getEgressIPStatusLen(eIP.Name)).Should(gomega.Equal(1))
+
+				getExpectedDatabaseState := func(destinationMatch string) []libovsdbtest.TestData {
+					reroutePolicy := getReRoutePolicy(egressPod.Status.PodIP, "4", "reroute-UUID", nodeLogicalRouterIPv4,
+						getEgressIPLRPReRouteDbIDs(eIP.Name, egressPod.Namespace, egressPod.Name, IPFamilyValueV4,
+							types.DefaultNetworkName, fakeOvn.controller.eIPC.controllerName).GetExternalIDs())
+					nat := getEIPSNAT(podV4IP, egressPod.Namespace, egressPod.Name, egressIP.String(), "k8s-node1", DefaultNetworkControllerName)
+					if destinationMatch != "" {
+						reroutePolicy.Match = destinationMatch + " && " + reroutePolicy.Match
+						nat.Match = destinationMatch
+						nat.Priority = 1
+					}
+					return []libovsdbtest.TestData{
+						reroutePolicy,
+						nat,
+						&nbdb.LogicalRouter{
+							Name:     types.OVNClusterRouter,
+							UUID:     types.OVNClusterRouter + "-UUID",
+							Policies: []string{"reroute-UUID"},
+						},
+						&nbdb.LogicalRouterPort{
+							UUID:     types.GWRouterToJoinSwitchPrefix + types.GWRouterPrefix + node1Name + "-UUID",
+							Name:     types.GWRouterToJoinSwitchPrefix + types.GWRouterPrefix + node1Name,
+							Networks: []string{nodeLogicalRouterIfAddrV6, nodeLogicalRouterIfAddrV4},
+						},
+						&nbdb.LogicalRouter{
+							Name:    types.GWRouterPrefix + node1Name,
+							UUID:    types.GWRouterPrefix + node1Name + "-UUID",
+							Ports:   []string{types.GWRouterToJoinSwitchPrefix + types.GWRouterPrefix + node1Name + "-UUID"},
+							Nat:     []string{"egressip-nat-UUID"},
+							Options: map[string]string{"dynamic_neigh_routers": "false"},
+						},
+						&nbdb.LogicalSwitchPort{
+							UUID: "k8s-" + node.Name + "-UUID",
+							Name: "k8s-" + node.Name,
+							Addresses: []string{"fe:1a:b2:3f:0e:fb " + util.GetNodeManagementIfAddr(nodeSubnetV4).IP.String(),
+								"fe:1a:b2:3f:0e:fb " + util.GetNodeManagementIfAddr(nodeSubnetV6).IP.String()},
+						},
+						&nbdb.LogicalSwitch{
+							UUID:  node.Name + "-UUID",
+							Name:  node.Name,
+							Ports: []string{"k8s-" + node.Name + "-UUID"},
+						},
+					}
+				}
+				ginkgo.By("only rerouting and SNATing the traffic toward the IPv4 destinations")
+				gomega.Eventually(fakeOvn.nbClient).Should(libovsdbtest.HaveData(getExpectedDatabaseState("ip4.dst == {203.0.113.0/24, 198.51.100.0/24}")))
+
+				ginkgo.By("rerouting and SNATing all the traffic once the destinationSelector is removed")
+				updatedEIP, err := fakeOvn.fakeClient.EgressIPClient.K8sV1().EgressIPs().Get(context.TODO(), eIP.Name, metav1.GetOptions{})
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				updatedEIP.Spec.DestinationSelector = nil
+				_, err = fakeOvn.fakeClient.EgressIPClient.K8sV1().EgressIPs().Update(context.TODO(), updatedEIP, metav1.UpdateOptions{})
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				gomega.Eventually(fakeOvn.nbClient).Should(libovsdbtest.HaveData(getExpectedDatabaseState("")))
+
+				ginkgo.By("using the destinations of the reconciled EgressIP rather than the ones of the informer cache")
+				reconciledEIP := updatedEIP.DeepCopy()
+				reconciledEIP.Spec.DestinationSelector = &egressipv1.EgressIPDestinationSelector{
+					CIDRs: []string{"203.0.113.0/24"},
+				}
+				gomega.Expect(fakeOvn.controller.eIPC.reconcileEgressIP(updatedEIP, reconciledEIP)).To(gomega.Succeed())
+				gomega.Eventually(fakeOvn.nbClient).Should(libovsdbtest.HaveData(getExpectedDatabaseState("ip4.dst == {203.0.113.0/24}")))
+				return nil
+			}
+			err := app.Run([]string{app.Name})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		})
+	})
+
 	ginkgo.Context("IPv6 on pod UPDATE", func() {
 
 		ginkgo.DescribeTable("should remove OVN pod egress setup when EgressIP stops matching pod label",
@@ -5131,6 +5307,10 @@ var _ = ginkgo.Describe("OVN master EgressIP Operations cluster default network"
 					key, err := retry.GetResourceKey(&eIP)
 					gomega.Expect(err).NotTo(gomega.HaveOccurred())
 					retry.CheckRetryObjectEventually(key, true, fakeOvn.controller.retryEgressIPs)
+					gomega.Eventually(getEgressIPZoneConditionStatus(eIP.Name, fakeOvn.controller.zone)).Should(gomega.Equal(metav1.ConditionFalse))
+					// reporting the failure must not be handled as a successful update
+					gomega.Consistently(getEgressIPZoneConditionStatus(eIP.Name, fakeOvn.controller.zone)).Should(gomega.Equal(metav1.ConditionFalse))
+					retry.CheckRetryObjectEventually(key, true, fakeOvn.controller.retryEgressIPs)
 
 					connCtx, cancel := context.WithTimeout(context.Background(), config.Default.OVSDBTxnTimeout)
 					defer cancel()
@@ -5139,6 +5319,7 @@ var _ = ginkgo.Describe("OVN master EgressIP Operations cluster default network"
 					fakeOvn.controller.retryEgressIPs.RequestRetryObjs()
 					// check the cache no longer has the entry
 					retry.CheckRetryObjectEventually(key, false, fakeOvn.controller.retryEgressIPs)
+					gomega.Eventually(getEgressIPZoneConditionStatus(eIP.Name, fakeOvn.controller.zone)).Should(gomega.Equal(metav1.ConditionTrue))
 
 					gomega.Eventually(getEgressIPStatusLen(eIP.Name)).Should(gomega.Equal(1))
 
@@ -7167,7 +7348,7 @@ var _ = ginkgo.Describe("OVN master EgressIP Operations cluster default network"
 				// recreate pod with same name immediately; simulating handler race (pods v/s egressip) condition,
 				// so instead of proper pod create, we try out egressIP pod setup which will be a no-op since pod doesn't exist
 				ginkgo.By("should not add egress IP setup for a deleted pod whose entry exists in logicalPortCache")
-				err = fakeOvn.controller.eIPC.addPodEgressIPAssignments(fakeOvn.controller, egressIPName, eIP.Status.Items, util.EgressIPMark{}, &egressPod1)
+				err = fakeOvn.controller.eIPC.addPodEgressIPAssignments(fakeOvn.controller, egressIPName, eIP.Status.Items, util.EgressIPMark{}, eIP.Spec.DestinationSelector, &egressPod1)
 				gomega.Expect(err).To(gomega.HaveOccurred())
 				// pod is gone but logicalPortCache holds the entry for 60seconds
 				egressPodPortInfo, err = fakeOvn.controller.logicalPortCache.get(&egressPod1, types.DefaultNetworkName)
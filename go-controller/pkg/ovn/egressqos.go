@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -15,6 +16,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/wait"
 	metaapplyv1 "k8s.io/client-go/applyconfigurations/meta/v1"
 	v1coreinformers "k8s.io/client-go/informers/core/v1"
@@ -32,6 +34,7 @@ import (
 	egressqosinformer "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressqos/v1/apis/informers/externalversions/egressqos/v1"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/factory"
 	libovsdbops "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
+	libovsdbutil "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/util"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
 	addressset "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn/address_set"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
@@ -65,6 +68,7 @@ type egressQoSRule struct {
 	addrSet     addressset.AddressSet
 	pods        *sync.Map // pods name -> ips in the addrSet
 	podSelector metav1.LabelSelector
+	ports       []*libovsdbutil.NetworkPolicyPort
 }
 
 func getEgressQosAddrSetDbIDs(namespace, priority, controller string) *libovsdbops.DbObjectIDs {
@@ -128,16 +132,49 @@ func (oc *DefaultNetworkController) cloneEgressQoSRule(raw egressqosapi.EgressQo
 		return nil, err
 	}
 
+	ports, err := getEgressQoSRulePorts(raw.Ports)
+	if err != nil {
+		return nil, err
+	}
+
 	eqr := &egressQoSRule{
 		priority:    priority,
 		dscp:        raw.DSCP,
 		destination: dst,
 		podSelector: raw.PodSelector,
+		ports:       ports,
 	}
 
 	return eqr, nil
 }
 
+// getEgressQoSRulePorts validates the ports of an EgressQoS rule and converts them to
+// their OVN representation. A port entry without port matches every port of its protocol,
+// so it supersedes the other entries of the same protocol.
+func getEgressQoSRulePorts(rawPorts []egressqosapi.EgressQoSPort) ([]*libovsdbutil.NetworkPolicyPort, error) {
+	anyPortProtocols := sets.New[string]()
+	for _, port := range rawPorts {
+		if libovsdbutil.ConvertK8sProtocolToOVNProtocol(corev1.Protocol(port.Protocol)) == "" {
+			return nil, fmt.Errorf("invalid protocol %q", port.Protocol)
+		}
+		if port.EndPort != 0 && (port.Port == 0 || port.EndPort < port.Port) {
+			return nil, fmt.Errorf("invalid port range %d-%d for protocol %s", port.Port, port.EndPort, port.Protocol)
+		}
+		if port.Port == 0 {
+			anyPortProtocols.Insert(port.Protocol)
+		}
+	}
+
+	ports := make([]*libovsdbutil.NetworkPolicyPort, 0, len(rawPorts))
+	for _, port := range rawPorts {
+		if port.Port != 0 && anyPortProtocols.Has(port.Protocol) {
+			continue
+		}
+		ports = append(ports, libovsdbutil.GetNetworkPolicyPort(corev1.Protocol(port.Protocol), port.Port, port.EndPort))
+	}
+	return ports, nil
+}
+
 func (oc *DefaultNetworkController) createASForEgressQoSRule(podSelector metav1.LabelSelector, namespace string, priority int) (addressset.AddressSet, *sync.Map, error) {
 	var addrSet addressset.AddressSet
 
@@ -653,7 +690,29 @@ func generateEgressQoSMatch(eq *egressQoSRule, hashedAddressSetNameIPv4, hashedA
 		}
 	}
 
-	return fmt.Sprintf("(%s) && %s", dst, src)
+	match := fmt.Sprintf("(%s) && %s", dst, src)
+	if l4Match := getEgressQoSL4Match(eq.ports); l4Match != "" {
+		match = fmt.Sprintf("%s && %s", match, l4Match)
+	}
+	return match
+}
+
+// getEgressQoSL4Match returns the match for the protocols and destination ports of a rule,
+// or an empty string if the rule applies to any protocol.
+func getEgressQoSL4Match(ports []*libovsdbutil.NetworkPolicyPort) string {
+	if len(ports) == 0 {
+		return ""
+	}
+	l4Matches := []string{}
+	for _, l4Match := range libovsdbutil.GetL4MatchesFromNetworkPolicyPorts(ports) {
+		l4Matches = append(l4Matches, fmt.Sprintf("(%s)", l4Match))
+	}
+	// sort the matches so that the QoS match doesn't change between syncs
+	sort.Strings(l4Matches)
+	if len(l4Matches) == 1 {
+		return l4Matches[0]
+	}
+	return fmt.Sprintf("(%s)", strings.Join(l4Matches, " || "))
 }
 
 func (oc *DefaultNetworkController) egressQoSSwitches() ([]string, error) {
@@ -512,6 +512,86 @@ var _ = ginkgo.Describe("OVN EgressQoS Operations", func() {
 		gomega.Expect(err).NotTo(gomega.HaveOccurred())
 	})
 
+	ginkgo.It("should match the protocols and ports of the rules", func() {
+		app.Action = func(*cli.Context) error {
+			namespaceT := *newNamespace("namespace1")
+
+			node1Switch := &nbdb.LogicalSwitch{
+				UUID: "node1-UUID",
+				Name: node1Name,
+			}
+
+			dbSetup := libovsdbtest.TestSetup{
+				NBData: []libovsdbtest.TestData{
+					node1Switch,
+				},
+			}
+
+			fakeOVN.startWithDBSetup(dbSetup,
+				&corev1.NamespaceList{
+					Items: []corev1.Namespace{
+						namespaceT,
+					},
+				},
+			)
+
+			eq := newEgressQoSObject("default", namespaceT.Name, []egressqosapi.EgressQoSRule{
+				{
+					DstCIDR: ptr.To("1.2.3.4/32"),
+					DSCP:    46,
+					Ports: []egressqosapi.EgressQoSPort{
+						{Protocol: "UDP", Port: 10000, EndPort: 20000},
+						{Protocol: "TCP", Port: 5060},
+						{Protocol: "UDP", Port: 5060},
+					},
+				},
+				{
+					DSCP: 10,
+					Ports: []egressqosapi.EgressQoSPort{
+						{Protocol: "SCTP"},
+						{Protocol: "SCTP", Port: 80},
+					},
+				},
+			})
+			_, err := fakeOVN.fakeClient.EgressQoSClient.K8sV1().EgressQoSes(namespaceT.Name).Create(context.TODO(), eq, metav1.CreateOptions{})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+			gomega.Expect(fakeOVN.InitAndRunEgressQoSController()).To(gomega.Succeed())
+
+			qos1 := &nbdb.QoS{
+				Direction: nbdb.QoSDirectionToLport,
+				Match: fmt.Sprintf("(ip4.dst == 1.2.3.4/32) && ip4.src == $%s && ((tcp && tcp.dst==5060) || (udp && (udp.dst==5060 || 10000<=udp.dst<=20000)))",
+					asv4),
+				Priority:    EgressQoSFlowStartPriority,
+				Action:      map[string]int{nbdb.QoSActionDSCP: 46},
+				ExternalIDs: getEgressQoSRuleDbIDs(namespaceT.Name, EgressQoSFlowStartPriority).GetExternalIDs(),
+				UUID:        "qos1-UUID",
+			}
+			// a protocol entry without port supersedes the ports of the same protocol
+			qos2 := &nbdb.QoS{
+				Direction:   nbdb.QoSDirectionToLport,
+				Match:       fmt.Sprintf("(ip4.dst == 0.0.0.0/0 || ip6.dst == ::/0) && ip4.src == $%s && (sctp)", asv4),
+				Priority:    EgressQoSFlowStartPriority - 1,
+				Action:      map[string]int{nbdb.QoSActionDSCP: 10},
+				ExternalIDs: getEgressQoSRuleDbIDs(namespaceT.Name, EgressQoSFlowStartPriority-1).GetExternalIDs(),
+				UUID:        "qos2-UUID",
+			}
+			node1Switch.QOSRules = []string{qos1.UUID, qos2.UUID}
+			expectedDatabaseState := []libovsdbtest.TestData{
+				qos1,
+				qos2,
+				node1Switch,
+			}
+
+			gomega.Eventually(fakeOVN.nbClient).Should(libovsdbtest.HaveDataIgnoringUUIDs(expectedDatabaseState))
+			expectEgressQoSStatusMessageEventually(fakeOVN, namespaceT.Name, false)
+
+			return nil
+		}
+		err := app.Run([]string{app.Name})
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+	})
+
 	ginkgo.It("should respond to node events correctly", func() {
 		app.Action = func(*cli.Context) error {
 			namespaceT := *newNamespace("namespace1")
@@ -782,6 +782,170 @@ var _ = ginkgo.Describe("OVN Egress Service Operations", func() {
 			ginkgo.Entry("IC Enabled, node1 is in the local zone, node2 in remote", true),
 		)
 
+		ginkgo.DescribeTable("should spread the traffic across multiple hosts", func(interconnectEnabled bool) {
+			app.Action = func(*cli.Context) error {
+				namespaceT := *newNamespace("testns")
+				config.IPv6Mode = true
+				config.OVNKubernetesFeature.EnableInterconnect = interconnectEnabled
+				node1 := nodeFor(node1Name, node1IPv4, node1IPv6, node1IPv4Subnet, node1IPv6Subnet, node1transitIPv4, node1transitIPv6)
+				node2 := nodeFor(node2Name, node2IPv4, node2IPv6, node2IPv4Subnet, node2IPv6Subnet, node2transitIPv4, node2transitIPv6)
+
+				clusterRouter := &nbdb.LogicalRouter{
+					Name: ovntypes.OVNClusterRouter,
+					UUID: ovntypes.OVNClusterRouter + "-UUID",
+				}
+
+				dbSetup := libovsdbtest.TestSetup{
+					NBData: []libovsdbtest.TestData{
+						clusterRouter,
+					},
+				}
+
+				ginkgo.By("creating a service allocated to both nodes")
+				esvc1 := egressserviceapi.EgressService{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "svc1",
+						Namespace: "testns",
+					},
+					Spec: egressserviceapi.EgressServiceSpec{
+						SourceIPBy: egressserviceapi.SourceIPLoadBalancer,
+						HostCount:  2,
+					},
+					Status: egressserviceapi.EgressServiceStatus{
+						Host:  node1Name,
+						Hosts: []string{node1Name, node2Name},
+					},
+				}
+				svc1 := lbSvcFor("testns", "svc1")
+
+				v4EpSlice := discovery.EndpointSlice{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "svc1-ipv4-epslice",
+						Namespace: "testns",
+						Labels: map[string]string{
+							discovery.LabelServiceName: "svc1",
+						},
+					},
+					AddressType: discovery.AddressTypeIPv4,
+					Endpoints: []discovery.Endpoint{
+						{
+							Addresses: []string{"10.128.1.5"},
+							NodeName:  &node1.Name,
+						},
+						{
+							Addresses: []string{"10.128.2.5"},
+							NodeName:  &node2.Name,
+						},
+					},
+				}
+
+				fakeOVN.startWithDBSetup(dbSetup,
+					&corev1.NamespaceList{
+						Items: []corev1.Namespace{
+							namespaceT,
+						},
+					},
+					&corev1.NodeList{
+						Items: []corev1.Node{
+							*node1,
+							*node2,
+						},
+					},
+					&corev1.ServiceList{
+						Items: []corev1.Service{
+							svc1,
+						},
+					},
+					&discovery.EndpointSliceList{
+						Items: []discovery.EndpointSlice{
+							v4EpSlice,
+						},
+					},
+					&egressserviceapi.EgressServiceList{
+						Items: []egressserviceapi.EgressService{
+							esvc1,
+						},
+					},
+				)
+
+				if interconnectEnabled {
+					fakeOVN.controller.zone = node1Name
+				}
+				fakeOVN.InitAndRunEgressSVCController()
+
+				var expectedDatabaseState []libovsdbtest.TestData
+				if !interconnectEnabled {
+					v4lrp1 := egressServiceRouterPolicy("v4lrp1-UUID", "testns/svc1", "10.128.1.5", "10.128.1.2")
+					v4lrp1.Nexthops = append(v4lrp1.Nexthops, "10.128.2.2")
+					v4lrp2 := egressServiceRouterPolicy("v4lrp2-UUID", "testns/svc1", "10.128.2.5", "10.128.1.2")
+					v4lrp2.Nexthops = append(v4lrp2.Nexthops, "10.128.2.2")
+					clusterRouter.Policies = []string{"v4lrp1-UUID", "v4lrp2-UUID"}
+					expectedDatabaseState = []libovsdbtest.TestData{
+						clusterRouter,
+						v4lrp1,
+						v4lrp2,
+					}
+				} else {
+					// the local endpoint is rerouted to the local node mgmt IP and to the remote node transit IP,
+					// the remote endpoint only to the local node mgmt IP.
+					v4lrp1 := egressServiceRouterPolicy("v4lrp1-UUID", "testns/svc1", "10.128.1.5", "10.128.1.2")
+					v4lrp1.Nexthops = append(v4lrp1.Nexthops, node2transitIPv4)
+					v4lrsr := egressServiceRouterPolicy("v4lrsr-UUID", "testns/svc1:ic", "10.128.2.5", "10.128.1.2")
+					clusterRouter.Policies = []string{"v4lrp1-UUID", "v4lrsr-UUID"}
+					expectedDatabaseState = []libovsdbtest.TestData{
+						clusterRouter,
+						v4lrp1,
+						v4lrsr,
+					}
+				}
+
+				for _, lrp := range getDefaultNoReroutePolicies(controllerName) {
+					expectedDatabaseState = append(expectedDatabaseState, lrp)
+					clusterRouter.Policies = append(clusterRouter.Policies, lrp.UUID)
+				}
+				gomega.Eventually(fakeOVN.nbClient).Should(libovsdbtest.HaveData(expectedDatabaseState))
+
+				ginkgo.By("removing the second node from the EgressService's hosts the traffic is only sent to the first node")
+				esvc1.Status.Hosts = []string{node1Name}
+				esvc1.ResourceVersion = "2"
+				_, err := fakeOVN.fakeClient.EgressServiceClient.K8sV1().EgressServices("testns").Update(context.TODO(), &esvc1, metav1.UpdateOptions{})
+				gomega.Expect(err).ToNot(gomega.HaveOccurred())
+
+				if !interconnectEnabled {
+					v4lrp1 := egressServiceRouterPolicy("v4lrp1-UUID", "testns/svc1", "10.128.1.5", "10.128.1.2")
+					v4lrp2 := egressServiceRouterPolicy("v4lrp2-UUID", "testns/svc1", "10.128.2.5", "10.128.1.2")
+					clusterRouter.Policies = []string{"v4lrp1-UUID", "v4lrp2-UUID"}
+					expectedDatabaseState = []libovsdbtest.TestData{
+						clusterRouter,
+						v4lrp1,
+						v4lrp2,
+					}
+				} else {
+					v4lrp1 := egressServiceRouterPolicy("v4lrp1-UUID", "testns/svc1", "10.128.1.5", "10.128.1.2")
+					v4lrsr := egressServiceRouterPolicy("v4lrsr-UUID", "testns/svc1:ic", "10.128.2.5", "10.128.1.2")
+					clusterRouter.Policies = []string{"v4lrp1-UUID", "v4lrsr-UUID"}
+					expectedDatabaseState = []libovsdbtest.TestData{
+						clusterRouter,
+						v4lrp1,
+						v4lrsr,
+					}
+				}
+
+				for _, lrp := range getDefaultNoReroutePolicies(controllerName) {
+					expectedDatabaseState = append(expectedDatabaseState, lrp)
+					clusterRouter.Policies = append(clusterRouter.Policies, lrp.UUID)
+				}
+				gomega.Eventually(fakeOVN.nbClient).Should(libovsdbtest.HaveData(expectedDatabaseState))
+
+				return nil
+			}
+			err := app.Run([]string{app.Name})
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+		},
+			ginkgo.Entry("IC Disabled, all nodes are in a single zone", false),
+			ginkgo.Entry("IC Enabled, node1 is in the local zone, node2 in remote", true),
+		)
+
 		ginkgo.DescribeTable("should delete resources when host changes to ALL", func(interconnectEnabled bool) {
 			app.Action = func(*cli.Context) error {
 				namespaceT := *newNamespace("testns")
@@ -17,10 +17,12 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/utils/ptr"
 
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
 	adminpolicybasedrouteapi "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/adminpolicybasedroute/v1"
 	adminpolicybasedrouteclientset "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/adminpolicybasedroute/v1/apis/clientset/versioned"
+	crdtypes "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/types"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/kube"
 	libovsdbops "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
@@ -1256,6 +1258,90 @@ var _ = ginkgo.Describe("OVN for APB External Route Operations", func() {
 			))
 	})
 
+	ginkgo.Context("on selecting the networks of the target namespaces", func() {
+
+		ginkgo.DescribeTable("does not route the pods of a namespace served by a primary user defined network", func(
+			networkSelectionType crdtypes.NetworkSelectionType, expectFailure bool) {
+			app.Action = func(*cli.Context) error {
+
+				namespaceT := *newUDNNamespace(namespaceName)
+
+				t := newTPod(
+					"node1",
+					"10.128.1.0/24",
+					"10.128.1.2",
+					"10.128.1.1",
+					"myPod",
+					"10.128.1.3",
+					"0a:58:0a:80:01:03",
+					namespaceT.Name,
+				)
+
+				policy := getStaticPolicy(false)
+				policy.Spec.From.NetworkSelectors = crdtypes.NetworkSelectors{
+					{NetworkSelectionType: networkSelectionType},
+				}
+				if networkSelectionType == crdtypes.PrimaryUserDefinedNetworks {
+					policy.Spec.From.NetworkSelectors[0].PrimaryUserDefinedNetworkSelector = &crdtypes.PrimaryUserDefinedNetworkSelector{
+						NamespaceSelector: metav1.LabelSelector{MatchLabels: map[string]string{"name": namespaceName}},
+					}
+				}
+
+				fakeOvn.startWithDBSetup(
+					libovsdbtest.TestSetup{
+						NBData: []libovsdbtest.TestData{
+							&nbdb.LogicalSwitch{
+								UUID: "node1",
+								Name: "node1",
+							},
+							&nbdb.LogicalRouter{
+								UUID: "GR_node1-UUID",
+								Name: "GR_node1",
+							},
+						},
+					},
+					&corev1.NamespaceList{
+						Items: []corev1.Namespace{
+							namespaceT,
+						},
+					},
+					&corev1.PodList{
+						Items: []corev1.Pod{
+							*newPod(t.namespace, t.podName, t.nodeName, t.podIP),
+						},
+					},
+					&adminpolicybasedrouteapi.AdminPolicyBasedExternalRouteList{
+						Items: []adminpolicybasedrouteapi.AdminPolicyBasedExternalRoute{
+							policy,
+						},
+					},
+				)
+
+				t.populateLogicalSwitchCache(fakeOvn)
+
+				injectNode(fakeOvn)
+				err := fakeOvn.controller.WatchNamespaces()
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				err = fakeOvn.controller.WatchPods()
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				fakeOvn.RunAPBExternalPolicyController()
+
+				checkAPBRouteStatus(fakeOvn, policyName, expectFailure)
+				gomega.Consistently(func() ([]*nbdb.LogicalRouterStaticRoute, error) {
+					return libovsdbops.FindLogicalRouterStaticRoutesWithPredicate(fakeOvn.nbClient,
+						func(*nbdb.LogicalRouterStaticRoute) bool { return true })
+				}, 2).Should(gomega.BeEmpty())
+				return nil
+			}
+
+			err := app.Run([]string{app.Name})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		},
+			ginkgo.Entry("when the default network is selected", crdtypes.DefaultNetwork, false),
+			ginkgo.Entry("when the primary user defined networks are selected", crdtypes.PrimaryUserDefinedNetworks, true),
+		)
+	})
+
 	ginkgo.Context("on setting pod dynamic gateways", func() {
 		ginkgo.DescribeTable("reconciles a host networked pod acting as a exgw for another namespace for new pod", func(bfd bool, finalNB []libovsdbtest.TestData) {
 			app.Action = func(*cli.Context) error {
@@ -2162,6 +2248,419 @@ var _ = ginkgo.Describe("OVN for APB External Route Operations", func() {
 			err := app.Run([]string{app.Name})
 			gomega.Expect(err).NotTo(gomega.HaveOccurred())
 		})
+		ginkgo.It("should only route through the backup next hops when every primary next hop is down", func() {
+			app.Action = func(*cli.Context) error {
+				namespaceT := *newNamespace(namespaceName)
+
+				t := newTPod(
+					"node1",
+					"10.128.1.0/24",
+					"10.128.1.2",
+					"10.128.1.1",
+					"myPod",
+					"10.128.1.3",
+					"0a:58:0a:80:01:03",
+					namespaceT.Name,
+				)
+				priorityPolicy := getStaticPolicy2IPs(true, false)
+				priorityPolicy.Spec.NextHops.StaticHops = []*adminpolicybasedrouteapi.StaticHop{
+					{IP: "9.0.0.1", BFDEnabled: true},
+					{IP: "9.0.0.2", BFDEnabled: true, Priority: 1},
+				}
+
+				fakeOvn.startWithDBSetup(
+					libovsdbtest.TestSetup{
+						NBData: []libovsdbtest.TestData{
+							&nbdb.LogicalSwitch{
+								UUID: "node1",
+								Name: "node1",
+							},
+							&nbdb.LogicalRouter{
+								UUID: "GR_node1-UUID",
+								Name: "GR_node1",
+							},
+						},
+					},
+					&corev1.NamespaceList{
+						Items: []corev1.Namespace{
+							namespaceT,
+						},
+					},
+					&corev1.PodList{
+						Items: []corev1.Pod{
+							*newPod(t.namespace, t.podName, t.nodeName, t.podIP),
+						},
+					},
+					&adminpolicybasedrouteapi.AdminPolicyBasedExternalRouteList{
+						Items: []adminpolicybasedrouteapi.AdminPolicyBasedExternalRoute{priorityPolicy},
+					},
+				)
+				t.populateLogicalSwitchCache(fakeOvn)
+
+				injectNode(fakeOvn)
+				err := fakeOvn.controller.WatchNamespaces()
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				err = fakeOvn.controller.WatchPods()
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				fakeOvn.RunAPBExternalPolicyController()
+
+				lsp := &nbdb.LogicalSwitchPort{
+					UUID:      "lsp1",
+					Addresses: []string{"0a:58:0a:80:01:03 10.128.1.3"},
+					ExternalIDs: map[string]string{
+						"pod":       "true",
+						"namespace": namespaceName,
+					},
+					Name: "namespace1_myPod",
+					Options: map[string]string{
+						"iface-id-ver":               "myPod",
+						libovsdbops.RequestedChassis: "node1",
+					},
+					PortSecurity: []string{"0a:58:0a:80:01:03 10.128.1.3"},
+				}
+				ls := &nbdb.LogicalSwitch{
+					UUID:  "node1",
+					Name:  "node1",
+					Ports: []string{"lsp1"},
+				}
+				primaryBFD := &nbdb.BFD{
+					UUID:        bfd1NamedUUID,
+					DstIP:       "9.0.0.1",
+					LogicalPort: "rtoe-GR_node1",
+				}
+				primaryRoute := &nbdb.LogicalRouterStaticRoute{
+					UUID:       "static-route-1-UUID",
+					IPPrefix:   "10.128.1.3/32",
+					Nexthop:    "9.0.0.1",
+					BFD:        &bfd1NamedUUID,
+					Policy:     &nbdb.LogicalRouterStaticRoutePolicySrcIP,
+					OutputPort: &logicalRouterPort,
+					Options: map[string]string{
+						"ecmp_symmetric_reply": "true",
+					},
+				}
+				finalNB := []libovsdbtest.TestData{
+					lsp,
+					ls,
+					primaryBFD,
+					primaryRoute,
+					&nbdb.LogicalRouter{
+						UUID:         "GR_node1-UUID",
+						Name:         "GR_node1",
+						StaticRoutes: []string{"static-route-1-UUID"},
+					},
+				}
+				gomega.Eventually(fakeOvn.nbClient, 5).Should(libovsdbtest.HaveData(finalNB))
+				checkAPBRouteStatus(fakeOvn, policyName, false)
+
+				ginkgo.By("Reporting the primary next hop down")
+				bfd, err := libovsdbops.LookupBFD(fakeOvn.nbClient, &nbdb.BFD{DstIP: "9.0.0.1", LogicalPort: logicalRouterPort})
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				bfd.Status = ptr.To(nbdb.BFDStatusDown)
+				ops, err := libovsdbops.CreateOrUpdateBFDOps(fakeOvn.nbClient, nil, bfd)
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				_, err = libovsdbops.TransactAndCheck(fakeOvn.nbClient, ops)
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+				primaryBFD.Status = ptr.To(nbdb.BFDStatusDown)
+				finalNB = []libovsdbtest.TestData{
+					lsp,
+					ls,
+					primaryBFD,
+					primaryRoute,
+					&nbdb.BFD{
+						UUID:        bfd2NamedUUID,
+						DstIP:       "9.0.0.2",
+						LogicalPort: "rtoe-GR_node1",
+					},
+					&nbdb.LogicalRouterStaticRoute{
+						UUID:       "static-route-2-UUID",
+						IPPrefix:   "10.128.1.3/32",
+						Nexthop:    "9.0.0.2",
+						BFD:        &bfd2NamedUUID,
+						Policy:     &nbdb.LogicalRouterStaticRoutePolicySrcIP,
+						OutputPort: &logicalRouterPort,
+						Options: map[string]string{
+							"ecmp_symmetric_reply": "true",
+						},
+					},
+					&nbdb.LogicalRouter{
+						UUID:         "GR_node1-UUID",
+						Name:         "GR_node1",
+						StaticRoutes: []string{"static-route-1-UUID", "static-route-2-UUID"},
+					},
+				}
+				gomega.Eventually(fakeOvn.nbClient, 5).Should(libovsdbtest.HaveData(finalNB))
+				gomega.Eventually(func() string { return getNextHopBFDConditionReason(fakeOvn, policyName, "9.0.0.1") }).
+					Should(gomega.Equal(adminpolicybasedrouteapi.NextHopBFDReasonDown))
+
+				ginkgo.By("Reporting the primary next hop up again")
+				bfd.Status = ptr.To(nbdb.BFDStatusUp)
+				ops, err = libovsdbops.CreateOrUpdateBFDOps(fakeOvn.nbClient, nil, bfd)
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				_, err = libovsdbops.TransactAndCheck(fakeOvn.nbClient, ops)
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+				primaryBFD.Status = ptr.To(nbdb.BFDStatusUp)
+				finalNB = []libovsdbtest.TestData{
+					lsp,
+					ls,
+					primaryBFD,
+					primaryRoute,
+					&nbdb.LogicalRouter{
+						UUID:         "GR_node1-UUID",
+						Name:         "GR_node1",
+						StaticRoutes: []string{"static-route-1-UUID"},
+					},
+				}
+				gomega.Eventually(fakeOvn.nbClient, 5).Should(libovsdbtest.HaveData(finalNB))
+				gomega.Eventually(func() string { return getNextHopBFDConditionReason(fakeOvn, policyName, "9.0.0.1") }).
+					Should(gomega.Equal(adminpolicybasedrouteapi.NextHopBFDReasonUp))
+				return nil
+			}
+
+			err := app.Run([]string{app.Name})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		})
+		ginkgo.It("should not route through the backup next hops before the BFD sessions of the primary next hops report their state", func() {
+			app.Action = func(*cli.Context) error {
+				namespaceT := *newNamespace(namespaceName)
+
+				t := newTPod(
+					"node1",
+					"10.128.1.0/24",
+					"10.128.1.2",
+					"10.128.1.1",
+					"myPod",
+					"10.128.1.3",
+					"0a:58:0a:80:01:03",
+					namespaceT.Name,
+				)
+				priorityPolicy := getStaticPolicy2IPs(true, false)
+				priorityPolicy.Spec.NextHops.StaticHops = []*adminpolicybasedrouteapi.StaticHop{
+					{IP: "9.0.0.1", BFDEnabled: true},
+					{IP: "9.0.0.2", BFDEnabled: true, Priority: 1},
+				}
+
+				fakeOvn.startWithDBSetup(
+					libovsdbtest.TestSetup{
+						NBData: []libovsdbtest.TestData{
+							&nbdb.LogicalSwitch{
+								UUID: "node1",
+								Name: "node1",
+							},
+							&nbdb.LogicalRouter{
+								UUID: "GR_node1-UUID",
+								Name: "GR_node1",
+							},
+						},
+					},
+					&corev1.NamespaceList{
+						Items: []corev1.Namespace{
+							namespaceT,
+						},
+					},
+					&corev1.PodList{
+						Items: []corev1.Pod{
+							*newPod(t.namespace, t.podName, t.nodeName, t.podIP),
+						},
+					},
+					&adminpolicybasedrouteapi.AdminPolicyBasedExternalRouteList{
+						Items: []adminpolicybasedrouteapi.AdminPolicyBasedExternalRoute{priorityPolicy},
+					},
+				)
+				t.populateLogicalSwitchCache(fakeOvn)
+
+				injectNode(fakeOvn)
+				err := fakeOvn.controller.WatchNamespaces()
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				err = fakeOvn.controller.WatchPods()
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				fakeOvn.RunAPBExternalPolicyController()
+
+				lsp := &nbdb.LogicalSwitchPort{
+					UUID:      "lsp1",
+					Addresses: []string{"0a:58:0a:80:01:03 10.128.1.3"},
+					ExternalIDs: map[string]string{
+						"pod":       "true",
+						"namespace": namespaceName,
+					},
+					Name: "namespace1_myPod",
+					Options: map[string]string{
+						"iface-id-ver":               "myPod",
+						libovsdbops.RequestedChassis: "node1",
+					},
+					PortSecurity: []string{"0a:58:0a:80:01:03 10.128.1.3"},
+				}
+				ls := &nbdb.LogicalSwitch{
+					UUID:  "node1",
+					Name:  "node1",
+					Ports: []string{"lsp1"},
+				}
+				finalNB := []libovsdbtest.TestData{
+					lsp,
+					ls,
+					&nbdb.BFD{
+						UUID:        bfd1NamedUUID,
+						DstIP:       "9.0.0.1",
+						LogicalPort: "rtoe-GR_node1",
+					},
+					&nbdb.LogicalRouterStaticRoute{
+						UUID:       "static-route-1-UUID",
+						IPPrefix:   "10.128.1.3/32",
+						Nexthop:    "9.0.0.1",
+						BFD:        &bfd1NamedUUID,
+						Policy:     &nbdb.LogicalRouterStaticRoutePolicySrcIP,
+						OutputPort: &logicalRouterPort,
+						Options: map[string]string{
+							"ecmp_symmetric_reply": "true",
+						},
+					},
+					&nbdb.LogicalRouter{
+						UUID:         "GR_node1-UUID",
+						Name:         "GR_node1",
+						StaticRoutes: []string{"static-route-1-UUID"},
+					},
+				}
+				// the BFD session of the primary next hop is created together with its route and has no state yet,
+				// the backup next hop must not get a route or a BFD session until the primary is reported down
+				gomega.Eventually(fakeOvn.nbClient, 5).Should(libovsdbtest.HaveData(finalNB))
+				gomega.Consistently(fakeOvn.nbClient, 2).Should(libovsdbtest.HaveData(finalNB))
+				checkAPBRouteStatus(fakeOvn, policyName, false)
+				gomega.Eventually(func() string { return getNextHopBFDConditionReason(fakeOvn, policyName, "9.0.0.1") }).
+					Should(gomega.Equal(adminpolicybasedrouteapi.NextHopBFDReasonPending))
+				return nil
+			}
+
+			err := app.Run([]string{app.Name})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		})
+		ginkgo.It("should configure the BFD session parameters of the next hop", func() {
+			app.Action = func(*cli.Context) error {
+				namespaceT := *newNamespace(namespaceName)
+
+				t := newTPod(
+					"node1",
+					"10.128.1.0/24",
+					"10.128.1.2",
+					"10.128.1.1",
+					"myPod",
+					"10.128.1.3",
+					"0a:58:0a:80:01:03",
+					namespaceT.Name,
+				)
+				bfdPolicy := getStaticPolicy(true)
+				bfdPolicy.Spec.NextHops.StaticHops[0].BFDConfig = &adminpolicybasedrouteapi.BFDConfig{
+					MinTx:      ptr.To[int32](100),
+					MinRx:      ptr.To[int32](200),
+					DetectMult: ptr.To[int32](3),
+				}
+
+				fakeOvn.startWithDBSetup(
+					libovsdbtest.TestSetup{
+						NBData: []libovsdbtest.TestData{
+							&nbdb.LogicalSwitch{
+								UUID: "node1",
+								Name: "node1",
+							},
+							&nbdb.LogicalRouter{
+								UUID: "GR_node1-UUID",
+								Name: "GR_node1",
+							},
+						},
+					},
+					&corev1.NamespaceList{
+						Items: []corev1.Namespace{
+							namespaceT,
+						},
+					},
+					&corev1.PodList{
+						Items: []corev1.Pod{
+							*newPod(t.namespace, t.podName, t.nodeName, t.podIP),
+						},
+					},
+					&adminpolicybasedrouteapi.AdminPolicyBasedExternalRouteList{
+						Items: []adminpolicybasedrouteapi.AdminPolicyBasedExternalRoute{bfdPolicy},
+					},
+				)
+				t.populateLogicalSwitchCache(fakeOvn)
+
+				injectNode(fakeOvn)
+				err := fakeOvn.controller.WatchNamespaces()
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				err = fakeOvn.controller.WatchPods()
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				fakeOvn.RunAPBExternalPolicyController()
+
+				bfd := &nbdb.BFD{
+					UUID:        bfd1NamedUUID,
+					DstIP:       "9.0.0.1",
+					LogicalPort: "rtoe-GR_node1",
+					MinTx:       ptr.To(100),
+					MinRx:       ptr.To(200),
+					DetectMult:  ptr.To(3),
+				}
+				finalNB := []libovsdbtest.TestData{
+					&nbdb.LogicalSwitchPort{
+						UUID:      "lsp1",
+						Addresses: []string{"0a:58:0a:80:01:03 10.128.1.3"},
+						ExternalIDs: map[string]string{
+							"pod":       "true",
+							"namespace": namespaceName,
+						},
+						Name: "namespace1_myPod",
+						Options: map[string]string{
+							"iface-id-ver":               "myPod",
+							libovsdbops.RequestedChassis: "node1",
+						},
+						PortSecurity: []string{"0a:58:0a:80:01:03 10.128.1.3"},
+					},
+					&nbdb.LogicalSwitch{
+						UUID:  "node1",
+						Name:  "node1",
+						Ports: []string{"lsp1"},
+					},
+					bfd,
+					&nbdb.LogicalRouterStaticRoute{
+						UUID:       "static-route-1-UUID",
+						IPPrefix:   "10.128.1.3/32",
+						Nexthop:    "9.0.0.1",
+						BFD:        &bfd1NamedUUID,
+						Policy:     &nbdb.LogicalRouterStaticRoutePolicySrcIP,
+						OutputPort: &logicalRouterPort,
+						Options: map[string]string{
+							"ecmp_symmetric_reply": "true",
+						},
+					},
+					&nbdb.LogicalRouter{
+						UUID:         "GR_node1-UUID",
+						Name:         "GR_node1",
+						StaticRoutes: []string{"static-route-1-UUID"},
+					},
+				}
+				gomega.Eventually(fakeOvn.nbClient, 5).Should(libovsdbtest.HaveData(finalNB))
+				checkAPBRouteStatus(fakeOvn, policyName, false)
+				gomega.Eventually(func() string { return getNextHopBFDConditionReason(fakeOvn, policyName, "9.0.0.1") }).
+					Should(gomega.Equal(adminpolicybasedrouteapi.NextHopBFDReasonPending))
+
+				ginkgo.By("Removing the BFD session parameters of the next hop")
+				p, err := fakeOvn.fakeClient.AdminPolicyRouteClient.K8sV1().AdminPolicyBasedExternalRoutes().Get(context.TODO(), policyName, metav1.GetOptions{})
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				p.Generation++
+				p.Spec.NextHops.StaticHops[0].BFDConfig = nil
+				_, err = fakeOvn.fakeClient.AdminPolicyRouteClient.K8sV1().AdminPolicyBasedExternalRoutes().Update(context.TODO(), p, metav1.UpdateOptions{})
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+				bfd.MinTx = nil
+				bfd.MinRx = nil
+				bfd.DetectMult = nil
+				gomega.Eventually(fakeOvn.nbClient, 5).Should(libovsdbtest.HaveData(finalNB))
+				return nil
+			}
+
+			err := app.Run([]string{app.Name})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		})
 		ginkgo.It("should disable bfd when removing the static hop from the namespace", func() {
 			app.Action = func(*cli.Context) error {
 				namespaceT := *newNamespace(namespaceName)
@@ -3218,6 +3717,23 @@ func (o *FakeOVN) RunAPBExternalPolicyController() {
 	gomega.Expect(err).ToNot(gomega.HaveOccurred())
 }
 
+// getNextHopBFDConditionReason returns the reason of the condition reporting the BFD sessions of the controller zone
+// with the given next hop, or an empty string when the condition is not set.
+func getNextHopBFDConditionReason(fakeOVN *FakeOVN, policyName, gwIP string) string {
+	status, err := fakeOVN.controller.apbExternalRouteController.GetAPBRoutePolicyStatus(policyName)
+	if err != nil {
+		return ""
+	}
+	conditionType := adminpolicybasedrouteapi.NextHopBFDConditionPrefix + gwIP + adminpolicybasedrouteapi.NextHopBFDConditionZoneInfix +
+		fakeOVN.controller.zone
+	for _, condition := range status.Conditions {
+		if condition.Type == conditionType {
+			return condition.Reason
+		}
+	}
+	return ""
+}
+
 func checkAPBRouteStatus(fakeOVN *FakeOVN, policyName string, expectFailure bool) {
 	var status *adminpolicybasedrouteapi.AdminPolicyBasedRouteStatus
 	var err error
@@ -244,6 +244,12 @@ func (h *layer2UserDefinedNetworkControllerEventHandler) SyncFunc(objs []interfa
 		case factory.IPAMClaimsType:
 			syncFunc = h.oc.syncIPAMClaims
 
+		case factory.EgressFirewallType:
+			syncFunc = h.oc.syncEgressFirewall
+
+		case factory.ClusterEgressFirewallType:
+			syncFunc = h.oc.syncClusterEgressFirewall
+
 		default:
 			return fmt.Errorf("no sync function for object type %s", h.objType)
 		}
@@ -426,6 +432,12 @@ func (oc *Layer2UserDefinedNetworkController) run() error {
 	if err != nil {
 		return err
 	}
+	if oc.IsPrimaryNetwork() && util.IsNetworkSegmentationSupportEnabled() {
+		// network peering ACLs depend on the namespace address sets
+		if err := oc.startNetworkPeering(); err != nil {
+			return fmt.Errorf("unable to start network peering controller for network %s: %w", oc.GetNetworkName(), err)
+		}
+	}
 	if oc.svcController != nil {
 		startSvc := time.Now()
 
@@ -464,6 +476,10 @@ func (oc *Layer2UserDefinedNetworkController) Cleanup() error {
 		return true
 	})
 
+	if err := cleanupNetworkPeerings(oc.nbClient, oc.GetNetInfo(), oc.controllerName); err != nil {
+		return fmt.Errorf("failed to delete network peerings of network %s: %w", networkName, err)
+	}
+
 	// remove load balancer groups
 	lbGroups := make([]*nbdb.LoadBalancerGroup, 0, 3)
 	for _, lbGroupUUID := range []string{oc.switchLoadBalancerGroupUUID, oc.clusterLoadBalancerGroupUUID, oc.routerLoadBalancerGroupUUID} {
@@ -523,13 +539,32 @@ func (oc *Layer2UserDefinedNetworkController) init() error {
 func (oc *Layer2UserDefinedNetworkController) Stop() {
 	klog.Infof("Stoping controller for UDN %s", oc.GetNetworkName())
 	oc.BaseLayer2UserDefinedNetworkController.stop()
+	oc.stopNetworkPeering()
 }
 
 func (oc *Layer2UserDefinedNetworkController) Reconcile(netInfo util.NetInfo) error {
-	return oc.BaseNetworkController.reconcile(
+	addedSubnets := util.GetAddedSubnets(oc.Subnets(), netInfo.Subnets())
+	if oc.networkPeeringController != nil {
+		// the namespaces of the network selected by its peerings may have changed
+		defer oc.networkPeeringController.ReconcileAll()
+	}
+	err := oc.BaseNetworkController.reconcile(
 		netInfo,
-		func(node string) { oc.gatewaysFailed.Store(node, true) },
+		func(node string) {
+			oc.gatewaysFailed.Store(node, true)
+			if len(addedSubnets) > 0 {
+				// the management port and EgressIP router policies are
+				// configured with the network subnets
+				oc.mgmtPortFailed.Store(node, true)
+				oc.syncEIPNodeRerouteFailed.Store(node, true)
+			}
+		},
 	)
+	if err != nil {
+		return err
+	}
+	oc.addSwitchSubnets(oc.GetNetworkScopedSwitchName(types.OVNLayer2Switch), addedSubnets)
+	return nil
 }
 
 func (oc *Layer2UserDefinedNetworkController) initRetryFramework() {
@@ -544,6 +579,10 @@ func (oc *Layer2UserDefinedNetworkController) initRetryFramework() {
 	if oc.IsPrimaryNetwork() {
 		oc.retryNamespaces = oc.newRetryFramework(factory.NamespaceType)
 		oc.retryNetworkPolicies = oc.newRetryFramework(factory.PolicyType)
+		if config.OVNKubernetesFeature.EnableEgressFirewall {
+			oc.retryEgressFirewalls = oc.newRetryFramework(factory.EgressFirewallType)
+			oc.retryClusterEgressFirewalls = oc.newRetryFramework(factory.ClusterEgressFirewallType)
+		}
 	}
 
 	// For secondary networks, we don't have to watch namespace events if
@@ -614,6 +653,10 @@ func (oc *Layer2UserDefinedNetworkController) addUpdateLocalNodeEvent(node *core
 					}
 				}
 				oc.gatewaysFailed.Delete(node.Name)
+				if oc.networkPeeringController != nil {
+					// the gateway router of the node connects the network to its peers
+					oc.networkPeeringController.ReconcileAll()
+				}
 				return nil
 			}()
 
@@ -10,6 +10,7 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/klog/v2"
 
 	"github.com/ovn-kubernetes/libovsdb/ovsdb"
@@ -35,6 +36,10 @@ import (
 	utilerrors "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util/errors"
 )
 
+// podIPUsageRecordInterval is how often the pod IP usage of the network is
+// recorded in metrics
+const podIPUsageRecordInterval = 30 * time.Second
+
 type Layer3UserDefinedNetworkControllerEventHandler struct {
 	baseHandler  baseNetworkControllerEventHandler
 	watchFactory *factory.WatchFactory
@@ -267,6 +272,12 @@ func (h *Layer3UserDefinedNetworkControllerEventHandler) SyncFunc(objs []interfa
 		case factory.MultiNetworkPolicyType:
 			syncFunc = h.oc.syncMultiNetworkPolicies
 
+		case factory.EgressFirewallType:
+			syncFunc = h.oc.syncEgressFirewall
+
+		case factory.ClusterEgressFirewallType:
+			syncFunc = h.oc.syncClusterEgressFirewall
+
 		default:
 			return fmt.Errorf("no sync function for object type %s", h.objType)
 		}
@@ -414,6 +425,10 @@ func (oc *Layer3UserDefinedNetworkController) initRetryFramework() {
 	if oc.IsPrimaryNetwork() {
 		oc.retryNamespaces = oc.newRetryFramework(factory.NamespaceType)
 		oc.retryNetworkPolicies = oc.newRetryFramework(factory.PolicyType)
+		if config.OVNKubernetesFeature.EnableEgressFirewall {
+			oc.retryEgressFirewalls = oc.newRetryFramework(factory.EgressFirewallType)
+			oc.retryClusterEgressFirewalls = oc.newRetryFramework(factory.ClusterEgressFirewallType)
+		}
 	}
 
 	// For secondary networks, we don't have to watch namespace events if
@@ -480,9 +495,24 @@ func (oc *Layer3UserDefinedNetworkController) Stop() {
 	if oc.namespaceHandler != nil {
 		oc.watchFactory.RemoveNamespaceHandler(oc.namespaceHandler)
 	}
+	oc.stopEgressFirewall()
+	oc.stopNetworkPeering()
 	if oc.routeImportManager != nil {
 		oc.routeImportManager.ForgetNetwork(oc.GetNetworkName())
 	}
+	metrics.DeleteNetworkPodIPUsage(oc.GetNetworkName())
+}
+
+// recordPodIPUsage records the usage of the pod IPs allocated by this zone in
+// the host subnets of the network
+func (oc *Layer3UserDefinedNetworkController) recordPodIPUsage() {
+	v4, v6 := oc.lsManager.Usage()
+	if v4.Allocated+v4.Free > 0 {
+		metrics.SetNetworkPodIPUsage(oc.GetNetworkName(), "IPv4", v4.Allocated, v4.Free)
+	}
+	if v6.Allocated+v6.Free > 0 {
+		metrics.SetNetworkPodIPUsage(oc.GetNetworkName(), "IPv6", v6.Allocated, v6.Free)
+	}
 }
 
 // Cleanup cleans up logical entities for the given network, called from net-attach-def routine
@@ -539,6 +569,10 @@ func (oc *Layer3UserDefinedNetworkController) Cleanup() error {
 		return fmt.Errorf("failed to deleting routers/switches of network %s: %v", netName, err)
 	}
 
+	if err = cleanupNetworkPeerings(oc.nbClient, oc.GetNetInfo(), oc.controllerName); err != nil {
+		return fmt.Errorf("failed to delete network peerings of network %s: %v", netName, err)
+	}
+
 	if config.OVNKubernetesFeature.EnableInterconnect {
 		if err = oc.zoneICHandler.Cleanup(); err != nil {
 			return fmt.Errorf("failed to delete interconnect transit switch of network %s: %v", netName, err)
@@ -599,6 +633,17 @@ func (oc *Layer3UserDefinedNetworkController) run() error {
 		if err := oc.WatchNetworkPolicy(); err != nil {
 			return err
 		}
+		if config.OVNKubernetesFeature.EnableEgressFirewall {
+			if err := oc.startEgressFirewall(); err != nil {
+				return err
+			}
+		}
+		if util.IsNetworkSegmentationSupportEnabled() {
+			// network peering ACLs depend on the namespace address sets
+			if err := oc.startNetworkPeering(); err != nil {
+				return fmt.Errorf("unable to start network peering controller for network %s: %w", oc.GetNetworkName(), err)
+			}
+		}
 	}
 
 	// Add ourselves to the route import manager
@@ -609,6 +654,12 @@ func (oc *Layer3UserDefinedNetworkController) run() error {
 		}
 	}
 
+	oc.wg.Add(1)
+	go func() {
+		defer oc.wg.Done()
+		wait.Until(oc.recordPodIPUsage, podIPUsageRecordInterval, oc.stopChan)
+	}()
+
 	// start NetworkQoS controller if feature is enabled
 	if config.OVNKubernetesFeature.EnableNetworkQoS {
 		err := oc.newNetworkQoSController()
@@ -629,11 +680,20 @@ func (oc *Layer3UserDefinedNetworkController) run() error {
 }
 
 func (oc *Layer3UserDefinedNetworkController) Reconcile(netInfo util.NetInfo) error {
+	subnetsAdded := len(util.GetAddedSubnets(oc.Subnets(), netInfo.Subnets())) > 0
+	if oc.networkPeeringController != nil {
+		// the namespaces of the network selected by its peerings may have changed
+		defer oc.networkPeeringController.ReconcileAll()
+	}
 	return oc.BaseNetworkController.reconcile(
 		netInfo,
 		func(node string) {
 			oc.addNodeFailed.Store(node, true)
 			oc.gatewaysFailed.Store(node, true)
+			if subnetsAdded {
+				// EgressIP router policies match on the network subnets
+				oc.syncEIPNodeRerouteFailed.Store(node, true)
+			}
 		},
 	)
 }
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -15,6 +17,7 @@ import (
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/allocator/pod"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/factory"
 	libovsdbops "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
+	libovsdbutil "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/util"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/metrics/recorders"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/networkmanager"
@@ -284,6 +287,12 @@ func (oc *LocalnetUserDefinedNetworkController) init() error {
 		return err
 	}
 
+	if len(oc.AllowedVLANs()) > 0 {
+		if err := oc.enableVLANTrunk(switchName); err != nil {
+			return err
+		}
+	}
+
 	// Add external interface as a logical port to external_switch.
 	// This is a learning switch port with "unknown" address. The external
 	// world is accessed via this port.
@@ -297,6 +306,17 @@ func (oc *LocalnetUserDefinedNetworkController) init() error {
 	if intVlanID != 0 {
 		logicalSwitchPort.TagRequest = &intVlanID
 	}
+	if len(oc.AllowedVLANs()) > 0 {
+		// OVN has no trunk configuration for localnet ports, record it for
+		// reference: the allowed VLANs are enforced by the switch ACLs and
+		// the native VLAN is set up by each node on its provider bridge
+		logicalSwitchPort.ExternalIDs = map[string]string{
+			types.AllowedVLANsExternalID: util.FormatVLANIDRanges(oc.AllowedVLANs()),
+		}
+		if oc.NativeVLAN() != 0 {
+			logicalSwitchPort.ExternalIDs[types.NativeVLANExternalID] = strconv.FormatUint(uint64(oc.NativeVLAN()), 10)
+		}
+	}
 
 	err = libovsdbops.CreateOrUpdateLogicalSwitchPortsOnSwitch(oc.nbClient, logicalSwitch, &logicalSwitchPort)
 	if err != nil {
@@ -307,16 +327,84 @@ func (oc *LocalnetUserDefinedNetworkController) init() error {
 	return nil
 }
 
+// enableVLANTrunk lets the 802.1Q tagged traffic of the pods through the
+// provided logical switch. The passthrough is enabled along with the ACL
+// dropping the traffic of the VLANs that are not allowed so that it never
+// reaches the provider network, whatever the state of the nodes.
+func (oc *LocalnetUserDefinedNetworkController) enableVLANTrunk(switchName string) error {
+	dropACL := libovsdbutil.BuildACL(
+		getLocalnetVLANDropACLDbIDs(oc.controllerName, oc.GetNetworkName()),
+		types.LocalnetVLANDenyPriority,
+		getLocalnetVLANDropMatch(oc.AllowedVLANs(), oc.NativeVLAN()),
+		nbdb.ACLActionDrop,
+		nil,
+		libovsdbutil.LportEgress,
+		types.PrimaryACLTier)
+
+	ops, err := libovsdbops.CreateOrUpdateACLsOps(oc.nbClient, nil, nil, dropACL)
+	if err != nil {
+		return fmt.Errorf("failed to create or update VLAN drop ACL for network %s: %w", oc.GetNetworkName(), err)
+	}
+	ops, err = libovsdbops.AddACLsToLogicalSwitchOps(oc.nbClient, ops, switchName, dropACL)
+	if err != nil {
+		return fmt.Errorf("failed to add VLAN drop ACL to logical switch %s: %w", switchName, err)
+	}
+	sw := &nbdb.LogicalSwitch{
+		Name:        switchName,
+		OtherConfig: map[string]string{"vlan-passthru": "true"},
+	}
+	ops, err = libovsdbops.UpdateLogicalSwitchSetOtherConfigOps(oc.nbClient, ops, sw)
+	if err != nil {
+		return fmt.Errorf("failed to enable VLAN passthrough on logical switch %s: %w", switchName, err)
+	}
+	if _, err = libovsdbops.TransactAndCheck(oc.nbClient, ops); err != nil {
+		return fmt.Errorf("failed to configure VLAN trunk on logical switch %s: %w", switchName, err)
+	}
+	return nil
+}
+
+func getLocalnetVLANDropACLDbIDs(controller, networkName string) *libovsdbops.DbObjectIDs {
+	return libovsdbops.NewDbObjectIDs(libovsdbops.ACLLocalnetVLAN, controller,
+		map[libovsdbops.ExternalIDKey]string{
+			libovsdbops.ObjectNameKey: networkName,
+		})
+}
+
+// getLocalnetVLANDropMatch returns the match of the traffic of a network in
+// VLAN trunk mode that is dropped: the traffic tagged with a VLAN that is not
+// allowed and, without a native VLAN, the untagged traffic.
+func getLocalnetVLANDropMatch(allowedVLANs []uint, nativeVLAN uint) string {
+	var allowedMatches []string
+	for _, vlanRange := range strings.Split(util.FormatVLANIDRanges(allowedVLANs), ",") {
+		if start, end, isRange := strings.Cut(vlanRange, "-"); isRange {
+			allowedMatches = append(allowedMatches, fmt.Sprintf("(vlan.vid >= %s && vlan.vid <= %s)", start, end))
+		} else {
+			allowedMatches = append(allowedMatches, "vlan.vid == "+vlanRange)
+		}
+	}
+	match := fmt.Sprintf("vlan.present && !(%s)", strings.Join(allowedMatches, " || "))
+	if nativeVLAN == 0 {
+		match = "!vlan.present || " + match
+	}
+	return match
+}
+
 func (oc *LocalnetUserDefinedNetworkController) Stop() {
 	klog.Infof("Stoping controller for UDN %s", oc.GetNetworkName())
 	oc.BaseLayer2UserDefinedNetworkController.stop()
 }
 
 func (oc *LocalnetUserDefinedNetworkController) Reconcile(netInfo util.NetInfo) error {
-	return oc.BaseNetworkController.reconcile(
+	addedSubnets := util.GetAddedSubnets(oc.Subnets(), netInfo.Subnets())
+	err := oc.BaseNetworkController.reconcile(
 		netInfo,
 		func(_ string) {},
 	)
+	if err != nil {
+		return err
+	}
+	oc.addSwitchSubnets(oc.GetNetworkScopedSwitchName(types.OVNLocalnetSwitch), addedSubnets)
+	return nil
 }
 
 func (oc *LocalnetUserDefinedNetworkController) initRetryFramework() {
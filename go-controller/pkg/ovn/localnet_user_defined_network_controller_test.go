@@ -0,0 +1,39 @@
+package ovn
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetLocalnetVLANDropMatch(t *testing.T) {
+	testcases := []struct {
+		desc         string
+		allowedVLANs []uint
+		nativeVLAN   uint
+		expected     string
+	}{
+		{
+			desc:         "drops the untagged traffic without native VLAN",
+			allowedVLANs: []uint{10},
+			expected:     "!vlan.present || vlan.present && !(vlan.vid == 10)",
+		},
+		{
+			desc:         "lets the untagged traffic through with a native VLAN",
+			allowedVLANs: []uint{10},
+			nativeVLAN:   5,
+			expected:     "vlan.present && !(vlan.vid == 10)",
+		},
+		{
+			desc:         "matches consecutive VLAN IDs as a range",
+			allowedVLANs: []uint{10, 20, 21, 22, 30},
+			nativeVLAN:   5,
+			expected:     "vlan.present && !(vlan.vid == 10 || (vlan.vid >= 20 && vlan.vid <= 22) || vlan.vid == 30)",
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.desc, func(t *testing.T) {
+			assert.Equal(t, tc.expected, getLocalnetVLANDropMatch(tc.allowedVLANs, tc.nativeVLAN))
+		})
+	}
+}
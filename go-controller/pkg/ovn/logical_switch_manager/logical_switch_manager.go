@@ -86,6 +86,34 @@ func (manager *LogicalSwitchManager) AddOrUpdateSwitch(switchName string, hostSu
 	})
 }
 
+// AddSwitchSubnets appends host subnets to an existing switch preserving the
+// IPs already allocated on it
+func (manager *LogicalSwitchManager) AddSwitchSubnets(switchName string, hostSubnets ...*net.IPNet) error {
+	var excludeSubnets []*net.IPNet
+	if manager.reserveIPs {
+		for _, hostSubnet := range hostSubnets {
+			gwIP, _ := util.MatchFirstIPNetFamily(knet.IsIPv6CIDR(hostSubnet), manager.gatewayIPs)
+			if gwIP == nil || !hostSubnet.Contains(gwIP.IP) {
+				gwIP = util.GetNodeGatewayIfAddr(hostSubnet)
+			}
+
+			mgmtIP, _ := util.MatchFirstIPNetFamily(knet.IsIPv6CIDR(hostSubnet), manager.mgmtIPs)
+			if mgmtIP == nil || !hostSubnet.Contains(mgmtIP.IP) {
+				mgmtIP = util.GetNodeManagementIfAddr(hostSubnet)
+			}
+
+			for _, ip := range []*net.IPNet{gwIP, mgmtIP} {
+				excludeSubnets = append(excludeSubnets, &net.IPNet{IP: ip.IP, Mask: util.GetIPFullMask(ip.IP)})
+			}
+		}
+	}
+	return manager.allocator.AddSubnets(subnet.SubnetConfig{
+		Name:           switchName,
+		Subnets:        hostSubnets,
+		ExcludeSubnets: excludeSubnets,
+	})
+}
+
 // AddNoHostSubnetSwitch adds/updates a switch without any host subnets
 // to the logical switch manager
 func (manager *LogicalSwitchManager) AddNoHostSubnetSwitch(switchName string) error {
@@ -185,6 +213,12 @@ func (manager *LogicalSwitchManager) ConditionalIPRelease(switchName string, ipn
 	return manager.allocator.ConditionalIPRelease(switchName, ipnets, predicate)
 }
 
+// Usage returns the number of allocated and free IPs of each IP family across
+// all switches
+func (manager *LogicalSwitchManager) Usage() (v4, v6 subnet.IPUsage) {
+	return manager.allocator.Usage()
+}
+
 // ForSubnet return an IP allocator for the specified switch
 func (manager *LogicalSwitchManager) ForSwitch(switchName string) subnet.NamedAllocator {
 	return manager.allocator.ForSubnet(switchName)
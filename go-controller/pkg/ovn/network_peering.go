@@ -0,0 +1,597 @@
+package ovn
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"reflect"
+	"strings"
+	"time"
+
+	libovsdbclient "github.com/ovn-kubernetes/libovsdb/client"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+	utilnet "k8s.io/utils/net"
+	"k8s.io/utils/ptr"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/controller"
+	userdefinednetworkv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/userdefinednetwork/v1"
+	libovsdbops "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
+	libovsdbutil "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/util"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
+)
+
+// The traffic between peered networks is implemented by:
+//   - a peering router shared by the two networks, connected to the network router of every network with a
+//     dedicated link allocated from the peering transit subnet. The network router is the cluster router of a Layer3
+//     network, and the gateway router of the node of the zone of a Layer2 network, as Layer2 networks support a
+//     single node per zone;
+//   - static routes on the peering router, sending the traffic to the subnets of every network to its network router;
+//   - a reroute policy on the network router of every network, sending the traffic to the peer subnets to the
+//     peering router;
+//   - ACLs on the cluster port group of every network, passing the traffic with the peer network for the selected
+//     namespaces and ports to the next ACL tiers, and dropping the rest of it. They are part of the primary ACL tier
+//     and take precedence over the primary networks and advertised networks isolation, while admin network policies
+//     and network policies still apply to the passed traffic.
+//
+// Every network controller only manages its own side of a peering: its link to the peering router, the routes to its
+// own subnets and its own policies and ACLs. The peering router is deleted by the last network that leaves it.
+// A Layer2 network is connected once the gateway router of the node of its zone is created.
+
+// newNetworkPeeringController returns the controller configuring the peerings of the network
+func (oc *BaseUserDefinedNetworkController) newNetworkPeeringController() controller.Controller {
+	npInformer := oc.watchFactory.NetworkPeeringInformer()
+	controllerConfig := &controller.ControllerConfig[userdefinednetworkv1.NetworkPeering]{
+		RateLimiter:    workqueue.NewTypedItemFastSlowRateLimiter[string](time.Second, 5*time.Second, 5),
+		Informer:       npInformer.Informer(),
+		Lister:         npInformer.Lister().List,
+		ObjNeedsUpdate: networkPeeringNeedsUpdate,
+		Reconcile:      oc.syncNetworkPeering,
+		Threadiness:    1,
+	}
+	return controller.NewController[userdefinednetworkv1.NetworkPeering]("network_peering_controller_"+oc.GetNetworkName(), controllerConfig)
+}
+
+func networkPeeringNeedsUpdate(oldPeering, newPeering *userdefinednetworkv1.NetworkPeering) bool {
+	if oldPeering == nil || newPeering == nil {
+		return true
+	}
+	return oldPeering.Generation != newPeering.Generation ||
+		oldPeering.Annotations[util.NetworkPeeringAnnotation] != newPeering.Annotations[util.NetworkPeeringAnnotation]
+}
+
+// newNetworkPeeringNamespaceController returns the controller updating the peerings of the network when the labels
+// of a namespace change, as they may select the namespaces allowed to talk to the peer network
+func (oc *BaseUserDefinedNetworkController) newNetworkPeeringNamespaceController() controller.Controller {
+	namespaceInformer := oc.watchFactory.NamespaceCoreInformer()
+	controllerConfig := &controller.ControllerConfig[corev1.Namespace]{
+		RateLimiter:    workqueue.NewTypedItemFastSlowRateLimiter[string](time.Second, 5*time.Second, 5),
+		Informer:       namespaceInformer.Informer(),
+		Lister:         namespaceInformer.Lister().List,
+		ObjNeedsUpdate: networkPeeringNamespaceNeedsUpdate,
+		Reconcile: func(string) error {
+			oc.networkPeeringController.ReconcileAll()
+			return nil
+		},
+		Threadiness: 1,
+	}
+	return controller.NewController[corev1.Namespace]("network_peering_namespace_controller_"+oc.GetNetworkName(), controllerConfig)
+}
+
+func networkPeeringNamespaceNeedsUpdate(oldNamespace, newNamespace *corev1.Namespace) bool {
+	if oldNamespace == nil || newNamespace == nil {
+		return true
+	}
+	return !reflect.DeepEqual(oldNamespace.Labels, newNamespace.Labels)
+}
+
+// startNetworkPeering removes the stale peerings of the network and starts the controllers configuring its peerings
+func (oc *BaseUserDefinedNetworkController) startNetworkPeering() error {
+	oc.networkPeeringController = oc.newNetworkPeeringController()
+	oc.networkPeeringNamespaceController = oc.newNetworkPeeringNamespaceController()
+	return controller.StartWithInitialSync(oc.syncNetworkPeerings, oc.networkPeeringController, oc.networkPeeringNamespaceController)
+}
+
+// stopNetworkPeering stops the controllers started by startNetworkPeering
+func (oc *BaseUserDefinedNetworkController) stopNetworkPeering() {
+	if oc.networkPeeringController != nil {
+		controller.Stop(oc.networkPeeringController, oc.networkPeeringNamespaceController)
+	}
+}
+
+// syncNetworkPeerings deletes the configuration of the peerings that were deleted while the controller was down
+func (oc *BaseUserDefinedNetworkController) syncNetworkPeerings() error {
+	lrps, err := libovsdbops.FindLogicalRouterPortWithPredicate(oc.nbClient, oc.isNetworkPeeringRouterPort)
+	if err != nil {
+		return fmt.Errorf("failed to find network peering router ports of network %s: %w", oc.GetNetworkName(), err)
+	}
+	names := make(map[string]bool, len(lrps))
+	for _, lrp := range lrps {
+		names[lrp.ExternalIDs[types.NetworkPeeringExternalID]] = true
+	}
+	var errs []error
+	for name := range names {
+		if _, err := oc.watchFactory.NetworkPeeringInformer().Lister().Get(name); err == nil || !apierrors.IsNotFound(err) {
+			continue
+		}
+		if err := oc.deleteNetworkPeering(name); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (oc *BaseUserDefinedNetworkController) isNetworkPeeringRouterPort(lrp *nbdb.LogicalRouterPort) bool {
+	return lrp.ExternalIDs[types.NetworkExternalID] == oc.GetNetworkName() && lrp.ExternalIDs[types.NetworkPeeringExternalID] != ""
+}
+
+// syncNetworkPeering configures the side of the network of the given peering, or deletes it if the network is no
+// longer peered by it
+func (oc *BaseUserDefinedNetworkController) syncNetworkPeering(name string) error {
+	peering, err := oc.watchFactory.NetworkPeeringInformer().Lister().Get(name)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	var info *util.NetworkPeeringInfo
+	if peering != nil {
+		info, err = util.ParseNetworkPeeringAnnotation(peering.Annotations)
+		if err != nil && !util.IsAnnotationNotSetError(err) {
+			return err
+		}
+	}
+	if info == nil || info.PeerOf(oc.GetNetworkName()) == "" {
+		return oc.deleteNetworkPeering(name)
+	}
+	klog.V(5).Infof("Configuring network peering %s for network %s", name, oc.GetNetworkName())
+	if routerName := oc.getNetworkPeeringRouterName(); routerName != "" {
+		if err := oc.ensureNetworkPeeringLink(name, info, routerName); err != nil {
+			return fmt.Errorf("failed to configure network peering %s link of network %s: %w", name, oc.GetNetworkName(), err)
+		}
+	}
+	if err := oc.ensureNetworkPeeringACLs(peering, info); err != nil {
+		return fmt.Errorf("failed to configure network peering %s ACLs of network %s: %w", name, oc.GetNetworkName(), err)
+	}
+	return nil
+}
+
+func networkPeeringRouterName(name string) string {
+	return types.NetworkPeeringRouterPrefix + name
+}
+
+// getNetworkPeeringRouterName returns the name of the router of the network connected to the peering routers, or an
+// empty string if a Layer2 network has no node in the zone yet
+func (oc *BaseUserDefinedNetworkController) getNetworkPeeringRouterName() string {
+	if oc.TopologyType() != types.Layer2Topology {
+		return oc.GetNetworkScopedClusterRouterName()
+	}
+	var nodeName string
+	oc.localZoneNodes.Range(func(key, _ any) bool {
+		nodeName = key.(string)
+		return false
+	})
+	if nodeName == "" {
+		return ""
+	}
+	return oc.GetNetworkScopedGWRouterName(nodeName)
+}
+
+// ensureNetworkPeeringLink connects the given router of the network to the peering router, and routes the traffic
+// between the network and its peer through it
+func (oc *BaseUserDefinedNetworkController) ensureNetworkPeeringLink(name string, info *util.NetworkPeeringInfo, routerName string) error {
+	ownSubnets, err := info.Subnets(oc.GetNetworkName())
+	if err != nil {
+		return err
+	}
+	peerSubnets, err := info.Subnets(info.PeerOf(oc.GetNetworkName()))
+	if err != nil {
+		return err
+	}
+	networkRouterIPs, peeringRouterIPs, err := util.GetNetworkPeeringLinkIPs(info.ID, info.LinkIndex(oc.GetNetworkName()))
+	if err != nil {
+		return err
+	}
+
+	peeringRouter := &nbdb.LogicalRouter{
+		Name:        networkPeeringRouterName(name),
+		ExternalIDs: map[string]string{types.NetworkPeeringExternalID: name},
+	}
+	if err := libovsdbops.CreateOrUpdateLogicalRouter(oc.nbClient, peeringRouter, &peeringRouter.ExternalIDs); err != nil {
+		return fmt.Errorf("failed to create peering router %s: %w", peeringRouter.Name, err)
+	}
+
+	externalIDs := map[string]string{
+		types.NetworkExternalID:        oc.GetNetworkName(),
+		types.NetworkPeeringExternalID: name,
+	}
+	networkRouterPortName := types.ClusterRouterToNetworkPeeringRouterPrefix + oc.GetNetworkScopedName(name)
+	peeringRouterPortName := types.NetworkPeeringRouterToClusterRouterPrefix + oc.GetNetworkScopedName(name)
+	networkRouterPort := &nbdb.LogicalRouterPort{
+		Name:        networkRouterPortName,
+		MAC:         util.IPAddrToHWAddr(networkRouterIPs[0].IP).String(),
+		Networks:    util.StringSlice(networkRouterIPs),
+		Peer:        ptr.To(peeringRouterPortName),
+		ExternalIDs: externalIDs,
+	}
+	peeringRouterPort := &nbdb.LogicalRouterPort{
+		Name:        peeringRouterPortName,
+		MAC:         util.IPAddrToHWAddr(peeringRouterIPs[0].IP).String(),
+		Networks:    util.StringSlice(peeringRouterIPs),
+		Peer:        ptr.To(networkRouterPortName),
+		ExternalIDs: externalIDs,
+	}
+	networkRouter := &nbdb.LogicalRouter{Name: routerName}
+	if err := libovsdbops.CreateOrUpdateLogicalRouterPort(oc.nbClient, networkRouter, networkRouterPort, nil,
+		&networkRouterPort.MAC, &networkRouterPort.Networks, &networkRouterPort.Peer, &networkRouterPort.ExternalIDs); err != nil {
+		return fmt.Errorf("failed to create port %s on router %s: %w", networkRouterPort.Name, networkRouter.Name, err)
+	}
+	if err := libovsdbops.CreateOrUpdateLogicalRouterPort(oc.nbClient, peeringRouter, peeringRouterPort, nil,
+		&peeringRouterPort.MAC, &peeringRouterPort.Networks, &peeringRouterPort.Peer, &peeringRouterPort.ExternalIDs); err != nil {
+		return fmt.Errorf("failed to create port %s on router %s: %w", peeringRouterPort.Name, peeringRouter.Name, err)
+	}
+
+	// route the traffic to the subnets of the network from the peering router to the network router
+	routeCIDRs := make(map[string]bool, len(ownSubnets))
+	for _, subnet := range ownSubnets {
+		nexthop, err := util.MatchFirstIPNetFamily(utilnet.IsIPv6CIDR(subnet), networkRouterIPs)
+		if err != nil {
+			return err
+		}
+		dbIDs := oc.getNetworkPeeringRouteDbIDs(name, subnet.String())
+		route := &nbdb.LogicalRouterStaticRoute{
+			IPPrefix:    subnet.String(),
+			Nexthop:     nexthop.IP.String(),
+			ExternalIDs: dbIDs.GetExternalIDs(),
+		}
+		if err := libovsdbops.CreateOrReplaceLogicalRouterStaticRouteWithPredicate(oc.nbClient, peeringRouter.Name, route,
+			libovsdbops.GetPredicate[*nbdb.LogicalRouterStaticRoute](dbIDs, nil)); err != nil {
+			return fmt.Errorf("failed to create route to %s on router %s: %w", subnet, peeringRouter.Name, err)
+		}
+		routeCIDRs[subnet.String()] = true
+	}
+	staleRoutes := libovsdbops.GetPredicate[*nbdb.LogicalRouterStaticRoute](oc.getNetworkPeeringDbIDs(libovsdbops.LogicalRouterStaticRouteNetworkPeering, name),
+		func(route *nbdb.LogicalRouterStaticRoute) bool {
+			return !routeCIDRs[route.ExternalIDs[libovsdbops.CIDRKey.String()]]
+		})
+	if err := libovsdbops.DeleteLogicalRouterStaticRoutesWithPredicate(oc.nbClient, peeringRouter.Name, staleRoutes); err != nil {
+		return fmt.Errorf("failed to delete stale routes on router %s: %w", peeringRouter.Name, err)
+	}
+
+	// reroute the traffic from the network to the peer subnets to the peering router
+	for _, ipFamily := range []utilnet.IPFamily{utilnet.IPv4, utilnet.IPv6} {
+		ipPrefix := "ip4"
+		if ipFamily == utilnet.IPv6 {
+			ipPrefix = "ip6"
+		}
+		dbIDs := oc.getNetworkPeeringPolicyDbIDs(name, ipPrefix)
+		p := libovsdbops.GetPredicate[*nbdb.LogicalRouterPolicy](dbIDs, nil)
+		srcSubnets := filterSubnetsByFamily(ownSubnets, ipFamily)
+		dstSubnets := filterSubnetsByFamily(peerSubnets, ipFamily)
+		nexthop, err := util.MatchFirstIPNetFamily(ipFamily == utilnet.IPv6, peeringRouterIPs)
+		if len(srcSubnets) == 0 || len(dstSubnets) == 0 || err != nil {
+			if err := libovsdbops.DeleteLogicalRouterPoliciesWithPredicate(oc.nbClient, networkRouter.Name, p); err != nil {
+				return fmt.Errorf("failed to delete stale %s policy on router %s: %w", ipPrefix, networkRouter.Name, err)
+			}
+			continue
+		}
+		policy := &nbdb.LogicalRouterPolicy{
+			Priority: types.NetworkPeeringPolicyPriority,
+			Match: fmt.Sprintf("%s.src == {%s} && %s.dst == {%s}", ipPrefix, strings.Join(srcSubnets, ", "),
+				ipPrefix, strings.Join(dstSubnets, ", ")),
+			Action:      nbdb.LogicalRouterPolicyActionReroute,
+			Nexthops:    []string{nexthop.IP.String()},
+			ExternalIDs: dbIDs.GetExternalIDs(),
+		}
+		if err := libovsdbops.CreateOrUpdateLogicalRouterPolicyWithPredicate(oc.nbClient, networkRouter.Name, policy, p); err != nil {
+			return fmt.Errorf("failed to create %s policy on router %s: %w", ipPrefix, networkRouter.Name, err)
+		}
+	}
+	return nil
+}
+
+func filterSubnetsByFamily(subnets []*net.IPNet, ipFamily utilnet.IPFamily) []string {
+	var filtered []string
+	for _, subnet := range subnets {
+		if utilnet.IPFamilyOfCIDR(subnet) == ipFamily {
+			filtered = append(filtered, subnet.String())
+		}
+	}
+	return filtered
+}
+
+// ensureNetworkPeeringACLs passes the traffic between the peer network and the selected namespaces and ports of the
+// network to the next ACL tiers, and drops the rest of the traffic with the peer network
+func (oc *BaseUserDefinedNetworkController) ensureNetworkPeeringACLs(peering *userdefinednetworkv1.NetworkPeering, info *util.NetworkPeeringInfo) error {
+	peerSubnets, err := info.Subnets(info.PeerOf(oc.GetNetworkName()))
+	if err != nil {
+		return err
+	}
+	peeredNetwork := oc.getOwnPeeredNetwork(peering)
+	if peeredNetwork == nil {
+		return fmt.Errorf("network %s is not part of the spec of network peering %s", oc.GetNetworkName(), peering.Name)
+	}
+
+	var v4ASHashNames, v6ASHashNames []string
+	allNamespaces := peeredNetwork.NamespaceSelector == nil
+	if !allNamespaces {
+		v4ASHashNames, v6ASHashNames, err = oc.getNetworkPeeringNamespaceAddressSets(peeredNetwork.NamespaceSelector)
+		if err != nil {
+			return err
+		}
+	}
+
+	var ingressPassMatches, egressPassMatches, ingressDenyMatches, egressDenyMatches []string
+	for _, ipFamily := range []utilnet.IPFamily{utilnet.IPv4, utilnet.IPv6} {
+		subnets := filterSubnetsByFamily(peerSubnets, ipFamily)
+		if len(subnets) == 0 {
+			continue
+		}
+		ipPrefix := "ip4"
+		asHashNames := v4ASHashNames
+		if ipFamily == utilnet.IPv6 {
+			ipPrefix = "ip6"
+			asHashNames = v6ASHashNames
+		}
+		peerSrc := fmt.Sprintf("%s.src == {%s}", ipPrefix, strings.Join(subnets, ", "))
+		peerDst := fmt.Sprintf("%s.dst == {%s}", ipPrefix, strings.Join(subnets, ", "))
+		ingressDenyMatches = append(ingressDenyMatches, peerSrc)
+		egressDenyMatches = append(egressDenyMatches, peerDst)
+		switch {
+		case allNamespaces:
+			ingressPassMatches = append(ingressPassMatches, peerSrc)
+			egressPassMatches = append(egressPassMatches, peerDst)
+		case len(asHashNames) > 0:
+			ingressPassMatches = append(ingressPassMatches, fmt.Sprintf("(%s && %s.dst == {%s})", peerSrc, ipPrefix, strings.Join(asHashNames, ", ")))
+			egressPassMatches = append(egressPassMatches, fmt.Sprintf("(%s && %s.src == {%s})", peerDst, ipPrefix, strings.Join(asHashNames, ", ")))
+		}
+	}
+
+	pgName := oc.getClusterPortGroupName(types.ClusterPortGroupNameBase)
+	var acls []*nbdb.ACL
+	addACL := func(aclDir libovsdbutil.ACLDirection, aclType, action string, priority int, matches []string, extraMatch string) {
+		if len(matches) == 0 {
+			return
+		}
+		match := strings.Join(matches, " || ")
+		if len(matches) > 1 || extraMatch != "" {
+			match = "(" + match + ")"
+		}
+		if extraMatch != "" {
+			match = match + " && " + extraMatch
+		}
+		acls = append(acls, libovsdbutil.BuildACL(
+			oc.getNetworkPeeringACLDbIDs(peering.Name, aclDir, aclType),
+			priority,
+			libovsdbutil.GetACLMatch(pgName, match, aclDir),
+			action,
+			nil,
+			libovsdbutil.ACLDirectionToACLPipeline(aclDir),
+			isolationTier))
+	}
+	addACL(libovsdbutil.ACLIngress, "pass", nbdb.ACLActionPass, types.NetworkPeeringPassPriority, ingressPassMatches,
+		getNetworkPeeringPortsMatch(peeredNetwork.Ports))
+	addACL(libovsdbutil.ACLIngress, "deny", nbdb.ACLActionDrop, types.NetworkPeeringDenyPriority, ingressDenyMatches, "")
+	addACL(libovsdbutil.ACLEgress, "pass", nbdb.ACLActionPass, types.NetworkPeeringPassPriority, egressPassMatches, "")
+	addACL(libovsdbutil.ACLEgress, "deny", nbdb.ACLActionDrop, types.NetworkPeeringDenyPriority, egressDenyMatches, "")
+
+	ops, err := libovsdbops.CreateOrUpdateACLsOps(oc.nbClient, nil, nil, acls...)
+	if err != nil {
+		return err
+	}
+	ops, err = libovsdbops.AddACLsToPortGroupOps(oc.nbClient, ops, pgName, acls...)
+	if err != nil {
+		return err
+	}
+	// remove the ACLs that are no longer needed, like the pass ACLs when no namespace is selected
+	aclNames := make(map[string]bool, len(acls))
+	for _, acl := range acls {
+		aclNames[acl.ExternalIDs[libovsdbops.PrimaryIDKey.String()]] = true
+	}
+	staleACLs, err := libovsdbops.FindACLsWithPredicate(oc.nbClient, libovsdbops.GetPredicate[*nbdb.ACL](
+		oc.getNetworkPeeringDbIDs(libovsdbops.ACLNetworkPeering, peering.Name), func(acl *nbdb.ACL) bool {
+			return !aclNames[acl.ExternalIDs[libovsdbops.PrimaryIDKey.String()]]
+		}))
+	if err != nil {
+		return err
+	}
+	ops, err = libovsdbops.DeleteACLsFromPortGroupOps(oc.nbClient, ops, pgName, staleACLs...)
+	if err != nil {
+		return err
+	}
+	_, err = libovsdbops.TransactAndCheck(oc.nbClient, ops)
+	return err
+}
+
+// getOwnPeeredNetwork returns the entry of the network in the spec of the given peering
+func (oc *BaseUserDefinedNetworkController) getOwnPeeredNetwork(peering *userdefinednetworkv1.NetworkPeering) *userdefinednetworkv1.PeeredNetwork {
+	for i := range peering.Spec.Networks {
+		peeredNetwork := &peering.Spec.Networks[i]
+		var networkName string
+		switch peeredNetwork.Kind {
+		case userdefinednetworkv1.PeeredNetworkKindUserDefinedNetwork:
+			networkName = util.GenerateUDNNetworkName(peeredNetwork.Namespace, peeredNetwork.Name)
+		case userdefinednetworkv1.PeeredNetworkKindClusterUserDefinedNetwork:
+			networkName = util.GenerateCUDNNetworkName(peeredNetwork.Name)
+		}
+		if networkName == oc.GetNetworkName() {
+			return peeredNetwork
+		}
+	}
+	return nil
+}
+
+// getNetworkPeeringNamespaceAddressSets returns the IPv4 and IPv6 hash names of the address sets of the namespaces of
+// the network selected by the given selector
+func (oc *BaseUserDefinedNetworkController) getNetworkPeeringNamespaceAddressSets(namespaceSelector *metav1.LabelSelector) (v4HashNames, v6HashNames []string, err error) {
+	selector, err := metav1.LabelSelectorAsSelector(namespaceSelector)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid namespace selector: %w", err)
+	}
+	for _, namespaceName := range oc.GetNADNamespaces() {
+		namespace, err := oc.watchFactory.GetNamespace(namespaceName)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return nil, nil, err
+		}
+		if !selector.Matches(labels.Set(namespace.Labels)) {
+			continue
+		}
+		// the namespace handler may not have created the address set yet
+		as, err := oc.addressSetFactory.EnsureAddressSet(getNamespaceAddrSetDbIDs(namespaceName, oc.controllerName))
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to ensure address set of namespace %s: %w", namespaceName, err)
+		}
+		v4HashName, v6HashName := as.GetASHashNames()
+		if v4HashName != "" {
+			v4HashNames = append(v4HashNames, "$"+v4HashName)
+		}
+		if v6HashName != "" {
+			v6HashNames = append(v6HashNames, "$"+v6HashName)
+		}
+	}
+	return v4HashNames, v6HashNames, nil
+}
+
+// getNetworkPeeringPortsMatch returns the match of the given ports, or an empty string if all ports are allowed
+func getNetworkPeeringPortsMatch(ports []userdefinednetworkv1.PeeringPort) string {
+	if len(ports) == 0 {
+		return ""
+	}
+	portMatches := make([]string, 0, len(ports))
+	for _, port := range ports {
+		protocol := strings.ToLower(string(port.Protocol))
+		if port.Port == 0 {
+			portMatches = append(portMatches, protocol)
+			continue
+		}
+		portMatches = append(portMatches, fmt.Sprintf("%s.dst == %d", protocol, port.Port))
+	}
+	return "(" + strings.Join(portMatches, " || ") + ")"
+}
+
+// getNetworkPeeringDbIDs returns the IDs shared by all the objects of the given type the network owns for the given
+// peering, to be completed with the keys specific to every object or used as is to look all of them up
+func (oc *BaseUserDefinedNetworkController) getNetworkPeeringDbIDs(idsType *libovsdbops.ObjectIDsType, name string) *libovsdbops.DbObjectIDs {
+	return libovsdbops.NewDbObjectIDs(idsType, oc.controllerName,
+		map[libovsdbops.ExternalIDKey]string{
+			libovsdbops.ObjectNameKey: name,
+		})
+}
+
+func (oc *BaseUserDefinedNetworkController) getNetworkPeeringACLDbIDs(name string, aclDir libovsdbutil.ACLDirection, aclType string) *libovsdbops.DbObjectIDs {
+	return oc.getNetworkPeeringDbIDs(libovsdbops.ACLNetworkPeering, name).AddIDs(
+		map[libovsdbops.ExternalIDKey]string{
+			libovsdbops.PolicyDirectionKey: string(aclDir),
+			libovsdbops.TypeKey:            aclType,
+		})
+}
+
+func (oc *BaseUserDefinedNetworkController) getNetworkPeeringPolicyDbIDs(name, ipFamily string) *libovsdbops.DbObjectIDs {
+	return oc.getNetworkPeeringDbIDs(libovsdbops.LogicalRouterPolicyNetworkPeering, name).AddIDs(
+		map[libovsdbops.ExternalIDKey]string{
+			libovsdbops.IPFamilyKey: ipFamily,
+		})
+}
+
+func (oc *BaseUserDefinedNetworkController) getNetworkPeeringRouteDbIDs(name, cidr string) *libovsdbops.DbObjectIDs {
+	return oc.getNetworkPeeringDbIDs(libovsdbops.LogicalRouterStaticRouteNetworkPeering, name).AddIDs(
+		map[libovsdbops.ExternalIDKey]string{
+			libovsdbops.CIDRKey: cidr,
+		})
+}
+
+// deleteNetworkPeering deletes the side of the network of the given peering, and the peering router once no network
+// is connected to it
+func (oc *BaseUserDefinedNetworkController) deleteNetworkPeering(name string) error {
+	klog.V(5).Infof("Deleting network peering %s for network %s", name, oc.GetNetworkName())
+	pgName := oc.getClusterPortGroupName(types.ClusterPortGroupNameBase)
+	acls, err := libovsdbops.FindACLsWithPredicate(oc.nbClient, libovsdbops.GetPredicate[*nbdb.ACL](
+		oc.getNetworkPeeringDbIDs(libovsdbops.ACLNetworkPeering, name), nil))
+	if err != nil {
+		return fmt.Errorf("failed to find network peering %s ACLs of network %s: %w", name, oc.GetNetworkName(), err)
+	}
+	if len(acls) > 0 {
+		ops, err := libovsdbops.DeleteACLsFromPortGroupOps(oc.nbClient, nil, pgName, acls...)
+		if err != nil {
+			return err
+		}
+		if _, err = libovsdbops.TransactAndCheck(oc.nbClient, ops); err != nil {
+			return fmt.Errorf("failed to delete network peering %s ACLs of network %s: %w", name, oc.GetNetworkName(), err)
+		}
+	}
+
+	// the gateway router of a Layer2 network is deleted with its policies and ports when the node leaves the zone
+	if routerName := oc.getNetworkPeeringRouterName(); routerName != "" {
+		networkRouter := &nbdb.LogicalRouter{Name: routerName}
+		err = libovsdbops.DeleteLogicalRouterPoliciesWithPredicate(oc.nbClient, networkRouter.Name,
+			libovsdbops.GetPredicate[*nbdb.LogicalRouterPolicy](oc.getNetworkPeeringDbIDs(libovsdbops.LogicalRouterPolicyNetworkPeering, name), nil))
+		if err != nil && !errors.Is(err, libovsdbclient.ErrNotFound) {
+			return fmt.Errorf("failed to delete network peering %s policies on router %s: %w", name, networkRouter.Name, err)
+		}
+		networkRouterPort := &nbdb.LogicalRouterPort{Name: types.ClusterRouterToNetworkPeeringRouterPrefix + oc.GetNetworkScopedName(name)}
+		if err := libovsdbops.DeleteLogicalRouterPorts(oc.nbClient, networkRouter, networkRouterPort); err != nil && !errors.Is(err, libovsdbclient.ErrNotFound) {
+			return fmt.Errorf("failed to delete port %s on router %s: %w", networkRouterPort.Name, networkRouter.Name, err)
+		}
+	}
+
+	return deleteNetworkPeeringRouterSide(oc.nbClient, name, oc.GetNetworkScopedName(name), oc.getNetworkPeeringDbIDs(libovsdbops.LogicalRouterStaticRouteNetworkPeering, name))
+}
+
+// deleteNetworkPeeringRouterSide deletes the port and the routes of a network on the peering router, and the peering
+// router once no network is connected to it
+func deleteNetworkPeeringRouterSide(nbClient libovsdbclient.Client, name, networkScopedName string, routeDbIDs *libovsdbops.DbObjectIDs) error {
+	peeringRouter := &nbdb.LogicalRouter{Name: networkPeeringRouterName(name)}
+	err := libovsdbops.DeleteLogicalRouterStaticRoutesWithPredicate(nbClient, peeringRouter.Name,
+		libovsdbops.GetPredicate[*nbdb.LogicalRouterStaticRoute](routeDbIDs, nil))
+	if err != nil {
+		return fmt.Errorf("failed to delete routes on router %s: %w", peeringRouter.Name, err)
+	}
+	peeringRouterPort := &nbdb.LogicalRouterPort{Name: types.NetworkPeeringRouterToClusterRouterPrefix + networkScopedName}
+	if err := libovsdbops.DeleteLogicalRouterPorts(nbClient, peeringRouter, peeringRouterPort); err != nil && !errors.Is(err, libovsdbclient.ErrNotFound) {
+		return fmt.Errorf("failed to delete port %s on router %s: %w", peeringRouterPort.Name, peeringRouter.Name, err)
+	}
+	peeringRouter, err = libovsdbops.GetLogicalRouter(nbClient, peeringRouter)
+	if err != nil {
+		if errors.Is(err, libovsdbclient.ErrNotFound) {
+			return nil
+		}
+		return fmt.Errorf("failed to get router %s: %w", networkPeeringRouterName(name), err)
+	}
+	if len(peeringRouter.Ports) > 0 {
+		return nil
+	}
+	if err := libovsdbops.DeleteLogicalRouter(nbClient, peeringRouter); err != nil {
+		return fmt.Errorf("failed to delete router %s: %w", peeringRouter.Name, err)
+	}
+	return nil
+}
+
+// cleanupNetworkPeerings deletes the ports and the routes of the network on the peering routers, the rest of the
+// configuration of its peerings is deleted with its routers and port groups
+func cleanupNetworkPeerings(nbClient libovsdbclient.Client, netInfo util.NetInfo, controllerName string) error {
+	lrps, err := libovsdbops.FindLogicalRouterPortWithPredicate(nbClient, func(lrp *nbdb.LogicalRouterPort) bool {
+		return lrp.ExternalIDs[types.NetworkExternalID] == netInfo.GetNetworkName() &&
+			strings.HasPrefix(lrp.Name, types.NetworkPeeringRouterToClusterRouterPrefix)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to find network peering router ports of network %s: %w", netInfo.GetNetworkName(), err)
+	}
+	var errs []error
+	for _, lrp := range lrps {
+		name := lrp.ExternalIDs[types.NetworkPeeringExternalID]
+		routeDbIDs := libovsdbops.NewDbObjectIDs(libovsdbops.LogicalRouterStaticRouteNetworkPeering, controllerName,
+			map[libovsdbops.ExternalIDKey]string{libovsdbops.ObjectNameKey: name})
+		if err := deleteNetworkPeeringRouterSide(nbClient, name, netInfo.GetNetworkScopedName(name), routeDbIDs); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
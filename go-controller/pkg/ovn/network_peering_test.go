@@ -0,0 +1,384 @@
+package ovn
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"sync"
+
+	cnitypes "github.com/containernetworking/cni/pkg/types"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	ovncnitypes "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/cni/types"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
+	userdefinednetworkv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/userdefinednetwork/v1"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/factory"
+	libovsdbops "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
+	libovsdbutil "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/util"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
+	addressset "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn/address_set"
+	libovsdbtest "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/testing/libovsdb"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Network peering", func() {
+	const peeringName = "peering"
+
+	var (
+		wf        *factory.WatchFactory
+		nbCleanup *libovsdbtest.Context
+		red, blue *BaseUserDefinedNetworkController
+	)
+
+	const nodeName = "node1"
+
+	newPeeredController := func(networkName, topology, subnets string, nadNames ...string) *BaseUserDefinedNetworkController {
+		netInfo, err := util.NewNetInfo(&ovncnitypes.NetConf{
+			NetConf:  cnitypes.NetConf{Name: networkName},
+			Topology: topology,
+			Role:     types.NetworkRolePrimary,
+			Subnets:  subnets,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		mutableNetInfo := util.NewMutableNetInfo(netInfo)
+		mutableNetInfo.AddNADs(nadNames...)
+		localZoneNodes := &sync.Map{}
+		localZoneNodes.Store(nodeName, true)
+		return &BaseUserDefinedNetworkController{
+			BaseNetworkController: BaseNetworkController{
+				controllerName:      getNetworkControllerName(netInfo.GetNetworkName()),
+				ReconcilableNetInfo: util.NewReconcilableNetInfo(mutableNetInfo),
+				localZoneNodes:      localZoneNodes,
+			},
+		}
+	}
+
+	peeredNetworkNBData := func(oc *BaseUserDefinedNetworkController) []libovsdbtest.TestData {
+		return []libovsdbtest.TestData{
+			&nbdb.LogicalRouter{
+				UUID: oc.getNetworkPeeringRouterName() + "-UUID",
+				Name: oc.getNetworkPeeringRouterName(),
+			},
+			libovsdbutil.BuildPortGroup(oc.getClusterPortGroupDbIDs(types.ClusterPortGroupNameBase), nil, nil),
+		}
+	}
+
+	// start runs the watch factory with the given objects, and connects the red and blue controllers to the NB DB
+	start := func(objects ...runtime.Object) {
+		fakeClient := util.GetOVNClientset(objects...).GetMasterClientset()
+		var err error
+		wf, err = factory.NewMasterWatchFactory(fakeClient)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(wf.Start()).To(Succeed())
+
+		nbClient, cleanup, err := libovsdbtest.NewNBTestHarness(libovsdbtest.TestSetup{
+			NBData: append(peeredNetworkNBData(red), peeredNetworkNBData(blue)...),
+		}, nil)
+		Expect(err).NotTo(HaveOccurred())
+		nbCleanup = cleanup
+		for _, oc := range []*BaseUserDefinedNetworkController{red, blue} {
+			oc.watchFactory = wf
+			oc.nbClient = nbClient
+			oc.addressSetFactory = addressset.NewOvnAddressSetFactory(nbClient, true, false)
+		}
+	}
+
+	newPeering := func(blueNetwork userdefinednetworkv1.PeeredNetwork) *userdefinednetworkv1.NetworkPeering {
+		return &userdefinednetworkv1.NetworkPeering{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: peeringName,
+				Annotations: map[string]string{
+					util.NetworkPeeringAnnotation: `{"id":1,"networks":{"red_net":["10.10.0.0/16"],"blue_net":["10.20.0.0/16"]}}`,
+				},
+			},
+			Spec: userdefinednetworkv1.NetworkPeeringSpec{
+				Networks: []userdefinednetworkv1.PeeredNetwork{
+					{
+						Kind:      userdefinednetworkv1.PeeredNetworkKindUserDefinedNetwork,
+						Namespace: "red",
+						Name:      "net",
+					},
+					blueNetwork,
+				},
+			},
+		}
+	}
+
+	findACL := func(oc *BaseUserDefinedNetworkController, aclDir libovsdbutil.ACLDirection, aclType string) *nbdb.ACL {
+		acls, err := libovsdbops.FindACLsWithPredicate(oc.nbClient, libovsdbops.GetPredicate[*nbdb.ACL](
+			oc.getNetworkPeeringACLDbIDs(peeringName, aclDir, aclType), nil))
+		Expect(err).NotTo(HaveOccurred())
+		if len(acls) == 0 {
+			return nil
+		}
+		Expect(acls).To(HaveLen(1))
+		return acls[0]
+	}
+
+	BeforeEach(func() {
+		Expect(config.PrepareTestConfig()).To(Succeed())
+		config.IPv4Mode = true
+		config.OVNKubernetesFeature.EnableMultiNetwork = true
+		config.OVNKubernetesFeature.EnableNetworkSegmentation = true
+		wf = nil
+		nbCleanup = nil
+		red = newPeeredController("red_net", types.Layer3Topology, "10.10.0.0/16/24", "red/net")
+		blue = newPeeredController("blue_net", types.Layer3Topology, "10.20.0.0/16/24", "blue/net")
+	})
+
+	AfterEach(func() {
+		if wf != nil {
+			wf.Shutdown()
+		}
+		if nbCleanup != nil {
+			nbCleanup.Cleanup()
+		}
+	})
+
+	It("connects the peered networks through the peering router", func() {
+		start(newPeering(userdefinednetworkv1.PeeredNetwork{
+			Kind:      userdefinednetworkv1.PeeredNetworkKindUserDefinedNetwork,
+			Namespace: "blue",
+			Name:      "net",
+			Ports:     []userdefinednetworkv1.PeeringPort{{Protocol: userdefinednetworkv1.PeeringProtocolTCP, Port: 80}},
+		}))
+		Expect(red.syncNetworkPeering(peeringName)).To(Succeed())
+		Expect(blue.syncNetworkPeering(peeringName)).To(Succeed())
+
+		By("connecting both cluster routers to the peering router")
+		peeringRouter, err := libovsdbops.GetLogicalRouter(red.nbClient, &nbdb.LogicalRouter{Name: networkPeeringRouterName(peeringName)})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(peeringRouter.Ports).To(HaveLen(2))
+		// blue_net sorts first and gets the first link of the peering
+		for _, link := range []struct {
+			oc                               *BaseUserDefinedNetworkController
+			clusterRouterIP, peeringRouterIP string
+		}{
+			{blue, "100.66.0.9/30", "100.66.0.10/30"},
+			{red, "100.66.0.13/30", "100.66.0.14/30"},
+		} {
+			lrps, err := libovsdbops.FindLogicalRouterPortWithPredicate(red.nbClient, func(lrp *nbdb.LogicalRouterPort) bool {
+				return lrp.Name == types.ClusterRouterToNetworkPeeringRouterPrefix+link.oc.GetNetworkScopedName(peeringName)
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(lrps).To(HaveLen(1))
+			Expect(lrps[0].Networks).To(ConsistOf(link.clusterRouterIP))
+			Expect(*lrps[0].Peer).To(Equal(types.NetworkPeeringRouterToClusterRouterPrefix + link.oc.GetNetworkScopedName(peeringName)))
+			lrps, err = libovsdbops.FindLogicalRouterPortWithPredicate(red.nbClient, func(lrp *nbdb.LogicalRouterPort) bool {
+				return lrp.Name == types.NetworkPeeringRouterToClusterRouterPrefix+link.oc.GetNetworkScopedName(peeringName)
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(lrps).To(HaveLen(1))
+			Expect(lrps[0].Networks).To(ConsistOf(link.peeringRouterIP))
+
+			routes, err := libovsdbops.FindLogicalRouterStaticRoutesWithPredicate(red.nbClient, libovsdbops.GetPredicate[*nbdb.LogicalRouterStaticRoute](
+				link.oc.getNetworkPeeringDbIDs(libovsdbops.LogicalRouterStaticRouteNetworkPeering, peeringName), nil))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(routes).To(HaveLen(1))
+			Expect(routes[0].Nexthop).To(Equal(link.clusterRouterIP[:len(link.clusterRouterIP)-3]))
+		}
+
+		By("rerouting the traffic to the peer subnets to the peering router")
+		policies, err := libovsdbops.FindLogicalRouterPoliciesWithPredicate(red.nbClient, libovsdbops.GetPredicate[*nbdb.LogicalRouterPolicy](
+			red.getNetworkPeeringPolicyDbIDs(peeringName, "ip4"), nil))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(policies).To(HaveLen(1))
+		Expect(policies[0].Match).To(Equal("ip4.src == {10.10.0.0/16} && ip4.dst == {10.20.0.0/16}"))
+		Expect(policies[0].Nexthops).To(ConsistOf("100.66.0.14"))
+
+		By("restricting the ports of the network the peer network may connect to")
+		blueIngressPass := findACL(blue, libovsdbutil.ACLIngress, "pass")
+		Expect(blueIngressPass).NotTo(BeNil())
+		Expect(blueIngressPass.Match).To(Equal(fmt.Sprintf("outport == @%s && (ip4.src == {10.10.0.0/16}) && (tcp.dst == 80)",
+			blue.getClusterPortGroupName(types.ClusterPortGroupNameBase))))
+		Expect(blueIngressPass.Action).To(Equal(nbdb.ACLActionPass))
+		Expect(blueIngressPass.Tier).To(Equal(types.PrimaryACLTier))
+		redIngressPass := findACL(red, libovsdbutil.ACLIngress, "pass")
+		Expect(redIngressPass).NotTo(BeNil())
+		Expect(redIngressPass.Match).To(Equal(fmt.Sprintf("outport == @%s && ip4.src == {10.20.0.0/16}",
+			red.getClusterPortGroupName(types.ClusterPortGroupNameBase))))
+		Expect(findACL(red, libovsdbutil.ACLIngress, "deny")).NotTo(BeNil())
+		Expect(findACL(red, libovsdbutil.ACLEgress, "deny")).NotTo(BeNil())
+
+		By("deleting the peering router once both networks left it")
+		Expect(red.deleteNetworkPeering(peeringName)).To(Succeed())
+		peeringRouter, err = libovsdbops.GetLogicalRouter(red.nbClient, &nbdb.LogicalRouter{Name: networkPeeringRouterName(peeringName)})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(peeringRouter.Ports).To(HaveLen(1))
+		Expect(findACL(red, libovsdbutil.ACLIngress, "deny")).To(BeNil())
+		Expect(blue.deleteNetworkPeering(peeringName)).To(Succeed())
+		routers, err := libovsdbops.FindLogicalRoutersWithPredicate(red.nbClient, func(lr *nbdb.LogicalRouter) bool {
+			return lr.Name == networkPeeringRouterName(peeringName)
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(routers).To(BeEmpty())
+	})
+
+	It("keeps applying the network policies of the peered namespaces", func() {
+		start(newPeering(userdefinednetworkv1.PeeredNetwork{
+			Kind:      userdefinednetworkv1.PeeredNetworkKindUserDefinedNetwork,
+			Namespace: "blue",
+			Name:      "net",
+		}))
+		Expect(blue.syncNetworkPeering(peeringName)).To(Succeed())
+		ingressPass := findACL(blue, libovsdbutil.ACLIngress, "pass")
+		Expect(ingressPass).NotTo(BeNil())
+		ingressDeny := findACL(blue, libovsdbutil.ACLIngress, "deny")
+		Expect(ingressDeny).NotTo(BeNil())
+
+		// verdict returns the action OVN applies to a packet matching all the given ACLs: within a tier only the
+		// matching ACL of highest priority applies, and a pass action moves the evaluation to the next tier
+		verdict := func(acls ...*nbdb.ACL) string {
+			sorted := slices.Clone(acls)
+			sort.SliceStable(sorted, func(i, j int) bool {
+				if sorted[i].Tier != sorted[j].Tier {
+					return sorted[i].Tier < sorted[j].Tier
+				}
+				return sorted[i].Priority > sorted[j].Priority
+			})
+			for i, acl := range sorted {
+				if i > 0 && sorted[i-1].Tier == acl.Tier {
+					continue
+				}
+				if acl.Action != nbdb.ACLActionPass {
+					return acl.Action
+				}
+			}
+			return nbdb.ACLActionAllow
+		}
+		By("letting the traffic from the peer network through without network policies")
+		Expect(verdict(ingressPass, ingressDeny)).To(Equal(nbdb.ACLActionAllow))
+
+		By("dropping the traffic from the peer network denied by a network policy of the namespace")
+		// the ingress default deny ACL is added for every network policy selecting the pods for ingress
+		policyDeny, _ := blue.buildDenyACLs("blue", blue.defaultDenyPortGroupName("blue", libovsdbutil.ACLIngress), nil, libovsdbutil.ACLIngress)
+		Expect(policyDeny.Tier).To(BeNumerically(">", ingressPass.Tier))
+		Expect(verdict(ingressPass, ingressDeny, policyDeny)).To(Equal(nbdb.ACLActionDrop))
+	})
+
+	It("only allows the namespaces selected by a ClusterUserDefinedNetwork", func() {
+		selected := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "blue", Labels: map[string]string{"peered": "true"}}}
+		notSelected := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "other"}}
+		peering := newPeering(userdefinednetworkv1.PeeredNetwork{
+			Kind:              userdefinednetworkv1.PeeredNetworkKindClusterUserDefinedNetwork,
+			Name:              "blue",
+			NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"peered": "true"}},
+		})
+		peering.Annotations[util.NetworkPeeringAnnotation] = `{"id":1,"networks":{"red_net":["10.10.0.0/16"],"cluster_udn_blue":["10.20.0.0/16"]}}`
+		// the blue network is a ClusterUserDefinedNetwork serving both namespaces
+		blue = newPeeredController(util.GenerateCUDNNetworkName("blue"), types.Layer3Topology, "10.20.0.0/16/24", "blue/net", "other/net")
+		start(peering, selected, notSelected)
+
+		Expect(blue.syncNetworkPeering(peeringName)).To(Succeed())
+
+		selectedAS, err := blue.addressSetFactory.GetAddressSet(getNamespaceAddrSetDbIDs("blue", blue.controllerName))
+		Expect(err).NotTo(HaveOccurred())
+		selectedASv4, _ := selectedAS.GetASHashNames()
+		egressPass := findACL(blue, libovsdbutil.ACLEgress, "pass")
+		Expect(egressPass).NotTo(BeNil())
+		Expect(egressPass.Match).To(Equal(fmt.Sprintf("inport == @%s && (ip4.dst == {10.10.0.0/16} && ip4.src == {$%s})",
+			blue.getClusterPortGroupName(types.ClusterPortGroupNameBase), selectedASv4)))
+		Expect(egressPass.Options).To(HaveKeyWithValue("apply-after-lb", "true"))
+	})
+
+	It("connects a Layer2 network through the gateway router of the node of the zone", func() {
+		red = newPeeredController("red_net", types.Layer2Topology, "10.10.0.0/16", "red/net")
+		start(newPeering(userdefinednetworkv1.PeeredNetwork{
+			Kind:      userdefinednetworkv1.PeeredNetworkKindUserDefinedNetwork,
+			Namespace: "blue",
+			Name:      "net",
+		}))
+		Expect(red.syncNetworkPeering(peeringName)).To(Succeed())
+		Expect(blue.syncNetworkPeering(peeringName)).To(Succeed())
+
+		By("connecting the gateway router of the Layer2 network to the peering router")
+		gwRouter, err := libovsdbops.GetLogicalRouter(red.nbClient, &nbdb.LogicalRouter{Name: red.GetNetworkScopedGWRouterName(nodeName)})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(gwRouter.Ports).To(HaveLen(1))
+		lrp, err := libovsdbops.GetLogicalRouterPort(red.nbClient, &nbdb.LogicalRouterPort{UUID: gwRouter.Ports[0]})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(lrp.Name).To(Equal(types.ClusterRouterToNetworkPeeringRouterPrefix + red.GetNetworkScopedName(peeringName)))
+		Expect(lrp.Networks).To(ConsistOf("100.66.0.13/30"))
+		Expect(*lrp.Peer).To(Equal(types.NetworkPeeringRouterToClusterRouterPrefix + red.GetNetworkScopedName(peeringName)))
+		peeringRouter, err := libovsdbops.GetLogicalRouter(red.nbClient, &nbdb.LogicalRouter{Name: networkPeeringRouterName(peeringName)})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(peeringRouter.Ports).To(HaveLen(2))
+		routes, err := libovsdbops.FindLogicalRouterStaticRoutesWithPredicate(red.nbClient, libovsdbops.GetPredicate[*nbdb.LogicalRouterStaticRoute](
+			red.getNetworkPeeringDbIDs(libovsdbops.LogicalRouterStaticRouteNetworkPeering, peeringName), nil))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(routes).To(HaveLen(1))
+		Expect(routes[0].IPPrefix).To(Equal("10.10.0.0/16"))
+		Expect(routes[0].Nexthop).To(Equal("100.66.0.13"))
+
+		By("rerouting the traffic to the peer subnets from the gateway router to the peering router")
+		Expect(gwRouter.Policies).To(HaveLen(1))
+		policy, err := libovsdbops.FindLogicalRouterPoliciesWithPredicate(red.nbClient, func(item *nbdb.LogicalRouterPolicy) bool {
+			return item.UUID == gwRouter.Policies[0]
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(policy).To(HaveLen(1))
+		Expect(policy[0].Match).To(Equal("ip4.src == {10.10.0.0/16} && ip4.dst == {10.20.0.0/16}"))
+		Expect(policy[0].Nexthops).To(ConsistOf("100.66.0.14"))
+		Expect(findACL(red, libovsdbutil.ACLIngress, "pass")).NotTo(BeNil())
+
+		By("disconnecting the gateway router from the peering router")
+		Expect(red.deleteNetworkPeering(peeringName)).To(Succeed())
+		gwRouter, err = libovsdbops.GetLogicalRouter(red.nbClient, gwRouter)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(gwRouter.Ports).To(BeEmpty())
+		Expect(gwRouter.Policies).To(BeEmpty())
+		peeringRouter, err = libovsdbops.GetLogicalRouter(red.nbClient, peeringRouter)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(peeringRouter.Ports).To(HaveLen(1))
+	})
+
+	It("connects two Layer2 networks", func() {
+		red = newPeeredController("red_net", types.Layer2Topology, "10.10.0.0/16", "red/net")
+		blue = newPeeredController("blue_net", types.Layer2Topology, "10.20.0.0/16", "blue/net")
+		start(newPeering(userdefinednetworkv1.PeeredNetwork{
+			Kind:      userdefinednetworkv1.PeeredNetworkKindUserDefinedNetwork,
+			Namespace: "blue",
+			Name:      "net",
+		}))
+		Expect(red.syncNetworkPeering(peeringName)).To(Succeed())
+		Expect(blue.syncNetworkPeering(peeringName)).To(Succeed())
+
+		for _, oc := range []*BaseUserDefinedNetworkController{red, blue} {
+			gwRouter, err := libovsdbops.GetLogicalRouter(oc.nbClient, &nbdb.LogicalRouter{Name: oc.GetNetworkScopedGWRouterName(nodeName)})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(gwRouter.Ports).To(HaveLen(1))
+			Expect(gwRouter.Policies).To(HaveLen(1))
+		}
+		peeringRouter, err := libovsdbops.GetLogicalRouter(red.nbClient, &nbdb.LogicalRouter{Name: networkPeeringRouterName(peeringName)})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(peeringRouter.Ports).To(HaveLen(2))
+		Expect(peeringRouter.StaticRoutes).To(HaveLen(2))
+	})
+
+	It("only configures the ACLs of a Layer2 network without a node in the zone", func() {
+		red = newPeeredController("red_net", types.Layer2Topology, "10.10.0.0/16", "red/net")
+		start(newPeering(userdefinednetworkv1.PeeredNetwork{
+			Kind:      userdefinednetworkv1.PeeredNetworkKindUserDefinedNetwork,
+			Namespace: "blue",
+			Name:      "net",
+		}))
+		red.localZoneNodes.Delete(nodeName)
+		Expect(red.syncNetworkPeering(peeringName)).To(Succeed())
+
+		routers, err := libovsdbops.FindLogicalRoutersWithPredicate(red.nbClient, func(lr *nbdb.LogicalRouter) bool {
+			return lr.Name == networkPeeringRouterName(peeringName)
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(routers).To(BeEmpty())
+		Expect(findACL(red, libovsdbutil.ACLIngress, "deny")).NotTo(BeNil())
+		Expect(red.deleteNetworkPeering(peeringName)).To(Succeed())
+		Expect(findACL(red, libovsdbutil.ACLIngress, "deny")).To(BeNil())
+	})
+})
@@ -135,7 +135,7 @@ func (o *FakeOVN) start(objects ...runtime.Object) {
 		switch o := object.(type) {
 		case *egressip.EgressIPList:
 			egressIPObjects = append(egressIPObjects, object)
-		case *egressfirewall.EgressFirewallList:
+		case *egressfirewall.EgressFirewallList, *egressfirewall.ClusterEgressFirewallList:
 			egressFirewallObjects = append(egressFirewallObjects, object)
 		case *ocpnetworkapiv1alpha1.DNSNameResolverList:
 			dnsNameResolverObjects = append(dnsNameResolverObjects, object)
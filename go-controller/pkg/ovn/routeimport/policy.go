@@ -0,0 +1,302 @@
+package routeimport
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net"
+	"slices"
+	"strings"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+	metaapplyv1 "k8s.io/client-go/applyconfigurations/meta/v1"
+	"k8s.io/utils/ptr"
+
+	ratypes "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/routeadvertisements/v1"
+	raapply "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/routeadvertisements/v1/apis/applyconfiguration/routeadvertisements/v1"
+	apitypes "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/types"
+	udnv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/userdefinednetwork/v1"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
+)
+
+const (
+	// limitReachedConditionType is the prefix of the condition type each zone
+	// reports on the RouteImportPolicies with a route limit
+	limitReachedConditionType = "RouteImportLimitReached-In-Zone-"
+	limitReachedReason        = "LimitReached"
+	limitNotReachedReason     = "LimitNotReached"
+)
+
+var cudnController = udnv1.SchemeGroupVersion.WithKind("ClusterUserDefinedNetwork")
+
+// getImportPolicies returns the RouteImportPolicies that select the network,
+// sorted by name.
+func (c *controller) getImportPolicies(network util.NetInfo) ([]*ratypes.RouteImportPolicy, error) {
+	if c.policyLister == nil {
+		return nil, nil
+	}
+	policies, err := c.policyLister.List(labels.Everything())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list route import policies: %w", err)
+	}
+	slices.SortFunc(policies, func(a, b *ratypes.RouteImportPolicy) int { return strings.Compare(a.Name, b.Name) })
+	selected := make([]*ratypes.RouteImportPolicy, 0, len(policies))
+	for _, policy := range policies {
+		isSelected, err := c.selectsNetwork(policy.Spec.NetworkSelectors, network)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check if route import policy %q selects network %q: %w", policy.Name, network.GetNetworkName(), err)
+		}
+		if isSelected {
+			selected = append(selected, policy)
+		}
+	}
+	return selected, nil
+}
+
+func (c *controller) selectsNetwork(networkSelectors apitypes.NetworkSelectors, network util.NetInfo) (bool, error) {
+	for _, networkSelector := range networkSelectors {
+		switch networkSelector.NetworkSelectionType {
+		case apitypes.DefaultNetwork:
+			if network.IsDefault() {
+				return true, nil
+			}
+		case apitypes.ClusterUserDefinedNetworks:
+			if c.nadLister == nil || networkSelector.ClusterUserDefinedNetworkSelector == nil {
+				continue
+			}
+			nadSelector, err := metav1.LabelSelectorAsSelector(&networkSelector.ClusterUserDefinedNetworkSelector.NetworkSelector)
+			if err != nil {
+				return false, err
+			}
+			nads, err := c.nadLister.List(nadSelector)
+			if err != nil {
+				return false, err
+			}
+			for _, nad := range nads {
+				// check this NAD is controlled by a CUDN
+				controller := metav1.GetControllerOfNoCopy(nad)
+				isCUDN := controller != nil && controller.Kind == cudnController.Kind && controller.APIVersion == cudnController.GroupVersion().String()
+				if isCUDN && network.HasNAD(util.GetNADName(nad.Namespace, nad.Name)) {
+					return true, nil
+				}
+			}
+		}
+	}
+	return false, nil
+}
+
+// applyImportPolicies filters out the routes denied by any of the policies and
+// caps the remaining ones to the lowest route limit of the policies. Routes
+// already imported are preferred over new ones when capping. Returns the routes
+// to import, the policies whose route limit was reached and the number of
+// allowed routes dropped because of it.
+func applyImportPolicies(policies []*ratypes.RouteImportPolicy, routes, imported sets.Set[route]) (sets.Set[route], sets.Set[string], int) {
+	allowed := sets.New[route]()
+	for r := range routes {
+		_, dst, err := net.ParseCIDR(r.dst)
+		if err != nil {
+			continue
+		}
+		if allowsRoute(policies, dst) {
+			allowed.Insert(r)
+		}
+	}
+
+	limitReached := sets.New[string]()
+	maxRoutes := -1
+	for _, policy := range policies {
+		if policy.Spec.MaxRoutes == nil || len(allowed) <= int(*policy.Spec.MaxRoutes) {
+			continue
+		}
+		limitReached.Insert(policy.Name)
+		if maxRoutes < 0 || int(*policy.Spec.MaxRoutes) < maxRoutes {
+			maxRoutes = int(*policy.Spec.MaxRoutes)
+		}
+	}
+	if maxRoutes < 0 {
+		return allowed, limitReached, 0
+	}
+
+	// group the routes by destination so that all the next hops of a
+	// destination are either imported or not
+	byDst := map[string][]route{}
+	for r := range allowed {
+		byDst[r.dst] = append(byDst[r.dst], r)
+	}
+	isImported := func(dst string) bool {
+		return slices.ContainsFunc(byDst[dst], imported.Has)
+	}
+	dsts := slices.Collect(maps.Keys(byDst))
+	slices.SortFunc(dsts, func(a, b string) int {
+		if isImported(a) != isImported(b) {
+			if isImported(a) {
+				return -1
+			}
+			return 1
+		}
+		return strings.Compare(a, b)
+	})
+
+	capped := sets.New[route]()
+	for _, dst := range dsts {
+		if len(capped)+len(byDst[dst]) > maxRoutes {
+			break
+		}
+		capped.Insert(byDst[dst]...)
+	}
+	return capped, limitReached, len(allowed) - len(capped)
+}
+
+// allowsRoute returns whether all the policies allow importing a route to dst.
+func allowsRoute(policies []*ratypes.RouteImportPolicy, dst *net.IPNet) bool {
+	for _, policy := range policies {
+		action := policy.Spec.DefaultAction
+		for _, rule := range policy.Spec.Rules {
+			if ruleMatches(&rule, dst) {
+				action = rule.Action
+				break
+			}
+		}
+		if action == ratypes.RouteImportDeny {
+			return false
+		}
+	}
+	return true
+}
+
+// ruleMatches returns whether dst matches the rule prefix with the same
+// semantics as a prefix list entry: without ge or le, dst has to be equal to
+// the prefix; otherwise dst has to be contained in the prefix and its length
+// has to be within the ge/le range.
+func ruleMatches(rule *ratypes.RouteImportRule, dst *net.IPNet) bool {
+	_, prefix, err := net.ParseCIDR(rule.Prefix)
+	if err != nil {
+		return false
+	}
+	prefixLen, bits := prefix.Mask.Size()
+	dstLen, dstBits := dst.Mask.Size()
+	if bits != dstBits || dstLen < prefixLen || !prefix.Contains(dst.IP) {
+		return false
+	}
+	if rule.GE == nil && rule.LE == nil {
+		return dstLen == prefixLen
+	}
+	minLen, maxLen := prefixLen, bits
+	if rule.GE != nil {
+		minLen = int(*rule.GE)
+	}
+	if rule.LE != nil {
+		maxLen = int(*rule.LE)
+	}
+	return dstLen >= minLen && dstLen <= maxLen
+}
+
+// setLimitReached tracks for which networks the route limit of the policies
+// has been reached and queues a status update for the policies where that
+// changed. Policies not in limitReached are considered to not have reached
+// their route limit for the network.
+func (c *controller) setLimitReached(network string, limitReached sets.Set[string]) {
+	if c.statusReconciler == nil {
+		return
+	}
+	c.Lock()
+	defer c.Unlock()
+	for policy := range limitReached {
+		if c.limitReached[policy] == nil {
+			c.limitReached[policy] = sets.New[string]()
+		}
+		if !c.limitReached[policy].Has(network) {
+			c.limitReached[policy].Insert(network)
+			c.statusReconciler.Reconcile(policy)
+		}
+	}
+	for policy, networks := range c.limitReached {
+		if !limitReached.Has(policy) && networks.Has(network) {
+			networks.Delete(network)
+			c.statusReconciler.Reconcile(policy)
+		}
+	}
+}
+
+// syncPolicy reconciles all networks on a RouteImportPolicy change.
+func (c *controller) syncPolicy(name string) error {
+	_, err := c.policyLister.Get(name)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	c.Lock()
+	defer c.Unlock()
+	if apierrors.IsNotFound(err) {
+		delete(c.limitReached, name)
+	}
+	for network := range c.networks {
+		c.reconcile(network)
+	}
+	return nil
+}
+
+// syncPolicyStatus reports on a RouteImportPolicy whether its route limit has
+// been reached in this zone, through a condition owned by the zone.
+func (c *controller) syncPolicyStatus(name string) error {
+	policy, err := c.policyLister.Get(name)
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	c.RLock()
+	networks := sets.List(c.limitReached[name])
+	c.RUnlock()
+
+	cond := metav1.Condition{
+		Type:    limitReachedConditionType + c.node,
+		Status:  metav1.ConditionFalse,
+		Reason:  limitNotReachedReason,
+		Message: "Route limit not reached",
+	}
+	if len(networks) > 0 {
+		cond.Status = metav1.ConditionTrue
+		cond.Reason = limitReachedReason
+		cond.Message = fmt.Sprintf("Route limit reached for networks: %s", strings.Join(networks, ", "))
+	}
+
+	existing := meta.FindStatusCondition(policy.Status.Conditions, cond.Type)
+	if existing == nil && len(networks) == 0 {
+		// nothing to report
+		return nil
+	}
+	if existing != nil && existing.Status == cond.Status && existing.Message == cond.Message {
+		return nil
+	}
+	lastTransitionTime := metav1.NewTime(time.Now())
+	if existing != nil && existing.Status == cond.Status {
+		lastTransitionTime = existing.LastTransitionTime
+	}
+
+	applyObj := raapply.RouteImportPolicy(name).
+		WithStatus(raapply.RouteImportPolicyStatus().WithConditions(
+			&metaapplyv1.ConditionApplyConfiguration{
+				Type:               &cond.Type,
+				Status:             &cond.Status,
+				Reason:             &cond.Reason,
+				Message:            &cond.Message,
+				LastTransitionTime: ptr.To(lastTransitionTime),
+			},
+		))
+	_, err = c.raClient.K8sV1().RouteImportPolicies().ApplyStatus(context.TODO(), applyObj, metav1.ApplyOptions{FieldManager: c.node, Force: true})
+	if err != nil {
+		return fmt.Errorf("failed to apply status of route import policy %q: %w", name, err)
+	}
+	return nil
+}
+
+func policyNeedsUpdate(oldObj, newObj *ratypes.RouteImportPolicy) bool {
+	return oldObj == nil || newObj == nil || oldObj.Generation != newObj.Generation
+}
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/go-logr/logr"
+	nadlisters "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/client/listers/k8s.cni.cncf.io/v1"
 	"github.com/vishvananda/netlink"
 	"golang.org/x/sys/unix"
 
@@ -19,7 +20,12 @@ import (
 	"github.com/ovn-kubernetes/libovsdb/ovsdb"
 
 	controllerutil "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/controller"
+	ratypes "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/routeadvertisements/v1"
+	raclientset "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/routeadvertisements/v1/apis/clientset/versioned"
+	ralisters "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/routeadvertisements/v1/apis/listers/routeadvertisements/v1"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/factory"
 	nbdbops "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/metrics"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
@@ -61,16 +67,20 @@ type Controller interface {
 	Stop()
 }
 
-func New(node string, nbClient client.Client) Controller {
+func New(node string, nbClient client.Client, wf *factory.WatchFactory, raClient raclientset.Interface) Controller {
 	c := &controller{
-		ctx:        util.NewCancelableContext(),
-		node:       node,
-		nbClient:   nbClient,
-		networkIDs: map[int]string{},
-		networks:   map[string]util.NetInfo{},
-		tables:     map[int]int{},
-		log:        klog.LoggerWithName(klog.Background(), controllerName),
-		netlink:    util.GetNetLinkOps(),
+		ctx:          util.NewCancelableContext(),
+		node:         node,
+		nbClient:     nbClient,
+		raClient:     raClient,
+		policyLister: wf.RouteImportPolicyInformer().Lister(),
+		nadLister:    wf.NADInformer().Lister(),
+		networkIDs:   map[int]string{},
+		networks:     map[string]util.NetInfo{},
+		tables:       map[int]int{},
+		limitReached: map[string]sets.Set[string]{},
+		log:          klog.LoggerWithName(klog.Background(), controllerName),
+		netlink:      util.GetNetLinkOps(),
 	}
 
 	c.reconciler = controllerutil.NewReconciler(
@@ -82,6 +92,27 @@ func New(node string, nbClient client.Client) Controller {
 		},
 	)
 
+	c.policyController = controllerutil.NewController(
+		controllerName+"Policy",
+		&controllerutil.ControllerConfig[ratypes.RouteImportPolicy]{
+			RateLimiter:    workqueue.DefaultTypedControllerRateLimiter[string](),
+			Reconcile:      c.syncPolicy,
+			Threadiness:    1,
+			Informer:       wf.RouteImportPolicyInformer().Informer(),
+			Lister:         wf.RouteImportPolicyInformer().Lister().List,
+			ObjNeedsUpdate: policyNeedsUpdate,
+		},
+	)
+
+	c.statusReconciler = controllerutil.NewReconciler(
+		controllerName+"PolicyStatus",
+		&controllerutil.ReconcilerConfig{
+			Threadiness: 1,
+			Reconcile:   c.syncPolicyStatus,
+			RateLimiter: workqueue.DefaultTypedControllerRateLimiter[string](),
+		},
+	)
+
 	return c
 }
 
@@ -93,12 +124,21 @@ type controller struct {
 	reconciler controllerutil.Reconciler
 	netlink    util.NetLinkOps
 
+	raClient         raclientset.Interface
+	policyLister     ralisters.RouteImportPolicyLister
+	nadLister        nadlisters.NetworkAttachmentDefinitionLister
+	policyController controllerutil.Controller
+	statusReconciler controllerutil.Reconciler
+
 	sync.RWMutex
 	networks map[string]util.NetInfo
 	// network IDs to names
 	networkIDs map[int]string
 	// tables to network IDs, hint for syncRouteUpdate
 	tables map[int]int
+	// route import policies to the networks for which their route limit has
+	// been reached
+	limitReached map[string]sets.Set[string]
 }
 
 func (c *controller) AddNetwork(network util.NetInfo) error {
@@ -145,6 +185,13 @@ func (c *controller) ForgetNetwork(name string) {
 	delete(c.networkIDs, network.GetNetworkID())
 	delete(c.networks, name)
 	c.setTableForNetworkUnlocked(network.GetNetworkID(), noTable)
+	for policy, networks := range c.limitReached {
+		if networks.Has(name) {
+			networks.Delete(name)
+			c.statusReconciler.Reconcile(policy)
+		}
+	}
+	metrics.DeleteRouteImportRoutes(name)
 
 	c.log.V(5).Info("Stopped tracking network", "name", name)
 }
@@ -175,11 +222,11 @@ func (c *controller) ReconcileNetwork(name string) error {
 func (c *controller) Start() error {
 	defer c.log.Info("Controller started")
 	c.subscribe(c.ctx.Done())
-	return controllerutil.Start(c.reconciler)
+	return controllerutil.Start(c.reconciler, c.policyController, c.statusReconciler)
 }
 
 func (c *controller) Stop() {
-	controllerutil.Stop(c.reconciler)
+	controllerutil.Stop(c.reconciler, c.policyController, c.statusReconciler)
 	c.ctx.Cancel()
 	c.log.Info("Controller stopped")
 }
@@ -363,6 +410,17 @@ func (c *controller) syncNetwork(network string) error {
 		return fmt.Errorf("failed to get routes from OVN: %w", err)
 	}
 
+	policies, err := c.getImportPolicies(info)
+	if err != nil {
+		return err
+	}
+	expected, limitReached, dropped := applyImportPolicies(policies, expected, actual)
+	c.setLimitReached(network, limitReached)
+	if len(limitReached) > 0 {
+		c.log.Info("Route import limit reached, not importing all routes", "network", network, "policies", sets.List(limitReached), "dropped", dropped)
+	}
+	metrics.SetRouteImportRoutes(network, len(expected), dropped)
+
 	deletes := actual.Difference(expected)
 	adds := expected.Difference(actual)
 	if len(deletes)+len(adds) == 0 {
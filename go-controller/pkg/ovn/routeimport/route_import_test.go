@@ -11,9 +11,15 @@ import (
 	"github.com/vishvananda/netlink"
 	"golang.org/x/sys/unix"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/ptr"
 
 	controllerutil "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/controller"
+	ratypes "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/routeadvertisements/v1"
+	ralisters "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/routeadvertisements/v1/apis/listers/routeadvertisements/v1"
+	apitypes "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/types"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
 	ovntesting "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/testing"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/testing/libovsdb"
@@ -58,6 +64,7 @@ func Test_controller_syncNetwork(t *testing.T) {
 		initial   []libovsdb.TestData
 		expected  []libovsdb.TestData
 		routes    []netlink.Route
+		policies  []*ratypes.RouteImportPolicy
 		link      netlink.Link
 		linkErr   bool
 		routesErr bool
@@ -168,6 +175,63 @@ func Test_controller_syncNetwork(t *testing.T) {
 				&nbdb.LogicalRouterStaticRoute{UUID: "untouched-1", IPPrefix: "3.3.3.0/24", Nexthop: "3.3.3.2", ExternalIDs: map[string]string{controllerExternalIDKey: controllerName}},
 			},
 		},
+		{
+			name: "filters and limits routes as per the import policies",
+			args: args{"default"},
+			fields: fields{
+				networkIDs: map[int]string{0: "default"},
+				networks:   map[string]util.NetInfo{"default": defaultNetwork},
+			},
+			link: &netlink.Vrf{Table: unix.RT_TABLE_MAIN},
+			initial: []libovsdb.TestData{
+				&nbdb.LogicalRouter{Name: defaultNetworkRouter, StaticRoutes: []string{"keep-1", "remove"}},
+				&nbdb.LogicalRouterStaticRoute{UUID: "keep-1", IPPrefix: "10.9.0.0/16", Nexthop: "1.1.1.1", OutputPort: &defaultNetworkRouterPort, ExternalIDs: map[string]string{controllerExternalIDKey: controllerName}},
+				&nbdb.LogicalRouterStaticRoute{UUID: "remove", IPPrefix: "2.2.2.0/24", Nexthop: "2.2.2.1", OutputPort: &defaultNetworkRouterPort, ExternalIDs: map[string]string{controllerExternalIDKey: controllerName}},
+			},
+			routes: []netlink.Route{
+				{Dst: ovntesting.MustParseIPNet("10.9.0.0/16"), Gw: ovntesting.MustParseIP("1.1.1.1")},
+				// denied by prefix
+				{Dst: ovntesting.MustParseIPNet("2.2.2.0/24"), Gw: ovntesting.MustParseIP("2.2.2.1")},
+				// denied by prefix length
+				{Dst: ovntesting.MustParseIPNet("10.3.0.0/25"), Gw: ovntesting.MustParseIP("1.1.1.1")},
+				{Dst: ovntesting.MustParseIPNet("10.1.0.0/16"), Gw: ovntesting.MustParseIP("1.1.1.1")},
+				// over the limit
+				{Dst: ovntesting.MustParseIPNet("10.2.0.0/16"), Gw: ovntesting.MustParseIP("1.1.1.1")},
+			},
+			policies: []*ratypes.RouteImportPolicy{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "filter"},
+					Spec: ratypes.RouteImportPolicySpec{
+						NetworkSelectors: apitypes.NetworkSelectors{{NetworkSelectionType: apitypes.DefaultNetwork}},
+						Rules: []ratypes.RouteImportRule{
+							{Prefix: "2.2.2.0/24", Action: ratypes.RouteImportDeny},
+							{Prefix: "10.0.0.0/8", GE: ptr.To[int32](25), Action: ratypes.RouteImportDeny},
+						},
+						DefaultAction: ratypes.RouteImportAllow,
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "limit"},
+					Spec: ratypes.RouteImportPolicySpec{
+						NetworkSelectors: apitypes.NetworkSelectors{{NetworkSelectionType: apitypes.DefaultNetwork}},
+						DefaultAction:    ratypes.RouteImportAllow,
+						MaxRoutes:        ptr.To[int32](2),
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "other-network"},
+					Spec: ratypes.RouteImportPolicySpec{
+						NetworkSelectors: apitypes.NetworkSelectors{{NetworkSelectionType: apitypes.ClusterUserDefinedNetworks}},
+						DefaultAction:    ratypes.RouteImportDeny,
+					},
+				},
+			},
+			expected: []libovsdb.TestData{
+				&nbdb.LogicalRouter{UUID: "router", Name: defaultNetworkRouter, StaticRoutes: []string{"keep-1", "add-1"}},
+				&nbdb.LogicalRouterStaticRoute{UUID: "keep-1", IPPrefix: "10.9.0.0/16", Nexthop: "1.1.1.1", OutputPort: &defaultNetworkRouterPort, ExternalIDs: map[string]string{controllerExternalIDKey: controllerName}},
+				&nbdb.LogicalRouterStaticRoute{UUID: "add-1", IPPrefix: "10.1.0.0/16", Nexthop: "1.1.1.1", OutputPort: &defaultNetworkRouterPort, ExternalIDs: map[string]string{controllerExternalIDKey: controllerName}},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -210,6 +274,13 @@ func Test_controller_syncNetwork(t *testing.T) {
 				tables:     map[int]int{},
 				netlink:    nlmock,
 			}
+			if len(tt.policies) > 0 {
+				indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+				for _, policy := range tt.policies {
+					g.Expect(indexer.Add(policy)).To(gomega.Succeed())
+				}
+				c.policyLister = ralisters.NewRouteImportPolicyLister(indexer)
+			}
 
 			err = c.syncNetwork(tt.args.network)
 			if tt.wantErr {
@@ -548,3 +619,68 @@ func Test_controller_subscribe(t *testing.T) {
 	g.Eventually(isLinkEventChSet).WithTimeout(subscribePeriod * 3).Should(gomega.Succeed())
 	g.Expect(c.tables).To(gomega.BeEmpty())
 }
+
+func Test_ruleMatches(t *testing.T) {
+	tests := []struct {
+		name string
+		rule ratypes.RouteImportRule
+		dst  string
+		want bool
+	}{
+		{
+			name: "matches exact prefix",
+			rule: ratypes.RouteImportRule{Prefix: "10.0.0.0/8"},
+			dst:  "10.0.0.0/8",
+			want: true,
+		},
+		{
+			name: "does not match longer prefix without ge or le",
+			rule: ratypes.RouteImportRule{Prefix: "10.0.0.0/8"},
+			dst:  "10.1.0.0/16",
+		},
+		{
+			name: "matches longer prefix with le",
+			rule: ratypes.RouteImportRule{Prefix: "10.0.0.0/8", LE: ptr.To[int32](24)},
+			dst:  "10.1.0.0/16",
+			want: true,
+		},
+		{
+			name: "does not match prefix longer than le",
+			rule: ratypes.RouteImportRule{Prefix: "10.0.0.0/8", LE: ptr.To[int32](24)},
+			dst:  "10.1.1.0/25",
+		},
+		{
+			name: "does not match prefix shorter than ge",
+			rule: ratypes.RouteImportRule{Prefix: "10.0.0.0/8", GE: ptr.To[int32](24)},
+			dst:  "10.1.0.0/16",
+		},
+		{
+			name: "matches prefix within ge and le",
+			rule: ratypes.RouteImportRule{Prefix: "10.0.0.0/8", GE: ptr.To[int32](16), LE: ptr.To[int32](24)},
+			dst:  "10.1.1.0/24",
+			want: true,
+		},
+		{
+			name: "does not match prefix outside of the rule prefix",
+			rule: ratypes.RouteImportRule{Prefix: "10.0.0.0/8", LE: ptr.To[int32](32)},
+			dst:  "11.0.0.0/16",
+		},
+		{
+			name: "does not match prefix of a different IP family",
+			rule: ratypes.RouteImportRule{Prefix: "::/0", LE: ptr.To[int32](128)},
+			dst:  "10.0.0.0/8",
+		},
+		{
+			name: "matches IPv6 prefix",
+			rule: ratypes.RouteImportRule{Prefix: "fd00::/8", GE: ptr.To[int32](48)},
+			dst:  "fd00:1::/64",
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			g.Expect(ruleMatches(&tt.rule, ovntesting.MustParseIPNet(tt.dst))).To(gomega.Equal(tt.want))
+		})
+	}
+}
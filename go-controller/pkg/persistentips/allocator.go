@@ -10,6 +10,7 @@ import (
 	ipamclaimsapi "github.com/k8snetworkplumbingwg/ipamclaims/pkg/crd/ipamclaims/v1alpha1"
 	ipamclaimslister "github.com/k8snetworkplumbingwg/ipamclaims/pkg/crd/ipamclaims/v1alpha1/apis/listers/ipamclaims/v1alpha1"
 
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/klog/v2"
 
 	ipam "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/allocator/ip"
@@ -146,6 +147,24 @@ func (icr *IPAMClaimReconciler) FindIPAMClaim(claimName string, namespace string
 	return claim, nil
 }
 
+// CountIPAMClaims returns the number of IPAMClaims of the network holding IPs
+func (icr *IPAMClaimReconciler) CountIPAMClaims() (int, error) {
+	if icr.lister == nil {
+		return 0, nil
+	}
+	ipamClaims, err := icr.lister.List(labels.Everything())
+	if err != nil {
+		return 0, fmt.Errorf("failed to list IPAMClaims: %w", err)
+	}
+	count := 0
+	for _, ipamClaim := range ipamClaims {
+		if ipamClaim.Spec.Network == icr.netInfo.GetNetworkName() && len(ipamClaim.Status.IPs) > 0 {
+			count++
+		}
+	}
+	return count, nil
+}
+
 // Sync initializes the IPs allocator with the IPAMClaims already existing on
 // the cluster. For live pods, therse are already allocated, so no error will
 // be thrown (e.g. we ignore the `ipam.IsErrAllocated` error
@@ -231,6 +231,27 @@ var _ = Describe("Persistent IP allocator operations", func() {
 
 	})
 
+	Context("counting IPAMClaims", func() {
+		It("only counts the IPAMClaims of the network holding IPs", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			lister, listerTeardown := generateIPAMClaimsListerAndTeardownFunc(
+				ctx.Done(),
+				ipamClaimWithIPs(namespace, claimName, networkName, "192.10.10.10/24"),
+				ipamClaimWithIPs(namespace, "claim2", networkName, "192.10.10.11/24"),
+				emptyDummyIPAMClaim(namespace, "claim3", networkName),
+				ipamClaimWithIPs(namespace, "claim4", "othernetwork", "192.10.10.12/24"),
+			)
+			defer func() {
+				cancel()
+				listerTeardown()
+			}()
+
+			netInfo, err := util.NewNetInfo(dummyNetconf(networkName))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(NewIPAMClaimReconciler(nil, netInfo, lister).CountIPAMClaims()).To(Equal(2))
+		})
+	})
+
 	Context("retrieving IPAMClaims", func() {
 		DescribeTable(
 			"succeeds",
@@ -74,6 +74,11 @@ const (
 	TransitSwitchToRouterPrefix = "tstor-"
 	RouterToTransitSwitchPrefix = "rtots-"
 
+	// NetworkPeeringRouterPrefix is the prefix of the router shared by the networks of a NetworkPeering
+	NetworkPeeringRouterPrefix                = "peering_"
+	ClusterRouterToNetworkPeeringRouterPrefix = "rtopr-"
+	NetworkPeeringRouterToClusterRouterPrefix = "prtor-"
+
 	// DefaultACLTier Priorities
 
 	// Default routed multicast allow acl rule priority
@@ -96,6 +101,18 @@ const (
 	PrimaryUDNAllowPriority = 1001
 	// Default deny acl rule priority
 	PrimaryUDNDenyPriority = 1000
+	// Pass priority for traffic between peered networks, higher than the advertised networks isolation priorities
+	NetworkPeeringPassPriority = 1110
+	// Deny priority for traffic between peered networks
+	NetworkPeeringDenyPriority = 1105
+	// Deny priority for the traffic of the VLANs that are not allowed on a localnet network in VLAN trunk mode,
+	// higher than any other priority of the tier
+	LocalnetVLANDenyPriority = 1200
+
+	// DefaultBANPACLTier Priorities
+
+	// DNS snooping allow acl rule priority, lower than the Baseline Admin Network Policy priorities
+	DNSSnoopingAllowPriority = 1000
 
 	// ACL Tiers
 	// Tier 0 is called Primary as it is evaluated before any other feature-related Tiers.
@@ -108,9 +125,16 @@ const (
 	// Default Tier for all ACLs belonging to Baseline Admin Network Policy
 	DefaultBANPACLTier = 3
 
+	// priority of ClusterEgressFirewall ACLs evaluated before and after the EgressFirewall ACLs.
+	// Every ClusterEgressFirewall priority gets a block of ClusterEgressFirewallMaxRules ACL priorities.
+	ClusterEgressFirewallBeforeStartPriority = 10800
+	ClusterEgressFirewallAfterStartPriority  = 1999
+	ClusterEgressFirewallMaxRules            = 80
+
 	// priority of logical router policies on the OVNClusterRouter
 	EgressFirewallStartPriority           = 10000
 	MinimumReservedEgressFirewallPriority = 2000
+	NetworkPeeringPolicyPriority          = 1006
 	MGMTPortPolicyPriority                = "1005"
 	NodeSubnetPolicyPriority              = "1004"
 	InterNodePolicyPriority               = "1003"
@@ -149,6 +173,11 @@ const (
 	UserDefinedPrimaryNetworkJoinSubnetV4 = "100.65.0.0/16"
 	UserDefinedPrimaryNetworkJoinSubnetV6 = "fd99::/64"
 
+	// subnets the links between the routers of peered networks and
+	// their shared peering router are allocated from
+	NetworkPeeringTransitSubnetV4 = "100.66.0.0/16"
+	NetworkPeeringTransitSubnetV6 = "fd96::/64"
+
 	// OpenFlow and Networking constants
 	RouteAdvertisementICMPType    = 134
 	NeighborSolicitationICMPType  = 135
@@ -222,6 +251,12 @@ const (
 	LoadBalancerOwnerExternalID = OvnK8sPrefix + "/" + "owner"
 	// key for UDN enabled services routes
 	UDNEnabledServiceExternalID = OvnK8sPrefix + "/" + "udn-enabled-default-service"
+	// key for the NetworkPeering name external-id
+	NetworkPeeringExternalID = OvnK8sPrefix + "/" + "network-peering"
+	// key for the allowed VLANs external-id of the localnet port of a network in VLAN trunk mode
+	AllowedVLANsExternalID = OvnK8sPrefix + "/" + "allowed-vlans"
+	// key for the native VLAN external-id of the localnet port of a network in VLAN trunk mode
+	NativeVLANExternalID = OvnK8sPrefix + "/" + "native-vlan"
 	// RequiredUDNNamespaceLabel is the required namespace label for enabling primary UDNs
 	RequiredUDNNamespaceLabel = "k8s.ovn.org/primary-user-defined-network"
 
@@ -10,6 +10,7 @@ const (
 	APBRouteErrorMsg       = "failed to apply policy"
 	EgressFirewallErrorMsg = "EgressFirewall Rules not correctly applied"
 	EgressQoSErrorMsg      = "EgressQoS Rules not correctly applied"
+	EgressIPErrorMsg       = "EgressIP not correctly applied"
 	NetworkQoSErrorMsg     = "NetworkQoS Destinations not correctly applied"
 )
 
@@ -20,3 +21,18 @@ func GetZoneStatus(zoneID, message string) string {
 func GetZoneFromStatus(status string) string {
 	return strings.Split(status, ":")[0]
 }
+
+// GetZoneNetworkFieldManager returns the field manager used by the controller of a network in a zone to report the
+// status of resources that are rendered by more than one network, so that the messages of the different networks of
+// a zone don't overwrite each other. The default network controller uses the zone as field manager.
+func GetZoneNetworkFieldManager(zoneID, networkName string) string {
+	if networkName == DefaultNetworkName {
+		return zoneID
+	}
+	return zoneID + "/" + networkName
+}
+
+// IsZoneFieldManager returns true if the given field manager reports status for the given zone.
+func IsZoneFieldManager(zoneID, fieldManager string) bool {
+	return fieldManager == zoneID || strings.HasPrefix(fieldManager, zoneID+"/")
+}
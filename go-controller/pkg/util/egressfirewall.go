@@ -22,8 +22,10 @@ const (
 )
 
 // ValidateAndGetEgressFirewallDestination validates an egress firewall rule destination and returns
-// the parsed contents of the destination.
-func ValidateAndGetEgressFirewallDestination(egressFirewallDestination egressfirewallv1.EgressFirewallDestination) (
+// the parsed contents of the destination. clusterSubnets are the subnets of the network the rule is
+// applied to, they are used to detect whether a CIDR selector intersects with the pod network.
+func ValidateAndGetEgressFirewallDestination(egressFirewallDestination egressfirewallv1.EgressFirewallDestination,
+	clusterSubnets []config.CIDRNetworkEntry) (
 	cidrSelector string,
 	dnsName string,
 	clusterSubnetIntersection bool,
@@ -31,12 +33,12 @@ func ValidateAndGetEgressFirewallDestination(egressFirewallDestination egressfir
 	err error) {
 	// Validate the egress firewall rule.
 	if egressFirewallDestination.DNSName != "" {
-		// Validate that DNS name is not wildcard when DNSNameResolver is not enabled.
-		if !config.OVNKubernetesFeature.EnableDNSNameResolver && IsWildcard(egressFirewallDestination.DNSName) {
+		// Validate that DNS name is not wildcard when neither DNSNameResolver nor DNS snooping is enabled.
+		if !IsWildcardDNSNameSupported() && IsWildcard(egressFirewallDestination.DNSName) {
 			return "", "", false, nil, fmt.Errorf("wildcard dns name is not supported as rule destination, %s", egressFirewallDestination.DNSName)
 		}
-		// Validate that DNS name if DNSNameResolver is enabled.
-		if config.OVNKubernetesFeature.EnableDNSNameResolver {
+		// Validate that DNS name if DNSNameResolver or DNS snooping is enabled.
+		if IsWildcardDNSNameSupported() {
 			exp := regexp.MustCompile(dnsRegex)
 			if !exp.MatchString(egressFirewallDestination.DNSName) {
 				return "", "", false, nil, fmt.Errorf("invalid dns name used as rule destination, %s", egressFirewallDestination.DNSName)
@@ -50,7 +52,7 @@ func ValidateAndGetEgressFirewallDestination(egressFirewallDestination egressfir
 			return "", "", false, nil, err
 		}
 		cidrSelector = egressFirewallDestination.CIDRSelector
-		for _, clusterSubnet := range config.Default.ClusterSubnets {
+		for _, clusterSubnet := range clusterSubnets {
 			if clusterSubnet.CIDR.Contains(ipNet.IP) || ipNet.Contains(clusterSubnet.CIDR.IP) {
 				clusterSubnetIntersection = true
 				break
@@ -79,6 +81,12 @@ func IsDNSNameResolverEnabled() bool {
 	return config.OVNKubernetesFeature.EnableEgressFirewall && config.OVNKubernetesFeature.EnableDNSNameResolver
 }
 
+// IsWildcardDNSNameSupported returns true if EgressFirewall DNS names are resolved
+// by a resolver supporting wildcard DNS names, i.e. DNSNameResolver or DNS snooping.
+func IsWildcardDNSNameSupported() bool {
+	return config.OVNKubernetesFeature.EnableDNSNameResolver || config.OVNKubernetesFeature.EnableDNSSnooping
+}
+
 // LowerCaseFQDN convert the DNS name to lower case fully qualified
 // domain name.
 func LowerCaseFQDN(dnsName string) string {
@@ -88,16 +96,39 @@ func LowerCaseFQDN(dnsName string) string {
 // GetDNSNames iterates through the egress firewall rules and returns the DNS
 // names present in them after validating the rules.
 func GetDNSNames(ef *egressfirewallv1.EgressFirewall) []string {
+	maxRules := types.EgressFirewallStartPriority - types.MinimumReservedEgressFirewallPriority + 1
+	if len(ef.Spec.Egress) > maxRules {
+		klog.Warningf("egressFirewall for namespace %s has too many rules, the rest will be ignored", ef.Namespace)
+	}
+	return getEgressFirewallRulesDNSNames(ef.Spec.Egress, maxRules)
+}
+
+// GetClusterEgressFirewallDNSNames iterates through the cluster egress firewall
+// rules and returns the DNS names present in them after validating the rules.
+func GetClusterEgressFirewallDNSNames(cef *egressfirewallv1.ClusterEgressFirewall) []string {
+	if len(cef.Spec.Egress) > types.ClusterEgressFirewallMaxRules {
+		klog.Warningf("clusterEgressFirewall %s has too many rules, the rest will be ignored", cef.Name)
+	}
+	return getEgressFirewallRulesDNSNames(cef.Spec.Egress, types.ClusterEgressFirewallMaxRules)
+}
+
+// GetClusterEgressFirewallDNSOwner returns the key used instead of a namespace
+// name to reference the DNS names of a cluster egress firewall. It can't
+// collide with a namespace name as namespace names can't contain '/'.
+func GetClusterEgressFirewallDNSOwner(name string) string {
+	return "ClusterEgressFirewall/" + name
+}
+
+func getEgressFirewallRulesDNSNames(rules []egressfirewallv1.EgressFirewallRule, maxRules int) []string {
 	var dnsNameSlice []string
-	for i, egressFirewallRule := range ef.Spec.Egress {
-		if i > types.EgressFirewallStartPriority-types.MinimumReservedEgressFirewallPriority {
-			klog.Warningf("egressFirewall for namespace %s has too many rules, the rest will be ignored", ef.Namespace)
+	for i, egressFirewallRule := range rules {
+		if i >= maxRules {
 			break
 		}
 
 		// Validate egress firewall rule destination and get the DNS name
 		// if used in the rule.
-		_, dnsName, _, _, err := ValidateAndGetEgressFirewallDestination(egressFirewallRule.To)
+		_, dnsName, _, _, err := ValidateAndGetEgressFirewallDestination(egressFirewallRule.To, nil)
 		if err != nil {
 			return []string{}
 		}
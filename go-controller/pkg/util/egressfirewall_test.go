@@ -28,6 +28,7 @@ func TestValidateAndGetEgressFirewallDestination(t *testing.T) {
 		name                      string
 		egressFirewallDestination egressfirewallapi.EgressFirewallDestination
 		dnsNameResolverEnabled    bool
+		dnsSnoopingEnabled        bool
 		expectedErr               bool
 		expectedOutput            output
 	}{
@@ -165,22 +166,40 @@ func TestValidateAndGetEgressFirewallDestination(t *testing.T) {
 				nodeSelector: &metav1.LabelSelector{},
 			},
 		},
+		{
+			name: "should correctly validate wildcard dns name when dns snooping is enabled",
+			egressFirewallDestination: egressfirewallapi.EgressFirewallDestination{
+				DNSName: "*.example.com",
+			},
+			dnsSnoopingEnabled: true,
+			expectedErr:        false,
+			expectedOutput: output{
+				dnsName: "*.example.com",
+			},
+		},
+		{
+			name: "should throw an error for tld wildcard dns name when dns snooping is enabled",
+			egressFirewallDestination: egressfirewallapi.EgressFirewallDestination{
+				DNSName: "*.com",
+			},
+			dnsSnoopingEnabled: true,
+			expectedErr:        true,
+		},
 	}
 
 	if err := config.PrepareTestConfig(); err != nil {
 		t.Fatalf("failed to PrepareTestConfig: %v", err)
 	}
 
-	config.Default.ClusterSubnets = []config.CIDRNetworkEntry{{CIDR: clusterSubnet}}
+	clusterSubnets := []config.CIDRNetworkEntry{{CIDR: clusterSubnet}}
 	for _, tc := range testcases {
 		t.Run(tc.name, func(t *testing.T) {
 
-			if tc.dnsNameResolverEnabled {
-				config.OVNKubernetesFeature.EnableDNSNameResolver = true
-			}
+			config.OVNKubernetesFeature.EnableDNSNameResolver = tc.dnsNameResolverEnabled
+			config.OVNKubernetesFeature.EnableDNSSnooping = tc.dnsSnoopingEnabled
 
 			cidrSelector, dnsName, clusterSubnetIntersection, nodeSelector, err :=
-				ValidateAndGetEgressFirewallDestination(tc.egressFirewallDestination)
+				ValidateAndGetEgressFirewallDestination(tc.egressFirewallDestination, clusterSubnets)
 			if tc.expectedErr {
 				require.Error(t, err)
 			} else {
@@ -1,6 +1,7 @@
 package util
 
 import (
+	"encoding/json"
 	"fmt"
 	"strconv"
 )
@@ -9,6 +10,10 @@ const (
 	EgressIPMarkAnnotation = "k8s.ovn.org/egressip-mark"
 	EgressIPMarkBase       = 50000
 	EgressIPMarkMax        = 55000
+	// EgressIPPreferredNodesAnnotation records the node each egress IP of an
+	// EgressIP with a Preemptive failover policy was first assigned to, as a
+	// map of the egress IP to the node name.
+	EgressIPPreferredNodesAnnotation = "k8s.ovn.org/egressip-preferred-nodes"
 )
 
 type EgressIPMark struct {
@@ -66,3 +71,17 @@ func IsEgressIPMarkValid(mark int) bool {
 func EgressIPMarkAnnotationChanged(annotationA, annotationB map[string]string) bool {
 	return annotationA[EgressIPMarkAnnotation] != annotationB[EgressIPMarkAnnotation]
 }
+
+// ParseEgressIPPreferredNodes returns the preferred node of the egress IPs
+// recorded in the EgressIP preferred nodes annotation, if any.
+func ParseEgressIPPreferredNodes(annotations map[string]string) (map[string]string, error) {
+	preferredNodes := map[string]string{}
+	annotation, ok := annotations[EgressIPPreferredNodesAnnotation]
+	if !ok {
+		return preferredNodes, nil
+	}
+	if err := json.Unmarshal([]byte(annotation), &preferredNodes); err != nil {
+		return nil, fmt.Errorf("failed to parse EgressIP preferred nodes annotation %q: %w", annotation, err)
+	}
+	return preferredNodes, nil
+}
@@ -57,9 +57,9 @@ func GetOVNClientset(objects ...runtime.Object) *OVNClientset {
 	frrObjects := []runtime.Object{}
 	for _, object := range objects {
 		switch object.(type) {
-		case *egressip.EgressIP:
+		case *egressip.EgressIP, *egressip.EgressIPPool:
 			egressIPObjects = append(egressIPObjects, object)
-		case *egressfirewall.EgressFirewall:
+		case *egressfirewall.EgressFirewall, *egressfirewall.ClusterEgressFirewall:
 			egressFirewallObjects = append(egressFirewallObjects, object)
 		case *egressqos.EgressQoS:
 			egressQoSObjects = append(egressQoSObjects, object)
@@ -77,7 +77,7 @@ func GetOVNClientset(objects ...runtime.Object) *OVNClientset {
 			anpObjects = append(anpObjects, object)
 		case *ocpnetworkapiv1alpha1.DNSNameResolver:
 			dnsNameResolverObjects = append(dnsNameResolverObjects, object)
-		case *udnv1.UserDefinedNetwork, *udnv1.ClusterUserDefinedNetwork:
+		case *udnv1.UserDefinedNetwork, *udnv1.ClusterUserDefinedNetwork, *udnv1.NetworkPeering:
 			udnObjects = append(udnObjects, object)
 		case *routeadvertisements.RouteAdvertisements:
 			raObjects = append(raObjects, object)
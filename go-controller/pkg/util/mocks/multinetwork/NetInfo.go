@@ -54,6 +54,26 @@ func (_m *NetInfo) GetNodeManagementIP(hostSubnet *net.IPNet) *net.IPNet {
 	return r0
 }
 
+// AllowedVLANs provides a mock function with given fields:
+func (_m *NetInfo) AllowedVLANs() []uint {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for AllowedVLANs")
+	}
+
+	var r0 []uint
+	if rf, ok := ret.Get(0).(func() []uint); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]uint)
+		}
+	}
+
+	return r0
+}
+
 // AllowsPersistentIPs provides a mock function with given fields:
 func (_m *NetInfo) AllowsPersistentIPs() bool {
 	ret := _m.Called()
@@ -746,6 +766,24 @@ func (_m *NetInfo) MTU() int {
 	return r0
 }
 
+// NativeVLAN provides a mock function with given fields:
+func (_m *NetInfo) NativeVLAN() uint {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for NativeVLAN")
+	}
+
+	var r0 uint
+	if rf, ok := ret.Get(0).(func() uint); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint)
+	}
+
+	return r0
+}
+
 // PhysicalNetworkName provides a mock function with given fields:
 func (_m *NetInfo) PhysicalNetworkName() string {
 	ret := _m.Called()
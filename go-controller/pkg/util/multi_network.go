@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net"
 	"reflect"
+	"slices"
 	"strconv"
 	"strings"
 	"sync"
@@ -48,6 +49,8 @@ type NetInfo interface {
 	JoinSubnetV6() *net.IPNet
 	JoinSubnets() []*net.IPNet
 	Vlan() uint
+	AllowedVLANs() []uint
+	NativeVLAN() uint
 	AllowsPersistentIPs() bool
 	PhysicalNetworkName() string
 	GetNodeGatewayIP(hostSubnet *net.IPNet) *net.IPNet
@@ -113,6 +116,9 @@ type MutableNetInfo interface {
 	AddNADs(nadName ...string)
 	DeleteNADs(nadName ...string)
 
+	// Subnets of a network can be appended to while the network is running
+	AddSubnets(subnets ...config.CIDRNetworkEntry)
+
 	// VRFs a pod network is being advertised on, also per node
 	SetPodNetworkAdvertisedVRFs(podAdvertisements map[string][]string)
 
@@ -227,6 +233,10 @@ type mutableNetInfo struct {
 	podNetworkAdvertisements map[string][]string
 	eipAdvertisements        map[string][]string
 
+	// subnets of the network. Subnets can only be appended to on day-2, never
+	// removed or changed.
+	subnets []config.CIDRNetworkEntry
+
 	// information generated from previous fields, not used in comparisons
 
 	// namespaces from nads
@@ -266,7 +276,8 @@ func (l *mutableNetInfo) equals(r *mutableNetInfo) bool {
 	return reflect.DeepEqual(l.id, r.id) &&
 		reflect.DeepEqual(l.nads, r.nads) &&
 		reflect.DeepEqual(l.podNetworkAdvertisements, r.podNetworkAdvertisements) &&
-		reflect.DeepEqual(l.eipAdvertisements, r.eipAdvertisements)
+		reflect.DeepEqual(l.eipAdvertisements, r.eipAdvertisements) &&
+		cmp.Equal(l.subnets, r.subnets, cmpopts.SortSlices(lessCIDRNetworkEntry), cmpopts.EquateEmpty())
 }
 
 func (l *mutableNetInfo) copyFrom(r *mutableNetInfo) {
@@ -280,6 +291,7 @@ func (l *mutableNetInfo) copyFrom(r *mutableNetInfo) {
 	aux.setPodNetworkAdvertisedOnVRFs(r.podNetworkAdvertisements)
 	aux.setEgressIPAdvertisedAtNodes(r.eipAdvertisements)
 	aux.namespaces = r.namespaces.Clone()
+	aux.subnets = slices.Clone(r.subnets)
 	r.RUnlock()
 	l.Lock()
 	defer l.Unlock()
@@ -288,6 +300,7 @@ func (l *mutableNetInfo) copyFrom(r *mutableNetInfo) {
 	l.podNetworkAdvertisements = aux.podNetworkAdvertisements
 	l.eipAdvertisements = aux.eipAdvertisements
 	l.namespaces = aux.namespaces
+	l.subnets = aux.subnets
 }
 
 func (nInfo *mutableNetInfo) GetNetworkID() int {
@@ -372,6 +385,20 @@ func (nInfo *mutableNetInfo) GetEgressIPAdvertisedNodes() []string {
 	return maps.Keys(nInfo.eipAdvertisements)
 }
 
+// AddSubnets appends the provided subnets to the network subnets, ignoring
+// those that are already part of the network
+func (nInfo *mutableNetInfo) AddSubnets(subnets ...config.CIDRNetworkEntry) {
+	nInfo.Lock()
+	defer nInfo.Unlock()
+	for _, subnet := range subnets {
+		if !slices.ContainsFunc(nInfo.subnets, func(existing config.CIDRNetworkEntry) bool {
+			return existing.String() == subnet.String()
+		}) {
+			nInfo.subnets = append(nInfo.subnets, subnet)
+		}
+	}
+}
+
 // GetNADs returns all the NADs associated with this network
 func (nInfo *mutableNetInfo) GetNADs() []string {
 	nInfo.RLock()
@@ -634,6 +661,16 @@ func (nInfo *DefaultNetInfo) Vlan() uint {
 	return config.Gateway.VLANID
 }
 
+// AllowedVLANs has no impact on defaultNetConfInfo (localnet feature)
+func (nInfo *DefaultNetInfo) AllowedVLANs() []uint {
+	return nil
+}
+
+// NativeVLAN has no impact on defaultNetConfInfo (localnet feature)
+func (nInfo *DefaultNetInfo) NativeVLAN() uint {
+	return 0
+}
+
 // AllowsPersistentIPs returns the defaultNetConfInfo's AllowPersistentIPs value
 func (nInfo *DefaultNetInfo) AllowsPersistentIPs() bool {
 	return false
@@ -663,10 +700,11 @@ type userDefinedNetInfo struct {
 	topology           string
 	mtu                int
 	vlan               uint
+	allowedVLANs       []uint
+	nativeVLAN         uint
 	allowPersistentIPs bool
 
 	ipv4mode, ipv6mode    bool
-	subnets               []config.CIDRNetworkEntry
 	excludeSubnets        []*net.IPNet
 	reservedSubnets       []*net.IPNet
 	infrastructureSubnets []*net.IPNet
@@ -780,6 +818,18 @@ func (nInfo *userDefinedNetInfo) Vlan() uint {
 	return nInfo.vlan
 }
 
+// AllowedVLANs returns the sorted VLAN IDs the tagged traffic of the pods is
+// allowed on when the network is in trunk mode, nil otherwise.
+func (nInfo *userDefinedNetInfo) AllowedVLANs() []uint {
+	return nInfo.allowedVLANs
+}
+
+// NativeVLAN returns the VLAN ID of the untagged traffic of the pods when the
+// network is in trunk mode
+func (nInfo *userDefinedNetInfo) NativeVLAN() uint {
+	return nInfo.nativeVLAN
+}
+
 // AllowsPersistentIPs returns the defaultNetConfInfo's AllowPersistentIPs value
 func (nInfo *userDefinedNetInfo) AllowsPersistentIPs() bool {
 	return nInfo.allowPersistentIPs
@@ -821,6 +871,8 @@ func (nInfo *userDefinedNetInfo) IPMode() (bool, bool) {
 
 // Subnets returns the Subnets value
 func (nInfo *userDefinedNetInfo) Subnets() []config.CIDRNetworkEntry {
+	nInfo.RLock()
+	defer nInfo.RUnlock()
 	return nInfo.subnets
 }
 
@@ -888,6 +940,12 @@ func (nInfo *userDefinedNetInfo) canReconcile(other NetInfo) bool {
 	if nInfo.vlan != other.Vlan() {
 		return false
 	}
+	if !cmp.Equal(nInfo.allowedVLANs, other.AllowedVLANs(), cmpopts.EquateEmpty()) {
+		return false
+	}
+	if nInfo.nativeVLAN != other.NativeVLAN() {
+		return false
+	}
 	if nInfo.allowPersistentIPs != other.AllowsPersistentIPs() {
 		return false
 	}
@@ -898,8 +956,17 @@ func (nInfo *userDefinedNetInfo) canReconcile(other NetInfo) bool {
 		return false
 	}
 
-	lessCIDRNetworkEntry := func(a, b config.CIDRNetworkEntry) bool { return a.String() < b.String() }
-	if !cmp.Equal(nInfo.subnets, other.Subnets(), cmpopts.SortSlices(lessCIDRNetworkEntry)) {
+	// subnets can be appended to but never removed, and the IP families of
+	// the network can't change
+	if !isSubnetsSubset(nInfo.Subnets(), other.Subnets()) {
+		return false
+	}
+	if ipv4, ipv6 := other.IPMode(); ipv4 != nInfo.ipv4mode || ipv6 != nInfo.ipv6mode {
+		return false
+	}
+	// preconfigured gateway and management IPs are bound to the original
+	// subnets of the network
+	if len(nInfo.defaultGatewayIPs) > 0 && len(nInfo.Subnets()) != len(other.Subnets()) {
 		return false
 	}
 
@@ -924,10 +991,11 @@ func (nInfo *userDefinedNetInfo) copy() *userDefinedNetInfo {
 		topology:              nInfo.topology,
 		mtu:                   nInfo.mtu,
 		vlan:                  nInfo.vlan,
+		allowedVLANs:          nInfo.allowedVLANs,
+		nativeVLAN:            nInfo.nativeVLAN,
 		allowPersistentIPs:    nInfo.allowPersistentIPs,
 		ipv4mode:              nInfo.ipv4mode,
 		ipv6mode:              nInfo.ipv6mode,
-		subnets:               nInfo.subnets,
 		excludeSubnets:        nInfo.excludeSubnets,
 		reservedSubnets:       nInfo.reservedSubnets,
 		infrastructureSubnets: nInfo.infrastructureSubnets,
@@ -947,6 +1015,16 @@ func newLayer3NetConfInfo(netconf *ovncnitypes.NetConf) (MutableNetInfo, error)
 	if err != nil {
 		return nil, err
 	}
+	excludes, err := parseSubnetList(netconf.ExcludeSubnets)
+	if err != nil {
+		return nil, fmt.Errorf("invalid exclude subnets for %s netconf %s: %v", netconf.Topology, netconf.Name, err)
+	}
+	if err := validateSubnetContainment(excludes, subnets, config.NewExcludedSubnetNotContainedError); err != nil {
+		return nil, err
+	}
+	if err := validateHostSubnetExcludes(excludes, subnets); err != nil {
+		return nil, err
+	}
 	joinSubnets, err := parseJoinSubnet(netconf.JoinSubnet)
 	if err != nil {
 		return nil, err
@@ -955,12 +1033,13 @@ func newLayer3NetConfInfo(netconf *ovncnitypes.NetConf) (MutableNetInfo, error)
 		netName:        netconf.Name,
 		primaryNetwork: netconf.Role == types.NetworkRolePrimary,
 		topology:       types.Layer3Topology,
-		subnets:        subnets,
 		joinSubnets:    joinSubnets,
+		excludeSubnets: excludes,
 		mtu:            netconf.MTU,
 		mutableNetInfo: mutableNetInfo{
-			id:   types.InvalidID,
-			nads: sets.Set[string]{},
+			id:      types.InvalidID,
+			nads:    sets.Set[string]{},
+			subnets: subnets,
 		},
 	}
 	ni.ipv4mode, ni.ipv6mode = getIPMode(subnets)
@@ -1018,7 +1097,6 @@ func newLayer2NetConfInfo(netconf *ovncnitypes.NetConf) (MutableNetInfo, error)
 		netName:               netconf.Name,
 		primaryNetwork:        netconf.Role == types.NetworkRolePrimary,
 		topology:              types.Layer2Topology,
-		subnets:               subnets,
 		joinSubnets:           joinSubnets,
 		excludeSubnets:        excludes,
 		reservedSubnets:       reserved,
@@ -1028,8 +1106,9 @@ func newLayer2NetConfInfo(netconf *ovncnitypes.NetConf) (MutableNetInfo, error)
 		defaultGatewayIPs:     defaultGatewayIPs,
 		managementIPs:         managementIPs,
 		mutableNetInfo: mutableNetInfo{
-			id:   types.InvalidID,
-			nads: sets.Set[string]{},
+			id:      types.InvalidID,
+			nads:    sets.Set[string]{},
+			subnets: subnets,
 		},
 	}
 	ni.ipv4mode, ni.ipv6mode = getIPMode(subnets)
@@ -1051,24 +1130,67 @@ func newLocalnetNetConfInfo(netconf *ovncnitypes.NetConf) (MutableNetInfo, error
 		return nil, err
 	}
 
+	allowedVLANs, err := parseVLANIDRanges(netconf.AllowedVLANs)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s netconf %s: %v", netconf.Topology, netconf.Name, err)
+	}
+	if len(allowedVLANs) > 0 && netconf.VLANID != 0 {
+		return nil, fmt.Errorf("invalid %s netconf %s: vlanID and allowedVLANs are mutually exclusive", netconf.Topology, netconf.Name)
+	}
+	if netconf.NativeVLAN != 0 && len(allowedVLANs) == 0 {
+		return nil, fmt.Errorf("invalid %s netconf %s: nativeVLAN requires allowedVLANs", netconf.Topology, netconf.Name)
+	}
+	if netconf.NativeVLAN < 0 || netconf.NativeVLAN > maxVLANID {
+		return nil, fmt.Errorf("invalid %s netconf %s: invalid nativeVLAN %d", netconf.Topology, netconf.Name, netconf.NativeVLAN)
+	}
+
 	ni := &userDefinedNetInfo{
 		netName:             netconf.Name,
 		topology:            types.LocalnetTopology,
-		subnets:             subnets,
 		excludeSubnets:      excludes,
 		mtu:                 netconf.MTU,
 		vlan:                uint(netconf.VLANID),
+		allowedVLANs:        allowedVLANs,
+		nativeVLAN:          uint(netconf.NativeVLAN),
 		allowPersistentIPs:  netconf.AllowPersistentIPs,
 		physicalNetworkName: netconf.PhysicalNetworkName,
 		mutableNetInfo: mutableNetInfo{
-			id:   types.InvalidID,
-			nads: sets.Set[string]{},
+			id:      types.InvalidID,
+			nads:    sets.Set[string]{},
+			subnets: subnets,
 		},
 	}
 	ni.ipv4mode, ni.ipv6mode = getIPMode(subnets)
 	return ni, nil
 }
 
+func lessCIDRNetworkEntry(a, b config.CIDRNetworkEntry) bool { return a.String() < b.String() }
+
+// isSubnetsSubset checks if every subnet in subnets is also part of
+// otherSubnets
+func isSubnetsSubset(subnets, otherSubnets []config.CIDRNetworkEntry) bool {
+	for _, subnet := range subnets {
+		if !slices.ContainsFunc(otherSubnets, func(other config.CIDRNetworkEntry) bool {
+			return other.String() == subnet.String()
+		}) {
+			return false
+		}
+	}
+	return true
+}
+
+// GetAddedSubnets returns the subnets in newSubnets that are not part of
+// oldSubnets
+func GetAddedSubnets(oldSubnets, newSubnets []config.CIDRNetworkEntry) []config.CIDRNetworkEntry {
+	var added []config.CIDRNetworkEntry
+	for _, subnet := range newSubnets {
+		if !isSubnetsSubset([]config.CIDRNetworkEntry{subnet}, oldSubnets) {
+			added = append(added, subnet)
+		}
+	}
+	return added
+}
+
 // parseNetworkSubnets parses network subnets based on the topology, returns nil if subnets is an empty string
 func parseNetworkSubnets(subnets, topology string) ([]config.CIDRNetworkEntry, error) {
 	if strings.TrimSpace(subnets) == "" {
@@ -1106,6 +1228,60 @@ func parseSubnetList(subnets string) ([]*net.IPNet, error) {
 	return nets, nil
 }
 
+// maxVLANID is the highest VLAN ID that can be used, 4095 being reserved
+const maxVLANID = 4094
+
+// parseVLANIDRanges parses a comma-separated list of VLAN IDs, or ranges of
+// VLAN IDs in the "<start>-<end>" format, into a sorted list of VLAN IDs.
+// Returns nil if ranges is an empty string.
+func parseVLANIDRanges(ranges string) ([]uint, error) {
+	if strings.TrimSpace(ranges) == "" {
+		return nil, nil
+	}
+	ids := sets.New[uint]()
+	for _, r := range strings.Split(ranges, ",") {
+		r = strings.TrimSpace(r)
+		startStr, endStr, isRange := strings.Cut(r, "-")
+		if !isRange {
+			endStr = startStr
+		}
+		start, err := strconv.ParseUint(startStr, 10, 16)
+		if err != nil {
+			return nil, fmt.Errorf("invalid VLAN ID range %q: %w", r, err)
+		}
+		end, err := strconv.ParseUint(endStr, 10, 16)
+		if err != nil {
+			return nil, fmt.Errorf("invalid VLAN ID range %q: %w", r, err)
+		}
+		if start < 1 || end > maxVLANID || start > end {
+			return nil, fmt.Errorf("invalid VLAN ID range %q", r)
+		}
+		for id := start; id <= end; id++ {
+			ids.Insert(uint(id))
+		}
+	}
+	return sets.List(ids), nil
+}
+
+// FormatVLANIDRanges formats a sorted list of VLAN IDs as a comma-separated
+// list of VLAN IDs, or ranges of VLAN IDs in the "<start>-<end>" format for
+// consecutive VLAN IDs. It is the reverse of parseVLANIDRanges.
+func FormatVLANIDRanges(ids []uint) string {
+	var ranges []string
+	for i := 0; i < len(ids); {
+		start, end := ids[i], ids[i]
+		for i++; i < len(ids) && ids[i] == end+1; i++ {
+			end = ids[i]
+		}
+		if start == end {
+			ranges = append(ranges, strconv.FormatUint(uint64(start), 10))
+		} else {
+			ranges = append(ranges, fmt.Sprintf("%d-%d", start, end))
+		}
+	}
+	return strings.Join(ranges, ",")
+}
+
 // validateSubnetContainment checks if every subnet in subnets is contained in containerSubnets
 // and returns a typed error using the provided error constructor function
 func validateSubnetContainment(subnets []*net.IPNet, containerSubnets []config.CIDRNetworkEntry,
@@ -1125,6 +1301,33 @@ func validateSubnetContainment(subnets []*net.IPNet, containerSubnets []config.C
 	return nil
 }
 
+// validateHostSubnetExcludes checks that every excluded subnet of a Layer3
+// network fits in a single host subnet of the network subnet that contains it,
+// and that it doesn't cover the gateway or management port IPs of that host
+// subnet. The excluded subnets must already be known to be contained in subnets.
+func validateHostSubnetExcludes(excludes []*net.IPNet, subnets []config.CIDRNetworkEntry) error {
+	for _, exclude := range excludes {
+		for _, subnet := range subnets {
+			if !ContainsCIDR(subnet.CIDR, exclude) {
+				continue
+			}
+			excludeLength, bits := exclude.Mask.Size()
+			if excludeLength < subnet.HostSubnetLength {
+				return config.NewExcludedSubnetSpansHostSubnetsError(exclude, subnet.HostSubnetLength)
+			}
+			hostMask := net.CIDRMask(subnet.HostSubnetLength, bits)
+			hostSubnet := &net.IPNet{IP: exclude.IP.Mask(hostMask), Mask: hostMask}
+			for _, nodeIP := range []net.IP{GetNodeGatewayIfAddr(hostSubnet).IP, GetNodeManagementIfAddr(hostSubnet).IP} {
+				if exclude.Contains(nodeIP) {
+					return config.NewExcludedSubnetContainsNodeIPError(exclude, nodeIP)
+				}
+			}
+			break
+		}
+	}
+	return nil
+}
+
 func parseJoinSubnet(joinSubnet string) ([]*net.IPNet, error) {
 	// assign the default values first
 	// if user provided only 1 family; we still populate the default value
@@ -1313,6 +1516,10 @@ func ValidateNetConf(nadName string, netconf *ovncnitypes.NetConf) error {
 		return fmt.Errorf("error parsing Network Attachment Definition %s: %w", nadName, ErrorUnsupportedIPAMKey)
 	}
 
+	if (netconf.AllowedVLANs != "" || netconf.NativeVLAN != 0) && netconf.Topology != types.LocalnetTopology {
+		return fmt.Errorf("allowedVLANs and nativeVLAN are only supported for localnet topology")
+	}
+
 	if netconf.JoinSubnet != "" && netconf.Topology == types.LocalnetTopology {
 		return fmt.Errorf("localnet topology does not allow specifying join-subnet as services are not supported")
 	}
@@ -141,6 +141,218 @@ func TestParseSubnetList(t *testing.T) {
 	}
 }
 
+func TestParseVLANIDRanges(t *testing.T) {
+	tests := []struct {
+		desc        string
+		ranges      string
+		expectedIDs []uint
+		expectError bool
+	}{
+		{
+			desc:        "VLAN IDs and ranges",
+			ranges:      "30, 10-12,11,4094",
+			expectedIDs: []uint{10, 11, 12, 30, 4094},
+		},
+		{
+			desc: "empty ranges",
+		},
+		{
+			desc:        "VLAN ID out of range",
+			ranges:      "10,4095",
+			expectError: true,
+		},
+		{
+			desc:        "reserved VLAN ID",
+			ranges:      "0-10",
+			expectError: true,
+		},
+		{
+			desc:        "range start higher than its end",
+			ranges:      "20-10",
+			expectError: true,
+		},
+		{
+			desc:        "invalid formatted range",
+			ranges:      "10-20-30",
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+
+			ids, err := parseVLANIDRanges(tc.ranges)
+			if tc.expectError {
+				g.Expect(err).To(gomega.HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			g.Expect(ids).To(gomega.Equal(tc.expectedIDs))
+		})
+	}
+}
+
+func TestFormatVLANIDRanges(t *testing.T) {
+	tests := []struct {
+		desc           string
+		ids            []uint
+		expectedRanges string
+	}{
+		{
+			desc:           "VLAN IDs and ranges",
+			ids:            []uint{5, 10, 11, 12, 30, 4093, 4094},
+			expectedRanges: "5,10-12,30,4093-4094",
+		},
+		{
+			desc: "no VLAN IDs",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+
+			ranges := FormatVLANIDRanges(tc.ids)
+			g.Expect(ranges).To(gomega.Equal(tc.expectedRanges))
+			ids, err := parseVLANIDRanges(ranges)
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			g.Expect(ids).To(gomega.Equal(tc.ids))
+		})
+	}
+}
+
+func TestNewLocalnetNetInfoVLANTrunk(t *testing.T) {
+	tests := []struct {
+		desc                 string
+		vlanID               int
+		allowedVLANs         string
+		nativeVLAN           int
+		expectedAllowedVLANs []uint
+		expectError          bool
+	}{
+		{
+			desc:                 "trunk with native VLAN",
+			allowedVLANs:         "10,20-21",
+			nativeVLAN:           5,
+			expectedAllowedVLANs: []uint{10, 20, 21},
+		},
+		{
+			desc:         "trunk and access VLAN",
+			vlanID:       10,
+			allowedVLANs: "10,20-21",
+			expectError:  true,
+		},
+		{
+			desc:        "native VLAN without trunk",
+			nativeVLAN:  5,
+			expectError: true,
+		},
+		{
+			desc:         "native VLAN out of range",
+			allowedVLANs: "10",
+			nativeVLAN:   4095,
+			expectError:  true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+
+			netInfo, err := NewNetInfo(&ovncnitypes.NetConf{
+				NetConf:      cnitypes.NetConf{Name: "localnet-network"},
+				Topology:     ovntypes.LocalnetTopology,
+				VLANID:       tc.vlanID,
+				AllowedVLANs: tc.allowedVLANs,
+				NativeVLAN:   tc.nativeVLAN,
+			})
+			if tc.expectError {
+				g.Expect(err).To(gomega.HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			g.Expect(netInfo.AllowedVLANs()).To(gomega.Equal(tc.expectedAllowedVLANs))
+			g.Expect(netInfo.NativeVLAN()).To(gomega.Equal(uint(tc.nativeVLAN)))
+		})
+	}
+}
+
+func TestNewLayer3NetInfoExcludeSubnets(t *testing.T) {
+	tests := []struct {
+		desc            string
+		subnets         string
+		excludeSubnets  string
+		expectedExclude []*net.IPNet
+		expectedError   error
+	}{
+		{
+			desc:            "exclude subnet within a host subnet",
+			subnets:         "10.128.0.0/16/24, fda6::/48",
+			excludeSubnets:  "10.128.3.200/30, fda6:0:0:5::100/120",
+			expectedExclude: ovntest.MustParseIPNets("10.128.3.200/30", "fda6:0:0:5::100/120"),
+		},
+		{
+			desc:            "exclude subnet covering half of a host subnet",
+			subnets:         "10.128.0.0/16/25",
+			excludeSubnets:  "10.128.3.64/26",
+			expectedExclude: ovntest.MustParseIPNets("10.128.3.64/26"),
+		},
+		{
+			desc:           "exclude subnet outside of the network subnets",
+			subnets:        "10.128.0.0/16/24",
+			excludeSubnets: "10.129.3.200/30",
+			expectedError:  config.NewExcludedSubnetNotContainedError(ovntest.MustParseIPNet("10.129.3.200/30")),
+		},
+		{
+			desc:           "exclude subnet spanning multiple host subnets",
+			subnets:        "10.128.0.0/16/24",
+			excludeSubnets: "10.128.2.0/23",
+			expectedError:  config.NewExcludedSubnetSpansHostSubnetsError(ovntest.MustParseIPNet("10.128.2.0/23"), 24),
+		},
+		{
+			desc:           "exclude subnet spanning multiple host subnets with the default host subnet length",
+			subnets:        "fda6::/48",
+			excludeSubnets: "fda6:0:0:4::/63",
+			expectedError:  config.NewExcludedSubnetSpansHostSubnetsError(ovntest.MustParseIPNet("fda6:0:0:4::/63"), 64),
+		},
+		{
+			desc:           "exclude subnet containing a node gateway IP",
+			subnets:        "10.128.0.0/16/24",
+			excludeSubnets: "10.128.3.0/30",
+			expectedError:  config.NewExcludedSubnetContainsNodeIPError(ovntest.MustParseIPNet("10.128.3.0/30"), ovntest.MustParseIP("10.128.3.1")),
+		},
+		{
+			desc:           "exclude subnet containing a node management IP",
+			subnets:        "10.128.0.0/16/24",
+			excludeSubnets: "10.128.3.2/32",
+			expectedError:  config.NewExcludedSubnetContainsNodeIPError(ovntest.MustParseIPNet("10.128.3.2/32"), ovntest.MustParseIP("10.128.3.2")),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			config.IPv4Mode = true
+			config.IPv6Mode = true
+
+			netInfo, err := NewNetInfo(&ovncnitypes.NetConf{
+				NetConf:        cnitypes.NetConf{Name: "l3-network"},
+				Topology:       ovntypes.Layer3Topology,
+				Role:           ovntypes.NetworkRolePrimary,
+				Subnets:        tc.subnets,
+				ExcludeSubnets: tc.excludeSubnets,
+			})
+			if tc.expectedError != nil {
+				g.Expect(err).To(gomega.MatchError(tc.expectedError))
+				return
+			}
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			g.Expect(netInfo.ExcludeSubnets()).To(gomega.Equal(tc.expectedExclude))
+		})
+	}
+}
+
 func TestValidateSubnetContainment(t *testing.T) {
 	tests := []struct {
 		desc             string
@@ -296,6 +508,41 @@ func TestParseNetconf(t *testing.T) {
 				NetConf:  cnitypes.NetConf{Name: "tenantred", Type: "ovn-k8s-cni-overlay"},
 			},
 		},
+		{
+			desc: "valid attachment definition for a localnet topology with a VLAN trunk",
+			inputNetAttachDefConfigSpec: `
+    {
+            "name": "tenantred",
+            "type": "ovn-k8s-cni-overlay",
+            "topology": "localnet",
+            "allowedVLANs": "10,20-30",
+            "nativeVLAN": 5,
+            "netAttachDefName": "ns1/nad1"
+    }
+`,
+			expectedNetConf: &ovncnitypes.NetConf{
+				Topology:     "localnet",
+				NADName:      "ns1/nad1",
+				MTU:          1400,
+				AllowedVLANs: "10,20-30",
+				NativeVLAN:   5,
+				NetConf:      cnitypes.NetConf{Name: "tenantred", Type: "ovn-k8s-cni-overlay"},
+			},
+		},
+		{
+			desc: "attachment definition with a VLAN trunk for a layer2 topology",
+			inputNetAttachDefConfigSpec: `
+    {
+            "name": "tenantred",
+            "type": "ovn-k8s-cni-overlay",
+            "topology": "layer2",
+            "subnets": "192.168.1.0/24",
+            "allowedVLANs": "10,20-30",
+            "netAttachDefName": "ns1/nad1"
+    }
+`,
+			expectedError: fmt.Errorf("allowedVLANs and nativeVLAN are only supported for localnet topology"),
+		},
 		{
 			desc: "valid attachment definition for the default network",
 			inputNetAttachDefConfigSpec: `
@@ -1587,6 +1834,34 @@ func TestAreNetworksCompatible(t *testing.T) {
 			expectedResult:         false,
 			expectationDescription: "we should reconcile on physical network name updates",
 		},
+		{
+			desc:                   "subnets appended",
+			aNetwork:               newLayer3NetInfo(t, "10.1.0.0/16/24,fd01::/48/64"),
+			anotherNetwork:         newLayer3NetInfo(t, "10.1.0.0/16/24,fd01::/48/64,10.2.0.0/16/24"),
+			expectedResult:         true,
+			expectationDescription: "subnets can be appended to a running network",
+		},
+		{
+			desc:                   "subnets removed",
+			aNetwork:               newLayer3NetInfo(t, "10.1.0.0/16/24,10.2.0.0/16/24"),
+			anotherNetwork:         newLayer3NetInfo(t, "10.1.0.0/16/24"),
+			expectedResult:         false,
+			expectationDescription: "subnets can't be removed from a running network",
+		},
+		{
+			desc:                   "subnet changed",
+			aNetwork:               newLayer3NetInfo(t, "10.1.0.0/16/24"),
+			anotherNetwork:         newLayer3NetInfo(t, "10.1.0.0/16/25"),
+			expectedResult:         false,
+			expectationDescription: "subnets of a running network can't be changed",
+		},
+		{
+			desc:                   "IP family appended",
+			aNetwork:               newLayer3NetInfo(t, "10.1.0.0/16/24"),
+			anotherNetwork:         newLayer3NetInfo(t, "10.1.0.0/16/24,fd01::/48/64"),
+			expectedResult:         false,
+			expectationDescription: "IP families of a running network can't be changed",
+		},
 	}
 
 	for _, test := range tests {
@@ -1600,6 +1875,35 @@ func TestAreNetworksCompatible(t *testing.T) {
 	}
 }
 
+func TestMutableNetInfoAddSubnets(t *testing.T) {
+	g := gomega.NewWithT(t)
+	netInfo := newLayer3NetInfo(t, "10.1.0.0/16/24")
+	mutableNetInfo := NewMutableNetInfo(netInfo)
+	added := newLayer3NetInfo(t, "10.1.0.0/16/24,10.2.0.0/16/24")
+
+	mutableNetInfo.AddSubnets(added.Subnets()...)
+	g.Expect(mutableNetInfo.Subnets()).To(gomega.Equal(added.Subnets()))
+	g.Expect(netInfo.Subnets()).To(gomega.HaveLen(1), "the original network should not be modified")
+	g.Expect(GetAddedSubnets(netInfo.Subnets(), mutableNetInfo.Subnets())).To(gomega.Equal(added.Subnets()[1:]))
+
+	// reconciling also appends the subnets
+	reconcilableNetInfo := NewReconcilableNetInfo(netInfo)
+	g.Expect(ReconcileNetInfo(reconcilableNetInfo, added)).To(gomega.Succeed())
+	g.Expect(reconcilableNetInfo.Subnets()).To(gomega.Equal(added.Subnets()))
+}
+
+func newLayer3NetInfo(t *testing.T, subnets string) NetInfo {
+	netInfo, err := NewNetInfo(&ovncnitypes.NetConf{
+		NetConf:  cnitypes.NetConf{Name: "l3-network"},
+		Topology: ovntypes.Layer3Topology,
+		Subnets:  subnets,
+	})
+	if err != nil {
+		t.Fatalf("failed to create network: %v", err)
+	}
+	return netInfo
+}
+
 func applyNADDefaults(nad *nadv1.NetworkAttachmentDefinition) *nadv1.NetworkAttachmentDefinition {
 	const (
 		name      = "nad1"
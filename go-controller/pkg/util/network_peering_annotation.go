@@ -0,0 +1,127 @@
+package util
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"sort"
+
+	utilnet "k8s.io/utils/net"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
+)
+
+const (
+	// NetworkPeeringAnnotation is set by cluster manager on a NetworkPeering once its networks are validated,
+	// it holds the peering ID and the subnets of the peered networks
+	NetworkPeeringAnnotation = "k8s.ovn.org/network-peering"
+
+	// MaxNetworkPeerings is the number of peerings the peering transit subnets have room for: every peering
+	// uses an IPv4 /29 (IPv6 /125), split in a /30 (/126) link per peered network
+	MaxNetworkPeerings = 1 << 13
+
+	networkPeeringLinkSize = 4
+	networkPeeringSize     = 2 * networkPeeringLinkSize
+)
+
+// NetworkPeeringInfo is the content of the NetworkPeeringAnnotation
+type NetworkPeeringInfo struct {
+	// ID is the unique ID of the peering, used to allocate the peering links
+	ID int `json:"id"`
+	// Networks maps the names of the peered networks to their subnets
+	Networks map[string][]string `json:"networks"`
+}
+
+// PeerOf returns the name of the network peered with the given network, or
+// an empty string if the given network is not part of the peering
+func (npi *NetworkPeeringInfo) PeerOf(networkName string) string {
+	if _, ok := npi.Networks[networkName]; !ok {
+		return ""
+	}
+	for name := range npi.Networks {
+		if name != networkName {
+			return name
+		}
+	}
+	return ""
+}
+
+// LinkIndex returns the index of the peering link of the given network
+func (npi *NetworkPeeringInfo) LinkIndex(networkName string) int {
+	names := make([]string, 0, len(npi.Networks))
+	for name := range npi.Networks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return sort.SearchStrings(names, networkName)
+}
+
+// Subnets returns the parsed subnets of the given network
+func (npi *NetworkPeeringInfo) Subnets(networkName string) ([]*net.IPNet, error) {
+	return ParseIPNets(npi.Networks[networkName])
+}
+
+// MarshalNetworkPeeringAnnotation returns the NetworkPeeringAnnotation value for the given peering
+func MarshalNetworkPeeringAnnotation(info *NetworkPeeringInfo) (string, error) {
+	bytes, err := json.Marshal(info)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal network peering annotation %+v: %v", info, err)
+	}
+	return string(bytes), nil
+}
+
+// ParseNetworkPeeringAnnotation parses the NetworkPeeringAnnotation from the given annotations
+func ParseNetworkPeeringAnnotation(annotations map[string]string) (*NetworkPeeringInfo, error) {
+	value, ok := annotations[NetworkPeeringAnnotation]
+	if !ok {
+		return nil, newAnnotationNotSetError("could not find %q annotation", NetworkPeeringAnnotation)
+	}
+	info := &NetworkPeeringInfo{}
+	if err := json.Unmarshal([]byte(value), info); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal %q annotation %q: %v", NetworkPeeringAnnotation, value, err)
+	}
+	if len(info.Networks) != 2 {
+		return nil, fmt.Errorf("invalid %q annotation %q: expected 2 networks", NetworkPeeringAnnotation, value)
+	}
+	return info, nil
+}
+
+// GetNetworkPeeringLinkIPs returns, for every configured IP family, the IPs of the ends of the link between the
+// cluster router of a peered network and the peering router, given the ID of the peering and the link index of the
+// network.
+func GetNetworkPeeringLinkIPs(id, linkIndex int) (clusterRouterIPs, peeringRouterIPs []*net.IPNet, err error) {
+	if id < 0 || id >= MaxNetworkPeerings {
+		return nil, nil, fmt.Errorf("invalid network peering ID %d", id)
+	}
+	transitSubnets := []struct {
+		enabled      bool
+		cidr         string
+		prefixLength int
+	}{
+		{config.IPv4Mode, types.NetworkPeeringTransitSubnetV4, 30},
+		{config.IPv6Mode, types.NetworkPeeringTransitSubnetV6, 126},
+	}
+	for _, transitSubnet := range transitSubnets {
+		if !transitSubnet.enabled {
+			continue
+		}
+		prefixLength := transitSubnet.prefixLength
+		_, subnet, err := net.ParseCIDR(transitSubnet.cidr)
+		if err != nil {
+			return nil, nil, err
+		}
+		base := utilnet.BigForIP(subnet.IP)
+		offset := id*networkPeeringSize + linkIndex*networkPeeringLinkSize
+		bits := len(subnet.IP) * 8
+		clusterRouterIPs = append(clusterRouterIPs, &net.IPNet{
+			IP:   utilnet.AddIPOffset(base, offset+1),
+			Mask: net.CIDRMask(prefixLength, bits),
+		})
+		peeringRouterIPs = append(peeringRouterIPs, &net.IPNet{
+			IP:   utilnet.AddIPOffset(base, offset+2),
+			Mask: net.CIDRMask(prefixLength, bits),
+		})
+	}
+	return clusterRouterIPs, peeringRouterIPs, nil
+}
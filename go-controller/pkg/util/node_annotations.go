@@ -94,6 +94,15 @@ const (
 	// OvnNodeEgressLabel is a user assigned node label indicating to ovn-kubernetes that the node is to be used for egress IP assignment
 	ovnNodeEgressLabel = "k8s.ovn.org/egress-assignable"
 
+	// OVNNodeEgressIPDrain is a user assigned node annotation indicating to ovn-kubernetes that the egress IPs
+	// assigned to the node are to be moved to other egress nodes, e.g. before maintenance, when set to "true"
+	OVNNodeEgressIPDrain = "k8s.ovn.org/egressip-drain"
+
+	// OVNNodeEgressIPBFDStatus is the status of the BFD sessions the node runs with the other egress nodes when
+	// the EgressIP node reachability is checked with BFD, keyed by the name of the other egress nodes
+	// "k8s.ovn.org/egressip-bfd-status": "{\"node2\":\"up\",\"node3\":\"down\"}"
+	OVNNodeEgressIPBFDStatus = "k8s.ovn.org/egressip-bfd-status"
+
 	// OVNNodeHostCIDRs is used to track the different host IP addresses and subnet masks on the node
 	OVNNodeHostCIDRs = "k8s.ovn.org/host-cidrs"
 
@@ -819,6 +828,39 @@ func GetNodeEgressLabel() string {
 	return ovnNodeEgressLabel
 }
 
+// IsNodeEgressIPDraining returns true if the egress IPs assigned to the node
+// are to be moved to other egress nodes
+func IsNodeEgressIPDraining(node *corev1.Node) bool {
+	return node.Annotations[OVNNodeEgressIPDrain] == "true"
+}
+
+const (
+	// EgressIPBFDStatusUp is the OVNNodeEgressIPBFDStatus of the egress nodes the node has an established BFD
+	// session with
+	EgressIPBFDStatusUp = "up"
+	// EgressIPBFDStatusDown is the OVNNodeEgressIPBFDStatus of the egress nodes the node had an established BFD
+	// session with, which went down since
+	EgressIPBFDStatusDown = "down"
+)
+
+// ParseNodeEgressIPBFDStatus returns the status of the BFD sessions the node
+// runs with the other egress nodes, keyed by the name of the other egress nodes
+func ParseNodeEgressIPBFDStatus(node *corev1.Node) (map[string]string, error) {
+	status := map[string]string{}
+	annotation, ok := node.Annotations[OVNNodeEgressIPBFDStatus]
+	if !ok {
+		return status, nil
+	}
+	if err := json.Unmarshal([]byte(annotation), &status); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal annotation %s for node %q: %v", OVNNodeEgressIPBFDStatus, node.Name, err)
+	}
+	return status, nil
+}
+
+func NodeEgressIPBFDStatusAnnotationChanged(oldNode, newNode *corev1.Node) bool {
+	return oldNode.Annotations[OVNNodeEgressIPBFDStatus] != newNode.Annotations[OVNNodeEgressIPBFDStatus]
+}
+
 func SetNodeHostCIDRs(nodeAnnotator kube.Annotator, cidrs sets.Set[string]) error {
 	return nodeAnnotator.Set(OVNNodeHostCIDRs, sets.List(cidrs))
 }
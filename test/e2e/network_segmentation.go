@@ -2341,8 +2341,8 @@ func generateCIDRforUDN(cs clientset.Interface, v4, v6 string) string {
 	return cidr
 }
 
-func filterDualStackCIDRs(cs clientset.Interface, cidrs udnv1.DualStackCIDRs) udnv1.DualStackCIDRs {
-	filteredCIDRs := make(udnv1.DualStackCIDRs, 0, len(cidrs))
+func filterDualStackCIDRs[T ~[]udnv1.CIDR](cs clientset.Interface, cidrs T) T {
+	filteredCIDRs := make(T, 0, len(cidrs))
 	for _, cidr := range cidrs {
 		if !isCIDRIPFamilySupported(cs, string(cidr)) {
 			continue
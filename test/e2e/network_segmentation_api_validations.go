@@ -31,6 +31,7 @@ var _ = Describe("Network Segmentation: API validations", func() {
 		Entry("ClusterUserDefinedNetwork, localnet, invalid vlan", testscenariocudn.LocalnetInvalidVLAN),
 		Entry("ClusterUserDefinedNetwork, layer2", testscenariocudn.Layer2CUDNInvalid),
 		Entry("UserDefinedNetwork, layer2", testscenariocudn.Layer2UDNInvalid),
+		Entry("ClusterUserDefinedNetwork, layer3", testscenariocudn.Layer3CUDNInvalid),
 	)
 
 	DescribeTable("api-server should accept valid CRs",
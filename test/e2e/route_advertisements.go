@@ -463,7 +463,7 @@ var _ = ginkgo.Describe("BGP: Pod to external server when CUDN network is advert
 						Topology: udnv1.NetworkTopologyLayer2,
 						Layer2: &udnv1.Layer2Config{
 							Role:    "Primary",
-							Subnets: udnv1.DualStackCIDRs{"103.0.0.0/16", "2014:100::0/60"},
+							Subnets: udnv1.SubnetCIDRs{"103.0.0.0/16", "2014:100::0/60"},
 						},
 					},
 				},
@@ -1165,7 +1165,7 @@ var _ = ginkgo.DescribeTableSubtree("BGP: isolation between advertised networks"
 					Topology: udnv1.NetworkTopologyLayer2,
 					Layer2: &udnv1.Layer2Config{
 						Role:    "Primary",
-						Subnets: udnv1.DualStackCIDRs{"102.102.0.0/16", "2013:100:200::0/60"},
+						Subnets: udnv1.SubnetCIDRs{"102.102.0.0/16", "2013:100:200::0/60"},
 					},
 				},
 			},
@@ -1179,7 +1179,7 @@ var _ = ginkgo.DescribeTableSubtree("BGP: isolation between advertised networks"
 					Topology: udnv1.NetworkTopologyLayer2,
 					Layer2: &udnv1.Layer2Config{
 						Role:    "Primary",
-						Subnets: udnv1.DualStackCIDRs{"103.103.0.0/16", "2014:100:200::0/60"},
+						Subnets: udnv1.SubnetCIDRs{"103.103.0.0/16", "2014:100:200::0/60"},
 					},
 				},
 			},
@@ -1326,7 +1326,7 @@ var _ = ginkgo.Describe("BGP: For a VRF-Lite configured network", feature.RouteA
 			Topology: udnv1.NetworkTopologyLayer2,
 			Layer2: &udnv1.Layer2Config{
 				Role:    "Primary",
-				Subnets: udnv1.DualStackCIDRs{cudnCIDRv4, cudnCIDRv6},
+				Subnets: udnv1.SubnetCIDRs{cudnCIDRv4, cudnCIDRv6},
 			},
 		}
 	)
@@ -1657,7 +1657,7 @@ var _ = ginkgo.Describe("BGP: For a VRF-Lite configured network", feature.RouteA
 							Topology: udnv1.NetworkTopologyLayer2,
 							Layer2: &udnv1.Layer2Config{
 								Role:    "Primary",
-								Subnets: udnv1.DualStackCIDRs{otherUDNCIDRv4, otherUDNCIDRv6},
+								Subnets: udnv1.SubnetCIDRs{otherUDNCIDRv4, otherUDNCIDRv6},
 							},
 						}
 					)
@@ -2373,7 +2373,7 @@ func checkL3NodePodRoute(node corev1.Node, serverContainerIP, routerContainerNam
 }
 
 // checkL2NodePodRoute checks that BGP routes for the given CIDRs are present in the FRR router.
-func checkL2NodePodRoute(node corev1.Node, serverContainerIP, routerContainerName string, cidrs udnv1.DualStackCIDRs) {
+func checkL2NodePodRoute(node corev1.Node, serverContainerIP, routerContainerName string, cidrs udnv1.SubnetCIDRs) {
 	isServerIPv6 := utilnet.IsIPv6String(serverContainerIP)
 	for _, podCIDR := range cidrs {
 		isPodCIDRv6 := utilnet.IsIPv6CIDRString(string(podCIDR))
@@ -19,6 +19,23 @@ spec:
       role: Secondary
       subnets: ["192.168.1.0/24"]
       defaultGatewayIPs: ["192.168.1.1"]
+`,
+	},
+	{
+		Description: "subnets must not overlap",
+		ExpectedErr: `CIDRs must not overlap`,
+		Manifest: `
+apiVersion: k8s.ovn.org/v1
+kind: ClusterUserDefinedNetwork
+metadata:
+  name: overlapping-subnets-fail
+spec:
+  namespaceSelector: {matchLabels: {kubernetes.io/metadata.name: red}}
+  network:
+    topology: Layer2
+    layer2:
+      role: Secondary
+      subnets: ["192.168.0.0/16", "192.168.1.0/24"]
 `,
 	},
 	{
@@ -0,0 +1,42 @@
+package cudn
+
+import "github.com/ovn-org/ovn-kubernetes/test/e2e/testscenario"
+
+var Layer3CUDNInvalid = []testscenario.ValidateCRScenario{
+	{
+		Description: "excludeSubnets must be in range of subnets",
+		ExpectedErr: `excludeSubnets must be subnetworks of the networks specified in the subnets field`,
+		Manifest: `
+apiVersion: k8s.ovn.org/v1
+kind: ClusterUserDefinedNetwork
+metadata:
+  name: exclude-subnets-outside-subnets-fail
+spec:
+  namespaceSelector: {matchLabels: {kubernetes.io/metadata.name: red}}
+  network:
+    topology: Layer3
+    layer3:
+      role: Secondary
+      subnets: [{cidr: "10.100.0.0/16", hostSubnet: 24}]
+      excludeSubnets: ["10.200.3.200/30"]
+`,
+	},
+	{
+		Description: "excludeSubnets must not span multiple host subnets",
+		ExpectedErr: `excludeSubnets must not span multiple host subnets`,
+		Manifest: `
+apiVersion: k8s.ovn.org/v1
+kind: ClusterUserDefinedNetwork
+metadata:
+  name: exclude-subnets-span-host-subnets-fail
+spec:
+  namespaceSelector: {matchLabels: {kubernetes.io/metadata.name: red}}
+  network:
+    topology: Layer3
+    layer3:
+      role: Secondary
+      subnets: [{cidr: "10.100.0.0/16", hostSubnet: 24}]
+      excludeSubnets: ["10.100.2.0/23"]
+`,
+	},
+}